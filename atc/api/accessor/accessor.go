@@ -14,10 +14,12 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedForPipeline(teamName string, pipelineName string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
 	TeamRoles() map[string][]string
+	PipelineRoles() map[string]map[string][]string
 	Claims() Claims
 }
 
@@ -39,16 +41,25 @@ type Verification struct {
 type access struct {
 	verification      Verification
 	requiredRole      string
+	customRoles       []string
 	systemClaimKey    string
 	systemClaimValues []string
 	teams             []db.Team
 	teamRoles         map[string][]string
+	pipelineBindings  map[string][]pipelineBinding
 	isAdmin           bool
 }
 
+// pipelineBinding is a role granted on only some of the pipelines of a team.
+type pipelineBinding struct {
+	role   string
+	config map[string][]string
+}
+
 func NewAccessor(
 	verification Verification,
 	requiredRole string,
+	customRoles []string,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
@@ -56,6 +67,7 @@ func NewAccessor(
 	a := &access{
 		verification:      verification,
 		requiredRole:      requiredRole,
+		customRoles:       customRoles,
 		systemClaimKey:    systemClaimKey,
 		systemClaimValues: systemClaimValues,
 		teams:             teams,
@@ -67,9 +79,24 @@ func NewAccessor(
 
 func (a *access) computeTeamRoles() {
 	a.teamRoles = map[string][]string{}
+	a.pipelineBindings = map[string][]pipelineBinding{}
+
+	apiTokenTeam, apiTokenRole, isAPIToken := a.apiTokenScope()
 
 	for _, team := range a.teams {
-		roles := a.rolesForTeam(team.Auth())
+		var roles []string
+		if isAPIToken {
+			// API tokens only have their one role, on the team they belong to
+			if strings.EqualFold(team.Name(), apiTokenTeam) {
+				roles = []string{apiTokenRole}
+			}
+		} else {
+			var bindings []pipelineBinding
+			roles, bindings = a.rolesForTeam(team.Auth())
+			if len(bindings) > 0 {
+				a.pipelineBindings[team.Name()] = bindings
+			}
+		}
 		if len(roles) > 0 {
 			a.teamRoles[team.Name()] = roles
 		}
@@ -88,52 +115,70 @@ func contains(arr []string, val string) bool {
 	return false
 }
 
-func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
+// rolesForTeam returns the roles the user has on the whole team, and the
+// bindings which grant them roles on only some of its pipelines.
+func (a *access) rolesForTeam(auth atc.TeamAuth) ([]string, []pipelineBinding) {
 	roleSet := map[string]bool{}
+	var bindings []pipelineBinding
+
+	for key, config := range auth {
+		if !a.isBound(config) {
+			continue
+		}
+
+		role := atc.BindingRole(key)
+		if _, scoped := config[atc.TeamAuthPipelines]; scoped {
+			bindings = append(bindings, pipelineBinding{role: role, config: config})
+		} else {
+			roleSet[role] = true
+		}
+	}
+
+	var roles []string
+	for role := range roleSet {
+		roles = append(roles, role)
+	}
+	return roles, bindings
+}
+
+func (a *access) isBound(config map[string][]string) bool {
+	userAuth := config["users"]
+	groupAuth := config["groups"]
+
+	// backwards compatibility for allow-all-users
+	if len(userAuth) == 0 && len(groupAuth) == 0 {
+		return true
+	}
 
 	groups := a.groups()
 	connectorID := a.connectorID()
 	userID := a.userID()
 	userName := a.UserName()
 
-	for role, auth := range auth {
-		userAuth := auth["users"]
-		groupAuth := auth["groups"]
-
-		// backwards compatibility for allow-all-users
-		if len(userAuth) == 0 && len(groupAuth) == 0 {
-			roleSet[role] = true
-		}
-
-		for _, user := range userAuth {
-			if userID != "" {
-				if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userID)) {
-					roleSet[role] = true
-				}
+	for _, user := range userAuth {
+		if userID != "" {
+			if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userID)) {
+				return true
 			}
-			if userName != "" {
-				if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userName)) {
-					roleSet[role] = true
-				}
+		}
+		if userName != "" {
+			if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userName)) {
+				return true
 			}
 		}
+	}
 
-		for _, group := range groupAuth {
-			for _, claimGroup := range groups {
-				if claimGroup != "" {
-					if strings.EqualFold(group, fmt.Sprintf("%v:%v", connectorID, claimGroup)) {
-						roleSet[role] = true
-					}
+	for _, group := range groupAuth {
+		for _, claimGroup := range groups {
+			if claimGroup != "" {
+				if strings.EqualFold(group, fmt.Sprintf("%v:%v", connectorID, claimGroup)) {
+					return true
 				}
 			}
 		}
 	}
 
-	var roles []string
-	for role := range roleSet {
-		roles = append(roles, role)
-	}
-	return roles
+	return false
 }
 
 func (a *access) HasToken() bool {
@@ -148,6 +193,22 @@ func (a *access) IsAuthorized(teamName string) bool {
 	return a.isAdmin || a.hasPermission(a.teamRoles[teamName])
 }
 
+// IsAuthorizedForPipeline is like IsAuthorized, but also considers the roles
+// granted on only some of the pipelines of the team.
+func (a *access) IsAuthorizedForPipeline(teamName string, pipelineName string) bool {
+	if a.IsAuthorized(teamName) {
+		return true
+	}
+
+	for _, binding := range a.pipelineBindings[teamName] {
+		if atc.BindingAppliesToPipeline(binding.config, pipelineName) && a.hasPermission([]string{binding.role}) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *access) TeamNames() []string {
 	teamNames := []string{}
 	for _, team := range a.teams {
@@ -161,17 +222,8 @@ func (a *access) TeamNames() []string {
 
 func (a *access) hasPermission(roles []string) bool {
 	for _, role := range roles {
-		switch a.requiredRole {
-		case OwnerRole:
-			return role == OwnerRole
-		case MemberRole:
-			return role == OwnerRole || role == MemberRole
-		case OperatorRole:
-			return role == OwnerRole || role == MemberRole || role == OperatorRole
-		case ViewerRole:
-			return role == OwnerRole || role == MemberRole || role == OperatorRole || role == ViewerRole
-		default:
-			return false
+		if satisfiesRole(role, a.requiredRole) || contains(a.customRoles, role) {
+			return true
 		}
 	}
 	return false
@@ -223,6 +275,26 @@ func (a *access) connectorID() string {
 	return a.federatedClaim("connector_id")
 }
 
+func (a *access) apiTokenScope() (string, string, bool) {
+	raw, ok := a.claims()["api_token"]
+	if !ok {
+		return "", "", false
+	}
+
+	scope, ok := raw.(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	team, _ := scope["team"].(string)
+	role, _ := scope["role"].(string)
+	if team == "" || role == "" {
+		return "", "", false
+	}
+
+	return team, role, true
+}
+
 func (a *access) groups() []string {
 	groups := []string{}
 	if raw, ok := a.claims()["groups"]; ok {
@@ -256,6 +328,22 @@ func (a *access) TeamRoles() map[string][]string {
 	return a.teamRoles
 }
 
+// PipelineRoles returns, by team, the pipeline patterns on which the user has
+// roles that don't apply to the whole team.
+func (a *access) PipelineRoles() map[string]map[string][]string {
+	pipelineRoles := map[string]map[string][]string{}
+	for teamName, bindings := range a.pipelineBindings {
+		patternRoles := map[string][]string{}
+		for _, binding := range bindings {
+			for _, pattern := range binding.config[atc.TeamAuthPipelines] {
+				patternRoles[pattern] = append(patternRoles[pattern], binding.role)
+			}
+		}
+		pipelineRoles[teamName] = patternRoles
+	}
+	return pipelineRoles
+}
+
 func (a *access) Claims() Claims {
 	return Claims{
 		Sub:       a.claim("sub"),
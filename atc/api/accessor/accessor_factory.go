@@ -40,12 +40,12 @@ type accessFactory struct {
 	systemClaimValues []string
 }
 
-func (a *accessFactory) Create(req *http.Request, role string) (Access, error) {
+func (a *accessFactory) Create(req *http.Request, role string, customRoles []string) (Access, error) {
 	teams, err := a.teamFetcher.GetTeams()
 	if err != nil {
 		return nil, fmt.Errorf("fetch teams: %w", err)
 	}
-	return NewAccessor(a.verifyToken(req), role, a.systemClaimKey, a.systemClaimValues, teams), nil
+	return NewAccessor(a.verifyToken(req), role, customRoles, a.systemClaimKey, a.systemClaimValues, teams), nil
 }
 
 func (a *accessFactory) verifyToken(req *http.Request) Verification {
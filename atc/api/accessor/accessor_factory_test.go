@@ -46,7 +46,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		JustBeforeEach(func() {
 			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues)
-			access, err = factory.Create(dummyRequest, role)
+			access, err = factory.Create(dummyRequest, role, nil)
 		})
 
 		Context("when the token is valid", func() {
@@ -37,7 +37,7 @@ var _ = Describe("Accessor", func() {
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+		access = accessor.NewAccessor(verification, requiredRole, nil, "sub", []string{"system"}, teams)
 	})
 
 	Describe("HasToken", func() {
@@ -190,7 +190,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, nil, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -237,7 +237,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, nil, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -609,6 +609,156 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("IsAuthorizedForPipeline", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+				"groups": []interface{}{"deployers"},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"viewer": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+				"member/1": map[string][]string{
+					"groups":    []string{"some-connector:deployers"},
+					"pipelines": []string{"prod-*"},
+				},
+				"owner/1": map[string][]string{
+					"users":     []string{"some-connector:someone-else"},
+					"pipelines": []string{"*"},
+				},
+			})
+		})
+
+		DescribeTable("pipeline-scoped roles",
+			func(role string, pipelineName string, authorized bool) {
+				requiredRole = role
+				access = accessor.NewAccessor(verification, requiredRole, nil, "sub", []string{"system"}, teams)
+
+				Expect(access.IsAuthorizedForPipeline("some-team-1", pipelineName)).To(Equal(authorized))
+			},
+			Entry("viewer on the whole team", accessor.ViewerRole, "staging", true),
+			Entry("member on a matching pipeline", accessor.MemberRole, "prod-eu", true),
+			Entry("member on another pipeline", accessor.MemberRole, "staging", false),
+			Entry("owner bound to someone else", accessor.OwnerRole, "prod-eu", false),
+		)
+
+		It("doesn't grant the scoped roles on the whole team", func() {
+			access = accessor.NewAccessor(verification, accessor.MemberRole, nil, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{"some-team-1": {"viewer"}}))
+			Expect(access.PipelineRoles()).To(Equal(map[string]map[string][]string{
+				"some-team-1": {"prod-*": {"member"}},
+			}))
+		})
+
+		It("doesn't grant roles on other teams", func() {
+			access = accessor.NewAccessor(verification, accessor.ViewerRole, nil, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAuthorizedForPipeline("some-team-2", "prod-eu")).To(BeFalse())
+		})
+	})
+
+	Describe("custom roles", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"viewer": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+				"release-manager": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+		})
+
+		It("authorizes the actions the custom role allows", func() {
+			access = accessor.NewAccessor(verification, accessor.OperatorRole, []string{"release-manager"}, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+			Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+		})
+
+		It("doesn't authorize actions the custom role doesn't allow", func() {
+			access = accessor.NewAccessor(verification, accessor.OperatorRole, []string{"other-role"}, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+		})
+
+		It("still considers the built-in roles", func() {
+			access = accessor.NewAccessor(verification, accessor.ViewerRole, []string{"other-role"}, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+			Expect(access.TeamRoles()["some-team-1"]).To(ConsistOf("viewer", "release-manager"))
+		})
+	})
+
+	Describe("Permissions", func() {
+		var (
+			customRoles map[string]string
+			roleActions accessor.RoleActions
+		)
+
+		BeforeEach(func() {
+			customRoles = map[string]string{}
+			roleActions = accessor.RoleActions{
+				"release-manager": {atc.CreateJobBuild, atc.PinResourceVersion},
+			}
+		})
+
+		It("includes the actions of the built-in roles the user has", func() {
+			permissions := accessor.Permissions([]string{"viewer"}, customRoles, roleActions)
+
+			Expect(permissions).To(ContainElement(atc.GetPipeline))
+			Expect(permissions).ToNot(ContainElement(atc.CreateJobBuild))
+			Expect(permissions).ToNot(ContainElement(atc.SaveConfig))
+		})
+
+		It("includes the actions of the custom roles the user has", func() {
+			permissions := accessor.Permissions([]string{"release-manager"}, customRoles, roleActions)
+
+			Expect(permissions).To(Equal([]string{atc.CreateJobBuild, atc.PinResourceVersion}))
+		})
+
+		It("considers the customized built-in roles", func() {
+			customRoles[atc.SaveConfig] = accessor.ViewerRole
+
+			permissions := accessor.Permissions([]string{"viewer"}, customRoles, roleActions)
+
+			Expect(permissions).To(ContainElement(atc.SaveConfig))
+		})
+	})
+
+	Describe("HasRole", func() {
+		It("is satisfied by a greater built-in role", func() {
+			Expect(accessor.HasRole([]string{"viewer", "owner"}, accessor.MemberRole)).To(BeTrue())
+		})
+
+		It("is not satisfied by a lesser built-in role", func() {
+			Expect(accessor.HasRole([]string{"viewer", "operator"}, accessor.MemberRole)).To(BeFalse())
+		})
+
+		It("is satisfied only by the custom role itself", func() {
+			Expect(accessor.HasRole([]string{"release-manager"}, "release-manager")).To(BeTrue())
+			Expect(accessor.HasRole([]string{"owner"}, "release-manager")).To(BeFalse())
+		})
+	})
+
 	Describe("TeamRoles", func() {
 		var result map[string][]string
 
@@ -771,5 +921,39 @@ var _ = Describe("Accessor", func() {
 				})
 			})
 		})
+
+		Context("when the token is an API token", func() {
+			BeforeEach(func() {
+				verification.HasToken = true
+				verification.IsTokenValid = true
+				verification.RawClaims = map[string]interface{}{
+					"sub": "api-token:some-team-2/deploy-bot",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "api-token",
+						"user_name":    "deploy-bot",
+					},
+					"api_token": map[string]interface{}{
+						"team": "some-team-2",
+						"role": "member",
+					},
+				}
+
+				// teams allowing all users must not grant API tokens any roles
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{},
+				})
+				fakeTeam2.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{
+						"users": []string{"api-token:deploy-bot"},
+					},
+				})
+			})
+
+			It("only has the token's role on the token's team", func() {
+				Expect(result).To(Equal(map[string][]string{
+					"some-team-2": {"member"},
+				}))
+			})
+		})
 	})
 })
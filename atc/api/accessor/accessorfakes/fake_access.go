@@ -59,6 +59,18 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAuthorizedForPipelineStub        func(string, string) bool
+	isAuthorizedForPipelineMutex       sync.RWMutex
+	isAuthorizedForPipelineArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isAuthorizedForPipelineReturns struct {
+		result1 bool
+	}
+	isAuthorizedForPipelineReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -69,6 +81,16 @@ type FakeAccess struct {
 	isSystemReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PipelineRolesStub        func() map[string]map[string][]string
+	pipelineRolesMutex       sync.RWMutex
+	pipelineRolesArgsForCall []struct {
+	}
+	pipelineRolesReturns struct {
+		result1 map[string]map[string][]string
+	}
+	pipelineRolesReturnsOnCall map[int]struct {
+		result1 map[string]map[string][]string
+	}
 	TeamNamesStub        func() []string
 	teamNamesMutex       sync.RWMutex
 	teamNamesArgsForCall []struct {
@@ -361,6 +383,67 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAuthorizedForPipeline(arg1 string, arg2 string) bool {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	ret, specificReturn := fake.isAuthorizedForPipelineReturnsOnCall[len(fake.isAuthorizedForPipelineArgsForCall)]
+	fake.isAuthorizedForPipelineArgsForCall = append(fake.isAuthorizedForPipelineArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("IsAuthorizedForPipeline", []interface{}{arg1, arg2})
+	fake.isAuthorizedForPipelineMutex.Unlock()
+	if fake.IsAuthorizedForPipelineStub != nil {
+		return fake.IsAuthorizedForPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAuthorizedForPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCallCount() int {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	return len(fake.isAuthorizedForPipelineArgsForCall)
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCalls(stub func(string, string) bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = stub
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineArgsForCall(i int) (string, string) {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	argsForCall := fake.isAuthorizedForPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturns(result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	fake.isAuthorizedForPipelineReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturnsOnCall(i int, result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	if fake.isAuthorizedForPipelineReturnsOnCall == nil {
+		fake.isAuthorizedForPipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAuthorizedForPipelineReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -413,6 +496,58 @@ func (fake *FakeAccess) IsSystemReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) PipelineRoles() map[string]map[string][]string {
+	fake.pipelineRolesMutex.Lock()
+	ret, specificReturn := fake.pipelineRolesReturnsOnCall[len(fake.pipelineRolesArgsForCall)]
+	fake.pipelineRolesArgsForCall = append(fake.pipelineRolesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRoles", []interface{}{})
+	fake.pipelineRolesMutex.Unlock()
+	if fake.PipelineRolesStub != nil {
+		return fake.PipelineRolesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) PipelineRolesCallCount() int {
+	fake.pipelineRolesMutex.RLock()
+	defer fake.pipelineRolesMutex.RUnlock()
+	return len(fake.pipelineRolesArgsForCall)
+}
+
+func (fake *FakeAccess) PipelineRolesCalls(stub func() map[string]map[string][]string) {
+	fake.pipelineRolesMutex.Lock()
+	defer fake.pipelineRolesMutex.Unlock()
+	fake.PipelineRolesStub = stub
+}
+
+func (fake *FakeAccess) PipelineRolesReturns(result1 map[string]map[string][]string) {
+	fake.pipelineRolesMutex.Lock()
+	defer fake.pipelineRolesMutex.Unlock()
+	fake.PipelineRolesStub = nil
+	fake.pipelineRolesReturns = struct {
+		result1 map[string]map[string][]string
+	}{result1}
+}
+
+func (fake *FakeAccess) PipelineRolesReturnsOnCall(i int, result1 map[string]map[string][]string) {
+	fake.pipelineRolesMutex.Lock()
+	defer fake.pipelineRolesMutex.Unlock()
+	fake.PipelineRolesStub = nil
+	if fake.pipelineRolesReturnsOnCall == nil {
+		fake.pipelineRolesReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[string][]string
+		})
+	}
+	fake.pipelineRolesReturnsOnCall[i] = struct {
+		result1 map[string]map[string][]string
+	}{result1}
+}
+
 func (fake *FakeAccess) TeamNames() []string {
 	fake.teamNamesMutex.Lock()
 	ret, specificReturn := fake.teamNamesReturnsOnCall[len(fake.teamNamesArgsForCall)]
@@ -530,8 +665,12 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
+	fake.pipelineRolesMutex.RLock()
+	defer fake.pipelineRolesMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
 	defer fake.teamNamesMutex.RUnlock()
 	fake.teamRolesMutex.RLock()
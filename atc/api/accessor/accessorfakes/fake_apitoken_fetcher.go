@@ -0,0 +1,194 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package accessorfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+type FakeAPITokenFetcher struct {
+	GetAPITokenStub        func(string) (atc.APIToken, bool, error)
+	getAPITokenMutex       sync.RWMutex
+	getAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	getAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	getAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	RecordAPITokenUseStub        func(int) error
+	recordAPITokenUseMutex       sync.RWMutex
+	recordAPITokenUseArgsForCall []struct {
+		arg1 int
+	}
+	recordAPITokenUseReturns struct {
+		result1 error
+	}
+	recordAPITokenUseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPITokenFetcher) GetAPIToken(arg1 string) (atc.APIToken, bool, error) {
+	fake.getAPITokenMutex.Lock()
+	ret, specificReturn := fake.getAPITokenReturnsOnCall[len(fake.getAPITokenArgsForCall)]
+	fake.getAPITokenArgsForCall = append(fake.getAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAPIToken", []interface{}{arg1})
+	fake.getAPITokenMutex.Unlock()
+	if fake.GetAPITokenStub != nil {
+		return fake.GetAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPITokenFetcher) GetAPITokenCallCount() int {
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	return len(fake.getAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFetcher) GetAPITokenCalls(stub func(string) (atc.APIToken, bool, error)) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFetcher) GetAPITokenArgsForCall(i int) string {
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	argsForCall := fake.getAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFetcher) GetAPITokenReturns(result1 atc.APIToken, result2 bool, result3 error) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = nil
+	fake.getAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFetcher) GetAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 bool, result3 error) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = nil
+	if fake.getAPITokenReturnsOnCall == nil {
+		fake.getAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUse(arg1 int) error {
+	fake.recordAPITokenUseMutex.Lock()
+	ret, specificReturn := fake.recordAPITokenUseReturnsOnCall[len(fake.recordAPITokenUseArgsForCall)]
+	fake.recordAPITokenUseArgsForCall = append(fake.recordAPITokenUseArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RecordAPITokenUse", []interface{}{arg1})
+	fake.recordAPITokenUseMutex.Unlock()
+	if fake.RecordAPITokenUseStub != nil {
+		return fake.RecordAPITokenUseStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordAPITokenUseReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUseCallCount() int {
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	return len(fake.recordAPITokenUseArgsForCall)
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUseCalls(stub func(int) error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = stub
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUseArgsForCall(i int) int {
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	argsForCall := fake.recordAPITokenUseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUseReturns(result1 error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = nil
+	fake.recordAPITokenUseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAPITokenFetcher) RecordAPITokenUseReturnsOnCall(i int, result1 error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = nil
+	if fake.recordAPITokenUseReturnsOnCall == nil {
+		fake.recordAPITokenUseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordAPITokenUseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAPITokenFetcher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPITokenFetcher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ accessor.APITokenFetcher = new(FakeAPITokenFetcher)
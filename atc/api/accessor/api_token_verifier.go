@@ -0,0 +1,71 @@
+package accessor
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// APITokenConnector is the connector of the claims of requests made with an
+// API token.
+const APITokenConnector = "api-token"
+
+//go:generate counterfeiter . APITokenFetcher
+
+type APITokenFetcher interface {
+	GetAPIToken(rawToken string) (atc.APIToken, bool, error)
+	RecordAPITokenUse(id int) error
+}
+
+// NewAPITokenVerifier verifies the API tokens of teams, and leaves any other
+// token to be verified by the given verifier.
+func NewAPITokenVerifier(apiTokenFetcher APITokenFetcher, verifier TokenVerifier) *apiTokenVerifier {
+	return &apiTokenVerifier{
+		apiTokenFetcher: apiTokenFetcher,
+		verifier:        verifier,
+	}
+}
+
+type apiTokenVerifier struct {
+	apiTokenFetcher APITokenFetcher
+	verifier        TokenVerifier
+}
+
+func (v *apiTokenVerifier) Verify(r *http.Request) (map[string]interface{}, error) {
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") || !strings.HasPrefix(parts[1], atc.APITokenPrefix) {
+		return v.verifier.Verify(r)
+	}
+
+	apiToken, found, err := v.apiTokenFetcher.GetAPIToken(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	if apiToken.ExpiresAt != 0 && time.Now().Unix() >= apiToken.ExpiresAt {
+		return nil, ErrVerificationTokenExpired
+	}
+
+	err = v.apiTokenFetcher.RecordAPITokenUse(apiToken.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"sub": APITokenConnector + ":" + apiToken.TeamName + "/" + apiToken.Name,
+		"federated_claims": map[string]interface{}{
+			"user_name":    apiToken.Name,
+			"connector_id": APITokenConnector,
+		},
+		"api_token": map[string]interface{}{
+			"team": apiToken.TeamName,
+			"role": apiToken.Role,
+		},
+	}, nil
+}
@@ -0,0 +1,88 @@
+package accessor
+
+import (
+	"crypto/x509"
+	"net/http"
+)
+
+// CertificateConnector is the connector of the claims of requests
+// authenticated with a client certificate. Teams grant it roles with users
+// and groups like 'cert:spiffe://example.org/ci' or 'cert:platform'.
+const CertificateConnector = "cert"
+
+// NewCertificateVerifier authenticates requests made without a token but with
+// a client certificate which the web server has verified, and leaves any
+// other request to be verified by the given verifier.
+//
+// Requests made by browsers are never authenticated by their certificate: a
+// browser presents it on requests that other sites trigger too, and without
+// a token there is no CSRF token to check them against.
+func NewCertificateVerifier(verifier TokenVerifier) *certificateVerifier {
+	return &certificateVerifier{
+		verifier: verifier,
+	}
+}
+
+type certificateVerifier struct {
+	verifier TokenVerifier
+}
+
+func (v *certificateVerifier) Verify(r *http.Request) (map[string]interface{}, error) {
+	if r.Header.Get("Authorization") != "" || r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || fromBrowser(r) {
+		return v.verifier.Verify(r)
+	}
+
+	cert := r.TLS.VerifiedChains[0][0]
+
+	userID, userName := certificateIdentity(cert)
+	if userID == "" {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	groups := []interface{}{}
+	for _, unit := range cert.Subject.OrganizationalUnit {
+		groups = append(groups, unit)
+	}
+
+	return map[string]interface{}{
+		"sub":  CertificateConnector + ":" + userID,
+		"name": userName,
+		"federated_claims": map[string]interface{}{
+			"user_id":      userID,
+			"user_name":    userName,
+			"connector_id": CertificateConnector,
+		},
+		"groups": groups,
+	}, nil
+}
+
+// fromBrowser tells requests made by browsers by the headers they add to
+// them, which scripts can't set or remove. Browsers send an Origin with every
+// request that isn't a GET or HEAD, and current ones describe every request
+// with the Sec-Fetch headers.
+func fromBrowser(r *http.Request) bool {
+	return r.Header.Get("Origin") != "" ||
+		r.Header.Get("Sec-Fetch-Site") != "" ||
+		r.Header.Get("Sec-Fetch-Mode") != ""
+}
+
+// certificateIdentity identifies the subject of a certificate by its URI SAN,
+// e.g. a SPIFFE ID, and names it by its common name. Either one stands in for
+// the other when the certificate only has one of them.
+func certificateIdentity(cert *x509.Certificate) (string, string) {
+	var uri string
+	if len(cert.URIs) > 0 {
+		uri = cert.URIs[0].String()
+	}
+
+	commonName := cert.Subject.CommonName
+
+	switch {
+	case uri != "" && commonName != "":
+		return uri, commonName
+	case uri != "":
+		return uri, uri
+	default:
+		return commonName, commonName
+	}
+}
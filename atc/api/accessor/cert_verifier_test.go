@@ -0,0 +1,166 @@
+package accessor_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CertificateVerifier", func() {
+	var (
+		fakeVerifier *accessorfakes.FakeTokenVerifier
+
+		cert *x509.Certificate
+		req  *http.Request
+
+		verifier accessor.TokenVerifier
+
+		claims map[string]interface{}
+		err    error
+	)
+
+	BeforeEach(func() {
+		fakeVerifier = new(accessorfakes.FakeTokenVerifier)
+		fakeVerifier.VerifyReturns(map[string]interface{}{"sub": "some-user"}, nil)
+
+		spiffeID, _ := url.Parse("spiffe://example.org/ns/ci/sa/deployer")
+		cert = &x509.Certificate{
+			Subject: pkix.Name{
+				CommonName:         "deployer",
+				OrganizationalUnit: []string{"platform"},
+			},
+			URIs: []*url.URL{spiffeID},
+		}
+
+		req, _ = http.NewRequest("GET", "localhost:8080", nil)
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}
+
+		verifier = accessor.NewCertificateVerifier(fakeVerifier)
+	})
+
+	JustBeforeEach(func() {
+		claims, err = verifier.Verify(req)
+	})
+
+	Context("when the request has a verified client certificate", func() {
+		It("returns claims identifying the certificate's subject", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(map[string]interface{}{
+				"sub":  "cert:spiffe://example.org/ns/ci/sa/deployer",
+				"name": "deployer",
+				"federated_claims": map[string]interface{}{
+					"user_id":      "spiffe://example.org/ns/ci/sa/deployer",
+					"user_name":    "deployer",
+					"connector_id": "cert",
+				},
+				"groups": []interface{}{"platform"},
+			}))
+			Expect(fakeVerifier.VerifyCallCount()).To(BeZero())
+		})
+
+		Context("when the certificate has no URI SAN", func() {
+			BeforeEach(func() {
+				cert.URIs = nil
+			})
+
+			It("identifies the subject by its common name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claims["sub"]).To(Equal("cert:deployer"))
+				Expect(claims["federated_claims"]).To(Equal(map[string]interface{}{
+					"user_id":      "deployer",
+					"user_name":    "deployer",
+					"connector_id": "cert",
+				}))
+			})
+		})
+
+		Context("when the certificate has no common name", func() {
+			BeforeEach(func() {
+				cert.Subject.CommonName = ""
+			})
+
+			It("names the subject by its URI SAN", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claims["name"]).To(Equal("spiffe://example.org/ns/ci/sa/deployer"))
+			})
+		})
+
+		Context("when the certificate identifies no subject", func() {
+			BeforeEach(func() {
+				cert.URIs = nil
+				cert.Subject.CommonName = ""
+			})
+
+			It("errors", func() {
+				Expect(err).To(Equal(accessor.ErrVerificationInvalidToken))
+			})
+		})
+
+		Context("when a browser makes the request", func() {
+			BeforeEach(func() {
+				req.Method = "POST"
+				req.Header.Set("Origin", "https://example.com")
+			})
+
+			It("delegates to the other verifier", func() {
+				Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a browser navigates to the API", func() {
+			BeforeEach(func() {
+				req.Header.Set("Sec-Fetch-Site", "cross-site")
+				req.Header.Set("Sec-Fetch-Mode", "navigate")
+			})
+
+			It("delegates to the other verifier", func() {
+				Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the request also has a token", func() {
+			BeforeEach(func() {
+				req.Header.Set("Authorization", "Bearer some-access-token")
+			})
+
+			It("delegates to the other verifier", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claims).To(Equal(map[string]interface{}{"sub": "some-user"}))
+				Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the client certificate was not verified", func() {
+		BeforeEach(func() {
+			req.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+			}
+		})
+
+		It("delegates to the other verifier", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(map[string]interface{}{"sub": "some-user"}))
+			Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the request was not made over TLS", func() {
+		BeforeEach(func() {
+			req.TLS = nil
+		})
+
+		It("delegates to the other verifier", func() {
+			Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -3,32 +3,49 @@ package accessor
 import (
 	"encoding/json"
 	"sync"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/golang/groupcache/lru"
 )
 
 type claimsCacheEntry struct {
-	claims db.Claims
-	size   int
+	claims    db.Claims
+	size      int
+	expiresAt time.Time
 }
 
 type claimsCacher struct {
+	logger             lager.Logger
+	notifications      Notifications
 	accessTokenFetcher AccessTokenFetcher
 	maxCacheSizeBytes  int
+	expiration         time.Duration
 
 	cache          *lru.Cache
 	cacheSizeBytes int
 	mu             sync.Mutex // lru.Cache is not safe for concurrent access
 }
 
+// NewClaimsCacher caches the claims of access tokens. The cache is cleared
+// whenever tokens are revoked, so that revoked tokens stop working right away.
+// Claims are only cached for the expiration, so that a revocation is picked up
+// even if its notification is missed.
 func NewClaimsCacher(
+	logger lager.Logger,
+	notifications Notifications,
 	accessTokenFetcher AccessTokenFetcher,
 	maxCacheSizeBytes int,
+	expiration time.Duration,
 ) *claimsCacher {
 	c := &claimsCacher{
+		logger:             logger,
+		notifications:      notifications,
 		accessTokenFetcher: accessTokenFetcher,
 		maxCacheSizeBytes:  maxCacheSizeBytes,
+		expiration:         expiration,
 		cache:              lru.New(0),
 	}
 	c.cache.OnEvicted = func(_ lru.Key, value interface{}) {
@@ -36,6 +53,8 @@ func NewClaimsCacher(
 		c.cacheSizeBytes -= entry.size
 	}
 
+	go c.waitForNotifications()
+
 	return c
 }
 
@@ -46,18 +65,31 @@ func (c *claimsCacher) GetAccessToken(rawToken string) (db.AccessToken, bool, er
 	claims, found := c.cache.Get(rawToken)
 	if found {
 		entry, _ := claims.(claimsCacheEntry)
-		return db.AccessToken{Token: rawToken, Claims: entry.claims}, true, nil
+		if time.Now().Before(entry.expiresAt) {
+			return db.AccessToken{Token: rawToken, Claims: entry.claims}, true, nil
+		}
+
+		c.cache.Remove(rawToken)
 	}
 
 	token, found, err := c.accessTokenFetcher.GetAccessToken(rawToken)
 	if err != nil {
 		return db.AccessToken{}, false, err
 	}
+
+	// don't remember tokens that don't exist; they may have been revoked
+	if !found {
+		return db.AccessToken{}, false, nil
+	}
 	payload, err := json.Marshal(token.Claims)
 	if err != nil {
 		return db.AccessToken{}, false, err
 	}
-	entry := claimsCacheEntry{claims: token.Claims, size: len(payload)}
+	entry := claimsCacheEntry{
+		claims:    token.Claims,
+		size:      len(payload),
+		expiresAt: time.Now().Add(c.expiration),
+	}
 	c.cache.Add(rawToken, entry)
 	c.cacheSizeBytes += entry.size
 
@@ -67,3 +99,26 @@ func (c *claimsCacher) GetAccessToken(rawToken string) (db.AccessToken, bool, er
 
 	return token, true, nil
 }
+
+func (c *claimsCacher) waitForNotifications() {
+	var notifier chan bool
+	for {
+		var err error
+		notifier, err = c.notifications.Listen(atc.AccessTokenCacheChannel)
+		if err == nil {
+			break
+		}
+
+		// cached claims expire in the meantime, so retrying as often is enough
+		c.logger.Error("failed-to-listen-for-access-token-cache", err)
+		time.Sleep(c.expiration)
+	}
+
+	defer c.notifications.Unlisten(atc.AccessTokenCacheChannel, notifier)
+
+	for range notifier {
+		c.mu.Lock()
+		c.cache.Clear()
+		c.mu.Unlock()
+	}
+}
@@ -2,7 +2,10 @@ package accessor_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
@@ -14,18 +17,27 @@ import (
 var _ = Describe("ClaimsCacher", func() {
 	var (
 		fakeAccessTokenFetcher *accessorfakes.FakeAccessTokenFetcher
+		fakeNotifications      *accessorfakes.FakeNotifications
+		notifier               chan bool
 		maxCacheSizeBytes      int
+		expiration             time.Duration
 
 		claimsCacher accessor.AccessTokenFetcher
 	)
 
 	BeforeEach(func() {
 		fakeAccessTokenFetcher = new(accessorfakes.FakeAccessTokenFetcher)
+		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, true, nil)
 		maxCacheSizeBytes = 1000
+		expiration = time.Minute
+
+		notifier = make(chan bool, 1)
+		fakeNotifications = new(accessorfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
 	})
 
 	JustBeforeEach(func() {
-		claimsCacher = accessor.NewClaimsCacher(fakeAccessTokenFetcher, maxCacheSizeBytes)
+		claimsCacher = accessor.NewClaimsCacher(lager.NewLogger("test"), fakeNotifications, fakeAccessTokenFetcher, maxCacheSizeBytes, expiration)
 	})
 
 	It("fetches claims from the DB", func() {
@@ -39,6 +51,65 @@ var _ = Describe("ClaimsCacher", func() {
 		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(1), "did not cache claims")
 	})
 
+	It("doesn't cache tokens that aren't found", func() {
+		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, false, nil)
+
+		_, found, err := claimsCacher.GetAccessToken("token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		claimsCacher.GetAccessToken("token")
+		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(2))
+	})
+
+	It("forgets the cached claims when tokens are revoked", func() {
+		claimsCacher.GetAccessToken("token")
+		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(1))
+
+		Eventually(fakeNotifications.ListenCallCount).Should(Equal(1))
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(atc.AccessTokenCacheChannel))
+
+		notifier <- true
+
+		Eventually(func() int {
+			claimsCacher.GetAccessToken("token")
+			return fakeAccessTokenFetcher.GetAccessTokenCallCount()
+		}).Should(BeNumerically(">", 1))
+	})
+
+	Context("when the cached claims have expired", func() {
+		BeforeEach(func() {
+			expiration = 10 * time.Millisecond
+		})
+
+		It("fetches them from the DB again", func() {
+			claimsCacher.GetAccessToken("token")
+			time.Sleep(2 * expiration)
+			claimsCacher.GetAccessToken("token")
+
+			Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when listening for revocations fails", func() {
+		BeforeEach(func() {
+			expiration = 10 * time.Millisecond
+			fakeNotifications.ListenReturnsOnCall(0, nil, errors.New("nope"))
+		})
+
+		It("tries listening again", func() {
+			Eventually(fakeNotifications.ListenCallCount).Should(Equal(2))
+
+			claimsCacher.GetAccessToken("token")
+			notifier <- true
+
+			Eventually(func() int {
+				claimsCacher.GetAccessToken("token")
+				return fakeAccessTokenFetcher.GetAccessTokenCallCount()
+			}).Should(BeNumerically(">", 1))
+		})
+	})
+
 	It("doesn't cache claims when cache size is exceeded", func() {
 		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{
 			Claims: db.Claims{RawClaims: map[string]interface{}{"a": stringWithLen(2000)}},
@@ -13,7 +13,9 @@ import (
 //go:generate counterfeiter . AccessFactory
 
 type AccessFactory interface {
-	Create(req *http.Request, role string) (Access, error)
+	// Create returns the access of the request to an action which requires
+	// the built-in role, or any of the custom roles.
+	Create(req *http.Request, role string, customRoles []string) (Access, error)
 }
 
 func NewHandler(
@@ -23,6 +25,7 @@ func NewHandler(
 	accessFactory AccessFactory,
 	auditor auditor.Auditor,
 	customRoles map[string]string,
+	roleActions RoleActions,
 ) http.Handler {
 	return &accessorHandler{
 		logger:        logger,
@@ -31,6 +34,7 @@ func NewHandler(
 		action:        action,
 		auditor:       auditor,
 		customRoles:   customRoles,
+		roleActions:   roleActions,
 	}
 }
 
@@ -41,6 +45,7 @@ type accessorHandler struct {
 	accessFactory AccessFactory
 	auditor       auditor.Auditor
 	customRoles   map[string]string
+	roleActions   RoleActions
 }
 
 func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -49,7 +54,7 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc, err := h.accessFactory.Create(r, requiredRole)
+	acc, err := h.accessFactory.Create(r, requiredRole, h.roleActions.RolesAllowing(h.action))
 	if err != nil {
 		h.logger.Error("failed-to-construct-accessor", err)
 		w.WriteHeader(http.StatusInternalServerError)
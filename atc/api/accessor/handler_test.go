@@ -27,6 +27,7 @@ var _ = Describe("Handler", func() {
 
 		action      string
 		customRoles map[string]string
+		roleActions accessor.RoleActions
 
 		r *http.Request
 		w *httptest.ResponseRecorder
@@ -42,6 +43,7 @@ var _ = Describe("Handler", func() {
 
 		action = "some-action"
 		customRoles = map[string]string{"some-action": "some-role"}
+		roleActions = accessor.RoleActions{}
 
 		var err error
 		r, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -59,6 +61,7 @@ var _ = Describe("Handler", func() {
 			fakeAccessorFactory,
 			fakeAuditor,
 			customRoles,
+			roleActions,
 		)
 
 		handler.ServeHTTP(w, r)
@@ -77,7 +80,7 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, role, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.MemberRole))
 				})
 			})
@@ -91,12 +94,29 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, role, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.ViewerRole))
 				})
 			})
 		})
 
+		Context("when custom roles allow the action", func() {
+			BeforeEach(func() {
+				action = atc.CreateJobBuild
+				roleActions = accessor.RoleActions{
+					"release-manager": {atc.CreateJobBuild, atc.PinResourceVersion},
+					"pinner":          {atc.PinResourceVersion},
+				}
+			})
+
+			It("sends the custom roles along with the role", func() {
+				Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
+				_, role, customRoles := fakeAccessorFactory.CreateArgsForCall(0)
+				Expect(role).To(Equal(accessor.OperatorRole))
+				Expect(customRoles).To(ConsistOf("release-manager"))
+			})
+		})
+
 		Context("when there's no default role for the given action", func() {
 			BeforeEach(func() {
 				action = "some-admin-role"
@@ -109,7 +129,7 @@ var _ = Describe("Handler", func() {
 
 				It("sends a blank role (admin roles don't have defaults)", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, role, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(BeEmpty())
 				})
 			})
@@ -1,6 +1,8 @@
 package accessor
 
 import (
+	"sort"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -23,6 +25,8 @@ var DefaultRoles = map[string]string{
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
+	atc.ListBuildApprovals:            ViewerRole,
+	atc.ApproveBuild:                  ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
 	atc.RerunJobBuild:                 OperatorRole,
@@ -30,6 +34,7 @@ var DefaultRoles = map[string]string{
 	atc.ListJobs:                      ViewerRole,
 	atc.ListJobBuilds:                 ViewerRole,
 	atc.ListJobInputs:                 ViewerRole,
+	atc.ExplainJobInputs:              ViewerRole,
 	atc.GetJobBuild:                   ViewerRole,
 	atc.PauseJob:                      OperatorRole,
 	atc.UnpauseJob:                    OperatorRole,
@@ -39,6 +44,7 @@ var DefaultRoles = map[string]string{
 	atc.MainJobBadge:                  ViewerRole,
 	atc.ClearTaskCache:                OperatorRole,
 	atc.ListAllResources:              ViewerRole,
+	atc.ListStaleResources:            ViewerRole,
 	atc.ListResources:                 ViewerRole,
 	atc.ListResourceTypes:             ViewerRole,
 	atc.GetResource:                   ViewerRole,
@@ -67,6 +73,8 @@ var DefaultRoles = map[string]string{
 	atc.HidePipeline:                  MemberRole,
 	atc.RenamePipeline:                MemberRole,
 	atc.ListPipelineBuilds:            ViewerRole,
+	atc.GetPipelineDeliveryMetrics:    ViewerRole,
+	atc.ListPipelineBlockedBuilds:     ViewerRole,
 	atc.CreatePipelineBuild:           MemberRole,
 	atc.PipelineBadge:                 ViewerRole,
 	atc.RegisterWorker:                MemberRole,
@@ -99,4 +107,76 @@ var DefaultRoles = map[string]string{
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
+	atc.ListAPITokens:                 OwnerRole,
+	atc.CreateAPIToken:                OwnerRole,
+	atc.DeleteAPIToken:                OwnerRole,
+	atc.ListAuditEvents:               OwnerRole,
+	atc.ListTeamAuditEvents:           OwnerRole,
+}
+
+// RoleActions are the custom roles defined in the RBAC config, by the actions
+// they allow. Unlike the built-in roles, they don't form a hierarchy: a custom
+// role allows exactly the actions it lists.
+type RoleActions map[string][]string
+
+// RolesAllowing returns the custom roles which allow the action.
+func (r RoleActions) RolesAllowing(action string) []string {
+	var roles []string
+	for role, actions := range r {
+		if contains(actions, action) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// Permissions returns the actions allowed by any of the roles, given the
+// built-in roles customized by customRoles and the custom roles defined by
+// roleActions.
+func Permissions(roles []string, customRoles map[string]string, roleActions RoleActions) []string {
+	permissions := []string{}
+	for action, requiredRole := range DefaultRoles {
+		if customRole, ok := customRoles[action]; ok {
+			requiredRole = customRole
+		}
+
+		for _, role := range roles {
+			if satisfiesRole(role, requiredRole) || contains(roleActions[role], action) {
+				permissions = append(permissions, action)
+				break
+			}
+		}
+	}
+
+	sort.Strings(permissions)
+
+	return permissions
+}
+
+// satisfiesRole returns whether the built-in role is at least the required
+// role.
+func satisfiesRole(role string, requiredRole string) bool {
+	switch requiredRole {
+	case OwnerRole:
+		return role == OwnerRole
+	case MemberRole:
+		return role == OwnerRole || role == MemberRole
+	case OperatorRole:
+		return role == OwnerRole || role == MemberRole || role == OperatorRole
+	case ViewerRole:
+		return role == OwnerRole || role == MemberRole || role == OperatorRole || role == ViewerRole
+	default:
+		return false
+	}
+}
+
+// HasRole returns whether any of the roles is at least the given built-in role,
+// or is the given custom role.
+func HasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role || satisfiesRole(r, role) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,100 @@
+package accessor
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/patrickmn/go-cache"
+)
+
+// teamPoliciesCacher caches the policy profiles and exemptions of teams, which
+// are looked up for every policy check. Like the teams cache, it's cleared
+// whenever a team is set, and also whenever an exemption is created or deleted.
+type teamPoliciesCacher struct {
+	logger        lager.Logger
+	cache         *cache.Cache
+	notifications Notifications
+	teamPolicies  db.TeamPolicies
+}
+
+type cachedExemption struct {
+	exemption atc.PolicyExemption
+	found     bool
+}
+
+func NewTeamPoliciesCacher(
+	logger lager.Logger,
+	notifications Notifications,
+	teamPolicies db.TeamPolicies,
+	expiration time.Duration,
+	cleanupInterval time.Duration,
+) *teamPoliciesCacher {
+	c := &teamPoliciesCacher{
+		logger:        logger,
+		cache:         cache.New(expiration, cleanupInterval),
+		notifications: notifications,
+		teamPolicies:  teamPolicies,
+	}
+
+	go c.waitForNotifications()
+
+	return c
+}
+
+func (c *teamPoliciesCacher) PolicyProfile(teamName string) (string, error) {
+	key := "profile:" + strings.ToLower(teamName)
+	if profile, found := c.cache.Get(key); found {
+		return profile.(string), nil
+	}
+
+	profile, err := c.teamPolicies.PolicyProfile(teamName)
+	if err != nil {
+		return "", err
+	}
+
+	c.cache.Set(key, profile, cache.DefaultExpiration)
+
+	return profile, nil
+}
+
+func (c *teamPoliciesCacher) ActiveExemption(teamName string, action string) (atc.PolicyExemption, bool, error) {
+	key := "exemption:" + strings.ToLower(teamName) + ":" + action
+	if cached, found := c.cache.Get(key); found {
+		entry := cached.(cachedExemption)
+
+		// the exemption may have expired since it was cached; as it was the
+		// one expiring last, no other exemption applies either
+		if entry.found && time.Now().Unix() >= entry.exemption.ExpiresAt {
+			return atc.PolicyExemption{}, false, nil
+		}
+
+		return entry.exemption, entry.found, nil
+	}
+
+	exemption, found, err := c.teamPolicies.ActiveExemption(teamName, action)
+	if err != nil {
+		return atc.PolicyExemption{}, false, err
+	}
+
+	c.cache.Set(key, cachedExemption{exemption, found}, cache.DefaultExpiration)
+
+	return exemption, found, nil
+}
+
+func (c *teamPoliciesCacher) waitForNotifications() {
+	notifier, err := c.notifications.Listen(atc.TeamCacheChannel)
+	if err != nil {
+		// entries still expire, so changes are only picked up later
+		c.logger.Error("failed-to-listen-for-team-policies-cache", err)
+		return
+	}
+
+	defer c.notifications.Unlisten(atc.TeamCacheChannel, notifier)
+
+	for range notifier {
+		c.cache.Flush()
+	}
+}
@@ -0,0 +1,158 @@
+package accessor_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamPoliciesCacher", func() {
+	var (
+		fakeNotifications *accessorfakes.FakeNotifications
+		fakeTeamPolicies  *dbfakes.FakeTeamPolicies
+		notifier          chan bool
+
+		teamPolicies policy.TeamPolicies
+	)
+
+	BeforeEach(func() {
+		notifier = make(chan bool, 1)
+		fakeNotifications = new(accessorfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
+
+		fakeTeamPolicies = new(dbfakes.FakeTeamPolicies)
+		fakeTeamPolicies.PolicyProfileReturns("strict", nil)
+	})
+
+	JustBeforeEach(func() {
+		teamPolicies = accessor.NewTeamPoliciesCacher(lager.NewLogger("test"), fakeNotifications, fakeTeamPolicies, time.Minute, time.Minute)
+	})
+
+	Describe("PolicyProfile", func() {
+		It("fetches the profile of a team from the DB once", func() {
+			profile, err := teamPolicies.PolicyProfile("some-team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profile).To(Equal("strict"))
+
+			profile, err = teamPolicies.PolicyProfile("Some-Team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profile).To(Equal("strict"))
+
+			Expect(fakeTeamPolicies.PolicyProfileCallCount()).To(Equal(1))
+		})
+
+		It("fetches the profile of each team", func() {
+			_, err := teamPolicies.PolicyProfile("some-team")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = teamPolicies.PolicyProfile("other-team")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeTeamPolicies.PolicyProfileCallCount()).To(Equal(2))
+		})
+
+		Context("when fetching the profile fails", func() {
+			BeforeEach(func() {
+				fakeTeamPolicies.PolicyProfileReturnsOnCall(0, "", errors.New("nope"))
+			})
+
+			It("doesn't cache the failure", func() {
+				_, err := teamPolicies.PolicyProfile("some-team")
+				Expect(err).To(HaveOccurred())
+
+				profile, err := teamPolicies.PolicyProfile("some-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(profile).To(Equal("strict"))
+			})
+		})
+
+		Context("when it receives a notification", func() {
+			It("fetches the profile from the DB again", func() {
+				_, err := teamPolicies.PolicyProfile("some-team")
+				Expect(err).ToNot(HaveOccurred())
+
+				notifier <- true
+
+				Eventually(func() int {
+					_, err := teamPolicies.PolicyProfile("some-team")
+					Expect(err).ToNot(HaveOccurred())
+					return fakeTeamPolicies.PolicyProfileCallCount()
+				}).Should(BeNumerically(">=", 2))
+			})
+		})
+	})
+
+	Describe("ActiveExemption", func() {
+		var exemption atc.PolicyExemption
+
+		BeforeEach(func() {
+			exemption = atc.PolicyExemption{
+				ID:        1,
+				Team:      "some-team",
+				Action:    "SaveConfig",
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			}
+
+			fakeTeamPolicies.ActiveExemptionReturns(exemption, true, nil)
+		})
+
+		It("fetches the exemption of a team for an action from the DB once", func() {
+			found, ok, err := teamPolicies.ActiveExemption("some-team", "SaveConfig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(found).To(Equal(exemption))
+
+			found, ok, err = teamPolicies.ActiveExemption("some-team", "SaveConfig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(found).To(Equal(exemption))
+
+			Expect(fakeTeamPolicies.ActiveExemptionCallCount()).To(Equal(1))
+
+			_, _, err = teamPolicies.ActiveExemption("some-team", "UseImage")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeTeamPolicies.ActiveExemptionCallCount()).To(Equal(2))
+		})
+
+		Context("when the team has no exemption", func() {
+			BeforeEach(func() {
+				fakeTeamPolicies.ActiveExemptionReturns(atc.PolicyExemption{}, false, nil)
+			})
+
+			It("caches that too", func() {
+				_, ok, err := teamPolicies.ActiveExemption("some-team", "SaveConfig")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+
+				_, ok, err = teamPolicies.ActiveExemption("some-team", "SaveConfig")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+
+				Expect(fakeTeamPolicies.ActiveExemptionCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the cached exemption has expired", func() {
+			BeforeEach(func() {
+				exemption.ExpiresAt = time.Now().Add(-time.Second).Unix()
+				fakeTeamPolicies.ActiveExemptionReturns(exemption, true, nil)
+			})
+
+			It("no longer applies", func() {
+				_, _, err := teamPolicies.ActiveExemption("some-team", "SaveConfig")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, ok, err := teamPolicies.ActiveExemption("some-team", "SaveConfig")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+})
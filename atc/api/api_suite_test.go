@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
+	"github.com/concourse/concourse/atc/api/jobserver/jobserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/creds"
@@ -51,6 +52,7 @@ var (
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccess              *accessorfakes.FakeAccess
 	fakeAccessor            *accessorfakes.FakeAccessFactory
+	fakeAuditor             *auditorfakes.FakeAuditor
 	dbWorkerFactory         *dbfakes.FakeWorkerFactory
 	dbWorkerLifecycle       *dbfakes.FakeWorkerLifecycle
 	build                   *dbfakes.FakeBuild
@@ -59,6 +61,15 @@ var (
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbTeamPolicies          *dbfakes.FakeTeamPolicies
+	dbAPITokenFactory       *dbfakes.FakeAPITokenFactory
+	dbAuditLog              *dbfakes.FakeAuditLog
+	dbLocalUserFactory      *dbfakes.FakeLocalUserFactory
+	dbAccessTokenFactory    *dbfakes.FakeAccessTokenFactory
+	dbAccessTokenLifecycle  *dbfakes.FakeAccessTokenLifecycle
+	customRoles             map[string]string
+	roleActions             accessor.RoleActions
+	fakeInputsExplainer     *jobserverfakes.FakeInputsExplainer
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -106,6 +117,15 @@ var _ = BeforeEach(func() {
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbTeamPolicies = new(dbfakes.FakeTeamPolicies)
+	dbAPITokenFactory = new(dbfakes.FakeAPITokenFactory)
+	dbAuditLog = new(dbfakes.FakeAuditLog)
+	dbLocalUserFactory = new(dbfakes.FakeLocalUserFactory)
+	dbAccessTokenFactory = new(dbfakes.FakeAccessTokenFactory)
+	dbAccessTokenLifecycle = new(dbfakes.FakeAccessTokenLifecycle)
+	customRoles = map[string]string{}
+	roleActions = accessor.RoleActions{}
+	fakeInputsExplainer = new(jobserverfakes.FakeInputsExplainer)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -117,7 +137,11 @@ var _ = BeforeEach(func() {
 	dbTeamFactory.GetByIDReturns(dbTeam)
 
 	fakeAccess = new(accessorfakes.FakeAccess)
+	fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, _ string) bool {
+		return fakeAccess.IsAuthorized(teamName)
+	}
 	fakeAccessor = new(accessorfakes.FakeAccessFactory)
+	fakeAuditor = new(auditorfakes.FakeAuditor)
 	fakeAccessor.CreateReturns(fakeAccess, nil)
 
 	fakePipeline = new(dbfakes.FakePipeline)
@@ -193,8 +217,10 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		fakeInputsExplainer,
 
 		constructedEventHandler.Construct,
+		fakeAuditor,
 
 		fakeWorkerClient,
 
@@ -211,6 +237,15 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		dbTeamPolicies,
+		dbAPITokenFactory,
+		dbAuditLog,
+		dbLocalUserFactory,
+		[]string{"some-static-user"},
+		dbAccessTokenFactory,
+		dbAccessTokenLifecycle,
+		customRoles,
+		roleActions,
 		fakeClock,
 	)
 
@@ -223,8 +258,9 @@ var _ = BeforeEach(func() {
 		"some-action",
 		handler,
 		fakeAccessor,
-		new(auditorfakes.FakeAuditor),
+		fakeAuditor,
 		map[string]string{},
+		accessor.RoleActions{},
 	)
 
 	handler = wrappa.LoggerHandler{
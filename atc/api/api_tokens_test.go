@@ -0,0 +1,350 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var response *http.Response
+
+	BeforeEach(func() {
+		dbTeam.NameReturns("some-team")
+	})
+
+	Describe("GET /api/v1/teams/:team_name/tokens", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/some-team/tokens", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokenFactory.ListAPITokensCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				dbAPITokenFactory.ListAPITokensReturns([]atc.APIToken{
+					{
+						ID:         1,
+						Name:       "deployer",
+						TeamName:   "some-team",
+						Role:       "member",
+						CreatedBy:  "some-owner",
+						CreatedAt:  100,
+						LastUsedAt: 110,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				expectedHeaderEntries := map[string]string{
+					"Content-Type": "application/json",
+				}
+				Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+			})
+
+			It("lists the tokens of the team", func() {
+				Expect(dbAPITokenFactory.ListAPITokensArgsForCall(0)).To(Equal("some-team"))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 1,
+						"name": "deployer",
+						"team_name": "some-team",
+						"role": "member",
+						"created_by": "some-owner",
+						"created_at": 100,
+						"last_used_at": 110
+					}
+				]`))
+			})
+
+			Context("when listing the tokens fails", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.ListAPITokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/tokens", func() {
+		var apiToken atc.APIToken
+
+		BeforeEach(func() {
+			apiToken = atc.APIToken{
+				Name:      "deployer",
+				Role:      "member",
+				ExpiresAt: 3600,
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(apiToken)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/tokens", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-owner"})
+
+				dbAPITokenFactory.CreateAPITokenStub = func(token string, t atc.APIToken) (atc.APIToken, error) {
+					t.ID = 42
+					t.CreatedAt = 123
+					return t, nil
+				}
+			})
+
+			It("returns 201", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			})
+
+			It("creates the token on the team on behalf of the user", func() {
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+
+				token, created := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+				Expect(token).To(HavePrefix(atc.APITokenPrefix))
+				Expect(len(token)).To(BeNumerically(">", len(atc.APITokenPrefix)+32))
+				Expect(created).To(Equal(atc.APIToken{
+					Name:      "deployer",
+					TeamName:  "some-team",
+					Role:      "member",
+					CreatedBy: "some-owner",
+					ExpiresAt: 3600,
+				}))
+			})
+
+			It("returns the token, which can't be fetched again", func() {
+				token, _ := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+
+				var created atc.APIToken
+				Expect(json.NewDecoder(response.Body).Decode(&created)).To(Succeed())
+				Expect(created).To(Equal(atc.APIToken{
+					ID:        42,
+					Name:      "deployer",
+					TeamName:  "some-team",
+					Role:      "member",
+					CreatedBy: "some-owner",
+					CreatedAt: 123,
+					ExpiresAt: 3600,
+					Token:     token,
+				}))
+			})
+
+			Context("when the name is missing", func() {
+				BeforeEach(func() {
+					apiToken.Name = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("name must be specified")))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the role is unknown", func() {
+				BeforeEach(func() {
+					apiToken.Role = "admin"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(strings.HasPrefix(string(body), "role must be one of")).To(BeTrue())
+				})
+
+				Context("when custom roles are defined", func() {
+					BeforeEach(func() {
+						roleActions["deployer"] = []string{atc.CreateJobBuild}
+					})
+
+					It("lists them as valid roles", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("role must be one of owner, member, pipeline-operator, viewer, deployer")))
+					})
+				})
+			})
+
+			Context("when the role is a custom role from the RBAC config", func() {
+				BeforeEach(func() {
+					roleActions["deployer"] = []string{atc.CreateJobBuild}
+					apiToken.Role = "deployer"
+				})
+
+				It("creates the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+					_, created := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+					Expect(created.Role).To(Equal("deployer"))
+				})
+			})
+
+			Context("when the token would already have expired", func() {
+				BeforeEach(func() {
+					apiToken.ExpiresAt = 100
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("expiry must be in the future")))
+				})
+			})
+
+			Context("when the token never expires", func() {
+				BeforeEach(func() {
+					apiToken.ExpiresAt = 0
+				})
+
+				It("returns 201", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				})
+			})
+
+			Context("when the name is already taken", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.CreateAPITokenStub = nil
+					dbAPITokenFactory.CreateAPITokenReturns(atc.APIToken{}, db.ErrAPITokenNameTaken)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when creating the token fails", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.CreateAPITokenStub = nil
+					dbAPITokenFactory.CreateAPITokenReturns(atc.APIToken{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/tokens/:token_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/tokens/deployer", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokenFactory.DeleteAPITokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbAPITokenFactory.DeleteAPITokenReturns(true, nil)
+			})
+
+			It("deletes the token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				teamName, name := dbAPITokenFactory.DeleteAPITokenArgsForCall(0)
+				Expect(teamName).To(Equal("some-team"))
+				Expect(name).To(Equal("deployer"))
+			})
+
+			Context("when the token does not exist", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.DeleteAPITokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when deleting the token fails", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.DeleteAPITokenReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package apitokenserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// apiTokenBytes is the amount of randomness in each API token.
+const apiTokenBytes = 32
+
+var builtInRoles = map[string]bool{
+	accessor.OwnerRole:    true,
+	accessor.MemberRole:   true,
+	accessor.OperatorRole: true,
+	accessor.ViewerRole:   true,
+}
+
+func (s *Server) CreateAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("create-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var apiToken atc.APIToken
+		err := json.NewDecoder(r.Body).Decode(&apiToken)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = s.validate(apiToken)
+		if err != nil {
+			logger.Info("invalid-api-token", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		token, err := generateToken()
+		if err != nil {
+			logger.Error("failed-to-generate-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		apiToken.TeamName = team.Name()
+		apiToken.CreatedBy = accessor.GetAccessor(r).Claims().UserName
+
+		created, err := s.apiTokenFactory.CreateAPIToken(token, apiToken)
+		if err != nil {
+			if err == db.ErrAPITokenNameTaken {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(err.Error()))
+				return
+			}
+
+			if err == db.ErrAPITokenTeamNotFound {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			logger.Error("failed-to-create-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("created", lager.Data{
+			"id":         created.ID,
+			"team":       created.TeamName,
+			"name":       created.Name,
+			"role":       created.Role,
+			"created-by": created.CreatedBy,
+			"expires-at": created.ExpiresAt,
+		})
+
+		created.Token = token
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(created)
+		if err != nil {
+			logger.Error("failed-to-encode-api-token", err)
+		}
+	})
+}
+
+func (s *Server) validate(apiToken atc.APIToken) error {
+	if apiToken.Name == "" {
+		return errors.New("name must be specified")
+	}
+
+	if !s.isValidRole(apiToken.Role) {
+		return fmt.Errorf("role must be one of %s", strings.Join(s.validRoles(), ", "))
+	}
+
+	if apiToken.ExpiresAt != 0 && apiToken.ExpiresAt <= s.clock.Now().Unix() {
+		return errors.New("expiry must be in the future")
+	}
+
+	return nil
+}
+
+// isValidRole returns whether the role is a built-in role or one of the custom
+// roles defined in the RBAC config.
+func (s *Server) isValidRole(role string) bool {
+	if builtInRoles[role] {
+		return true
+	}
+
+	_, found := s.roleActions[role]
+	return found
+}
+
+func (s *Server) validRoles() []string {
+	roles := []string{
+		accessor.OwnerRole,
+		accessor.MemberRole,
+		accessor.OperatorRole,
+		accessor.ViewerRole,
+	}
+
+	var customRoles []string
+	for role := range s.roleActions {
+		customRoles = append(customRoles, role)
+	}
+	sort.Strings(customRoles)
+
+	return append(roles, customRoles...)
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, apiTokenBytes)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+
+	return atc.APITokenPrefix + hex.EncodeToString(buf), nil
+}
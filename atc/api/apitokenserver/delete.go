@@ -0,0 +1,32 @@
+package apitokenserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DeleteAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("delete-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":token_name")
+
+		deleted, err := s.apiTokenFactory.DeleteAPIToken(team.Name(), name)
+		if err != nil {
+			logger.Error("failed-to-delete-api-token", err, lager.Data{"team": team.Name(), "name": name})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		logger.Info("deleted", lager.Data{"team": team.Name(), "name": name})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,29 @@
+package apitokenserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAPITokens(team db.Team) http.Handler {
+	logger := s.logger.Session("list-api-tokens")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiTokens, err := s.apiTokenFactory.ListAPITokens(team.Name())
+		if err != nil {
+			logger.Error("failed-to-list-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(apiTokens)
+		if err != nil {
+			logger.Error("failed-to-encode-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
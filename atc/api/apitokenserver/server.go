@@ -0,0 +1,24 @@
+package apitokenserver
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger          lager.Logger
+	apiTokenFactory db.APITokenFactory
+	roleActions     accessor.RoleActions
+	clock           clock.Clock
+}
+
+func NewServer(logger lager.Logger, apiTokenFactory db.APITokenFactory, roleActions accessor.RoleActions, clock clock.Clock) *Server {
+	return &Server{
+		logger:          logger,
+		apiTokenFactory: apiTokenFactory,
+		roleActions:     roleActions,
+		clock:           clock,
+	}
+}
@@ -0,0 +1,206 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events API", func() {
+	var (
+		query    string
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		query = ""
+
+		dbAuditLog.EventsReturns([]atc.AuditEvent{
+			{
+				ID:         42,
+				Time:       100,
+				Actor:      "some-user",
+				Action:     atc.SaveConfig,
+				Team:       "some-team",
+				Object:     "pipeline:some-pipeline",
+				Method:     "PUT",
+				Path:       "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+				RemoteAddr: "1.2.3.4:5678",
+			},
+		}, db.Pagination{
+			Older: &db.Page{To: db.NewIntPtr(41), Limit: 1},
+		}, nil)
+	})
+
+	Describe("GET /api/v1/audit_events", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/audit_events" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAuditLog.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("returns the audit events", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 42,
+						"time": 100,
+						"actor": "some-user",
+						"action": "SaveConfig",
+						"team": "some-team",
+						"object": "pipeline:some-pipeline",
+						"method": "PUT",
+						"path": "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+						"remote_addr": "1.2.3.4:5678"
+					}
+				]`))
+			})
+
+			It("returns the events of every team with the default page", func() {
+				filter, page := dbAuditLog.EventsArgsForCall(0)
+				Expect(filter).To(Equal(db.AuditEventFilter{}))
+				Expect(page).To(Equal(db.Page{Limit: atc.PaginationAPIDefaultLimit}))
+			})
+
+			Context("when filtering", func() {
+				BeforeEach(func() {
+					query = "?team=some-team&actor=some-user&action=SaveConfig&since=100&until=200&to=50&limit=1"
+				})
+
+				It("passes the filter and page to the audit log", func() {
+					filter, page := dbAuditLog.EventsArgsForCall(0)
+					Expect(filter).To(Equal(db.AuditEventFilter{
+						Team:   "some-team",
+						Actor:  "some-user",
+						Action: "SaveConfig",
+						Since:  time.Unix(100, 0),
+						Until:  time.Unix(200, 0),
+					}))
+					Expect(page).To(Equal(db.Page{To: db.NewIntPtr(50), Limit: 1}))
+				})
+
+				It("keeps the filter in the Link headers", func() {
+					Expect(response.Header["Link"]).To(ConsistOf([]string{
+						`<https://example.com/api/v1/audit_events?action=SaveConfig&actor=some-user&limit=1&since=100&team=some-team&to=41&until=200>; rel="next"`,
+					}))
+				})
+			})
+
+			Context("when the time is invalid", func() {
+				BeforeEach(func() {
+					query = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					dbAuditLog.EventsReturns(nil, db.Pagination{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/audit_events", func() {
+		BeforeEach(func() {
+			dbTeam.NameReturns("some-team")
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/audit_events" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAuditLog.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				query = "?team=other-team&actor=some-user"
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("only returns the events of the team", func() {
+				filter, _ := dbAuditLog.EventsArgsForCall(0)
+				Expect(filter).To(Equal(db.AuditEventFilter{
+					Team:  "some-team",
+					Actor: "some-user",
+				}))
+			})
+
+			It("links to the next page of the team's events", func() {
+				Expect(response.Header["Link"]).To(ConsistOf([]string{
+					`<https://example.com/api/v1/teams/some-team/audit_events?actor=some-user&limit=1&to=41>; rel="next"`,
+				}))
+			})
+		})
+	})
+})
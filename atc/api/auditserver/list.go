@@ -0,0 +1,142 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListAuditEvents lists the audit events of every team. It's only available to
+// admins.
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	s.listAuditEvents(w, r, r.FormValue(atc.AuditQueryTeam), "/api/v1/audit_events", append([]string{atc.AuditQueryTeam}, filterParams...))
+}
+
+// ListTeamAuditEvents lists the audit events of a single team.
+func (s *Server) ListTeamAuditEvents(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.listAuditEvents(w, r, team.Name(), fmt.Sprintf("/api/v1/teams/%s/audit_events", url.PathEscape(team.Name())), filterParams)
+	})
+}
+
+// filterParams are the query parameters filtering the events, which are kept
+// when linking to other pages.
+var filterParams = []string{
+	atc.AuditQueryActor,
+	atc.AuditQueryAction,
+	atc.AuditQuerySince,
+	atc.AuditQueryUntil,
+}
+
+func (s *Server) listAuditEvents(w http.ResponseWriter, r *http.Request, teamName string, path string, linkParams []string) {
+	logger := s.logger.Session("list-audit-events")
+
+	filter := db.AuditEventFilter{
+		Team:   teamName,
+		Actor:  r.FormValue(atc.AuditQueryActor),
+		Action: r.FormValue(atc.AuditQueryAction),
+	}
+
+	var err error
+	filter.Since, err = parseTime(r.FormValue(atc.AuditQuerySince))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid %s: %s", atc.AuditQuerySince, err)
+		return
+	}
+
+	filter.Until, err = parseTime(r.FormValue(atc.AuditQueryUntil))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid %s: %s", atc.AuditQueryUntil, err)
+		return
+	}
+
+	page := db.Page{}
+
+	page.Limit, _ = strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if page.Limit == 0 {
+		page.Limit = atc.PaginationAPIDefaultLimit
+	}
+
+	if urlFrom := r.FormValue(atc.PaginationQueryFrom); urlFrom != "" {
+		from, _ := strconv.Atoi(urlFrom)
+		page.From = db.NewIntPtr(from)
+	}
+
+	if urlTo := r.FormValue(atc.PaginationQueryTo); urlTo != "" {
+		to, _ := strconv.Atoi(urlTo)
+		page.To = db.NewIntPtr(to)
+	}
+
+	events, pagination, err := s.auditLog.Events(filter, page)
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	linkQuery := url.Values{}
+	for _, param := range linkParams {
+		if value := r.FormValue(param); value != "" {
+			linkQuery.Set(param, value)
+		}
+	}
+
+	if pagination.Older != nil {
+		s.addLink(w, path, linkQuery, atc.PaginationQueryTo, *pagination.Older.To, pagination.Older.Limit, atc.LinkRelNext)
+	}
+
+	if pagination.Newer != nil {
+		s.addLink(w, path, linkQuery, atc.PaginationQueryFrom, *pagination.Newer.From, pagination.Newer.Limit, atc.LinkRelPrevious)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// addLink links to another page of audit events, keeping the filters of the
+// request.
+func (s *Server) addLink(w http.ResponseWriter, path string, filter url.Values, boundary string, id int, limit int, rel string) {
+	query := url.Values{}
+	for param, values := range filter {
+		query[param] = values
+	}
+
+	query.Set(boundary, strconv.Itoa(id))
+	query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+
+	w.Header().Add("Link", fmt.Sprintf(
+		`<%s%s?%s>; rel="%s"`,
+		s.externalURL,
+		path,
+		query.Encode(),
+		rel,
+	))
+}
+
+// parseTime parses a time given as seconds since the epoch.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(seconds, 0), nil
+}
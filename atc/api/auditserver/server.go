@@ -0,0 +1,20 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger      lager.Logger
+	externalURL string
+	auditLog    db.AuditLog
+}
+
+func NewServer(logger lager.Logger, externalURL string, auditLog db.AuditLog) *Server {
+	return &Server{
+		logger:      logger,
+		externalURL: externalURL,
+		auditLog:    auditLog,
+	}
+}
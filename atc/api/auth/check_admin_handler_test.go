@@ -60,6 +60,7 @@ var _ = Describe("CheckAdminHandler", func() {
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
 			map[string]string{},
+			accessor.RoleActions{},
 		))
 
 		client = &http.Client{
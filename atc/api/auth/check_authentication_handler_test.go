@@ -73,6 +73,7 @@ var _ = Describe("AuthenticationHandler", func() {
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
 				map[string]string{},
+				accessor.RoleActions{},
 			))
 		})
 
@@ -131,6 +132,7 @@ var _ = Describe("AuthenticationHandler", func() {
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
 				map[string]string{},
+				accessor.RoleActions{},
 			))
 		})
 
@@ -31,7 +31,12 @@ func (h checkAuthorizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 
 	teamName := r.URL.Query().Get(":team_name")
 
-	if !acc.IsAuthorized(teamName) {
+	authorized := acc.IsAuthorized(teamName)
+	if pipelineName := r.URL.Query().Get(":pipeline_name"); pipelineName != "" {
+		authorized = acc.IsAuthorizedForPipeline(teamName, pipelineName)
+	}
+
+	if !authorized {
 		h.rejector.Forbidden(w, r)
 		return
 	}
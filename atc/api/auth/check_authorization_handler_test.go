@@ -60,6 +60,7 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
 			map[string]string{},
+			accessor.RoleActions{},
 		))
 
 		client = &http.Client{
@@ -125,6 +126,40 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 			})
 		})
 
+		Context("when the request is for a pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+
+				urlValues := url.Values{":team_name": []string{"some-team"}, ":pipeline_name": []string{"some-pipeline"}}
+				request.URL.RawQuery = urlValues.Encode()
+			})
+
+			Context("when authorized for the pipeline", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedForPipelineReturns(true)
+				})
+
+				It("proxies to the handler", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					teamName, pipelineName := fakeaccess.IsAuthorizedForPipelineArgsForCall(0)
+					Expect(teamName).To(Equal("some-team"))
+					Expect(pipelineName).To(Equal("some-pipeline"))
+				})
+			})
+
+			Context("when not authorized for the pipeline", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedForPipelineReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
 		Context("when the request is not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
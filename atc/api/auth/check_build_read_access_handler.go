@@ -104,7 +104,7 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 var errDisappeared = errors.New("internal: build parent disappeared")
 
 func (h checkBuildReadAccessHandler) allow(build db.Build, acc accessor.Access) (bool, error) {
-	if acc.IsAuthenticated() && acc.IsAuthorized(build.TeamName()) {
+	if acc.IsAuthenticated() && isAuthorizedForBuild(acc, build) {
 		return true, nil
 	}
 
@@ -115,6 +115,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
 				map[string]string{},
+				accessor.RoleActions{},
 			)
 		})
 
@@ -122,6 +123,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthorizedForPipelineReturns(true)
 			})
 
 			WithExistingBuild(ItReturnsTheBuild)
@@ -247,6 +249,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
 				map[string]string{},
+				accessor.RoleActions{},
 			)
 		})
 
@@ -329,6 +332,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
 				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthorizedForPipelineReturns(true)
 			})
 
 			WithExistingBuild(ItReturnsTheBuild)
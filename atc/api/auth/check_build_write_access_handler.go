@@ -67,7 +67,7 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if !acc.IsAuthorized(build.TeamName()) {
+	if !isAuthorizedForBuild(acc, build) {
 		h.rejector.Forbidden(w, r)
 		return
 	}
@@ -75,3 +75,13 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	ctx := context.WithValue(r.Context(), BuildContextKey, build)
 	h.delegateHandler.ServeHTTP(w, r.WithContext(ctx))
 }
+
+// isAuthorizedForBuild considers the roles on the pipeline of the build, if it
+// has one, as well as those on its team.
+func isAuthorizedForBuild(acc accessor.Access, build db.Build) bool {
+	if build.PipelineID() != 0 {
+		return acc.IsAuthorizedForPipeline(build.TeamName(), build.PipelineName())
+	}
+
+	return acc.IsAuthorized(build.TeamName())
+}
@@ -52,6 +52,7 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
 			map[string]string{},
+			accessor.RoleActions{},
 		)
 	})
 
@@ -124,6 +125,40 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 		})
 	})
 
+	Context("when the build belongs to a pipeline", func() {
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(false)
+			build.PipelineIDReturns(41)
+			build.PipelineNameReturns("some-pipeline")
+			buildFactory.BuildReturns(build, true, nil)
+		})
+
+		Context("when authorized for the pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedForPipelineReturns(true)
+			})
+
+			It("returns 200 ok", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				teamName, pipelineName := fakeaccess.IsAuthorizedForPipelineArgsForCall(0)
+				Expect(teamName).To(Equal("some-team"))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+			})
+		})
+
+		Context("when not authorized for the pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedForPipelineReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Context("when not authenticated", func() {
 		BeforeEach(func() {
 			fakeaccess.IsAuthenticatedReturns(false)
@@ -51,6 +51,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
 			map[string]string{},
+			accessor.RoleActions{},
 		)
 	})
 
@@ -118,7 +119,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 			Context("and authorized", func() {
 				BeforeEach(func() {
 					fakeaccess.IsAuthenticatedReturns(true)
-					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.IsAuthorizedForPipelineReturns(true)
 				})
 
 				It("calls pipelineScopedHandler with pipelineDB in context", func() {
@@ -126,6 +127,12 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 					Expect(delegate.ContextPipelineDB).To(BeIdenticalTo(pipeline))
 				})
 
+				It("checks the roles on the pipeline", func() {
+					teamName, pipelineName := fakeaccess.IsAuthorizedForPipelineArgsForCall(0)
+					Expect(teamName).To(Equal("some-team"))
+					Expect(pipelineName).To(Equal("some-pipeline"))
+				})
+
 				It("returns 200 OK", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
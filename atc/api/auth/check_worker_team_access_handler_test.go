@@ -47,6 +47,7 @@ var _ = Describe("CheckWorkerTeamAccessHandler", func() {
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
 			map[string]string{},
+			accessor.RoleActions{},
 		)
 	})
 
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -106,6 +107,7 @@ var _ = Describe("Builds API", func() {
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
 
 						dbTeam.CreateStartedBuildReturns(fakeBuild, nil)
+						fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub"})
 					})
 
 					It("returns 201 Created", func() {
@@ -121,7 +123,9 @@ var _ = Describe("Builds API", func() {
 
 					It("creates a started build", func() {
 						Expect(dbTeam.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbTeam.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbTeam.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-sub"))
 					})
 
 					It("returns the created build", func() {
@@ -636,6 +640,22 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the build is awaiting approval", func() {
+							BeforeEach(func() {
+								build.StatusReturns(db.BuildStatusStarted)
+								build.AwaitingApprovalReturns(true)
+							})
+
+							It("says so", func() {
+								var returned atc.Build
+								err := json.NewDecoder(response.Body).Decode(&returned)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(returned.Status).To(Equal("started"))
+								Expect(returned.AwaitingApproval).To(BeTrue())
+							})
+						})
 					})
 				})
 			})
@@ -1113,6 +1133,334 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/approvals", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/approvals")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized and the build is found", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				build.TeamNameReturns("some-team")
+				build.PipelineIDReturns(0)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when getting the approvals succeeds", func() {
+				BeforeEach(func() {
+					build.ApprovalsReturns([]atc.BuildApproval{
+						{
+							ID:          1,
+							PlanID:      "some-plan-id",
+							Name:        "deploy",
+							Role:        "owner",
+							RequestedAt: 100,
+							ApprovedBy:  "some-approver",
+							ApprovedAt:  200,
+						},
+						{
+							ID:          2,
+							PlanID:      "some-other-plan-id",
+							Name:        "release",
+							Role:        "member",
+							RequestedAt: 300,
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the approvals", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 1,
+							"plan_id": "some-plan-id",
+							"name": "deploy",
+							"role": "owner",
+							"requested_at": 100,
+							"approved_by": "some-approver",
+							"approved_at": 200
+						},
+						{
+							"id": 2,
+							"plan_id": "some-other-plan-id",
+							"name": "release",
+							"role": "member",
+							"requested_at": 300
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the approvals fails", func() {
+				BeforeEach(func() {
+					build.ApprovalsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/approvals/:approval_id", func() {
+		var (
+			approvalID string
+			response   *http.Response
+		)
+
+		BeforeEach(func() {
+			approvalID = "2"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/42/approvals/"+approvalID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{
+					Sub:       "some-approver-sub",
+					UserName:  "some-approver",
+					Connector: "github",
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					build.PipelineNameReturns("some-pipeline")
+					dbBuildFactory.BuildReturns(build, true, nil)
+
+					build.ApprovalsReturns([]atc.BuildApproval{
+						{ID: 1, Name: "deploy", Role: "member", ApprovedBy: "someone", ApprovedAt: 100},
+						{ID: 2, Name: "release", Role: "member"},
+					}, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not approve the build", func() {
+						Expect(build.ApproveCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					Context("when the user has the required role", func() {
+						BeforeEach(func() {
+							fakeAccess.TeamRolesReturns(map[string][]string{
+								"some-team": {"owner"},
+							})
+							build.ApproveReturns(true, nil)
+						})
+
+						It("returns 204", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+						})
+
+						It("approves the build as the user", func() {
+							Expect(build.ApproveCallCount()).To(Equal(1))
+							approvalID, approvedBy := build.ApproveArgsForCall(0)
+							Expect(approvalID).To(Equal(2))
+							Expect(approvedBy).To(Equal("some-approver"))
+						})
+
+						It("records who approved the step", func() {
+							Expect(fakeAuditor.AuditBuildApprovalCallCount()).To(Equal(1))
+							userName, approvedBuild, approval := fakeAuditor.AuditBuildApprovalArgsForCall(0)
+							Expect(userName).To(Equal("some-approver"))
+							Expect(approvedBuild).To(Equal(build))
+							Expect(approval.Name).To(Equal("release"))
+						})
+
+						Context("when the approval was approved concurrently", func() {
+							BeforeEach(func() {
+								build.ApproveReturns(false, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							})
+
+							It("does not record an approval", func() {
+								Expect(fakeAuditor.AuditBuildApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when approving fails", func() {
+							BeforeEach(func() {
+								build.ApproveReturns(false, errors.New("nope"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when the user triggered the build", func() {
+							BeforeEach(func() {
+								createdBy := "some-approver-sub"
+								build.CreatedByReturns(&createdBy)
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+
+							It("does not approve the build", func() {
+								Expect(build.ApproveCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when another user triggered the build", func() {
+							BeforeEach(func() {
+								createdBy := "some-other-sub"
+								build.CreatedByReturns(&createdBy)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						for _, connector := range []string{accessor.APITokenConnector, accessor.CertificateConnector} {
+							connector := connector
+
+							Context("when approving with the "+connector+" connector", func() {
+								BeforeEach(func() {
+									fakeAccess.ClaimsReturns(accessor.Claims{
+										Sub:       connector + ":some-approver",
+										UserName:  "some-approver",
+										Connector: connector,
+									})
+								})
+
+								It("returns 403", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+								})
+
+								It("does not approve the build", func() {
+									Expect(build.ApproveCallCount()).To(BeZero())
+								})
+							})
+						}
+					})
+
+					Context("when the user has the required role only on the build's pipeline", func() {
+						BeforeEach(func() {
+							fakeAccess.TeamRolesReturns(map[string][]string{
+								"some-team": {"viewer"},
+							})
+							fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+								"some-team": {"some-*": {"member"}},
+							})
+							build.ApproveReturns(true, nil)
+						})
+
+						It("returns 204", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+						})
+					})
+
+					Context("when the user only has a lesser role", func() {
+						BeforeEach(func() {
+							fakeAccess.TeamRolesReturns(map[string][]string{
+								"some-team": {"viewer"},
+							})
+							fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+								"some-team": {"other-*": {"member"}},
+							})
+						})
+
+						It("returns 403", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						})
+
+						It("does not approve the build", func() {
+							Expect(build.ApproveCallCount()).To(BeZero())
+						})
+
+						Context("when the user is an admin", func() {
+							BeforeEach(func() {
+								fakeAccess.IsAdminReturns(true)
+								build.ApproveReturns(true, nil)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+					})
+
+					Context("when the approval has already been approved", func() {
+						BeforeEach(func() {
+							approvalID = "1"
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+					})
+
+					Context("when the approval does not exist", func() {
+						BeforeEach(func() {
+							approvalID = "3"
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
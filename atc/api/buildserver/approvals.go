@@ -0,0 +1,135 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListBuildApprovals(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-build-approvals", build.LagerData())
+
+		approvals, err := build.Approvals()
+		if err != nil {
+			logger.Error("failed-to-get-build-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(approvals)
+		if err != nil {
+			logger.Error("failed-to-encode-build-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// ApproveBuild approves a manual_approval step of the build. The approver
+// must be a person with the role required by the step, and must not be the
+// user who triggered the build. Users are told apart by their subject, as user
+// names are only unique within a connector. Builds started by the scheduler
+// have no triggerer, so anyone with the role may approve them.
+func (s *Server) ApproveBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("approve-build", build.LagerData())
+
+		approvalID, err := strconv.Atoi(r.FormValue(":approval_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		approvals, err := build.Approvals()
+		if err != nil {
+			logger.Error("failed-to-get-build-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var approval atc.BuildApproval
+		var found bool
+		for _, a := range approvals {
+			if a.ID == approvalID {
+				approval = a
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if approval.Approved() {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		claims := acc.Claims()
+		userName := claims.UserName
+
+		// API tokens and certificates stand for automation, and may well be
+		// held by whoever triggered the build
+		if claims.Connector == accessor.APITokenConnector || claims.Connector == accessor.CertificateConnector {
+			logger.Info("approver-not-a-person", lager.Data{"user": userName, "connector": claims.Connector})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		createdBy := build.CreatedBy()
+		if createdBy != nil && *createdBy != "" && *createdBy == claims.Sub {
+			logger.Info("approver-triggered-build", lager.Data{"user": userName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if !acc.IsAdmin() && !accessor.HasRole(buildRoles(acc, build), approval.Role) {
+			logger.Info("approver-missing-role", lager.Data{"user": userName, "role": approval.Role})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		approved, err := build.Approve(approvalID, userName)
+		if err != nil {
+			logger.Error("failed-to-approve-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !approved {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		s.auditor.AuditBuildApproval(userName, build, approval)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// buildRoles returns the roles the user has on the build's team, including the
+// roles granted on only some pipelines which apply to the build's pipeline.
+func buildRoles(acc accessor.Access, build db.Build) []string {
+	team := build.TeamName()
+
+	roles := append([]string{}, acc.TeamRoles()[team]...)
+	for pattern, patternRoles := range acc.PipelineRoles()[team] {
+		scope := map[string][]string{atc.TeamAuthPipelines: {pattern}}
+		if atc.BindingAppliesToPipeline(scope, build.PipelineName()) {
+			roles = append(roles, patternRoles...)
+		}
+	}
+
+	return roles
+}
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -22,7 +23,7 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 			return
 		}
 
-		build, err := team.CreateStartedBuild(plan)
+		build, err := team.CreateStartedBuild(plan, accessor.GetAccessor(r).Claims().Sub)
 		if err != nil {
 			hLog.Error("failed-to-create-one-off-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
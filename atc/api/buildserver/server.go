@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -18,6 +19,7 @@ type Server struct {
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
 	eventHandlerFactory EventHandlerFactory
+	auditor             auditor.Auditor
 	rejector            auth.Rejector
 }
 
@@ -27,6 +29,7 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
+	auditor auditor.Auditor,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -36,6 +39,7 @@ func NewServer(
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
 		eventHandlerFactory: eventHandlerFactory,
+		auditor:             auditor,
 
 		rejector: auth.UnauthorizedRejector{},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/tedsuo/rata"
@@ -387,6 +388,35 @@ var _ = Describe("Config API", func() {
 				})
 			})
 
+			Context("when a policy warns about the config", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed:  false,
+						Reasons:  []string{"privileged tasks are discouraged"},
+						Severity: policy.SeverityWarn,
+					}, nil)
+
+					request.Header.Set("Content-Type", "application/json")
+
+					payload, err := json.Marshal(pipelineConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					request.Body = gbytes.BufferWithBytes(payload)
+				})
+
+				It("returns the policy warnings in the response body", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+							{
+								"warnings": [
+									{
+										"type": "policy",
+										"message": "privileged tasks are discouraged"
+									}
+								]
+							}`))
+				})
+			})
+
 			Context("when a config version is specified", func() {
 				BeforeEach(func() {
 					request.Header.Set(atc.ConfigVersionHeader, "42")
@@ -506,6 +536,23 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when the team's pipeline quota is reached", func() {
+							BeforeEach(func() {
+								dbTeam.SavePipelineReturns(nil, false, db.QuotaReachedError{
+									Quota: db.QuotaPipelines,
+									Limit: 2,
+								})
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+
+							It("returns the error in the response body", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("failed to save config: team quota of 2 pipelines reached")))
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
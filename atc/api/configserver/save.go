@@ -3,6 +3,7 @@ package configserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/vars"
 	"github.com/hashicorp/go-multierror"
 	"github.com/tedsuo/rata"
@@ -80,6 +82,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		warnings = append(warnings, *warning)
 	}
 
+	for _, reason := range policy.WarningsFromContext(r.Context()) {
+		warnings = append(warnings, atc.ConfigWarning{
+			Type:    "policy",
+			Message: reason,
+		})
+	}
+
 	pipelineRef := atc.PipelineRef{Name: pipelineName}
 	if atc.EnablePipelineInstances {
 		if instanceVars := query.Get("instance_vars"); instanceVars != "" {
@@ -121,6 +130,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
+	if errors.As(err, &db.QuotaReachedError{}) {
+		session.Info("pipeline-quota-reached", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintf(w, "failed to save config: %s", err)
+		return
+	}
+
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -8,7 +8,10 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/apitokenserver"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
@@ -16,15 +19,19 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
+	"github.com/concourse/concourse/atc/api/localuserserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
+	"github.com/concourse/concourse/atc/api/policyexemptionserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/sessionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
+	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
@@ -54,8 +61,10 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	inputsExplainer jobserver.InputsExplainer,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
+	aud auditor.Auditor,
 
 	workerClient worker.Client,
 
@@ -72,6 +81,15 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	dbTeamPolicies db.TeamPolicies,
+	dbAPITokenFactory db.APITokenFactory,
+	dbAuditLog db.AuditLog,
+	dbLocalUserFactory db.LocalUserFactory,
+	staticLocalUsers []string,
+	dbAccessTokenFactory db.AccessTokenFactory,
+	dbAccessTokenLifecycle db.AccessTokenLifecycle,
+	customRoles map[string]string,
+	roleActions accessor.RoleActions,
 	clock clock.Clock,
 ) (http.Handler, error) {
 
@@ -84,8 +102,8 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
-	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, aud)
+	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory, inputsExplainer)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
@@ -100,8 +118,13 @@ func NewHandler(
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
-	usersServer := usersserver.NewServer(logger, dbUserFactory)
+	usersServer := usersserver.NewServer(logger, dbUserFactory, customRoles, roleActions)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	policyExemptionServer := policyexemptionserver.NewServer(logger, dbTeamPolicies, clock)
+	apiTokenServer := apitokenserver.NewServer(logger, dbAPITokenFactory, roleActions, clock)
+	auditServer := auditserver.NewServer(logger, externalURL, dbAuditLog)
+	localUserServer := localuserserver.NewServer(logger, dbLocalUserFactory, dbAccessTokenLifecycle, staticLocalUsers)
+	sessionServer := sessionserver.NewServer(logger, dbAccessTokenFactory, dbAccessTokenLifecycle)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -118,19 +141,22 @@ func NewHandler(
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
-
-		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
-		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
-		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
-		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
-		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
-		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
-		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
-		atc.RerunJobBuild:  pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
-		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
-		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
-		atc.ScheduleJob:    pipelineHandlerFactory.HandlerFor(jobServer.ScheduleJob),
-		atc.JobBadge:       pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
+		atc.ListBuildApprovals:  buildHandlerFactory.HandlerFor(buildServer.ListBuildApprovals),
+		atc.ApproveBuild:        buildHandlerFactory.HandlerFor(buildServer.ApproveBuild),
+
+		atc.ListAllJobs:      http.HandlerFunc(jobServer.ListAllJobs),
+		atc.ListJobs:         pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
+		atc.GetJob:           pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
+		atc.ListJobBuilds:    pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
+		atc.ListJobInputs:    pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.ExplainJobInputs: pipelineHandlerFactory.HandlerFor(jobServer.ExplainJobInputs),
+		atc.GetJobBuild:      pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
+		atc.CreateJobBuild:   pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
+		atc.RerunJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
+		atc.PauseJob:         pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
+		atc.UnpauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
+		atc.ScheduleJob:      pipelineHandlerFactory.HandlerFor(jobServer.ScheduleJob),
+		atc.JobBadge:         pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
 		atc.MainJobBadge: mainredirect.Handler{
 			Routes: atc.Routes,
 			Route:  atc.JobBadge,
@@ -154,7 +180,11 @@ func NewHandler(
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
 
+		atc.GetPipelineDeliveryMetrics: pipelineHandlerFactory.HandlerFor(pipelineServer.GetDeliveryMetrics),
+		atc.ListPipelineBlockedBuilds:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListBlockedBuilds),
+
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
+		atc.ListStaleResources:      http.HandlerFunc(resourceServer.ListStaleResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
 		atc.ListResourceTypes:       pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
 		atc.GetResource:             pipelineHandlerFactory.HandlerFor(resourceServer.GetResource),
@@ -214,6 +244,29 @@ func NewHandler(
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListPolicyExemptions:  http.HandlerFunc(policyExemptionServer.ListPolicyExemptions),
+		atc.CreatePolicyExemption: http.HandlerFunc(policyExemptionServer.CreatePolicyExemption),
+		atc.DeletePolicyExemption: http.HandlerFunc(policyExemptionServer.DeletePolicyExemption),
+
+		atc.ListAPITokens:  teamHandlerFactory.HandlerFor(apiTokenServer.ListAPITokens),
+		atc.CreateAPIToken: teamHandlerFactory.HandlerFor(apiTokenServer.CreateAPIToken),
+		atc.DeleteAPIToken: teamHandlerFactory.HandlerFor(apiTokenServer.DeleteAPIToken),
+
+		atc.ListAuditEvents:     http.HandlerFunc(auditServer.ListAuditEvents),
+		atc.ListTeamAuditEvents: teamHandlerFactory.HandlerFor(auditServer.ListTeamAuditEvents),
+
+		atc.ListLocalUsers:       http.HandlerFunc(localUserServer.ListLocalUsers),
+		atc.CreateLocalUser:      http.HandlerFunc(localUserServer.CreateLocalUser),
+		atc.SetLocalUserPassword: http.HandlerFunc(localUserServer.SetLocalUserPassword),
+		atc.DisableLocalUser:     http.HandlerFunc(localUserServer.DisableLocalUser),
+		atc.EnableLocalUser:      http.HandlerFunc(localUserServer.EnableLocalUser),
+
+		atc.ListSessions:       http.HandlerFunc(sessionServer.ListSessions),
+		atc.RevokeSession:      http.HandlerFunc(sessionServer.RevokeSession),
+		atc.RevokeSessions:     http.HandlerFunc(sessionServer.RevokeSessions),
+		atc.ListUserSessions:   http.HandlerFunc(sessionServer.ListUserSessions),
+		atc.RevokeUserSessions: http.HandlerFunc(sessionServer.RevokeUserSessions),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
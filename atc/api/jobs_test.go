@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -231,6 +233,40 @@ var _ = Describe("Jobs API", func() {
 				Expect(dbJobFactory.VisibleJobsArgsForCall(0)).To(ContainElement("some-team"))
 			})
 
+			Context("when the user has roles on only some pipelines of a team", func() {
+				BeforeEach(func() {
+					fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+						"other-team": {"dev-*": {"member"}},
+					})
+					fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+						return teamName == "other-team" && pipelineName == "dev-api"
+					}
+
+					dbJobFactory.VisibleJobsReturnsOnCall(1, atc.Dashboard{
+						{ID: 1, Name: "some-job", PipelineName: "some-pipeline", TeamName: "some-team"},
+						{ID: 2, Name: "dev-job", PipelineName: "dev-api", TeamName: "other-team"},
+						{ID: 3, Name: "prod-job", PipelineName: "prod-api", TeamName: "other-team"},
+					}, nil)
+				})
+
+				It("also looks up the jobs of the team", func() {
+					Expect(dbJobFactory.VisibleJobsCallCount()).To(Equal(2))
+					Expect(dbJobFactory.VisibleJobsArgsForCall(1)).To(Equal([]string{"other-team"}))
+				})
+
+				It("adds the jobs of the pipelines the user has a role on", func() {
+					var jobs []atc.Job
+					err := json.NewDecoder(response.Body).Decode(&jobs)
+					Expect(err).NotTo(HaveOccurred())
+
+					var names []string
+					for _, job := range jobs {
+						names = append(names, job.Name)
+					}
+					Expect(names).To(Equal([]string{"some-job", "dev-job"}))
+				})
+			})
+
 			Context("user has the admin privilege", func() {
 				BeforeEach(func() {
 					fakeAccess.IsAdminReturns(true)
@@ -1525,6 +1561,7 @@ var _ = Describe("Jobs API", func() {
 				BeforeEach(func() {
 					fakeJob.NameReturns("some-job")
 					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub", UserName: "some-user"})
 				})
 
 				Context("when manual triggering is disabled", func() {
@@ -1574,6 +1611,10 @@ var _ = Describe("Jobs API", func() {
 							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
 						})
 
+						It("records the subject of the user who triggered it", func() {
+							Expect(fakeJob.CreateBuildArgsForCall(0)).To(Equal("some-sub"))
+						})
+
 						Context("when finding the pipeline resources fails", func() {
 							BeforeEach(func() {
 								fakePipeline.ResourcesReturns(nil, errors.New("nope"))
@@ -1897,6 +1938,166 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs/explain", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/inputs/explain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				var inputConfigs db.InputConfigs
+
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+
+					inputConfigs = db.InputConfigs{
+						{Name: "some-input", ResourceID: 1, JobID: 3, Passed: db.JobSet{4: true}},
+					}
+					fakeJob.AlgorithmInputsReturns(inputConfigs, nil)
+
+					upstreamJob := new(dbfakes.FakeJob)
+					upstreamJob.IDReturns(4)
+					upstreamJob.NameReturns("upstream-job")
+					fakePipeline.JobsReturns(db.Jobs{fakeJob, upstreamJob}, nil)
+
+					resource := new(dbfakes.FakeResource)
+					resource.IDReturns(1)
+					resource.NameReturns("some-resource")
+					fakePipeline.ResourcesReturns(db.Resources{resource}, nil)
+				})
+
+				Context("when explaining the inputs fails", func() {
+					BeforeEach(func() {
+						fakeInputsExplainer.ExplainReturns(algorithm.Explanation{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when explaining the inputs succeeds", func() {
+					BeforeEach(func() {
+						fakeInputsExplainer.ExplainReturns(algorithm.Explanation{
+							Resolved: true,
+							Inputs: []algorithm.ExplainedInput{
+								{
+									Name:            "some-input",
+									ResourceID:      1,
+									Version:         atc.Version{"ref": "v1"},
+									PassedBuildIDs:  []int{42},
+									FirstOccurrence: true,
+								},
+							},
+							Steps: []algorithm.ExplainStep{
+								{
+									Event:      algorithm.ExplainRejected,
+									Input:      "some-input",
+									ResourceID: 1,
+									JobID:      4,
+									BuildID:    43,
+									Version:    atc.Version{"ref": "v2"},
+									Detail:     "the other inputs could not be satisfied with this build's versions",
+								},
+								{
+									Event:      algorithm.ExplainVouched,
+									Input:      "some-input",
+									ResourceID: 1,
+									JobID:      4,
+									BuildID:    42,
+									Version:    atc.Version{"ref": "v1"},
+								},
+							},
+						}, nil)
+					})
+
+					It("explains the job's inputs without saving them", func() {
+						Expect(fakeInputsExplainer.ExplainCallCount()).To(Equal(1))
+						_, job, inputs := fakeInputsExplainer.ExplainArgsForCall(0)
+						Expect(job).To(Equal(fakeJob))
+						Expect(inputs).To(Equal(inputConfigs))
+
+						Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response).Should(IncludeHeaderEntries(map[string]string{
+							"Content-Type": "application/json",
+						}))
+					})
+
+					It("returns the explanation with job and resource names", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"resolved": true,
+							"inputs": [
+								{
+									"name": "some-input",
+									"resource": "some-resource",
+									"version": {"ref": "v1"},
+									"passed_build_ids": [42],
+									"first_occurrence": true
+								}
+							],
+							"steps": [
+								{
+									"event": "rejected",
+									"input": "some-input",
+									"resource": "some-resource",
+									"job_name": "upstream-job",
+									"build_id": 43,
+									"version": {"ref": "v2"},
+									"detail": "the other inputs could not be satisfied with this build's versions"
+								},
+								{
+									"event": "vouched",
+									"input": "some-input",
+									"resource": "some-resource",
+									"job_name": "upstream-job",
+									"build_id": 42,
+									"version": {"ref": "v1"}
+								}
+							]
+						}`))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
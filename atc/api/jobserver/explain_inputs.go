@@ -0,0 +1,103 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ExplainJobInputs(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("explain-job-inputs")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		inputConfigs, err := job.AlgorithmInputs()
+		if err != nil {
+			logger.Error("failed-to-get-algorithm-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		explanation, err := s.inputsExplainer.Explain(r.Context(), job, inputConfigs)
+		if err != nil {
+			logger.Error("failed-to-explain-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jobs, err := pipeline.Jobs()
+		if err != nil {
+			logger.Error("failed-to-get-jobs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jobNames := map[int]string{}
+		for _, job := range jobs {
+			jobNames[job.ID()] = job.Name()
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resourceNames := map[int]string{}
+		for _, resource := range resources {
+			resourceNames[resource.ID()] = resource.Name()
+		}
+
+		presented := atc.InputsExplanation{
+			Resolved: explanation.Resolved,
+			Inputs:   []atc.ExplainedInput{},
+			Steps:    []atc.InputExplainStep{},
+		}
+
+		for _, input := range explanation.Inputs {
+			presented.Inputs = append(presented.Inputs, atc.ExplainedInput{
+				Name:            input.Name,
+				Resource:        resourceNames[input.ResourceID],
+				Version:         input.Version,
+				PassedBuildIDs:  input.PassedBuildIDs,
+				FirstOccurrence: input.FirstOccurrence,
+				ResolveError:    string(input.ResolveError),
+			})
+		}
+
+		for _, step := range explanation.Steps {
+			presented.Steps = append(presented.Steps, atc.InputExplainStep{
+				Event:    step.Event,
+				Input:    step.Input,
+				Resource: resourceNames[step.ResourceID],
+				JobName:  jobNames[step.JobID],
+				BuildID:  step.BuildID,
+				Version:  step.Version,
+				Detail:   step.Detail,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-inputs-explanation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
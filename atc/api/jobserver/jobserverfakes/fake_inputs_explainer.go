@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package jobserverfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/concourse/concourse/atc/api/jobserver"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
+)
+
+type FakeInputsExplainer struct {
+	ExplainStub        func(context.Context, db.Job, db.InputConfigs) (algorithm.Explanation, error)
+	explainMutex       sync.RWMutex
+	explainArgsForCall []struct {
+		arg1 context.Context
+		arg2 db.Job
+		arg3 db.InputConfigs
+	}
+	explainReturns struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
+	explainReturnsOnCall map[int]struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInputsExplainer) Explain(arg1 context.Context, arg2 db.Job, arg3 db.InputConfigs) (algorithm.Explanation, error) {
+	fake.explainMutex.Lock()
+	ret, specificReturn := fake.explainReturnsOnCall[len(fake.explainArgsForCall)]
+	fake.explainArgsForCall = append(fake.explainArgsForCall, struct {
+		arg1 context.Context
+		arg2 db.Job
+		arg3 db.InputConfigs
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Explain", []interface{}{arg1, arg2, arg3})
+	fake.explainMutex.Unlock()
+	if fake.ExplainStub != nil {
+		return fake.ExplainStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.explainReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputsExplainer) ExplainCallCount() int {
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	return len(fake.explainArgsForCall)
+}
+
+func (fake *FakeInputsExplainer) ExplainCalls(stub func(context.Context, db.Job, db.InputConfigs) (algorithm.Explanation, error)) {
+	fake.explainMutex.Lock()
+	defer fake.explainMutex.Unlock()
+	fake.ExplainStub = stub
+}
+
+func (fake *FakeInputsExplainer) ExplainArgsForCall(i int) (context.Context, db.Job, db.InputConfigs) {
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	argsForCall := fake.explainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInputsExplainer) ExplainReturns(result1 algorithm.Explanation, result2 error) {
+	fake.explainMutex.Lock()
+	defer fake.explainMutex.Unlock()
+	fake.ExplainStub = nil
+	fake.explainReturns = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputsExplainer) ExplainReturnsOnCall(i int, result1 algorithm.Explanation, result2 error) {
+	fake.explainMutex.Lock()
+	defer fake.explainMutex.Unlock()
+	fake.ExplainStub = nil
+	if fake.explainReturnsOnCall == nil {
+		fake.explainReturnsOnCall = make(map[int]struct {
+			result1 algorithm.Explanation
+			result2 error
+		})
+	}
+	fake.explainReturnsOnCall[i] = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputsExplainer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInputsExplainer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ jobserver.InputsExplainer = new(FakeInputsExplainer)
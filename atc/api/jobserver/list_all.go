@@ -21,6 +21,9 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 		dashboard, err = s.jobFactory.AllActiveJobs()
 	} else {
 		dashboard, err = s.jobFactory.VisibleJobs(acc.TeamNames())
+		if err == nil && len(acc.PipelineRoles()) > 0 {
+			dashboard, err = s.withPipelineScopedJobs(acc, dashboard)
+		}
 	}
 
 	if err != nil {
@@ -48,3 +51,30 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// withPipelineScopedJobs adds the jobs of the pipelines on which the user has
+// roles that don't apply to the whole team.
+func (s *Server) withPipelineScopedJobs(acc accessor.Access, dashboard atc.Dashboard) (atc.Dashboard, error) {
+	var teamNames []string
+	for teamName := range acc.PipelineRoles() {
+		teamNames = append(teamNames, teamName)
+	}
+
+	scopedJobs, err := s.jobFactory.VisibleJobs(teamNames)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := map[int]bool{}
+	for _, job := range dashboard {
+		listed[job.ID] = true
+	}
+
+	for _, job := range scopedJobs {
+		if !listed[job.ID] && acc.IsAuthorizedForPipeline(job.TeamName, job.PipelineName) {
+			dashboard = append(dashboard, job)
+		}
+	}
+
+	return dashboard, nil
+}
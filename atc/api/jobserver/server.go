@@ -1,12 +1,21 @@
 package jobserver
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
 )
 
+//go:generate counterfeiter . InputsExplainer
+
+type InputsExplainer interface {
+	Explain(context.Context, db.Job, db.InputConfigs) (algorithm.Explanation, error)
+}
+
 type Server struct {
 	logger lager.Logger
 
@@ -15,6 +24,8 @@ type Server struct {
 	secretManager creds.Secrets
 	jobFactory    db.JobFactory
 	checkFactory  db.CheckFactory
+
+	inputsExplainer InputsExplainer
 }
 
 func NewServer(
@@ -23,6 +34,7 @@ func NewServer(
 	secretManager creds.Secrets,
 	jobFactory db.JobFactory,
 	checkFactory db.CheckFactory,
+	inputsExplainer InputsExplainer,
 ) *Server {
 	return &Server{
 		logger:        logger,
@@ -31,5 +43,7 @@ func NewServer(
 		secretManager: secretManager,
 		jobFactory:    jobFactory,
 		checkFactory:  checkFactory,
+
+		inputsExplainer: inputsExplainer,
 	}
 }
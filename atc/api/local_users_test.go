@@ -0,0 +1,438 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	"golang.org/x/crypto/bcrypt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local Users API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/local_users", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/local_users", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbLocalUserFactory.LocalUsersCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbLocalUserFactory.LocalUsersReturns([]atc.LocalUser{
+					{
+						Username:  "some-user",
+						CreatedBy: "some-admin",
+						CreatedAt: 100,
+						UpdatedAt: 110,
+					},
+					{
+						Username:  "disabled-user",
+						Disabled:  true,
+						CreatedAt: 100,
+						UpdatedAt: 120,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				expectedHeaderEntries := map[string]string{
+					"Content-Type": "application/json",
+				}
+				Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+			})
+
+			It("lists the users sorted by username, including the static users", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"username": "disabled-user",
+						"disabled": true,
+						"created_at": 100,
+						"updated_at": 120
+					},
+					{
+						"username": "some-static-user",
+						"read_only": true
+					},
+					{
+						"username": "some-user",
+						"created_by": "some-admin",
+						"created_at": 100,
+						"updated_at": 110
+					}
+				]`))
+			})
+
+			Context("when listing the users fails", func() {
+				BeforeEach(func() {
+					dbLocalUserFactory.LocalUsersReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/local_users", func() {
+		var user atc.LocalUser
+
+		BeforeEach(func() {
+			user = atc.LocalUser{
+				Username: "some-user",
+				Password: "some-password",
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(user)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/local_users", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbLocalUserFactory.CreateLocalUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-admin"})
+
+				dbLocalUserFactory.CreateLocalUserReturns(atc.LocalUser{
+					Username:  "some-user",
+					CreatedBy: "some-admin",
+					CreatedAt: 100,
+					UpdatedAt: 100,
+				}, nil)
+			})
+
+			It("returns 201 with the created user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"username": "some-user",
+					"created_by": "some-admin",
+					"created_at": 100,
+					"updated_at": 100
+				}`))
+			})
+
+			It("stores a bcrypt hash of the password", func() {
+				Expect(dbLocalUserFactory.CreateLocalUserCallCount()).To(Equal(1))
+				created, hash := dbLocalUserFactory.CreateLocalUserArgsForCall(0)
+				Expect(created.Username).To(Equal("some-user"))
+				Expect(created.CreatedBy).To(Equal("some-admin"))
+				Expect(created.Password).To(BeEmpty())
+				Expect(bcrypt.CompareHashAndPassword(hash, []byte("some-password"))).To(Succeed())
+			})
+
+			Context("when the username is missing", func() {
+				BeforeEach(func() {
+					user.Username = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("username must be specified"))
+					Expect(dbLocalUserFactory.CreateLocalUserCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the username contains a colon", func() {
+				BeforeEach(func() {
+					user.Username = "some:user"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the password is missing", func() {
+				BeforeEach(func() {
+					user.Password = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("password must be specified"))
+				})
+			})
+
+			Context("when the username belongs to a static user", func() {
+				BeforeEach(func() {
+					user.Username = "Some-Static-User"
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(dbLocalUserFactory.CreateLocalUserCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the user already exists", func() {
+				BeforeEach(func() {
+					dbLocalUserFactory.CreateLocalUserReturns(atc.LocalUser{}, db.ErrLocalUserExists)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("already exists"))
+				})
+			})
+
+			Context("when creating the user fails", func() {
+				BeforeEach(func() {
+					dbLocalUserFactory.CreateLocalUserReturns(atc.LocalUser{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/local_users/:local_user_name/password", func() {
+		var username, password string
+
+		BeforeEach(func() {
+			username = "some-user"
+			password = "new-password"
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(atc.LocalUser{Password: password})
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/local_users/"+username+"/password", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbLocalUserFactory.SetLocalUserPasswordCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbLocalUserFactory.SetLocalUserPasswordReturns(true, nil)
+			})
+
+			It("returns 204 and stores the new hash", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(dbLocalUserFactory.SetLocalUserPasswordCallCount()).To(Equal(1))
+				updated, hash := dbLocalUserFactory.SetLocalUserPasswordArgsForCall(0)
+				Expect(updated).To(Equal("some-user"))
+				Expect(bcrypt.CompareHashAndPassword(hash, []byte("new-password"))).To(Succeed())
+			})
+
+			Context("when the password is missing", func() {
+				BeforeEach(func() {
+					password = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbLocalUserFactory.SetLocalUserPasswordCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the user is a static user", func() {
+				BeforeEach(func() {
+					username = "some-static-user"
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("--add-local-user"))
+					Expect(dbLocalUserFactory.SetLocalUserPasswordCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the user does not exist", func() {
+				BeforeEach(func() {
+					dbLocalUserFactory.SetLocalUserPasswordReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when updating the user fails", func() {
+				BeforeEach(func() {
+					dbLocalUserFactory.SetLocalUserPasswordReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	for _, action := range []string{"disable", "enable"} {
+		action := action
+
+		Describe("PUT /api/v1/local_users/:local_user_name/"+action, func() {
+			var username string
+
+			BeforeEach(func() {
+				username = "some-user"
+			})
+
+			JustBeforeEach(func() {
+				req, err := http.NewRequest("PUT", server.URL+"/api/v1/local_users/"+username+"/"+action, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = client.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when not an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbLocalUserFactory.SetLocalUserDisabledCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAdminReturns(true)
+
+					dbLocalUserFactory.SetLocalUserDisabledReturns(true, nil)
+				})
+
+				It("returns 204 and updates the user", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					Expect(dbLocalUserFactory.SetLocalUserDisabledCallCount()).To(Equal(1))
+					updated, disabled := dbLocalUserFactory.SetLocalUserDisabledArgsForCall(0)
+					Expect(updated).To(Equal("some-user"))
+					Expect(disabled).To(Equal(action == "disable"))
+				})
+
+				if action == "disable" {
+					It("revokes the sessions of the user", func() {
+						Expect(dbAccessTokenLifecycle.RevokeAccessTokensCallCount()).To(Equal(1))
+						revoked, connector := dbAccessTokenLifecycle.RevokeAccessTokensArgsForCall(0)
+						Expect(revoked).To(Equal("some-user"))
+						Expect(connector).To(Equal("local"))
+					})
+
+					Context("when revoking the sessions fails", func() {
+						BeforeEach(func() {
+							dbAccessTokenLifecycle.RevokeAccessTokensReturns(0, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				} else {
+					It("doesn't revoke any sessions", func() {
+						Expect(dbAccessTokenLifecycle.RevokeAccessTokensCallCount()).To(Equal(0))
+					})
+				}
+
+				Context("when the user is a static user", func() {
+					BeforeEach(func() {
+						username = "some-static-user"
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(dbLocalUserFactory.SetLocalUserDisabledCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the user does not exist", func() {
+					BeforeEach(func() {
+						dbLocalUserFactory.SetLocalUserDisabledReturns(false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+			})
+		})
+	}
+})
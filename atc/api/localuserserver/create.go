@@ -0,0 +1,104 @@
+package localuserserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// maxPasswordLength is the most bcrypt can hash.
+const maxPasswordLength = 72
+
+func (s *Server) CreateLocalUser(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-local-user")
+
+	var user atc.LocalUser
+	err := json.NewDecoder(r.Body).Decode(&user)
+	if err != nil {
+		logger.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = validate(user)
+	if err != nil {
+		logger.Info("invalid-local-user", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if s.isStatic(user.Username) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(db.ErrLocalUserExists.Error()))
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("failed-to-hash-password", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	created, err := s.localUserFactory.CreateLocalUser(atc.LocalUser{
+		Username:  user.Username,
+		CreatedBy: accessor.GetAccessor(r).Claims().UserName,
+	}, hash)
+	if err != nil {
+		if err == db.ErrLocalUserExists {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		logger.Error("failed-to-create-local-user", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("created", lager.Data{
+		"username":   created.Username,
+		"created-by": created.CreatedBy,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(created)
+	if err != nil {
+		logger.Error("failed-to-encode-local-user", err)
+	}
+}
+
+func validate(user atc.LocalUser) error {
+	if user.Username == "" {
+		return errors.New("username must be specified")
+	}
+
+	if strings.IndexFunc(user.Username, unicode.IsSpace) != -1 || strings.Contains(user.Username, ":") {
+		return errors.New("username must not contain whitespace or colons")
+	}
+
+	return validatePassword(user.Password)
+}
+
+func validatePassword(password string) error {
+	if password == "" {
+		return errors.New("password must be specified")
+	}
+
+	if len(password) > maxPasswordLength {
+		return errors.New("password must be at most 72 bytes long")
+	}
+
+	return nil
+}
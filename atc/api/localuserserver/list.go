@@ -0,0 +1,42 @@
+package localuserserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// ListLocalUsers lists the users managed through the API along with the
+// read-only users added with the --add-local-user flag.
+func (s *Server) ListLocalUsers(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-local-users")
+
+	users, err := s.localUserFactory.LocalUsers()
+	if err != nil {
+		logger.Error("failed-to-list-local-users", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	for _, username := range s.staticUsers {
+		users = append(users, atc.LocalUser{
+			Username: username,
+			ReadOnly: true,
+		})
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(users[i].Username) < strings.ToLower(users[j].Username)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(users)
+	if err != nil {
+		logger.Error("failed-to-encode-local-users", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
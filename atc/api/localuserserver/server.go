@@ -0,0 +1,44 @@
+package localuserserver
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// localConnector is the connector local users log in with.
+const localConnector = "local"
+
+type Server struct {
+	logger               lager.Logger
+	localUserFactory     db.LocalUserFactory
+	accessTokenLifecycle db.AccessTokenLifecycle
+	staticUsers          []string
+}
+
+// NewServer constructs a Server. The static users are the ones added with the
+// --add-local-user flag, which can't be changed through the API.
+func NewServer(
+	logger lager.Logger,
+	localUserFactory db.LocalUserFactory,
+	accessTokenLifecycle db.AccessTokenLifecycle,
+	staticUsers []string,
+) *Server {
+	return &Server{
+		logger:               logger,
+		localUserFactory:     localUserFactory,
+		accessTokenLifecycle: accessTokenLifecycle,
+		staticUsers:          staticUsers,
+	}
+}
+
+func (s *Server) isStatic(username string) bool {
+	for _, static := range s.staticUsers {
+		if strings.EqualFold(static, username) {
+			return true
+		}
+	}
+
+	return false
+}
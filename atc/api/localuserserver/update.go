@@ -0,0 +1,109 @@
+package localuserserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const errStaticUser = "this user was added with --add-local-user and can only be changed by restarting the web node"
+
+func (s *Server) SetLocalUserPassword(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("set-local-user-password")
+
+	username := r.FormValue(":local_user_name")
+
+	var user atc.LocalUser
+	err := json.NewDecoder(r.Body).Decode(&user)
+	if err != nil {
+		logger.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = validatePassword(user.Password)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if s.isStatic(username) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(errStaticUser))
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("failed-to-hash-password", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	updated, err := s.localUserFactory.SetLocalUserPassword(username, hash)
+	if err != nil {
+		logger.Error("failed-to-set-local-user-password", err, lager.Data{"username": username})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !updated {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger.Info("password-set", lager.Data{"username": username})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) DisableLocalUser(w http.ResponseWriter, r *http.Request) {
+	s.setDisabled(w, r, true)
+}
+
+func (s *Server) EnableLocalUser(w http.ResponseWriter, r *http.Request) {
+	s.setDisabled(w, r, false)
+}
+
+func (s *Server) setDisabled(w http.ResponseWriter, r *http.Request, disabled bool) {
+	logger := s.logger.Session("set-local-user-disabled")
+
+	username := r.FormValue(":local_user_name")
+
+	if s.isStatic(username) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(errStaticUser))
+		return
+	}
+
+	updated, err := s.localUserFactory.SetLocalUserDisabled(username, disabled)
+	if err != nil {
+		logger.Error("failed-to-set-local-user-disabled", err, lager.Data{"username": username})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !updated {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// a disabled user can't log in again, but their sessions would otherwise
+	// keep working until they expire
+	if disabled {
+		_, err = s.accessTokenLifecycle.RevokeAccessTokens(username, localConnector)
+		if err != nil {
+			logger.Error("failed-to-revoke-sessions", err, lager.Data{"username": username})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	logger.Info("updated", lager.Data{"username": username, "disabled": disabled})
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -201,6 +202,45 @@ var _ = Describe("Pipelines API", func() {
 				))
 			})
 
+			Context("when the user has roles on only some pipelines of another team", func() {
+				BeforeEach(func() {
+					fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+						"other": {"deploy-*": {"member"}},
+					})
+					fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+						return teamName == "main" || (teamName == "other" && pipelineName == "deploy-prod")
+					}
+
+					scopedPipeline := new(dbfakes.FakePipeline)
+					scopedPipeline.IDReturns(5)
+					scopedPipeline.TeamNameReturns("other")
+					scopedPipeline.NameReturns("deploy-prod")
+
+					unscopedPipeline := new(dbfakes.FakePipeline)
+					unscopedPipeline.IDReturns(6)
+					unscopedPipeline.TeamNameReturns("other")
+					unscopedPipeline.NameReturns("experiments")
+
+					dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{privatePipeline, publicPipeline, scopedPipeline, unscopedPipeline}, nil)
+				})
+
+				It("also returns the private pipelines of that team the user has a role on", func() {
+					Expect(dbPipelineFactory.VisiblePipelinesArgsForCall(0)).To(ConsistOf("main", "other"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pipelines []map[string]interface{}
+					err = json.Unmarshal(body, &pipelines)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pipelines).To(ConsistOf(
+						HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", 5)),
+					))
+				})
+			})
+
 			Context("user has the Admin privilege", func() {
 				BeforeEach(func() {
 					fakeAccess.IsAdminReturns(true)
@@ -335,6 +375,43 @@ var _ = Describe("Pipelines API", func() {
 			})
 		})
 
+		Context("when authorized on only some of the team's pipelines", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+					"main": {"private-*": {"member"}},
+				})
+				fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+					return teamName == "main" && pipelineName == "private-pipeline"
+				}
+
+				otherPrivatePipeline := new(dbfakes.FakePipeline)
+				otherPrivatePipeline.IDReturns(4)
+				otherPrivatePipeline.TeamNameReturns("main")
+				otherPrivatePipeline.NameReturns("secret-pipeline")
+
+				fakeTeam.PipelinesReturns([]db.Pipeline{
+					privatePipeline,
+					otherPrivatePipeline,
+					publicPipeline,
+				}, nil)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns the public pipelines and those the user has a role on", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				var pipelines []map[string]interface{}
+				json.Unmarshal(body, &pipelines)
+
+				Expect(pipelines).To(ConsistOf(
+					HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+				))
+			})
+		})
+
 		Context("when authenticated as another team", func() {
 			BeforeEach(func() {
 				fakeAccess.IsAuthenticatedReturns(false)
@@ -1619,6 +1696,23 @@ var _ = Describe("Pipelines API", func() {
 					Expect(dbPipeline.RenameArgsForCall(0)).To(Equal("some-new-name"))
 				})
 
+				Context("when the requester may not access a pipeline with the new name", func() {
+					BeforeEach(func() {
+						dbPipeline.TeamNameReturns("a-team")
+						fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+							return teamName == "a-team" && pipelineName == "a-pipeline"
+						}
+					})
+
+					It("returns 403 Forbidden", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not rename the pipeline", func() {
+						Expect(dbPipeline.RenameCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when a warning occurs", func() {
 
 					BeforeEach(func() {
@@ -1947,6 +2041,7 @@ var _ = Describe("Pipelines API", func() {
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
 
 						dbPipeline.CreateStartedBuildReturns(fakeBuild, nil)
+						fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub"})
 					})
 
 					It("returns 201 Created", func() {
@@ -1962,7 +2057,9 @@ var _ = Describe("Pipelines API", func() {
 
 					It("creates a started build", func() {
 						Expect(dbPipeline.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbPipeline.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbPipeline.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-sub"))
 					})
 
 					It("returns the created build", func() {
@@ -1984,4 +2081,253 @@ var _ = Describe("Pipelines API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/delivery-metrics", func() {
+		var response *http.Response
+		var queryParams string
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/delivery-metrics" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakePipeline.DeliveryMetricsReturns([]atc.JobDeliveryMetrics{
+					{
+						TeamName:          "some-team",
+						PipelineName:      "some-pipeline",
+						JobName:           "deploy",
+						Deployments:       4,
+						Failures:          1,
+						ChangeFailureRate: 0.2,
+						LeadTime:          3600,
+						TimeToRecover:     600,
+					},
+				}, nil)
+			})
+
+			Context("when since is passed", func() {
+				BeforeEach(func() {
+					queryParams = "?since=1600000000"
+				})
+
+				It("returns the delivery metrics of builds since then", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					Expect(fakePipeline.DeliveryMetricsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeliveryMetricsArgsForCall(0)).To(Equal(time.Unix(1600000000, 0)))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"since": 1600000000,
+						"jobs": [
+							{
+								"team_name": "some-team",
+								"pipeline_name": "some-pipeline",
+								"job_name": "deploy",
+								"deployments": 4,
+								"failures": 1,
+								"change_failure_rate": 0.2,
+								"lead_time": 3600,
+								"time_to_recover": 600
+							}
+						]
+					}`))
+				})
+			})
+
+			Context("when since is not passed", func() {
+				It("defaults to the last 30 days", func() {
+					Expect(fakePipeline.DeliveryMetricsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeliveryMetricsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+				})
+			})
+
+			Context("when since is malformed", func() {
+				BeforeEach(func() {
+					queryParams = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakePipeline.DeliveryMetricsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when computing the delivery metrics fails", func() {
+				BeforeEach(func() {
+					fakePipeline.DeliveryMetricsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/blocked-builds", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/blocked-builds")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when there are blocked builds", func() {
+				BeforeEach(func() {
+					pendingBuild := new(dbfakes.FakeBuild)
+					pendingBuild.IDReturns(1)
+					pendingBuild.NameReturns("3")
+					pendingBuild.JobNameReturns("some-job")
+					pendingBuild.PipelineIDReturns(1)
+					pendingBuild.PipelineNameReturns("some-pipeline")
+					pendingBuild.TeamNameReturns("some-team")
+					pendingBuild.StatusReturns(db.BuildStatusPending)
+					pendingBuild.PendingReasonReturns("max in flight reached")
+
+					fakePipeline.BlockedBuildsReturns([]db.Build{pendingBuild}, nil)
+				})
+
+				It("returns the builds with why they are blocked", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"id": 1,
+							"name": "3",
+							"status": "pending",
+							"job_name": "some-job",
+							"pipeline_id": 1,
+							"pipeline_name": "some-pipeline",
+							"team_name": "some-team",
+							"api_url": "/api/v1/builds/1",
+							"pending_reason": "max in flight reached"
+						}
+					]`))
+				})
+			})
+
+			Context("when a build is waiting in the fair-share queue", func() {
+				BeforeEach(func() {
+					queuedBuild := new(dbfakes.FakeBuild)
+					queuedBuild.IDReturns(2)
+					queuedBuild.NameReturns("4")
+					queuedBuild.JobNameReturns("some-job")
+					queuedBuild.PipelineIDReturns(1)
+					queuedBuild.PipelineNameReturns("some-pipeline")
+					queuedBuild.TeamNameReturns("some-team")
+					queuedBuild.StatusReturns(db.BuildStatusPending)
+					queuedBuild.PendingReasonReturns("waiting for a fair share of the build capacity")
+					queuedBuild.QueuePositionReturns(3)
+
+					fakePipeline.BlockedBuildsReturns([]db.Build{queuedBuild}, nil)
+				})
+
+				It("returns the build's queue position", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"id": 2,
+							"name": "4",
+							"status": "pending",
+							"job_name": "some-job",
+							"pipeline_id": 1,
+							"pipeline_name": "some-pipeline",
+							"team_name": "some-team",
+							"api_url": "/api/v1/builds/2",
+							"pending_reason": "waiting for a fair share of the build capacity",
+							"queue_position": 3
+						}
+					]`))
+				})
+			})
+
+			Context("when there are no blocked builds", func() {
+				It("returns an empty list", func() {
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+				})
+			})
+
+			Context("when getting the blocked builds fails", func() {
+				BeforeEach(func() {
+					fakePipeline.BlockedBuildsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
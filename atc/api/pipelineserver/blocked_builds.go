@@ -0,0 +1,40 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListBlockedBuilds lists the builds of the pipeline's jobs that haven't run,
+// along with why: the pending builds the scheduler couldn't start, the
+// started builds waiting for their team's quota, and the latest builds that
+// errored because no worker could run them.
+func (s *Server) ListBlockedBuilds(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-blocked-builds")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		builds, err := pipeline.BlockedBuilds()
+		if err != nil {
+			logger.Error("failed-to-get-blocked-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := []atc.Build{}
+		for _, build := range builds {
+			presented = append(presented, present.Build(build))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultDeliveryMetricsWindow is how far back delivery metrics look at
+// builds unless the request says otherwise.
+const defaultDeliveryMetricsWindow = 30 * 24 * time.Hour
+
+func (s *Server) GetDeliveryMetrics(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-delivery-metrics")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since := time.Now().Add(-defaultDeliveryMetricsWindow)
+
+		if urlSince := r.FormValue("since"); urlSince != "" {
+			unix, err := strconv.ParseInt(urlSince, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "malformed since: %s", urlSince)
+				return
+			}
+
+			since = time.Unix(unix, 0)
+		}
+
+		jobs, err := pipeline.DeliveryMetrics(since)
+		if err != nil {
+			logger.Error("failed-to-get-delivery-metrics", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if jobs == nil {
+			jobs = []atc.JobDeliveryMetrics{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(atc.DeliveryMetrics{
+			Since: since.Unix(),
+			Jobs:  jobs,
+		})
+		if err != nil {
+			logger.Error("failed-to-encode-delivery-metrics", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
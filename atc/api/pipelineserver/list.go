@@ -30,6 +30,9 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 
 	if acc.IsAuthorized(requestTeamName) {
 		pipelines, err = team.Pipelines()
+	} else if _, scoped := acc.PipelineRoles()[requestTeamName]; scoped {
+		pipelines, err = team.Pipelines()
+		pipelines = authorizedPipelines(acc, pipelines)
 	} else {
 		pipelines, err = team.PublicPipelines()
 	}
@@ -48,3 +51,15 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// authorizedPipelines keeps the pipelines which are public or on which the
+// user has a role.
+func authorizedPipelines(acc accessor.Access, pipelines []db.Pipeline) []db.Pipeline {
+	authorized := []db.Pipeline{}
+	for _, pipeline := range pipelines {
+		if pipeline.Public() || acc.IsAuthorizedForPipeline(pipeline.TeamName(), pipeline.Name()) {
+			authorized = append(authorized, pipeline)
+		}
+	}
+	return authorized
+}
@@ -20,6 +20,14 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 
 	if acc.IsAdmin() {
 		pipelines, err = s.pipelineFactory.AllPipelines()
+	} else if len(acc.PipelineRoles()) > 0 {
+		teamNames := acc.TeamNames()
+		for teamName := range acc.PipelineRoles() {
+			teamNames = append(teamNames, teamName)
+		}
+
+		pipelines, err = s.pipelineFactory.VisiblePipelines(teamNames)
+		pipelines = authorizedPipelines(acc, pipelines)
 	} else {
 		pipelines, err = s.pipelineFactory.VisiblePipelines(acc.TeamNames())
 	}
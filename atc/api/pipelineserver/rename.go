@@ -5,7 +5,10 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -28,6 +31,15 @@ func (s *Server) RenamePipeline(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		// a role granted on only some pipelines must not be able to move the
+		// pipeline out of its reach, or into the reach of other roles
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAuthorizedForPipeline(pipeline.TeamName(), rename.NewName) {
+			logger.Info("not-authorized-for-new-name", lager.Data{"new-name": rename.NewName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		var warnings []atc.ConfigWarning
 		warning := atc.ValidateIdentifier(rename.NewName, "pipeline")
 		if warning != nil {
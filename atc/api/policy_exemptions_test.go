@@ -0,0 +1,281 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy Exemptions API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/policy/exemptions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/policy/exemptions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedStub = func(team string) bool {
+					return team == "some-team"
+				}
+
+				dbTeamPolicies.ActiveExemptionsReturns([]atc.PolicyExemption{
+					{
+						ID:        1,
+						Team:      "some-team",
+						Action:    "RunStep",
+						Reason:    "migrating",
+						CreatedBy: "some-admin",
+						CreatedAt: 100,
+						ExpiresAt: 200,
+					},
+					{
+						ID:        2,
+						Team:      "other-team",
+						Action:    "*",
+						Reason:    "incident",
+						CreatedBy: "some-admin",
+						CreatedAt: 100,
+						ExpiresAt: 300,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				expectedHeaderEntries := map[string]string{
+					"Content-Type": "application/json",
+				}
+				Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+			})
+
+			It("returns the exemptions of the teams the user is authorized for", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 1,
+						"team": "some-team",
+						"action": "RunStep",
+						"reason": "migrating",
+						"created_by": "some-admin",
+						"created_at": 100,
+						"expires_at": 200
+					}
+				]`))
+			})
+
+			Context("when getting the exemptions fails", func() {
+				BeforeEach(func() {
+					dbTeamPolicies.ActiveExemptionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/policy/exemptions", func() {
+		var exemption atc.PolicyExemption
+
+		BeforeEach(func() {
+			exemption = atc.PolicyExemption{
+				Team:      "some-team",
+				Action:    "RunStep",
+				Reason:    "migrating",
+				ExpiresAt: 3600,
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(exemption)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/policy/exemptions", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated but not admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeamPolicies.CreateExemptionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authenticated as admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-admin"})
+
+				dbTeamPolicies.CreateExemptionStub = func(e atc.PolicyExemption) (atc.PolicyExemption, error) {
+					e.ID = 42
+					e.CreatedAt = 123
+					return e, nil
+				}
+			})
+
+			It("returns 201", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			})
+
+			It("creates the exemption on behalf of the user", func() {
+				Expect(dbTeamPolicies.CreateExemptionCallCount()).To(Equal(1))
+				Expect(dbTeamPolicies.CreateExemptionArgsForCall(0)).To(Equal(atc.PolicyExemption{
+					Team:      "some-team",
+					Action:    "RunStep",
+					Reason:    "migrating",
+					CreatedBy: "some-admin",
+					ExpiresAt: 3600,
+				}))
+			})
+
+			It("returns the created exemption", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"id": 42,
+					"team": "some-team",
+					"action": "RunStep",
+					"reason": "migrating",
+					"created_by": "some-admin",
+					"created_at": 123,
+					"expires_at": 3600
+				}`))
+			})
+
+			Context("when the reason is missing", func() {
+				BeforeEach(func() {
+					exemption.Reason = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("reason must be specified")))
+					Expect(dbTeamPolicies.CreateExemptionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the exemption has already expired", func() {
+				BeforeEach(func() {
+					exemption.ExpiresAt = 100
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("expiry must be in the future")))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamPolicies.CreateExemptionStub = nil
+					dbTeamPolicies.CreateExemptionReturns(atc.PolicyExemption{}, db.ErrPolicyExemptionTeamNotFound)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when creating the exemption fails", func() {
+				BeforeEach(func() {
+					dbTeamPolicies.CreateExemptionStub = nil
+					dbTeamPolicies.CreateExemptionReturns(atc.PolicyExemption{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/policy/exemptions/:exemption_id", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/policy/exemptions/42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated but not admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeamPolicies.DeleteExemptionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authenticated as admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				dbTeamPolicies.DeleteExemptionReturns(true, nil)
+			})
+
+			It("deletes the exemption", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbTeamPolicies.DeleteExemptionArgsForCall(0)).To(Equal(42))
+			})
+
+			Context("when the exemption does not exist", func() {
+				BeforeEach(func() {
+					dbTeamPolicies.DeleteExemptionReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
@@ -39,7 +39,7 @@ var _ = Describe("PolicyChecker", func() {
 	})
 
 	JustBeforeEach(func() {
-		policyCheck, err := policy.Initialize(testLogger, "some-cluster", "some-version", policyFilter)
+		policyCheck, err := policy.Initialize(testLogger, "some-cluster", "some-version", policyFilter, nil, nil)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(policyCheck).ToNot(BeNil())
 		result, checkErr = policychecker.NewApiPolicyChecker(policyCheck).Check("some-action", fakeAccess, fakeRequest)
@@ -41,7 +41,7 @@ func (h policyCheckingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			fmt.Fprintf(w, fmt.Sprintf("policy check error: %s", err.Error()))
 			return
 		}
-		if !result.Allowed {
+		if result.Blocked() {
 			w.WriteHeader(http.StatusForbidden)
 			policyCheckErr := policy.PolicyCheckNotPass{
 				Reasons: result.Reasons,
@@ -49,6 +49,10 @@ func (h policyCheckingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			fmt.Fprintf(w, policyCheckErr.Error())
 			return
 		}
+
+		if warnings := result.Warnings(); len(warnings) > 0 {
+			r = r.WithContext(policy.RecordWarnings(r.Context(), warnings))
+		}
 	}
 
 	h.handler.ServeHTTP(w, r)
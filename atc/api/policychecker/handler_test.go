@@ -19,6 +19,7 @@ import (
 var _ = Describe("Handler", func() {
 	var (
 		innerHandlerCalled   bool
+		innerHandlerWarnings []string
 		dummyHandler         http.HandlerFunc
 		policyCheckerHandler http.Handler
 		req                  *http.Request
@@ -34,6 +35,7 @@ var _ = Describe("Handler", func() {
 		innerHandlerCalled = false
 		dummyHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			innerHandlerCalled = true
+			innerHandlerWarnings = policy.WarningsFromContext(r.Context())
 		})
 
 		responseWriter = httptest.NewRecorder()
@@ -93,6 +95,39 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("policy check doesn't pass with warn severity", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"a policy discourages that"},
+					Severity: policy.SeverityWarn,
+				}, nil)
+			})
+
+			It("calls the inner handler", func() {
+				Expect(innerHandlerCalled).To(BeTrue())
+			})
+
+			It("records the warnings in the request context", func() {
+				Expect(innerHandlerWarnings).To(ConsistOf("a policy discourages that"))
+			})
+		})
+
+		Context("policy check doesn't pass with audit severity", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"a policy will forbid that"},
+					Severity: policy.SeverityAudit,
+				}, nil)
+			})
+
+			It("calls the inner handler without warnings", func() {
+				Expect(innerHandlerCalled).To(BeTrue())
+				Expect(innerHandlerWarnings).To(BeEmpty())
+			})
+		})
+
 		Context("policy check errors", func() {
 			BeforeEach(func() {
 				fakePolicyChecker.CheckReturns(policy.FailedPolicyCheck(), errors.New("some-error"))
@@ -0,0 +1,84 @@
+package policyexemptionserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CreatePolicyExemption(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-policy-exemption")
+
+	var exemption atc.PolicyExemption
+	err := json.NewDecoder(r.Body).Decode(&exemption)
+	if err != nil {
+		logger.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = s.validate(exemption)
+	if err != nil {
+		logger.Info("invalid-exemption", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	exemption.CreatedBy = accessor.GetAccessor(r).Claims().UserName
+
+	created, err := s.teamPolicies.CreateExemption(exemption)
+	if err != nil {
+		if err == db.ErrPolicyExemptionTeamNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		logger.Error("failed-to-create-exemption", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("created", lager.Data{
+		"id":         created.ID,
+		"team":       created.Team,
+		"action":     created.Action,
+		"reason":     created.Reason,
+		"created-by": created.CreatedBy,
+		"expires-at": created.ExpiresAt,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(created)
+	if err != nil {
+		logger.Error("failed-to-encode-exemption", err)
+	}
+}
+
+func (s *Server) validate(exemption atc.PolicyExemption) error {
+	if exemption.Team == "" {
+		return errors.New("team must be specified")
+	}
+
+	if exemption.Action == "" {
+		return errors.New("action must be specified")
+	}
+
+	if exemption.Reason == "" {
+		return errors.New("reason must be specified")
+	}
+
+	if exemption.ExpiresAt <= s.clock.Now().Unix() {
+		return errors.New("expiry must be in the future")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package policyexemptionserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+)
+
+func (s *Server) DeletePolicyExemption(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("delete-policy-exemption")
+
+	id, err := strconv.Atoi(r.FormValue(":exemption_id"))
+	if err != nil {
+		logger.Error("invalid-exemption-id", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.teamPolicies.DeleteExemption(id)
+	if err != nil {
+		logger.Error("failed-to-delete-exemption", err, lager.Data{"id": id})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !deleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger.Info("deleted", lager.Data{"id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
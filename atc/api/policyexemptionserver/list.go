@@ -0,0 +1,36 @@
+package policyexemptionserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+func (s *Server) ListPolicyExemptions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-policy-exemptions")
+
+	exemptions, err := s.teamPolicies.ActiveExemptions()
+	if err != nil {
+		logger.Error("failed-to-get-exemptions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+	presentedExemptions := []atc.PolicyExemption{}
+	for _, exemption := range exemptions {
+		if acc.IsAuthorized(exemption.Team) {
+			presentedExemptions = append(presentedExemptions, exemption)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(presentedExemptions)
+	if err != nil {
+		logger.Error("failed-to-encode-exemptions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
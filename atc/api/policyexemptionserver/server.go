@@ -0,0 +1,21 @@
+package policyexemptionserver
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger       lager.Logger
+	teamPolicies db.TeamPolicies
+	clock        clock.Clock
+}
+
+func NewServer(logger lager.Logger, teamPolicies db.TeamPolicies, clock clock.Clock) *Server {
+	return &Server{
+		logger:       logger,
+		teamPolicies: teamPolicies,
+		clock:        clock,
+	}
+}
@@ -29,6 +29,9 @@ func Build(build db.Build) atc.Build {
 		TeamName:             build.TeamName(),
 		Status:               string(build.Status()),
 		APIURL:               apiURL,
+		PendingReason:        build.PendingReason(),
+		QueuePosition:        build.QueuePosition(),
+		AwaitingApproval:     build.AwaitingApproval(),
 	}
 
 	if build.RerunOf() != 0 {
@@ -29,10 +29,11 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 		Type:                 resource.Type(),
 		Icon:                 resource.Icon(),
 
-		FailingToCheck:  failingToCheck,
-		CheckSetupError: checkErrString,
-		CheckError:      rcCheckErrString,
-		PinComment:      resource.PinComment(),
+		FailingToCheck:           failingToCheck,
+		CheckSetupError:          checkErrString,
+		CheckError:               rcCheckErrString,
+		ConsecutiveCheckFailures: resource.ConsecutiveCheckFailures(),
+		PinComment:               resource.PinComment(),
 	}
 
 	if !resource.LastCheckEndTime().IsZero() {
@@ -6,9 +6,17 @@ import (
 )
 
 func Team(team db.Team) atc.Team {
-	return atc.Team{
+	atcTeam := atc.Team{
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		PolicyProfile: team.PolicyProfile(),
+	}
+
+	if quotas := team.Quotas(); quotas != (atc.TeamQuotas{}) {
+		atcTeam.Quotas = &quotas
 	}
+
+	return atcTeam
 }
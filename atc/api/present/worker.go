@@ -46,5 +46,9 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		atcWorker.StartTime = workerInfo.StartTime().Unix()
 	}
 
+	if !workerInfo.DrainDeadline().IsZero() {
+		atcWorker.DrainDeadline = workerInfo.DrainDeadline().Unix()
+	}
+
 	return atcWorker
 }
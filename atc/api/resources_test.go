@@ -170,6 +170,47 @@ var _ = Describe("Resources API", func() {
 					Expect(dbResourceFactory.VisibleResourcesArgsForCall(0)).To(ContainElement("some-team"))
 				})
 
+				Context("when the user has roles on only some pipelines of a team", func() {
+					BeforeEach(func() {
+						fakeAccess.PipelineRolesReturns(map[string]map[string][]string{
+							"other-team": {"dev-*": {"member"}},
+						})
+						fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+							return teamName == "other-team" && pipelineName == "dev-api"
+						}
+
+						devResource := new(dbfakes.FakeResource)
+						devResource.IDReturns(10)
+						devResource.NameReturns("dev-resource")
+						devResource.PipelineNameReturns("dev-api")
+						devResource.TeamNameReturns("other-team")
+
+						prodResource := new(dbfakes.FakeResource)
+						prodResource.IDReturns(11)
+						prodResource.NameReturns("prod-resource")
+						prodResource.PipelineNameReturns("prod-api")
+						prodResource.TeamNameReturns("other-team")
+
+						dbResourceFactory.VisibleResourcesReturnsOnCall(1, []db.Resource{devResource, prodResource}, nil)
+					})
+
+					It("adds the resources of the pipelines the user has a role on", func() {
+						Expect(dbResourceFactory.VisibleResourcesCallCount()).To(Equal(2))
+						Expect(dbResourceFactory.VisibleResourcesArgsForCall(1)).To(Equal([]string{"other-team"}))
+
+						var resources []atc.Resource
+						err := json.NewDecoder(response.Body).Decode(&resources)
+						Expect(err).NotTo(HaveOccurred())
+
+						var names []string
+						for _, resource := range resources {
+							names = append(names, resource.Name)
+						}
+						Expect(names).To(ContainElement("dev-resource"))
+						Expect(names).ToNot(ContainElement("prod-resource"))
+					})
+				})
+
 				Context("when user has admin privilege", func() {
 					BeforeEach(func() {
 						fakeAccess.IsAdminReturns(true)
@@ -183,6 +224,120 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/resources/stale", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/resources/stale" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedStub = func(teamName string) bool {
+					return teamName == "some-team"
+				}
+
+				resource1 = new(dbfakes.FakeResource)
+				resource1.PipelineIDReturns(1)
+				resource1.PipelineNameReturns("a-pipeline")
+				resource1.TeamNameReturns("some-team")
+				resource1.NameReturns("resource-1")
+				resource1.TypeReturns("type-1")
+				resource1.CheckErrorReturns(errors.New("sup"))
+				resource1.ConsecutiveCheckFailuresReturns(3)
+				resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+
+				resource2 := new(dbfakes.FakeResource)
+				resource2.PipelineIDReturns(2)
+				resource2.PipelineNameReturns("other-pipeline")
+				resource2.TeamNameReturns("other-team")
+				resource2.NameReturns("resource-2")
+				resource2.TypeReturns("type-2")
+
+				dbResourceFactory.StaleResourcesReturns([]db.Resource{resource1, resource2}, nil)
+			})
+
+			It("returns the stale resources of the user's teams", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"name": "resource-1",
+						"pipeline_id": 1,
+						"pipeline_name": "a-pipeline",
+						"team_name": "some-team",
+						"type": "type-1",
+						"last_checked": 1513364881,
+						"failing_to_check": true,
+						"check_error": "sup",
+						"consecutive_check_failures": 3
+					}
+				]`))
+			})
+
+			It("defaults to resources that haven't been checked successfully in a day", func() {
+				Expect(dbResourceFactory.StaleResourcesCallCount()).To(Equal(1))
+				Expect(dbResourceFactory.StaleResourcesArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			})
+
+			Context("when a threshold is given", func() {
+				BeforeEach(func() {
+					query = "?threshold=2h"
+				})
+
+				It("looks for resources that haven't been checked successfully within it", func() {
+					Expect(dbResourceFactory.StaleResourcesCallCount()).To(Equal(1))
+					Expect(dbResourceFactory.StaleResourcesArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+				})
+			})
+
+			Context("when the threshold is malformed", func() {
+				BeforeEach(func() {
+					query = "?threshold=nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbResourceFactory.StaleResourcesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the stale resources fails", func() {
+				BeforeEach(func() {
+					dbResourceFactory.StaleResourcesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources", func() {
 		var response *http.Response
 
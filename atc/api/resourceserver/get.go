@@ -35,7 +35,7 @@ func (s *Server) GetResource(pipeline db.Pipeline) http.Handler {
 		acc := accessor.GetAccessor(r)
 		resource := present.Resource(
 			dbResource,
-			acc.IsAuthorized(teamName),
+			acc.IsAuthorizedForPipeline(teamName, dbResource.PipelineName()),
 			teamName,
 		)
 
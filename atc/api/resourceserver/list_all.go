@@ -23,6 +23,9 @@ func (s *Server) ListAllResources(w http.ResponseWriter, r *http.Request) {
 		dbResources, err = s.resourceFactory.AllResources()
 	} else {
 		dbResources, err = s.resourceFactory.VisibleResources(acc.TeamNames())
+		if err == nil && len(acc.PipelineRoles()) > 0 {
+			dbResources, err = s.withPipelineScopedResources(acc, dbResources)
+		}
 	}
 	if err != nil {
 		logger.Error("failed-to-get-all-visible-resources", err)
@@ -50,3 +53,30 @@ func (s *Server) ListAllResources(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// withPipelineScopedResources adds the resources of the pipelines on which the
+// user has roles that don't apply to the whole team.
+func (s *Server) withPipelineScopedResources(acc accessor.Access, dbResources []db.Resource) ([]db.Resource, error) {
+	var teamNames []string
+	for teamName := range acc.PipelineRoles() {
+		teamNames = append(teamNames, teamName)
+	}
+
+	scopedResources, err := s.resourceFactory.VisibleResources(teamNames)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := map[int]bool{}
+	for _, resource := range dbResources {
+		listed[resource.ID()] = true
+	}
+
+	for _, resource := range scopedResources {
+		if !listed[resource.ID()] && acc.IsAuthorizedForPipeline(resource.TeamName(), resource.PipelineName()) {
+			dbResources = append(dbResources, resource)
+		}
+	}
+
+	return dbResources, nil
+}
@@ -0,0 +1,65 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+const defaultStaleThreshold = 24 * time.Hour
+
+// ListStaleResources lists the resources of the user's teams that haven't
+// been checked successfully within the threshold, so that checks which keep
+// failing can be alerted on before anyone notices them in the UI.
+func (s *Server) ListStaleResources(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-stale-resources")
+
+	threshold := defaultStaleThreshold
+	if value := r.URL.Query().Get("threshold"); value != "" {
+		var err error
+		threshold, err = time.ParseDuration(value)
+		if err != nil || threshold <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed threshold: %s", value)
+			return
+		}
+	}
+
+	dbResources, err := s.resourceFactory.StaleResources(time.Now().Add(-threshold))
+	if err != nil {
+		logger.Error("failed-to-get-stale-resources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+
+	resources := []atc.Resource{}
+
+	for _, resource := range dbResources {
+		if !acc.IsAuthorizedForPipeline(resource.TeamName(), resource.PipelineName()) {
+			continue
+		}
+
+		resources = append(
+			resources,
+			present.Resource(
+				resource,
+				true,
+				resource.TeamName(),
+			),
+		)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(resources)
+	if err != nil {
+		logger.Error("failed-to-encode-resources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
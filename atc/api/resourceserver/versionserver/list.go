@@ -106,7 +106,7 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 		w.WriteHeader(http.StatusOK)
 
 		acc := accessor.GetAccessor(r)
-		hideMetadata := !resource.Public() && !acc.IsAuthorized(teamName)
+		hideMetadata := !resource.Public() && !acc.IsAuthorizedForPipeline(teamName, resource.PipelineName())
 
 		versions = present.ResourceVersions(hideMetadata, versions)
 
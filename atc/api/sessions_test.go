@@ -0,0 +1,341 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sessions API", func() {
+	var response *http.Response
+
+	sessionToken := func(id int, token string, username string, connector string) db.AccessToken {
+		return db.AccessToken{
+			ID:        id,
+			Token:     token,
+			CreatedAt: time.Unix(100, 0),
+			Claims: db.Claims{
+				Claims: jwt.Claims{Expiry: jwt.NewNumericDate(time.Unix(200, 0))},
+				FederatedClaims: db.FederatedClaims{
+					Username:  username,
+					Connector: connector,
+				},
+			},
+		}
+	}
+
+	Describe("GET /api/v1/user/sessions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/user/sessions", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Authorization", "Bearer some-token")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user", Connector: "github"})
+			})
+
+			Context("when listing the tokens succeeds", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.ListAccessTokensReturns([]db.AccessToken{
+						sessionToken(2, "some-token", "some-user", "github"),
+						sessionToken(1, "other-token", "some-user", "github"),
+					}, nil)
+				})
+
+				It("lists the sessions of the user on their connector", func() {
+					Expect(dbAccessTokenFactory.ListAccessTokensCallCount()).To(Equal(1))
+					username, connector := dbAccessTokenFactory.ListAccessTokensArgsForCall(0)
+					Expect(username).To(Equal("some-user"))
+					Expect(connector).To(Equal("github"))
+				})
+
+				It("returns the sessions, marking the current one", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"username": "some-user",
+							"connector": "github",
+							"created_at": 100,
+							"expires_at": 200,
+							"current": true
+						},
+						{
+							"id": 1,
+							"username": "some-user",
+							"connector": "github",
+							"created_at": 100,
+							"expires_at": 200
+						}
+					]`))
+				})
+			})
+
+			Context("when the claims have no user name", func() {
+				BeforeEach(func() {
+					fakeAccess.ClaimsReturns(accessor.Claims{})
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAccessTokenFactory.ListAccessTokensCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when listing the tokens fails", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.ListAccessTokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/user/sessions/:session_id", func() {
+		var sessionID string
+
+		BeforeEach(func() {
+			sessionID = "42"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/user/sessions/"+sessionID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user", Connector: "github"})
+			})
+
+			Context("when the session is revoked", func() {
+				BeforeEach(func() {
+					dbAccessTokenLifecycle.RevokeAccessTokenReturns(true, nil)
+				})
+
+				It("revokes only the user's own session", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					Expect(dbAccessTokenLifecycle.RevokeAccessTokenCallCount()).To(Equal(1))
+					username, connector, id := dbAccessTokenLifecycle.RevokeAccessTokenArgsForCall(0)
+					Expect(username).To(Equal("some-user"))
+					Expect(connector).To(Equal("github"))
+					Expect(id).To(Equal(42))
+				})
+			})
+
+			Context("when the session is not found", func() {
+				BeforeEach(func() {
+					dbAccessTokenLifecycle.RevokeAccessTokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the session id is invalid", func() {
+				BeforeEach(func() {
+					sessionID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAccessTokenLifecycle.RevokeAccessTokenCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when revoking fails", func() {
+				BeforeEach(func() {
+					dbAccessTokenLifecycle.RevokeAccessTokenReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/user/sessions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/user/sessions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user", Connector: "github"})
+				dbAccessTokenLifecycle.RevokeAccessTokensReturns(3, nil)
+			})
+
+			It("revokes every session of the user and returns the count", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"revoked":3}`))
+
+				Expect(dbAccessTokenLifecycle.RevokeAccessTokensCallCount()).To(Equal(1))
+				username, connector := dbAccessTokenLifecycle.RevokeAccessTokensArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(connector).To(Equal("github"))
+			})
+		})
+
+		Context("when the claims have no user name", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{})
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbAccessTokenLifecycle.RevokeAccessTokensCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/users/:user_name/sessions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/users/other-user/sessions?connector=local", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAccessTokenFactory.ListAccessTokensCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				dbAccessTokenFactory.ListAccessTokensReturns([]db.AccessToken{
+					sessionToken(1, "other-token", "other-user", "local"),
+				}, nil)
+			})
+
+			It("lists the sessions of the given user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				username, connector := dbAccessTokenFactory.ListAccessTokensArgsForCall(0)
+				Expect(username).To(Equal("other-user"))
+				Expect(connector).To(Equal("local"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 1,
+						"username": "other-user",
+						"connector": "local",
+						"created_at": 100,
+						"expires_at": 200
+					}
+				]`))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/users/:user_name/sessions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/users/other-user/sessions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAccessTokenLifecycle.RevokeAccessTokensCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				dbAccessTokenLifecycle.RevokeAccessTokensReturns(2, nil)
+			})
+
+			It("revokes the user's sessions on every connector", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				username, connector := dbAccessTokenLifecycle.RevokeAccessTokensArgsForCall(0)
+				Expect(username).To(Equal("other-user"))
+				Expect(connector).To(BeEmpty())
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"revoked":2}`))
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package sessionserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+// ListSessions lists the sessions of the user making the request.
+func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-sessions")
+
+	claims := accessor.GetAccessor(r).Claims()
+	if claims.UserName == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.listSessions(logger, w, r, claims.UserName, claims.Connector)
+}
+
+// ListUserSessions lists the sessions of any user. Without a connector the
+// user's sessions on every connector are listed.
+func (s *Server) ListUserSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-user-sessions")
+
+	s.listSessions(logger, w, r, r.FormValue(":user_name"), r.URL.Query().Get("connector"))
+}
+
+func (s *Server) listSessions(logger lager.Logger, w http.ResponseWriter, r *http.Request, username string, connector string) {
+	tokens, err := s.accessTokenFactory.ListAccessTokens(username, connector)
+	if err != nil {
+		logger.Error("failed-to-list-access-tokens", err, lager.Data{"user": username})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	current := bearerToken(r)
+
+	sessions := []atc.Session{}
+	for _, token := range tokens {
+		session := atc.Session{
+			ID:        token.ID,
+			Username:  token.Claims.Username,
+			Connector: token.Claims.Connector,
+			CreatedAt: token.CreatedAt.Unix(),
+			Current:   current != "" && token.Token == current,
+		}
+
+		if token.Claims.Expiry != nil {
+			session.ExpiresAt = token.Claims.Expiry.Time().Unix()
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(sessions)
+	if err != nil {
+		logger.Error("failed-to-encode-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// bearerToken returns the token the request was authenticated with, if any.
+func bearerToken(r *http.Request) string {
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return ""
+	}
+
+	return parts[1]
+}
@@ -0,0 +1,85 @@
+package sessionserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+// RevokeSession revokes one of the sessions of the user making the request.
+func (s *Server) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-session")
+
+	claims := accessor.GetAccessor(r).Claims()
+	if claims.UserName == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(r.FormValue(":session_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := s.accessTokenLifecycle.RevokeAccessToken(claims.UserName, claims.Connector, id)
+	if err != nil {
+		logger.Error("failed-to-revoke-access-token", err, lager.Data{"user": claims.UserName, "id": id})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !revoked {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger.Info("revoked", lager.Data{"user": claims.UserName, "id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeSessions revokes every session of the user making the request,
+// including the one the request was made with.
+func (s *Server) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-sessions")
+
+	claims := accessor.GetAccessor(r).Claims()
+	if claims.UserName == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.revokeSessions(logger, w, claims.UserName, claims.Connector)
+}
+
+// RevokeUserSessions revokes the sessions of any user. Without a connector
+// the user's sessions on every connector are revoked.
+func (s *Server) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-user-sessions")
+
+	s.revokeSessions(logger, w, r.FormValue(":user_name"), r.URL.Query().Get("connector"))
+}
+
+func (s *Server) revokeSessions(logger lager.Logger, w http.ResponseWriter, username string, connector string) {
+	revoked, err := s.accessTokenLifecycle.RevokeAccessTokens(username, connector)
+	if err != nil {
+		logger.Error("failed-to-revoke-access-tokens", err, lager.Data{"user": username, "connector": connector})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("revoked", lager.Data{"user": username, "connector": connector, "count": revoked})
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(atc.RevokedSessions{Revoked: revoked})
+	if err != nil {
+		logger.Error("failed-to-encode-revoked-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
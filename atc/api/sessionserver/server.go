@@ -0,0 +1,24 @@
+package sessionserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger               lager.Logger
+	accessTokenFactory   db.AccessTokenFactory
+	accessTokenLifecycle db.AccessTokenLifecycle
+}
+
+func NewServer(
+	logger lager.Logger,
+	accessTokenFactory db.AccessTokenFactory,
+	accessTokenLifecycle db.AccessTokenLifecycle,
+) *Server {
+	return &Server{
+		logger:               logger,
+		accessTokenFactory:   accessTokenFactory,
+		accessTokenLifecycle: accessTokenLifecycle,
+	}
+}
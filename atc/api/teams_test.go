@@ -201,6 +201,38 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 
+		Context("when the team has quotas", func() {
+			BeforeEach(func() {
+				fakeTeam.QuotasReturns(atc.TeamQuotas{MaxPipelines: 10, MaxVolumes: 50})
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("includes them in the team JSON", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`
+				{
+					"id": 1,
+					"name": "a-team",
+					"auth": {
+						"owner": {
+							"groups": [],
+							"users": [
+								"local:username"
+							]
+						}
+					},
+					"quotas": {
+						"max_pipelines": 10,
+						"max_volumes": 50
+					}
+				}`))
+			})
+		})
+
 		Context("when authenticated to specified team", func() {
 			BeforeEach(func() {
 				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
@@ -353,6 +385,90 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team is found and a new policy profile is given", func() {
+				BeforeEach(func() {
+					atcTeam.PolicyProfile = "strict"
+					fakeTeam.PolicyProfileReturns("default")
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the policy profile", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdatePolicyProfileCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdatePolicyProfileArgsForCall(0)).To(Equal("strict"))
+				})
+
+				Context("when updating the policy profile fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdatePolicyProfileReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the team is found and new quotas are given", func() {
+				BeforeEach(func() {
+					atcTeam.Quotas = &atc.TeamQuotas{MaxPipelines: 3, MaxRunningBuilds: 2}
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the quotas", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateQuotasArgsForCall(0)).To(Equal(atc.TeamQuotas{MaxPipelines: 3, MaxRunningBuilds: 2}))
+				})
+
+				Context("when updating the quotas fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdateQuotasReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the team is found and a quota is negative", func() {
+				BeforeEach(func() {
+					atcTeam.Quotas = &atc.TeamQuotas{MaxContainers: -1}
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team is found and the policy profile is unknown", func() {
+				BeforeEach(func() {
+					atcTeam.PolicyProfile = "lenient"
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					Expect(fakeTeam.UpdatePolicyProfileCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team is found and no policy profile is given", func() {
+				BeforeEach(func() {
+					fakeTeam.PolicyProfileReturns("default")
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("keeps the policy profile", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdatePolicyProfileCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -421,6 +537,62 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team is found and a new policy profile is given", func() {
+				BeforeEach(func() {
+					atcTeam.PolicyProfile = "default"
+					fakeTeam.PolicyProfileReturns("strict")
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("does not change the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					Expect(fakeTeam.UpdatePolicyProfileCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team is found and new quotas are given", func() {
+				BeforeEach(func() {
+					atcTeam.Quotas = &atc.TeamQuotas{MaxContainers: 100}
+					fakeTeam.QuotasReturns(atc.TeamQuotas{MaxContainers: 10})
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("does not change the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team is found and the quotas are unchanged", func() {
+				BeforeEach(func() {
+					atcTeam.Quotas = &atc.TeamQuotas{MaxContainers: 10}
+					fakeTeam.QuotasReturns(atc.TeamQuotas{MaxContainers: 10})
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team is found and the policy profile is unchanged", func() {
+				BeforeEach(func() {
+					atcTeam.PolicyProfile = "strict"
+					fakeTeam.PolicyProfileReturns("strict")
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdatePolicyProfileCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
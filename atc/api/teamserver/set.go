@@ -56,6 +56,20 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 
 	response := SetTeamResponse{}
 	if found {
+		profileChanged := atcTeam.PolicyProfile != "" && atcTeam.PolicyProfile != team.PolicyProfile()
+		if profileChanged && !acc.IsAdmin() {
+			hLog.Debug("not-allowed-to-change-policy-profile")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		quotasChanged := atcTeam.Quotas != nil && *atcTeam.Quotas != team.Quotas()
+		if quotasChanged && !acc.IsAdmin() {
+			hLog.Debug("not-allowed-to-change-quotas")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
 		if err != nil {
@@ -64,6 +78,24 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if profileChanged {
+			err = team.UpdatePolicyProfile(atcTeam.PolicyProfile)
+			if err != nil {
+				hLog.Error("failed-to-update-policy-profile", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		if quotasChanged {
+			err = team.UpdateQuotas(*atcTeam.Quotas)
+			if err != nil {
+				hLog.Error("failed-to-update-quotas", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -1,12 +1,14 @@
 package api_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -85,6 +87,31 @@ var _ = Describe("Users API", func() {
 			})
 		})
 
+		Context("when permissions are requested", func() {
+			BeforeEach(func() {
+				query = url.Values{"permissions": []string{"true"}}
+
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.TeamRolesReturns(map[string][]string{
+					"some-team": []string{"viewer", "pauser"},
+				})
+
+				roleActions["pauser"] = []string{atc.PausePipeline}
+			})
+
+			It("returns the actions the user may perform on each team", func() {
+				var userInfo atc.UserInfo
+				err := json.NewDecoder(response.Body).Decode(&userInfo)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(userInfo.Permissions).To(HaveKey("some-team"))
+				Expect(userInfo.Permissions["some-team"]).To(ContainElement(atc.GetPipeline))
+				Expect(userInfo.Permissions["some-team"]).To(ContainElement(atc.PausePipeline))
+				Expect(userInfo.Permissions["some-team"]).ToNot(ContainElement(atc.UnpausePipeline))
+				Expect(userInfo.Permissions["some-team"]).ToNot(ContainElement(atc.SaveConfig))
+			})
+		})
+
 		Context("not authenticated", func() {
 			BeforeEach(func() {
 				fakeAccess.IsAuthenticatedReturns(false)
@@ -16,6 +16,14 @@ func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
 
 	claims := acc.Claims()
 
+	var permissions map[string][]string
+	if r.URL.Query().Get("permissions") == "true" {
+		permissions = map[string][]string{}
+		for team, roles := range acc.TeamRoles() {
+			permissions[team] = accessor.Permissions(roles, s.customRoles, s.roleActions)
+		}
+	}
+
 	user := atc.UserInfo{
 		Sub:      claims.Sub,
 		Name:     claims.Name,
@@ -25,6 +33,9 @@ func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
 		IsAdmin:  acc.IsAdmin(),
 		IsSystem: acc.IsSystem(),
 		Teams:    acc.TeamRoles(),
+
+		PipelineRoles: acc.PipelineRoles(),
+		Permissions:   permissions,
 	}
 
 	err := json.NewEncoder(w).Encode(user)
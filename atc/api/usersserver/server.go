@@ -2,20 +2,27 @@ package usersserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type Server struct {
 	logger      lager.Logger
 	userFactory db.UserFactory
+	customRoles map[string]string
+	roleActions accessor.RoleActions
 }
 
 func NewServer(
 	logger lager.Logger,
 	userFactory db.UserFactory,
+	customRoles map[string]string,
+	roleActions accessor.RoleActions,
 ) *Server {
 	return &Server{
 		logger:      logger,
 		userFactory: userFactory,
+		customRoles: customRoles,
+		roleActions: roleActions,
 	}
 }
@@ -134,6 +134,34 @@ var _ = Describe("Workers API", func() {
 					}))
 
 				})
+
+				Context("when a worker is draining builds", func() {
+					BeforeEach(func() {
+						teamWorker2.NameReturns("landing-worker")
+						teamWorker2.StateReturns(db.WorkerStateLanding)
+						dbWorkerFactory.DrainingBuildsCountPerWorkerReturns(map[string]int{"landing-worker": 2}, nil)
+					})
+
+					It("counts them for every worker at once", func() {
+						var returnedWorkers []atc.Worker
+						err := json.NewDecoder(response.Body).Decode(&returnedWorkers)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(dbWorkerFactory.DrainingBuildsCountPerWorkerCallCount()).To(Equal(1))
+						Expect(returnedWorkers[0].DrainingBuilds).To(BeZero())
+						Expect(returnedWorkers[1].DrainingBuilds).To(Equal(2))
+					})
+				})
+
+				Context("when counting the draining builds fails", func() {
+					BeforeEach(func() {
+						dbWorkerFactory.DrainingBuildsCountPerWorkerReturns(nil, errors.New("error!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
 			})
 
 			Context("when getting the workers fails", func() {
@@ -489,11 +517,12 @@ var _ = Describe("Workers API", func() {
 		var (
 			response   *http.Response
 			workerName string
+			query      string
 			fakeWorker *dbfakes.FakeWorker
 		)
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land", nil)
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(req)
@@ -503,6 +532,7 @@ var _ = Describe("Workers API", func() {
 		BeforeEach(func() {
 			fakeWorker = new(dbfakes.FakeWorker)
 			workerName = "some-worker"
+			query = ""
 			fakeWorker.NameReturns(workerName)
 			fakeWorker.TeamNameReturns("some-team")
 			fakeWorker.LandReturns(nil)
@@ -524,6 +554,33 @@ var _ = Describe("Workers API", func() {
 				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
 				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
 				Expect(fakeWorker.LandCallCount()).To(Equal(1))
+				Expect(fakeWorker.LandArgsForCall(0)).To(BeZero())
+			})
+
+			Context("when a drain timeout is given", func() {
+				BeforeEach(func() {
+					query = "?drain_timeout=1h30m"
+				})
+
+				It("lands the worker with the drain timeout", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeWorker.LandCallCount()).To(Equal(1))
+					Expect(fakeWorker.LandArgsForCall(0)).To(Equal(90 * time.Minute))
+				})
+			})
+
+			Context("when the drain timeout is invalid", func() {
+				BeforeEach(func() {
+					query = "?drain_timeout=bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not land the worker", func() {
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+				})
 			})
 
 			Context("when landing the worker fails", func() {
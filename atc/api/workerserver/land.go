@@ -1,11 +1,27 @@
 package workerserver
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
 
 func (s *Server) LandWorker(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("landing-worker")
 	workerName := r.FormValue(":worker_name")
 
+	var drainTimeout time.Duration
+	if timeout := r.FormValue("drain_timeout"); timeout != "" {
+		var err error
+		drainTimeout, err = time.ParseDuration(timeout)
+		if err != nil || drainTimeout < 0 {
+			logger.Info("invalid-drain-timeout", lager.Data{"drain-timeout": timeout})
+			http.Error(w, "invalid drain timeout: "+timeout, http.StatusBadRequest)
+			return
+		}
+	}
+
 	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
 	if err != nil {
 		logger.Error("failed-finding-worker-to-land", err)
@@ -19,7 +35,7 @@ func (s *Server) LandWorker(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = worker.Land()
+	err = worker.Land(drainTimeout)
 	if err != nil {
 		logger.Error("failed-to-land-worker", err)
 		w.WriteHeader(http.StatusInternalServerError)
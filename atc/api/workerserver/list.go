@@ -32,9 +32,17 @@ func (s *Server) ListWorkers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	drainingBuilds, err := s.dbWorkerFactory.DrainingBuildsCountPerWorker()
+	if err != nil {
+		logger.Error("failed-to-count-draining-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
 	atcWorkers := make([]atc.Worker, len(workers))
 	for i, savedWorker := range workers {
 		atcWorkers[i] = present.Worker(savedWorker)
+		atcWorkers[i].DrainingBuilds = drainingBuilds[savedWorker.Name()]
 	}
 
 	w.Header().Set("Content-Type", "application/json")
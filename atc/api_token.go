@@ -0,0 +1,21 @@
+package atc
+
+// APITokenPrefix starts every API token, which lets them be told apart from
+// the access tokens issued when logging in.
+const APITokenPrefix = "concourse_api_"
+
+// APIToken is a named, long-lived token which authenticates requests with a
+// single role on a single team. The token itself is only returned when it is
+// created; only a hash of it is stored.
+type APIToken struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	TeamName   string `json:"team_name"`
+	Role       string `json:"role"`
+	CreatedBy  string `json:"created_by,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	ExpiresAt  int64  `json:"expires_at,omitempty"`
+	LastUsedAt int64  `json:"last_used_at,omitempty"`
+
+	Token string `json:"token,omitempty"`
+}
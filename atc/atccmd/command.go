@@ -13,6 +13,7 @@ import (
 	_ "net/http/pprof"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
@@ -38,6 +40,7 @@ import (
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
+	"github.com/concourse/concourse/atc/delivery"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/gc"
@@ -82,6 +85,7 @@ import (
 
 	// dynamically registered policy checkers
 	_ "github.com/concourse/concourse/atc/policy/opa"
+	_ "github.com/concourse/concourse/atc/policy/rego"
 
 	// dynamically registered credential managers
 	_ "github.com/concourse/concourse/atc/creds/conjur"
@@ -122,6 +126,7 @@ type RunCommand struct {
 	TLSBindPort uint16    `long:"tls-bind-port" description:"Port on which to listen for HTTPS traffic."`
 	TLSCert     flag.File `long:"tls-cert"      description:"File containing an SSL certificate."`
 	TLSKey      flag.File `long:"tls-key"       description:"File containing an RSA private key, used to encrypt HTTPS traffic."`
+	TLSClientCA flag.File `long:"tls-client-ca" description:"File containing the CA certificates of clients which may authenticate with a client certificate instead of a token. Teams grant them roles with 'cert:' users and groups."`
 
 	LetsEncrypt struct {
 		Enable  bool     `long:"enable-lets-encrypt"   description:"Automatically configure TLS certificates via Let's Encrypt/ACME."`
@@ -170,6 +175,9 @@ type RunCommand struct {
 		Attributes          map[string]string `long:"metrics-attribute" description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
 		BufferSize          uint32            `long:"metrics-buffer-size" default:"1000" description:"The size of the buffer used in emitting event metrics."`
 		CaptureErrorMetrics bool              `long:"capture-error-metrics" description:"Enable capturing of error log metrics"`
+
+		DeliveryMetricsInterval time.Duration `long:"delivery-metrics-interval" default:"5m" description:"Interval on which to emit the delivery metrics (deployments, change failure rate, lead time and time to recover) of every job."`
+		DeliveryMetricsWindow   time.Duration `long:"delivery-metrics-window" default:"720h" description:"How far back to look at builds when computing the emitted delivery metrics."`
 	} `group:"Metrics & Diagnostics"`
 
 	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
@@ -200,6 +208,8 @@ type RunCommand struct {
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	ContainerMetricsInterval time.Duration `long:"container-metrics-interval" default:"10s" description:"Interval on which to sample the resource usage of task containers."`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -210,6 +220,12 @@ type RunCommand struct {
 
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
+	FairShare struct {
+		BuildsPerWorker int            `long:"builds-per-worker" description:"Number of builds each running worker can take before pending builds queue up and start in fair-share order across teams. 0 means builds are not queued."`
+		DefaultShare    int            `long:"default-share" default:"1" description:"Share of the build capacity given to teams without a configured share."`
+		TeamShares      map[string]int `long:"team-share" description:"Share of the build capacity given to a team, relative to the shares of other teams. Can be specified multiple times." value-name:"TEAM:SHARE"`
+	} `group:"Fair-share Build Scheduling" namespace:"fair-share"`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
@@ -223,6 +239,8 @@ type RunCommand struct {
 		EnableTeamAuditLog      bool `long:"enable-team-auditing" description:"Enable auditing for all api requests connected to teams."`
 		EnableWorkerAuditLog    bool `long:"enable-worker-auditing" description:"Enable auditing for all api requests connected to workers."`
 		EnableVolumeAuditLog    bool `long:"enable-volume-auditing" description:"Enable auditing for all api requests connected to volumes."`
+
+		Retention time.Duration `long:"audit-retention" default:"720h" description:"How long to keep audit events in the database. Set to 0 to keep them forever."`
 	}
 
 	Syslog struct {
@@ -614,12 +632,38 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyCheckers.Filter)
+	aud := auditor.NewAuditor(
+		cmd.Auditor.EnableBuildAuditLog,
+		cmd.Auditor.EnableContainerAuditLog,
+		cmd.Auditor.EnableJobAuditLog,
+		cmd.Auditor.EnablePipelineAuditLog,
+		cmd.Auditor.EnableResourceAuditLog,
+		cmd.Auditor.EnableSystemAuditLog,
+		cmd.Auditor.EnableTeamAuditLog,
+		cmd.Auditor.EnableWorkerAuditLog,
+		cmd.Auditor.EnableVolumeAuditLog,
+		db.NewAuditLog(apiConn),
+		db.NewBuildFactory(apiConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod),
+		logger,
+	)
+
+	dbClock := db.NewClock()
+	teamPolicies := db.NewTeamPolicies(apiConn, &dbClock)
+
+	teamPoliciesCacher := accessor.NewTeamPoliciesCacher(
+		logger,
+		apiConn.Bus(),
+		teamPolicies,
+		time.Minute,
+		time.Minute,
+	)
+
+	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyCheckers.Filter, aud, teamPoliciesCacher)
 	if err != nil {
 		return nil, err
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker, aud, teamPolicies)
 	if err != nil {
 		return nil, err
 	}
@@ -686,6 +730,8 @@ func (cmd *RunCommand) constructAPIMembers(
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
 	policyChecker *policy.Checker,
+	aud auditor.Auditor,
+	teamPolicies db.TeamPolicies,
 ) ([]grouper.Member, error) {
 
 	httpClient, err := cmd.skyHttpClient()
@@ -707,6 +753,8 @@ func (cmd *RunCommand) constructAPIMembers(
 
 	userFactory := db.NewUserFactory(dbConn)
 
+	inputsExplainer := algorithm.New(db.NewVersionsDB(dbConn, algorithmLimitRows, schedulerCache))
+
 	resourceFactory := resource.NewResourceFactory()
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
 	fetchSourceFactory := worker.NewFetchSourceFactory(dbResourceCacheFactory)
@@ -749,8 +797,8 @@ func (cmd *RunCommand) constructAPIMembers(
 		policyChecker,
 	)
 
-	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval)
+	pool := worker.NewPool(workerProvider, db.NewQuotaChecker(dbConn))
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval, cmd.ContainerMetricsInterval)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -765,10 +813,28 @@ func (cmd *RunCommand) constructAPIMembers(
 		Timeout:             cmd.GlobalResourceCheckTimeout,
 	})
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
+	dbAccessTokenLifecycle := db.NewAccessTokenLifecycle(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbAPITokenFactory := db.NewAPITokenFactory(dbConn, &dbClock)
+	dbAuditLog := db.NewAuditLog(dbConn)
+	dbLocalUserFactory := db.NewLocalUserFactory(dbConn)
+
+	MiB := 1024 * 1024
+	claimsCacher := accessor.NewClaimsCacher(
+		logger.Session("claims-cacher"),
+		dbConn.Bus(),
+		dbAccessTokenFactory,
+		1*MiB,
+		time.Minute,
+	)
 
-	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
+	tokenVerifier := accessor.NewCertificateVerifier(
+		accessor.NewAPITokenVerifier(
+			dbAPITokenFactory,
+			cmd.constructTokenVerifier(claimsCacher),
+		),
+	)
 
 	teamsCacher := accessor.NewTeamsCacher(
 		logger,
@@ -785,7 +851,10 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.SystemClaimValues,
 	)
 
-	middleware := token.NewMiddleware(cmd.Auth.AuthFlags.SecureCookies)
+	middleware := token.NewRevocationMiddleware(
+		token.NewMiddleware(cmd.Auth.AuthFlags.SecureCookies),
+		claimsCacher,
+	)
 
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
@@ -802,12 +871,20 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		inputsExplainer,
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		teamPolicies,
+		dbAPITokenFactory,
+		dbAuditLog,
+		dbLocalUserFactory,
+		dbAccessTokenFactory,
+		dbAccessTokenLifecycle,
 		policyChecker,
+		aud,
 	)
 	if err != nil {
 		return nil, err
@@ -823,6 +900,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		storage,
 		dbAccessTokenFactory,
 		userFactory,
+		dbLocalUserFactory,
 	)
 	if err != nil {
 		return nil, err
@@ -1007,12 +1085,25 @@ func (cmd *RunCommand) backendComponents(
 		policyChecker,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	dbQuotaChecker := db.NewQuotaChecker(dbConn)
+
+	buildStartQueue := scheduler.NewFairShareQueue(
+		db.NewBuildQueue(dbConn),
+		dbWorkerFactory,
+		scheduler.FairShareConfig{
+			BuildsPerWorker: cmd.FairShare.BuildsPerWorker,
+			DefaultShare:    cmd.FairShare.DefaultShare,
+			TeamShares:      cmd.FairShare.TeamShares,
+		},
+	)
+
+	pool := worker.NewPool(workerProvider, dbQuotaChecker)
 	workerClient := worker.NewClient(pool,
 		workerProvider,
 		compressionLib,
 		workerAvailabilityPollingInterval,
-		workerStatusPublishInterval)
+		workerStatusPublishInterval,
+		cmd.ContainerMetricsInterval)
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
@@ -1045,6 +1136,7 @@ func (cmd *RunCommand) backendComponents(
 		buildContainerStrategy,
 		lockFactory,
 		rateLimiter,
+		policyChecker,
 	)
 
 	// In case that a user configures resource-checking-interval, but forgets to
@@ -1083,8 +1175,12 @@ func (cmd *RunCommand) backendComponents(
 						builds.NewPlanner(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						dbQuotaChecker,
+						buildStartQueue,
+					),
 				},
+				buildStartQueue,
 				cmd.JobSchedulingMaxInFlight,
 			),
 		},
@@ -1115,6 +1211,14 @@ func (cmd *RunCommand) backendComponents(
 		},
 	}
 
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentDeliveryMetrics,
+			Interval: cmd.Metrics.DeliveryMetricsInterval,
+		},
+		Runnable: delivery.NewMetricsCollector(dbPipelineFactory, metric.Metrics, cmd.Metrics.DeliveryMetricsWindow),
+	})
+
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
@@ -1144,6 +1248,7 @@ func (cmd *RunCommand) gcComponents(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbAccessTokenLifecycle := db.NewAccessTokenLifecycle(gcConn)
+	dbAuditLog := db.NewAuditLog(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
@@ -1172,6 +1277,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
+		atc.ComponentCollectorAuditEvents:       gc.NewAuditEventsCollector(dbAuditLog, cmd.Auditor.Retention),
 	}
 
 	var components []RunnableComponent
@@ -1188,20 +1294,37 @@ func (cmd *RunCommand) gcComponents(
 	return components, nil
 }
 
-func (cmd *RunCommand) validateCustomRoles() error {
+// rbacConfig is the content of the RBAC config file: the actions to grant to
+// each of the built-in roles, and the custom roles to define.
+type rbacConfig struct {
+	Roles       map[string][]string  `yaml:",inline"`
+	CustomRoles accessor.RoleActions `yaml:"custom_roles"`
+}
+
+func (cmd *RunCommand) loadRBACConfig() (rbacConfig, error) {
+	var config rbacConfig
+
 	path := cmd.ConfigRBAC.Path()
 	if path == "" {
-		return nil
+		return config, nil
 	}
 
 	content, err := ioutil.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to open RBAC config file (%s): %w", cmd.ConfigRBAC, err)
+		return config, fmt.Errorf("failed to open RBAC config file (%s): %w", cmd.ConfigRBAC, err)
+	}
+
+	if err = yaml.Unmarshal(content, &config); err != nil {
+		return config, fmt.Errorf("failed to parse RBAC config file (%s): %w", cmd.ConfigRBAC, err)
 	}
 
-	var data map[string][]string
-	if err = yaml.Unmarshal(content, &data); err != nil {
-		return fmt.Errorf("failed to parse RBAC config file (%s): %w", cmd.ConfigRBAC, err)
+	return config, nil
+}
+
+func (cmd *RunCommand) validateCustomRoles() error {
+	config, err := cmd.loadRBACConfig()
+	if err != nil {
+		return err
 	}
 
 	allKnownRoles := map[string]bool{}
@@ -1209,7 +1332,7 @@ func (cmd *RunCommand) validateCustomRoles() error {
 		allKnownRoles[roleName] = true
 	}
 
-	for role, actions := range data {
+	for role, actions := range config.Roles {
 		if _, ok := allKnownRoles[role]; !ok {
 			return fmt.Errorf("failed to customize roles: %w", fmt.Errorf("unknown role %s", role))
 		}
@@ -1221,34 +1344,46 @@ func (cmd *RunCommand) validateCustomRoles() error {
 		}
 	}
 
-	return nil
-}
+	for role, actions := range config.CustomRoles {
+		if _, ok := allKnownRoles[role]; ok {
+			return fmt.Errorf("failed to define custom roles: %w", fmt.Errorf("role %s is a built-in role", role))
+		}
 
-func (cmd *RunCommand) parseCustomRoles() (map[string]string, error) {
-	mapping := map[string]string{}
+		if len(actions) == 0 {
+			return fmt.Errorf("failed to define custom roles: %w", fmt.Errorf("role %s has no actions", role))
+		}
 
-	path := cmd.ConfigRBAC.Path()
-	if path == "" {
-		return mapping, nil
+		for _, action := range actions {
+			if _, ok := accessor.DefaultRoles[action]; !ok {
+				return fmt.Errorf("failed to define custom roles: %w", fmt.Errorf("unknown action %s", action))
+			}
+		}
 	}
 
-	content, err := ioutil.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	var data map[string][]string
-	if err = yaml.Unmarshal(content, &data); err != nil {
-		return nil, err
+// parseCustomRoles returns the role now required by each customized action,
+// and the actions allowed by each custom role.
+func (cmd *RunCommand) parseCustomRoles() (map[string]string, accessor.RoleActions, error) {
+	config, err := cmd.loadRBACConfig()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for role, actions := range data {
+	mapping := map[string]string{}
+	for role, actions := range config.Roles {
 		for _, action := range actions {
 			mapping[action] = role
 		}
 	}
 
-	return mapping, nil
+	roleActions := config.CustomRoles
+	if roleActions == nil {
+		roleActions = accessor.RoleActions{}
+	}
+
+	return mapping, roleActions, nil
 }
 
 func workerVersion() (version.Version, error) {
@@ -1400,6 +1535,23 @@ func (cmd *RunCommand) tlsConfig(logger lager.Logger, dbConn db.Conn) (*tls.Conf
 			}
 			tlsConfig.Certificates = []tls.Certificate{cert}
 		}
+
+		if cmd.TLSClientCA != "" {
+			tlsLogger.Debug("loading-tls-client-ca")
+			caCert, err := ioutil.ReadFile(string(cmd.TLSClientCA))
+			if err != nil {
+				return nil, err
+			}
+
+			clientCAs := x509.NewCertPool()
+			if !clientCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no certificates found in %s", cmd.TLSClientCA)
+			}
+
+			// clients without a certificate still log in with a token
+			tlsConfig.ClientCAs = clientCAs
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
 	}
 	return tlsConfig, nil
 }
@@ -1466,7 +1618,7 @@ func (cmd *RunCommand) validate() error {
 
 	switch {
 	case cmd.TLSBindPort == 0:
-		if cmd.TLSCert != "" || cmd.TLSKey != "" || cmd.LetsEncrypt.Enable {
+		if cmd.TLSCert != "" || cmd.TLSKey != "" || cmd.TLSClientCA != "" || cmd.LetsEncrypt.Enable {
 			errs = multierror.Append(
 				errs,
 				errors.New("must specify --tls-bind-port to use TLS"),
@@ -1497,9 +1649,31 @@ func (cmd *RunCommand) validate() error {
 		errs = multierror.Append(errs, err)
 	}
 
+	if err := cmd.validateFairShare(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	return errs.ErrorOrNil()
 }
 
+func (cmd *RunCommand) validateFairShare() error {
+	if cmd.FairShare.BuildsPerWorker < 0 {
+		return errors.New("--fair-share-builds-per-worker must not be negative")
+	}
+
+	if cmd.FairShare.DefaultShare < 1 {
+		return errors.New("--fair-share-default-share must be at least 1")
+	}
+
+	for team, share := range cmd.FairShare.TeamShares {
+		if share < 1 {
+			return fmt.Errorf("share of team '%s' must be at least 1", team)
+		}
+	}
+
+	return nil
+}
+
 func (cmd *RunCommand) nonTLSBindAddr() string {
 	return fmt.Sprintf("%s:%d", cmd.BindIP, cmd.BindPort)
 }
@@ -1624,6 +1798,7 @@ func (cmd *RunCommand) constructEngine(
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
 	rateLimiter builder.RateLimiter,
+	policyChecker *policy.Checker,
 ) engine.Engine {
 
 	stepFactory := builder.NewStepFactory(
@@ -1637,6 +1812,7 @@ func (cmd *RunCommand) constructEngine(
 		defaultLimits,
 		strategy,
 		lockFactory,
+		policyChecker,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
@@ -1703,6 +1879,7 @@ func (cmd *RunCommand) constructAuthHandler(
 	storage storage.Storage,
 	accessTokenFactory db.AccessTokenFactory,
 	userFactory db.UserFactory,
+	localUserFactory db.LocalUserFactory,
 ) (http.Handler, error) {
 
 	issuerPath, _ := url.Parse("/sky/issuer")
@@ -1724,6 +1901,8 @@ func (cmd *RunCommand) constructAuthHandler(
 		WebHostURL:  "/sky/issuer",
 		SigningKey:  cmd.Auth.AuthFlags.SigningKey.PrivateKey,
 		Storage:     storage,
+
+		LocalUserFactory: localUserFactory,
 	})
 	if err != nil {
 		return nil, err
@@ -1739,6 +1918,21 @@ func (cmd *RunCommand) constructAuthHandler(
 	), nil
 }
 
+// staticLocalUsers returns the usernames of the users added with the
+// --add-local-user flag.
+func (cmd *RunCommand) staticLocalUsers() []string {
+	var usernames []string
+	for username, password := range cmd.Auth.AuthFlags.LocalUsers {
+		if username != "" && password != "" {
+			usernames = append(usernames, username)
+		}
+	}
+
+	sort.Strings(usernames)
+
+	return usernames
+}
+
 func (cmd *RunCommand) constructLoginHandler(
 	logger lager.Logger,
 	httpClient *http.Client,
@@ -1781,17 +1975,14 @@ func (cmd *RunCommand) constructLoginHandler(
 	return skyserver.NewSkyHandler(skyServer), nil
 }
 
-func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenFactory) accessor.TokenVerifier {
+func (cmd *RunCommand) constructTokenVerifier(accessTokenFetcher accessor.AccessTokenFetcher) accessor.TokenVerifier {
 
 	validClients := []string{flyClientID}
 	for clientId := range cmd.Auth.AuthFlags.Clients {
 		validClients = append(validClients, clientId)
 	}
 
-	MiB := 1024 * 1024
-	claimsCacher := accessor.NewClaimsCacher(accessTokenFactory, 1*MiB)
-
-	return accessor.NewVerifier(claimsCacher, validClients)
+	return accessor.NewVerifier(accessTokenFetcher, validClients)
 }
 
 func (cmd *RunCommand) constructAPIHandler(
@@ -1809,12 +2000,20 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	inputsExplainer jobserver.InputsExplainer,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	teamPolicies db.TeamPolicies,
+	dbAPITokenFactory db.APITokenFactory,
+	dbAuditLog db.AuditLog,
+	dbLocalUserFactory db.LocalUserFactory,
+	dbAccessTokenFactory db.AccessTokenFactory,
+	dbAccessTokenLifecycle db.AccessTokenLifecycle,
 	policyChecker *policy.Checker,
+	aud auditor.Auditor,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1824,20 +2023,7 @@ func (cmd *RunCommand) constructAPIHandler(
 
 	rejectArchivedHandlerFactory := pipelineserver.NewRejectArchivedHandlerFactory(teamFactory)
 
-	aud := auditor.NewAuditor(
-		cmd.Auditor.EnableBuildAuditLog,
-		cmd.Auditor.EnableContainerAuditLog,
-		cmd.Auditor.EnableJobAuditLog,
-		cmd.Auditor.EnablePipelineAuditLog,
-		cmd.Auditor.EnableResourceAuditLog,
-		cmd.Auditor.EnableSystemAuditLog,
-		cmd.Auditor.EnableTeamAuditLog,
-		cmd.Auditor.EnableWorkerAuditLog,
-		cmd.Auditor.EnableVolumeAuditLog,
-		logger,
-	)
-
-	customRoles, err := cmd.parseCustomRoles()
+	customRoles, roleActions, err := cmd.parseCustomRoles()
 	if err != nil {
 		return nil, err
 	}
@@ -1862,6 +2048,7 @@ func (cmd *RunCommand) constructAPIHandler(
 			accessFactory,
 			aud,
 			customRoles,
+			roleActions,
 		),
 		wrappa.NewCompressionWrappa(logger),
 	}
@@ -1884,8 +2071,10 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		inputsExplainer,
 
 		buildserver.NewEventHandler,
+		aud,
 
 		workerClient,
 
@@ -1902,6 +2091,15 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		teamPolicies,
+		dbAPITokenFactory,
+		dbAuditLog,
+		dbLocalUserFactory,
+		cmd.staticLocalUsers(),
+		dbAccessTokenFactory,
+		dbAccessTokenLifecycle,
+		customRoles,
+		roleActions,
 		clock.NewClock(),
 	)
 }
@@ -0,0 +1,37 @@
+package atc
+
+// Query parameters for filtering audit events.
+const (
+	AuditQueryTeam   = "team"
+	AuditQueryActor  = "actor"
+	AuditQueryAction = "action"
+	AuditQuerySince  = "since"
+	AuditQueryUntil  = "until"
+)
+
+// AuditEvent is an audited API request, or a policy check which was let
+// through despite not passing.
+type AuditEvent struct {
+	ID         int                 `json:"id"`
+	Time       int64               `json:"time"`
+	Actor      string              `json:"actor,omitempty"`
+	Action     string              `json:"action"`
+	Team       string              `json:"team,omitempty"`
+	Object     string              `json:"object,omitempty"`
+	Method     string              `json:"method,omitempty"`
+	Path       string              `json:"path,omitempty"`
+	RemoteAddr string              `json:"remote_addr,omitempty"`
+	Parameters map[string][]string `json:"parameters,omitempty"`
+
+	Policy *AuditPolicyDecision `json:"policy,omitempty"`
+}
+
+// AuditPolicyDecision is the result of a policy check which didn't pass, but
+// which only warned about or audited the action, or which was overridden by an
+// exemption.
+type AuditPolicyDecision struct {
+	Severity    string   `json:"severity,omitempty"`
+	Reasons     []string `json:"reasons,omitempty"`
+	ExemptionID int      `json:"exemption_id,omitempty"`
+	ExemptedBy  string   `json:"exempted_by,omitempty"`
+}
@@ -3,9 +3,14 @@ package auditor
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 )
 
 //go:generate counterfeiter . Auditor
@@ -20,6 +25,8 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	auditLog db.AuditLog,
+	buildFactory db.BuildFactory,
 	logger lager.Logger,
 ) *auditor {
 	return &auditor{
@@ -32,12 +39,17 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		auditLog:                auditLog,
+		buildFactory:            buildFactory,
 		logger:                  logger,
 	}
 }
 
 type Auditor interface {
 	Audit(action string, userName string, r *http.Request)
+	AuditPolicyCheck(input policy.PolicyCheckInput, output policy.PolicyCheckOutput)
+	AuditPolicyExemption(input policy.PolicyCheckInput, output policy.PolicyCheckOutput, exemption atc.PolicyExemption)
+	AuditBuildApproval(userName string, build db.Build, approval atc.BuildApproval)
 }
 
 type auditor struct {
@@ -50,6 +62,8 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	auditLog                db.AuditLog
+	buildFactory            db.BuildFactory
 	logger                  lager.Logger
 }
 
@@ -64,6 +78,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
+		atc.ListBuildApprovals,
+		atc.ApproveBuild,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -82,6 +98,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.ExplainJobInputs,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -101,10 +118,13 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.HidePipeline,
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
+		atc.GetPipelineDeliveryMetrics,
+		atc.ListPipelineBlockedBuilds,
 		atc.CreatePipelineBuild,
 		atc.PipelineBadge:
 		return a.EnablePipelineAuditLog
 	case atc.ListAllResources,
+		atc.ListStaleResources,
 		atc.ListResources,
 		atc.ListResourceTypes,
 		atc.GetResource,
@@ -135,14 +155,32 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ListPolicyExemptions,
+		atc.CreatePolicyExemption,
+		atc.DeletePolicyExemption,
+		atc.ListAuditEvents,
+		atc.ListLocalUsers,
+		atc.CreateLocalUser,
+		atc.SetLocalUserPassword,
+		atc.DisableLocalUser,
+		atc.EnableLocalUser,
+		atc.ListSessions,
+		atc.RevokeSession,
+		atc.RevokeSessions,
+		atc.ListUserSessions,
+		atc.RevokeUserSessions:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.ListAPITokens,
+		atc.CreateAPIToken,
+		atc.DeleteAPIToken,
+		atc.ListTeamAuditEvents:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -165,5 +203,216 @@ func (a *auditor) Audit(action string, userName string, r *http.Request) {
 	err := r.ParseForm()
 	if err == nil && a.ValidateAction(action) {
 		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+
+		a.record(atc.AuditEvent{
+			Actor:      userName,
+			Action:     action,
+			Team:       a.auditedTeam(r.Form),
+			Object:     auditedObject(r.Form),
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			RemoteAddr: r.RemoteAddr,
+			Parameters: queryParameters(r.Form),
+		})
+	}
+}
+
+// AuditPolicyCheck records a policy check that didn't pass but only warned
+// about or audited the action. These are always logged, regardless of which
+// audit logs are enabled, as they're how policies are staged.
+func (a *auditor) AuditPolicyCheck(input policy.PolicyCheckInput, output policy.PolicyCheckOutput) {
+	a.logger.Info("policy-audit", lager.Data{
+		"action":   input.Action,
+		"user":     input.User,
+		"team":     input.Team,
+		"pipeline": input.Pipeline,
+		"severity": output.Severity,
+		"reasons":  output.Reasons,
+	})
+
+	a.record(atc.AuditEvent{
+		Actor:  input.User,
+		Action: input.Action,
+		Team:   input.Team,
+		Object: pipelineObject(input.Pipeline),
+		Method: input.HttpMethod,
+		Policy: &atc.AuditPolicyDecision{
+			Severity: string(output.Severity),
+			Reasons:  output.Reasons,
+		},
+	})
+}
+
+// AuditPolicyExemption records an action that a policy refused but that was
+// let through by an exemption of its team. Like policy audits, these are
+// always logged.
+func (a *auditor) AuditPolicyExemption(input policy.PolicyCheckInput, output policy.PolicyCheckOutput, exemption atc.PolicyExemption) {
+	a.logger.Info("policy-exemption", lager.Data{
+		"action":       input.Action,
+		"user":         input.User,
+		"team":         input.Team,
+		"pipeline":     input.Pipeline,
+		"reasons":      output.Reasons,
+		"exemption":    exemption.ID,
+		"exempted-by":  exemption.CreatedBy,
+		"exempted-for": exemption.Reason,
+		"expires-at":   exemption.ExpiresAt,
+	})
+
+	a.record(atc.AuditEvent{
+		Actor:  input.User,
+		Action: input.Action,
+		Team:   input.Team,
+		Object: pipelineObject(input.Pipeline),
+		Method: input.HttpMethod,
+		Policy: &atc.AuditPolicyDecision{
+			Severity:    string(output.Severity),
+			Reasons:     output.Reasons,
+			ExemptionID: exemption.ID,
+			ExemptedBy:  exemption.CreatedBy,
+		},
+	})
+}
+
+// AuditBuildApproval records who approved a manual_approval step of a build.
+// Like policy audits, these are always logged, as they're the record that the
+// build was reviewed by someone other than whoever triggered it.
+func (a *auditor) AuditBuildApproval(userName string, build db.Build, approval atc.BuildApproval) {
+	a.logger.Info("build-approval", lager.Data{
+		"user":     userName,
+		"team":     build.TeamName(),
+		"pipeline": build.PipelineName(),
+		"job":      build.JobName(),
+		"build":    build.ID(),
+		"step":     approval.Name,
+		"role":     approval.Role,
+	})
+
+	a.record(atc.AuditEvent{
+		Actor:  userName,
+		Action: atc.ApproveBuild,
+		Team:   build.TeamName(),
+		Object: approvalObject(build, approval),
+		Method: http.MethodPut,
+	})
+}
+
+// record persists the event, so that it can be queried through the API. A
+// failure is only logged, as it shouldn't fail the audited request.
+func (a *auditor) record(event atc.AuditEvent) {
+	err := a.auditLog.RecordEvent(event)
+	if err != nil {
+		a.logger.Error("failed-to-record-audit-event", err, lager.Data{"action": event.Action})
+	}
+}
+
+// auditedTeam is the team which owns the object of an audited request. Routes
+// of builds are only scoped by the build's ID, so its team is looked up, as
+// the request is audited before the build is loaded to authorize it.
+func (a *auditor) auditedTeam(form url.Values) string {
+	if team := form.Get(":team_name"); team != "" {
+		return team
+	}
+
+	buildID, err := strconv.Atoi(form.Get(":build_id"))
+	if err != nil {
+		return ""
+	}
+
+	build, found, err := a.buildFactory.Build(buildID)
+	if err != nil {
+		a.logger.Error("failed-to-find-audited-build", err, lager.Data{"build": buildID})
+		return ""
+	}
+
+	if !found {
+		return ""
+	}
+
+	return build.TeamName()
+}
+
+// auditedObjectParams are the route parameters which identify the object an
+// action is performed on, from the outermost to the innermost.
+var auditedObjectParams = []struct {
+	param string
+	kind  string
+}{
+	{":pipeline_name", "pipeline"},
+	{":job_name", "job"},
+	{":resource_name", "resource"},
+	{":resource_type_name", "resource-type"},
+	{":step_name", "step"},
+	{":build_name", "build"},
+	{":build_id", "build-id"},
+	{":resource_config_version_id", "version"},
+	{":resource_version_id", "version"},
+	{":worker_name", "worker"},
+	{":id", "container"},
+	{":artifact_id", "artifact"},
+	{":approval_id", "approval"},
+	{":exemption_id", "exemption"},
+	{":token_name", "token"},
+	{":local_user_name", "local-user"},
+	{":user_name", "user"},
+	{":session_id", "session"},
+}
+
+// auditedObject describes the object identified by the route parameters of
+// an audited request, e.g. "pipeline:some-pipeline/job:some-job".
+func auditedObject(form url.Values) string {
+	var parts []string
+	for _, p := range auditedObjectParams {
+		if value := form.Get(p.param); value != "" {
+			parts = append(parts, p.kind+":"+value)
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// approvalObject describes the approved step in the same form as
+// auditedObject, e.g. "pipeline:p/job:j/build:3/step:deploy".
+func approvalObject(build db.Build, approval atc.BuildApproval) string {
+	var parts []string
+	if build.PipelineName() != "" {
+		parts = append(parts, "pipeline:"+build.PipelineName())
 	}
+
+	if build.JobName() != "" {
+		parts = append(parts, "job:"+build.JobName(), "build:"+build.Name())
+	} else {
+		parts = append(parts, fmt.Sprintf("build-id:%d", build.ID()))
+	}
+
+	parts = append(parts, "step:"+approval.Name)
+
+	return strings.Join(parts, "/")
+}
+
+func pipelineObject(pipeline string) string {
+	if pipeline == "" {
+		return ""
+	}
+
+	return "pipeline:" + pipeline
+}
+
+// queryParameters returns the parameters of the request other than the route
+// parameters, which are recorded as the team and object of the event.
+func queryParameters(form url.Values) map[string][]string {
+	var parameters map[string][]string
+	for key, values := range form {
+		if strings.HasPrefix(key, ":") {
+			continue
+		}
+
+		if parameters == nil {
+			parameters = map[string][]string{}
+		}
+
+		parameters[key] = values
+	}
+
+	return parameters
 }
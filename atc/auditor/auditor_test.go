@@ -1,12 +1,15 @@
 package auditor_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,6 +21,8 @@ var _ = Describe("Audit", func() {
 		dummyAction             string
 		userName                string
 		logger                  *lagertest.TestLogger
+		fakeAuditLog            *dbfakes.FakeAuditLog
+		fakeBuildFactory        *dbfakes.FakeBuildFactory
 		req                     *http.Request
 		EnableBuildAuditLog     bool
 		EnableContainerAuditLog bool
@@ -32,6 +37,7 @@ var _ = Describe("Audit", func() {
 
 	BeforeEach(func() {
 		userName = "test"
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -40,6 +46,7 @@ var _ = Describe("Audit", func() {
 
 	JustBeforeEach(func() {
 		logger = lagertest.NewTestLogger("access_handler")
+		fakeAuditLog = new(dbfakes.FakeAuditLog)
 
 		aud = auditor.NewAuditor(
 			EnableBuildAuditLog,
@@ -51,6 +58,8 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			fakeAuditLog,
+			fakeBuildFactory,
 			logger,
 		)
 	})
@@ -87,6 +96,107 @@ var _ = Describe("Audit", func() {
 		})
 	})
 
+	Describe("recording audit events", func() {
+		BeforeEach(func() {
+			EnablePipelineAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/pause?:team_name=some-team&:pipeline_name=some-pipeline&:job_name=some-job&vars.branch=main", http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+			req.RemoteAddr = "1.2.3.4:5678"
+		})
+
+		It("records the audited request", func() {
+			aud.Audit(atc.PausePipeline, userName, req)
+
+			Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RecordEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+				Actor:      "test",
+				Action:     atc.PausePipeline,
+				Team:       "some-team",
+				Object:     "pipeline:some-pipeline/job:some-job",
+				Method:     "PUT",
+				Path:       "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/pause",
+				RemoteAddr: "1.2.3.4:5678",
+				Parameters: map[string][]string{"vars.branch": {"main"}},
+			}))
+		})
+
+		Context("when the route is scoped by a build rather than a team", func() {
+			var fakeBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				EnableBuildAuditLog = true
+
+				fakeBuild = new(dbfakes.FakeBuild)
+				fakeBuild.TeamNameReturns("some-team")
+				fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+				var err error
+				req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/builds/42/abort?:build_id=42", http.NoBody)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("records the team of the build", func() {
+				aud.Audit(atc.AbortBuild, userName, req)
+
+				Expect(fakeBuildFactory.BuildCallCount()).To(Equal(1))
+				Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+
+				Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+				event := fakeAuditLog.RecordEventArgsForCall(0)
+				Expect(event.Team).To(Equal("some-team"))
+				Expect(event.Object).To(Equal("build-id:42"))
+			})
+
+			Context("when the build is not found", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("records the event without a team", func() {
+					aud.Audit(atc.AbortBuild, userName, req)
+
+					Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+					Expect(fakeAuditLog.RecordEventArgsForCall(0).Team).To(BeEmpty())
+				})
+			})
+
+			Context("when looking up the build fails", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.BuildReturns(nil, false, errors.New("nope"))
+				})
+
+				It("still records the event, without a team", func() {
+					aud.Audit(atc.AbortBuild, userName, req)
+
+					Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+					Expect(fakeAuditLog.RecordEventArgsForCall(0).Team).To(BeEmpty())
+				})
+			})
+		})
+
+		It("does not record requests which aren't audited", func() {
+			aud.Audit(atc.GetBuild, userName, req)
+
+			Expect(fakeAuditLog.RecordEventCallCount()).To(BeZero())
+		})
+
+		Context("when recording the event fails", func() {
+			JustBeforeEach(func() {
+				fakeAuditLog.RecordEventReturns(errors.New("nope"))
+			})
+
+			It("logs the error", func() {
+				aud.Audit(atc.PausePipeline, userName, req)
+
+				logs := logger.Logs()
+				Expect(logs).To(HaveLen(2))
+				Expect(logs[1].Message).To(Equal("access_handler.failed-to-record-audit-event"))
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
@@ -590,4 +700,150 @@ var _ = Describe("Audit", func() {
 			})
 		})
 	})
+
+	Describe("AuditPolicyCheck", func() {
+		It("creates a log regardless of the enabled audit logs", func() {
+			aud.AuditPolicyCheck(
+				policy.PolicyCheckInput{
+					Action:   "SaveConfig",
+					User:     "some-user",
+					Team:     "some-team",
+					Pipeline: "some-pipeline",
+				},
+				policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityWarn,
+				},
+			)
+
+			logs := logger.Logs()
+			Expect(len(logs)).To(Equal(1))
+			Expect(logs[0].Message).To(Equal("access_handler.policy-audit"))
+			Expect(logs[0].Data["action"]).To(Equal("SaveConfig"))
+			Expect(logs[0].Data["team"]).To(Equal("some-team"))
+			Expect(logs[0].Data["severity"]).To(Equal("warn"))
+			Expect(logs[0].Data["reasons"]).To(ConsistOf("some-reason"))
+		})
+
+		It("records the policy decision", func() {
+			aud.AuditPolicyCheck(
+				policy.PolicyCheckInput{
+					Action:     "SaveConfig",
+					HttpMethod: "PUT",
+					User:       "some-user",
+					Team:       "some-team",
+					Pipeline:   "some-pipeline",
+				},
+				policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityAudit,
+				},
+			)
+
+			Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RecordEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+				Actor:  "some-user",
+				Action: "SaveConfig",
+				Team:   "some-team",
+				Object: "pipeline:some-pipeline",
+				Method: "PUT",
+				Policy: &atc.AuditPolicyDecision{
+					Severity: "audit",
+					Reasons:  []string{"some-reason"},
+				},
+			}))
+		})
+	})
+
+	Describe("AuditPolicyExemption", func() {
+		It("creates a log regardless of the enabled audit logs", func() {
+			aud.AuditPolicyExemption(
+				policy.PolicyCheckInput{
+					Action: "RunStep",
+					User:   "some-user",
+					Team:   "some-team",
+				},
+				policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityWarn,
+				},
+				atc.PolicyExemption{
+					ID:        42,
+					Team:      "some-team",
+					Action:    "RunStep",
+					Reason:    "migrating off privileged tasks",
+					CreatedBy: "some-admin",
+					ExpiresAt: 1602871813,
+				},
+			)
+
+			logs := logger.Logs()
+			Expect(len(logs)).To(Equal(1))
+			Expect(logs[0].Message).To(Equal("access_handler.policy-exemption"))
+			Expect(logs[0].Data["action"]).To(Equal("RunStep"))
+			Expect(logs[0].Data["team"]).To(Equal("some-team"))
+			Expect(logs[0].Data["exemption"]).To(BeEquivalentTo(42))
+			Expect(logs[0].Data["exempted-by"]).To(Equal("some-admin"))
+			Expect(logs[0].Data["exempted-for"]).To(Equal("migrating off privileged tasks"))
+
+			Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+			event := fakeAuditLog.RecordEventArgsForCall(0)
+			Expect(event.Action).To(Equal("RunStep"))
+			Expect(event.Policy).To(Equal(&atc.AuditPolicyDecision{
+				Severity:    "warn",
+				Reasons:     []string{"some-reason"},
+				ExemptionID: 42,
+				ExemptedBy:  "some-admin",
+			}))
+		})
+	})
+
+	Describe("AuditBuildApproval", func() {
+		var build *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			build = new(dbfakes.FakeBuild)
+			build.IDReturns(42)
+			build.NameReturns("7")
+			build.JobNameReturns("deploy")
+			build.PipelineNameReturns("some-pipeline")
+			build.TeamNameReturns("some-team")
+		})
+
+		It("records who approved which step regardless of the enabled audit logs", func() {
+			aud.AuditBuildApproval("some-approver", build, atc.BuildApproval{ID: 3, Name: "release", Role: "owner"})
+
+			logs := logger.Logs()
+			Expect(len(logs)).To(Equal(1))
+			Expect(logs[0].Message).To(Equal("access_handler.build-approval"))
+			Expect(logs[0].Data["user"]).To(Equal("some-approver"))
+			Expect(logs[0].Data["step"]).To(Equal("release"))
+			Expect(logs[0].Data["build"]).To(BeEquivalentTo(42))
+
+			Expect(fakeAuditLog.RecordEventCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RecordEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+				Actor:  "some-approver",
+				Action: "ApproveBuild",
+				Team:   "some-team",
+				Object: "pipeline:some-pipeline/job:deploy/build:7/step:release",
+				Method: "PUT",
+			}))
+		})
+
+		Context("when the build is a one-off build", func() {
+			BeforeEach(func() {
+				build.JobNameReturns("")
+				build.PipelineNameReturns("")
+			})
+
+			It("identifies the build by its id", func() {
+				aud.AuditBuildApproval("some-approver", build, atc.BuildApproval{ID: 3, Name: "release"})
+
+				Expect(fakeAuditLog.RecordEventArgsForCall(0).Object).To(Equal("build-id:42/step:release"))
+			})
+		})
+	})
 })
@@ -5,7 +5,10 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 )
 
 type FakeAuditor struct {
@@ -16,6 +19,26 @@ type FakeAuditor struct {
 		arg2 string
 		arg3 *http.Request
 	}
+	AuditBuildApprovalStub        func(string, db.Build, atc.BuildApproval)
+	auditBuildApprovalMutex       sync.RWMutex
+	auditBuildApprovalArgsForCall []struct {
+		arg1 string
+		arg2 db.Build
+		arg3 atc.BuildApproval
+	}
+	AuditPolicyCheckStub        func(policy.PolicyCheckInput, policy.PolicyCheckOutput)
+	auditPolicyCheckMutex       sync.RWMutex
+	auditPolicyCheckArgsForCall []struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+	}
+	AuditPolicyExemptionStub        func(policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption)
+	auditPolicyExemptionMutex       sync.RWMutex
+	auditPolicyExemptionArgsForCall []struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+		arg3 atc.PolicyExemption
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -53,11 +76,115 @@ func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeAuditor) AuditBuildApproval(arg1 string, arg2 db.Build, arg3 atc.BuildApproval) {
+	fake.auditBuildApprovalMutex.Lock()
+	fake.auditBuildApprovalArgsForCall = append(fake.auditBuildApprovalArgsForCall, struct {
+		arg1 string
+		arg2 db.Build
+		arg3 atc.BuildApproval
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuditBuildApproval", []interface{}{arg1, arg2, arg3})
+	fake.auditBuildApprovalMutex.Unlock()
+	if fake.AuditBuildApprovalStub != nil {
+		fake.AuditBuildApprovalStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeAuditor) AuditBuildApprovalCallCount() int {
+	fake.auditBuildApprovalMutex.RLock()
+	defer fake.auditBuildApprovalMutex.RUnlock()
+	return len(fake.auditBuildApprovalArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditBuildApprovalCalls(stub func(string, db.Build, atc.BuildApproval)) {
+	fake.auditBuildApprovalMutex.Lock()
+	defer fake.auditBuildApprovalMutex.Unlock()
+	fake.AuditBuildApprovalStub = stub
+}
+
+func (fake *FakeAuditor) AuditBuildApprovalArgsForCall(i int) (string, db.Build, atc.BuildApproval) {
+	fake.auditBuildApprovalMutex.RLock()
+	defer fake.auditBuildApprovalMutex.RUnlock()
+	argsForCall := fake.auditBuildApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditor) AuditPolicyCheck(arg1 policy.PolicyCheckInput, arg2 policy.PolicyCheckOutput) {
+	fake.auditPolicyCheckMutex.Lock()
+	fake.auditPolicyCheckArgsForCall = append(fake.auditPolicyCheckArgsForCall, struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+	}{arg1, arg2})
+	fake.recordInvocation("AuditPolicyCheck", []interface{}{arg1, arg2})
+	fake.auditPolicyCheckMutex.Unlock()
+	if fake.AuditPolicyCheckStub != nil {
+		fake.AuditPolicyCheckStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckCallCount() int {
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	return len(fake.auditPolicyCheckArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckCalls(stub func(policy.PolicyCheckInput, policy.PolicyCheckOutput)) {
+	fake.auditPolicyCheckMutex.Lock()
+	defer fake.auditPolicyCheckMutex.Unlock()
+	fake.AuditPolicyCheckStub = stub
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckArgsForCall(i int) (policy.PolicyCheckInput, policy.PolicyCheckOutput) {
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	argsForCall := fake.auditPolicyCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditor) AuditPolicyExemption(arg1 policy.PolicyCheckInput, arg2 policy.PolicyCheckOutput, arg3 atc.PolicyExemption) {
+	fake.auditPolicyExemptionMutex.Lock()
+	fake.auditPolicyExemptionArgsForCall = append(fake.auditPolicyExemptionArgsForCall, struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+		arg3 atc.PolicyExemption
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuditPolicyExemption", []interface{}{arg1, arg2, arg3})
+	fake.auditPolicyExemptionMutex.Unlock()
+	if fake.AuditPolicyExemptionStub != nil {
+		fake.AuditPolicyExemptionStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionCallCount() int {
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
+	return len(fake.auditPolicyExemptionArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionCalls(stub func(policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption)) {
+	fake.auditPolicyExemptionMutex.Lock()
+	defer fake.auditPolicyExemptionMutex.Unlock()
+	fake.AuditPolicyExemptionStub = stub
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionArgsForCall(i int) (policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption) {
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
+	argsForCall := fake.auditPolicyExemptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.auditMutex.RLock()
 	defer fake.auditMutex.RUnlock()
+	fake.auditBuildApprovalMutex.RLock()
+	defer fake.auditBuildApprovalMutex.RUnlock()
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
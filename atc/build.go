@@ -27,6 +27,9 @@ type Build struct {
 	ReapTime             int64         `json:"reap_time,omitempty"`
 	RerunNumber          int           `json:"rerun_number,omitempty"`
 	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
+	PendingReason        string        `json:"pending_reason,omitempty"`
+	QueuePosition        int           `json:"queue_position,omitempty"`
+	AwaitingApproval     bool          `json:"awaiting_approval,omitempty"`
 }
 
 type RerunOfBuild struct {
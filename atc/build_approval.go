@@ -0,0 +1,22 @@
+package atc
+
+// DefaultApprovalRole is the role required to approve a manual_approval step
+// which doesn't specify one.
+const DefaultApprovalRole = "member"
+
+// BuildApproval is a manual_approval step of a build, which the build waits on
+// until it is approved.
+type BuildApproval struct {
+	ID          int    `json:"id"`
+	PlanID      PlanID `json:"plan_id"`
+	Name        string `json:"name"`
+	Role        string `json:"role"`
+	RequestedAt int64  `json:"requested_at"`
+	ApprovedBy  string `json:"approved_by,omitempty"`
+	ApprovedAt  int64  `json:"approved_at,omitempty"`
+}
+
+// Approved returns whether the build may continue past the step.
+func (approval BuildApproval) Approved() bool {
+	return approval.ApprovedAt != 0
+}
@@ -284,6 +284,20 @@ func (visitor *planVisitor) VisitLoadVar(step *atc.LoadVarStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitManualApproval(step *atc.ManualApprovalStep) error {
+	role := step.Role
+	if role == "" {
+		role = atc.DefaultApprovalRole
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.ManualApprovalPlan{
+		Name: step.Name,
+		Role: role,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitTry(step *atc.TryStep) error {
 	err := step.Step.Config.Visit(visitor)
 	if err != nil {
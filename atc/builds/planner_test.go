@@ -258,6 +258,37 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "manual_approval step",
+
+		Config: &atc.ManualApprovalStep{
+			Name: "deploy",
+			Role: "owner",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"manual_approval": {
+				"name": "deploy",
+				"role": "owner"
+			}
+		}`,
+	},
+	{
+		Title: "manual_approval step without a role",
+
+		Config: &atc.ManualApprovalStep{
+			Name: "deploy",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"manual_approval": {
+				"name": "deploy",
+				"role": "member"
+			}
+		}`,
+	},
 	{
 		Title: "try step",
 
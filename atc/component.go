@@ -8,8 +8,10 @@ const (
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentDeliveryMetrics            = "delivery_metrics"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
 	ComponentCollectorArtifacts         = "collector_artifacts"
+	ComponentCollectorAuditEvents       = "collector_audit_events"
 	ComponentCollectorBuilds            = "collector_builds"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
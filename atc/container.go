@@ -36,3 +36,22 @@ const (
 	ContainerStateDestroying = "destroying"
 	ContainerStateFailed     = "failed"
 )
+
+// Properties which the containerd runtime computes from the cgroup of a
+// container's task when they're asked for. They cover the whole life of the
+// task, which periodic samples of its metrics could miss.
+const (
+	ContainerPropertyMemoryPeak   = "concourse:memory-peak"
+	ContainerPropertyBlockIORead  = "concourse:block-io-read"
+	ContainerPropertyBlockIOWrite = "concourse:block-io-write"
+)
+
+// ContainerPropertyOOMKills is the property through which the containerd
+// runtime reports how many processes of a container were killed by the kernel
+// for exceeding its memory limit so far.
+const ContainerPropertyOOMKills = "concourse:oom-kills"
+
+// ContainerPropertyNetworkOf is the property through which a container can be
+// made to join the network namespace of the container with the given handle,
+// rather than being added to the network itself.
+const ContainerPropertyNetworkOf = "concourse:network-of"
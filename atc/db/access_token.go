@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"gopkg.in/square/go-jose.v2/jwt"
 )
@@ -11,6 +12,10 @@ import (
 type AccessToken struct {
 	Token  string
 	Claims Claims
+
+	// ID and CreatedAt are only set when listing a user's tokens.
+	ID        int
+	CreatedAt time.Time
 }
 
 func scanAccessToken(rcv *AccessToken, scan scannable) error {
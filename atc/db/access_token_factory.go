@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -12,6 +13,7 @@ import (
 type AccessTokenFactory interface {
 	CreateAccessToken(token string, claims Claims) error
 	GetAccessToken(token string) (AccessToken, bool, error)
+	ListAccessTokens(username string, connector string) ([]AccessToken, error)
 }
 
 func NewAccessTokenFactory(conn Conn) AccessTokenFactory {
@@ -55,3 +57,46 @@ func (a *accessTokenFactory) GetAccessToken(token string) (AccessToken, bool, er
 	}
 	return accessToken, true, nil
 }
+
+// ListAccessTokens returns the unexpired tokens issued to the user, newest
+// first. An empty connector matches the user on every connector.
+func (a *accessTokenFactory) ListAccessTokens(username string, connector string) ([]AccessToken, error) {
+	rows, err := psql.Select("id", "token", "claims", "created_at").
+		From("access_tokens").
+		Where(issuedTo(username, connector)).
+		Where(sq.Expr("expires_at > now()")).
+		OrderBy("created_at DESC", "id DESC").
+		RunWith(a.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []AccessToken{}
+	for rows.Next() {
+		var token AccessToken
+		err = rows.Scan(&token.ID, &token.Token, &token.Claims, &token.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// issuedTo matches the access tokens issued to the user.
+func issuedTo(username string, connector string) sq.Sqlizer {
+	condition := sq.And{
+		sq.Eq{"LOWER(claims->'federated_claims'->>'user_name')": strings.ToLower(username)},
+	}
+
+	if connector != "" {
+		condition = append(condition, sq.Eq{"claims->'federated_claims'->>'connector_id'": connector})
+	}
+
+	return condition
+}
@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc/db"
 	"gopkg.in/square/go-jose.v2/jwt"
 
@@ -76,4 +78,51 @@ var _ = Describe("Access Token Factory", func() {
 			},
 		}))
 	})
+
+	Describe("ListAccessTokens", func() {
+		BeforeEach(func() {
+			tomorrow := jwt.NewNumericDate(now().Add(24 * time.Hour))
+			yesterday := jwt.NewNumericDate(now().Add(-24 * time.Hour))
+
+			Expect(factory.CreateAccessToken("first-token", userClaims("some-user", "github", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("second-token", userClaims("Some-User", "github", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("local-token", userClaims("some-user", "local", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("expired-token", userClaims("some-user", "github", yesterday))).To(Succeed())
+			Expect(factory.CreateAccessToken("other-token", userClaims("other-user", "github", tomorrow))).To(Succeed())
+		})
+
+		It("lists the unexpired tokens of the user on the connector, newest first", func() {
+			tokens, err := factory.ListAccessTokens("some-user", "github")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(HaveLen(2))
+			Expect(tokens[0].Token).To(Equal("second-token"))
+			Expect(tokens[1].Token).To(Equal("first-token"))
+			Expect(tokens[0].ID).To(BeNumerically(">", tokens[1].ID))
+			Expect(tokens[0].CreatedAt).ToNot(BeZero())
+			Expect(tokens[0].Claims.Username).To(Equal("Some-User"))
+		})
+
+		It("lists the tokens of the user on every connector when no connector is given", func() {
+			tokens, err := factory.ListAccessTokens("some-user", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(HaveLen(3))
+		})
+	})
 })
+
+func userClaims(username string, connector string, expiry *jwt.NumericDate) db.Claims {
+	return db.Claims{
+		Claims: jwt.Claims{Expiry: expiry},
+		FederatedClaims: db.FederatedClaims{
+			Username:  username,
+			Connector: connector,
+		},
+		RawClaims: map[string]interface{}{
+			"exp": expiry,
+			"federated_claims": map[string]interface{}{
+				"user_name":    username,
+				"connector_id": connector,
+			},
+		},
+	}
+}
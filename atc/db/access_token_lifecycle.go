@@ -5,12 +5,16 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
 )
 
 //go:generate counterfeiter . AccessTokenLifecycle
 
 type AccessTokenLifecycle interface {
 	RemoveExpiredAccessTokens(leeway time.Duration) (int, error)
+	RevokeAccessToken(username string, connector string, id int) (bool, error)
+	RevokeAccessTokens(username string, connector string) (int, error)
 }
 
 type accessTokenLifecycle struct {
@@ -37,3 +41,46 @@ func (a accessTokenLifecycle) RemoveExpiredAccessTokens(leeway time.Duration) (i
 	}
 	return int(n), nil
 }
+
+// RevokeAccessToken removes one of the tokens issued to the user, so that it
+// can't be used even though it hasn't expired.
+func (a accessTokenLifecycle) RevokeAccessToken(username string, connector string, id int) (bool, error) {
+	n, err := a.revoke(sq.And{issuedTo(username, connector), sq.Eq{"id": id}})
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// RevokeAccessTokens removes every token issued to the user. An empty
+// connector matches the user on every connector.
+func (a accessTokenLifecycle) RevokeAccessTokens(username string, connector string) (int, error) {
+	return a.revoke(issuedTo(username, connector))
+}
+
+// revoke removes the matching tokens and tells every ATC to forget the claims
+// it has cached for them.
+func (a accessTokenLifecycle) revoke(condition sq.Sqlizer) (int, error) {
+	res, err := psql.Delete("access_tokens").
+		Where(condition).
+		RunWith(a.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if n > 0 {
+		err = a.conn.Bus().Notify(atc.AccessTokenCacheChannel)
+		if err != nil {
+			return int(n), err
+		}
+	}
+
+	return int(n), nil
+}
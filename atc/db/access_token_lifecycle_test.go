@@ -3,6 +3,7 @@ package db_test
 import (
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"gopkg.in/square/go-jose.v2/jwt"
 
@@ -57,6 +58,73 @@ var _ = Describe("Access Token Lifecycle", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(n).To(Equal(0), "did not respect leeway")
 	})
+
+	Describe("revoking tokens", func() {
+		var tokens []db.AccessToken
+
+		BeforeEach(func() {
+			tomorrow := jwt.NewNumericDate(now().Add(24 * time.Hour))
+
+			Expect(factory.CreateAccessToken("first-token", userClaims("some-user", "github", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("second-token", userClaims("some-user", "github", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("local-token", userClaims("some-user", "local", tomorrow))).To(Succeed())
+			Expect(factory.CreateAccessToken("other-token", userClaims("other-user", "github", tomorrow))).To(Succeed())
+
+			var err error
+			tokens, err = factory.ListAccessTokens("some-user", "github")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(HaveLen(2))
+		})
+
+		It("revokes one token of the user", func() {
+			revoked, err := lifecycle.RevokeAccessToken("Some-User", "github", tokens[0].ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			_, found, err := factory.GetAccessToken(tokens[0].Token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = factory.GetAccessToken(tokens[1].Token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("does not revoke the tokens of other users", func() {
+			revoked, err := lifecycle.RevokeAccessToken("other-user", "github", tokens[0].ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+
+		It("revokes every token of the user on the connector", func() {
+			n, err := lifecycle.RevokeAccessTokens("some-user", "github")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(2))
+
+			_, found, _ := factory.GetAccessToken("local-token")
+			Expect(found).To(BeTrue())
+
+			_, found, _ = factory.GetAccessToken("other-token")
+			Expect(found).To(BeTrue())
+		})
+
+		It("revokes the tokens of the user on every connector when no connector is given", func() {
+			n, err := lifecycle.RevokeAccessTokens("some-user", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(3))
+		})
+
+		It("tells the ATCs to forget the cached claims", func() {
+			notifier, err := dbConn.Bus().Listen(atc.AccessTokenCacheChannel)
+			Expect(err).ToNot(HaveOccurred())
+			defer dbConn.Bus().Unlisten(atc.AccessTokenCacheChannel, notifier)
+
+			_, err = lifecycle.RevokeAccessTokens("some-user", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(notifier).Should(Receive())
+		})
+	})
 })
 
 func now() time.Time {
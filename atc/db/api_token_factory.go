@@ -0,0 +1,218 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var (
+	ErrAPITokenTeamNotFound = errors.New("team of api token not found")
+	ErrAPITokenNameTaken    = errors.New("an api token with this name already exists on the team")
+)
+
+// apiTokenUseInterval limits how often the last used time of an API token is
+// written, so that busy automation doesn't write to the database on every
+// request.
+const apiTokenUseInterval = time.Minute
+
+//go:generate counterfeiter . APITokenFactory
+
+// APITokenFactory stores the API tokens of teams. Only a hash of each token
+// is stored, so a token can't be recovered once it has been created.
+type APITokenFactory interface {
+	CreateAPIToken(token string, apiToken atc.APIToken) (atc.APIToken, error)
+	GetAPIToken(token string) (atc.APIToken, bool, error)
+	RecordAPITokenUse(id int) error
+	ListAPITokens(teamName string) ([]atc.APIToken, error)
+	DeleteAPIToken(teamName string, name string) (bool, error)
+}
+
+type apiTokenFactory struct {
+	conn  Conn
+	clock Clock
+}
+
+func NewAPITokenFactory(conn Conn, clock Clock) APITokenFactory {
+	return &apiTokenFactory{
+		conn:  conn,
+		clock: clock,
+	}
+}
+
+var apiTokensQuery = psql.Select(
+	"a.id",
+	"a.name",
+	"t.name",
+	"a.role",
+	"a.created_by",
+	"a.created_at",
+	"a.expires_at",
+	"a.last_used_at",
+).
+	From("api_tokens a").
+	Join("teams t ON t.id = a.team_id")
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (f *apiTokenFactory) CreateAPIToken(token string, apiToken atc.APIToken) (atc.APIToken, error) {
+	var expiresAt *time.Time
+	if apiToken.ExpiresAt != 0 {
+		expiry := time.Unix(apiToken.ExpiresAt, 0)
+		expiresAt = &expiry
+	}
+
+	var id int
+	var createdAt time.Time
+	err := psql.Insert("api_tokens").
+		Columns("team_id", "name", "role", "token_hash", "created_by", "expires_at").
+		Select(
+			psql.Select("id").
+				Column("?::text", apiToken.Name).
+				Column("?::text", apiToken.Role).
+				Column("?::text", hashAPIToken(token)).
+				Column("?::text", apiToken.CreatedBy).
+				Column("?::timestamptz", expiresAt).
+				From("teams").
+				Where(sq.Eq{"LOWER(name)": strings.ToLower(apiToken.TeamName)}),
+		).
+		Suffix("RETURNING id, created_at").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.APIToken{}, ErrAPITokenTeamNotFound
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return atc.APIToken{}, ErrAPITokenNameTaken
+		}
+
+		return atc.APIToken{}, err
+	}
+
+	apiToken.ID = id
+	apiToken.CreatedAt = createdAt.Unix()
+
+	return apiToken, nil
+}
+
+// GetAPIToken finds the API token, whether or not it has expired.
+func (f *apiTokenFactory) GetAPIToken(token string) (atc.APIToken, bool, error) {
+	row := apiTokensQuery.
+		Where(sq.Eq{"a.token_hash": hashAPIToken(token)}).
+		RunWith(f.conn).
+		QueryRow()
+
+	apiToken, err := scanAPIToken(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.APIToken{}, false, nil
+		}
+
+		return atc.APIToken{}, false, err
+	}
+
+	return apiToken, true, nil
+}
+
+func (f *apiTokenFactory) RecordAPITokenUse(id int) error {
+	now := f.clock.Now()
+
+	_, err := psql.Update("api_tokens").
+		Set("last_used_at", now).
+		Where(sq.Eq{"id": id}).
+		Where(sq.Or{
+			sq.Eq{"last_used_at": nil},
+			sq.Lt{"last_used_at": now.Add(-apiTokenUseInterval)},
+		}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *apiTokenFactory) ListAPITokens(teamName string) ([]atc.APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(sq.Eq{"LOWER(t.name)": strings.ToLower(teamName)}).
+		OrderBy("a.name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	apiTokens := []atc.APIToken{}
+	for rows.Next() {
+		apiToken, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		apiTokens = append(apiTokens, apiToken)
+	}
+
+	return apiTokens, nil
+}
+
+func (f *apiTokenFactory) DeleteAPIToken(teamName string, name string) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(sq.Eq{"name": name}).
+		Where(sq.Expr("team_id = (SELECT id FROM teams WHERE LOWER(name) = ?)", strings.ToLower(teamName))).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func scanAPIToken(row scannable) (atc.APIToken, error) {
+	var apiToken atc.APIToken
+	var createdAt time.Time
+	var expiresAt, lastUsedAt pq.NullTime
+	err := row.Scan(
+		&apiToken.ID,
+		&apiToken.Name,
+		&apiToken.TeamName,
+		&apiToken.Role,
+		&apiToken.CreatedBy,
+		&createdAt,
+		&expiresAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	apiToken.CreatedAt = createdAt.Unix()
+
+	if expiresAt.Valid {
+		apiToken.ExpiresAt = expiresAt.Time.Unix()
+	}
+
+	if lastUsedAt.Valid {
+		apiToken.LastUsedAt = lastUsedAt.Time.Unix()
+	}
+
+	return apiToken, nil
+}
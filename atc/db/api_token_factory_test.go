@@ -0,0 +1,169 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APITokenFactory", func() {
+	var (
+		now     time.Time
+		created atc.APIToken
+	)
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+
+		fakeClock = dbfakes.FakeClock{}
+		fakeClock.NowReturns(now)
+
+		var err error
+		created, err = apiTokenFactory.CreateAPIToken("concourse_api_some-token", atc.APIToken{
+			Name:      "deploy-bot",
+			TeamName:  "default-team",
+			Role:      "member",
+			CreatedBy: "some-user",
+			ExpiresAt: now.Add(time.Hour).Unix(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("CreateAPIToken", func() {
+		It("returns the created token", func() {
+			Expect(created.ID).ToNot(BeZero())
+			Expect(created.CreatedAt).ToNot(BeZero())
+			Expect(created.Name).To(Equal("deploy-bot"))
+		})
+
+		It("does not store the token itself", func() {
+			var tokenHash string
+			err := dbConn.QueryRow("SELECT token_hash FROM api_tokens WHERE id = $1", created.ID).Scan(&tokenHash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokenHash).ToNot(ContainSubstring("some-token"))
+		})
+
+		Context("when the team already has a token with the same name", func() {
+			It("returns ErrAPITokenNameTaken", func() {
+				_, err := apiTokenFactory.CreateAPIToken("concourse_api_other-token", atc.APIToken{
+					Name:     "deploy-bot",
+					TeamName: "default-team",
+					Role:     "viewer",
+				})
+				Expect(err).To(Equal(db.ErrAPITokenNameTaken))
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			It("returns ErrAPITokenTeamNotFound", func() {
+				_, err := apiTokenFactory.CreateAPIToken("concourse_api_other-token", atc.APIToken{
+					Name:     "deploy-bot",
+					TeamName: "bogus-team",
+					Role:     "viewer",
+				})
+				Expect(err).To(Equal(db.ErrAPITokenTeamNotFound))
+			})
+		})
+	})
+
+	Describe("GetAPIToken", func() {
+		It("finds the token by its value", func() {
+			apiToken, found, err := apiTokenFactory.GetAPIToken("concourse_api_some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(apiToken).To(Equal(atc.APIToken{
+				ID:        created.ID,
+				Name:      "deploy-bot",
+				TeamName:  "default-team",
+				Role:      "member",
+				CreatedBy: "some-user",
+				CreatedAt: created.CreatedAt,
+				ExpiresAt: now.Add(time.Hour).Unix(),
+			}))
+		})
+
+		It("does not find unknown tokens", func() {
+			_, found, err := apiTokenFactory.GetAPIToken("concourse_api_bogus")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("RecordAPITokenUse", func() {
+		lastUsedAt := func() int64 {
+			apiToken, found, err := apiTokenFactory.GetAPIToken("concourse_api_some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return apiToken.LastUsedAt
+		}
+
+		It("records when the token was last used", func() {
+			err := apiTokenFactory.RecordAPITokenUse(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastUsedAt()).To(Equal(now.Unix()))
+		})
+
+		It("only records it again after a minute", func() {
+			err := apiTokenFactory.RecordAPITokenUse(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.NowReturns(now.Add(30 * time.Second))
+			err = apiTokenFactory.RecordAPITokenUse(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastUsedAt()).To(Equal(now.Unix()))
+
+			fakeClock.NowReturns(now.Add(2 * time.Minute))
+			err = apiTokenFactory.RecordAPITokenUse(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastUsedAt()).To(Equal(now.Add(2 * time.Minute).Unix()))
+		})
+	})
+
+	Describe("ListAPITokens", func() {
+		BeforeEach(func() {
+			_, err := apiTokenFactory.CreateAPIToken("concourse_api_another-token", atc.APIToken{
+				Name:     "audit-bot",
+				TeamName: "default-team",
+				Role:     "viewer",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists the tokens of the team by name", func() {
+			apiTokens, err := apiTokenFactory.ListAPITokens("default-team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(HaveLen(2))
+			Expect(apiTokens[0].Name).To(Equal("audit-bot"))
+			Expect(apiTokens[1].Name).To(Equal("deploy-bot"))
+		})
+
+		It("lists no tokens for other teams", func() {
+			apiTokens, err := apiTokenFactory.ListAPITokens("bogus-team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteAPIToken", func() {
+		It("deletes the token so that it is no longer found", func() {
+			deleted, err := apiTokenFactory.DeleteAPIToken("default-team", "deploy-bot")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			_, found, err := apiTokenFactory.GetAPIToken("concourse_api_some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not delete tokens of other teams", func() {
+			deleted, err := apiTokenFactory.DeleteAPIToken("bogus-team", "deploy-bot")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+})
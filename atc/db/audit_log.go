@@ -0,0 +1,288 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . AuditLog
+
+// AuditLog stores the events recorded by the auditor, so that they can be
+// queried later.
+type AuditLog interface {
+	RecordEvent(event atc.AuditEvent) error
+	Events(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error)
+	RemoveEventsOlderThan(retention time.Duration) (int, error)
+}
+
+// AuditEventFilter limits the audit events returned. Empty fields match every
+// event.
+type AuditEventFilter struct {
+	Team   string
+	Actor  string
+	Action string
+	Since  time.Time
+	Until  time.Time
+}
+
+type auditLog struct {
+	conn Conn
+}
+
+func NewAuditLog(conn Conn) AuditLog {
+	return &auditLog{
+		conn: conn,
+	}
+}
+
+var auditEventsQuery = psql.Select(
+	"e.id",
+	"e.created_at",
+	"e.actor",
+	"e.action",
+	"e.team_name",
+	"e.object",
+	"e.method",
+	"e.path",
+	"e.remote_addr",
+	"e.parameters",
+	"e.policy",
+).
+	From("audit_events e")
+
+var auditEventIDsQuery = psql.Select("e.id").
+	From("audit_events e")
+
+func (filter AuditEventFilter) apply(query sq.SelectBuilder) sq.SelectBuilder {
+	if filter.Team != "" {
+		query = query.Where(sq.Eq{"LOWER(e.team_name)": strings.ToLower(filter.Team)})
+	}
+
+	if filter.Actor != "" {
+		query = query.Where(sq.Eq{"e.actor": filter.Actor})
+	}
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"e.action": filter.Action})
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"e.created_at": filter.Since})
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where(sq.Lt{"e.created_at": filter.Until})
+	}
+
+	return query
+}
+
+func (l *auditLog) RecordEvent(event atc.AuditEvent) error {
+	var parameters, policy interface{}
+
+	if len(event.Parameters) != 0 {
+		payload, err := json.Marshal(event.Parameters)
+		if err != nil {
+			return err
+		}
+
+		parameters = string(payload)
+	}
+
+	if event.Policy != nil {
+		payload, err := json.Marshal(event.Policy)
+		if err != nil {
+			return err
+		}
+
+		policy = string(payload)
+	}
+
+	_, err := psql.Insert("audit_events").
+		SetMap(map[string]interface{}{
+			"actor":       event.Actor,
+			"action":      event.Action,
+			"team_name":   event.Team,
+			"object":      event.Object,
+			"method":      event.Method,
+			"path":        event.Path,
+			"remote_addr": event.RemoteAddr,
+			"parameters":  parameters,
+			"policy":      policy,
+		}).
+		RunWith(l.conn).
+		Exec()
+	return err
+}
+
+// Events returns the audit events matching the filter, newest first.
+func (l *auditLog) Events(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	query := filter.apply(auditEventsQuery)
+
+	tx, err := l.conn.Begin()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Rollback(tx)
+
+	pageQuery := query.Limit(uint64(page.Limit))
+
+	var reverse bool
+	if page.From != nil && page.To != nil && *page.From > *page.To {
+		return nil, Pagination{}, fmt.Errorf("Invalid range boundaries")
+	}
+
+	if page.From != nil {
+		pageQuery = pageQuery.Where(sq.GtOrEq{"e.id": *page.From})
+	}
+
+	if page.To != nil {
+		pageQuery = pageQuery.Where(sq.LtOrEq{"e.id": *page.To})
+	}
+
+	if page.From != nil {
+		pageQuery = pageQuery.OrderBy("e.id ASC")
+		reverse = true
+	} else {
+		pageQuery = pageQuery.OrderBy("e.id DESC")
+	}
+
+	rows, err := pageQuery.RunWith(tx).Query()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		events = append(events, event)
+	}
+
+	if reverse {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	if len(events) == 0 {
+		return events, Pagination{}, nil
+	}
+
+	var pagination Pagination
+
+	newest := events[0].ID
+	oldest := events[len(events)-1].ID
+
+	var olderID int
+	err = filter.apply(auditEventIDsQuery).
+		Where(sq.Lt{"e.id": oldest}).
+		OrderBy("e.id DESC").
+		Limit(1).
+		RunWith(tx).
+		QueryRow().
+		Scan(&olderID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, Pagination{}, err
+	} else if err == nil {
+		pagination.Older = &Page{
+			To:    NewIntPtr(olderID),
+			Limit: page.Limit,
+		}
+	}
+
+	var newerID int
+	err = filter.apply(auditEventIDsQuery).
+		Where(sq.Gt{"e.id": newest}).
+		OrderBy("e.id ASC").
+		Limit(1).
+		RunWith(tx).
+		QueryRow().
+		Scan(&newerID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, Pagination{}, err
+	} else if err == nil {
+		pagination.Newer = &Page{
+			From:  NewIntPtr(newerID),
+			Limit: page.Limit,
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return events, pagination, nil
+}
+
+func (l *auditLog) RemoveEventsOlderThan(retention time.Duration) (int, error) {
+	res, err := psql.Delete("audit_events").
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(retention.Seconds())))).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
+
+func scanAuditEvent(row scannable) (atc.AuditEvent, error) {
+	var event atc.AuditEvent
+	var createdAt time.Time
+	var parameters, policy sql.NullString
+	err := row.Scan(
+		&event.ID,
+		&createdAt,
+		&event.Actor,
+		&event.Action,
+		&event.Team,
+		&event.Object,
+		&event.Method,
+		&event.Path,
+		&event.RemoteAddr,
+		&parameters,
+		&policy,
+	)
+	if err != nil {
+		return atc.AuditEvent{}, err
+	}
+
+	event.Time = createdAt.Unix()
+
+	if parameters.Valid {
+		err = json.Unmarshal([]byte(parameters.String), &event.Parameters)
+		if err != nil {
+			return atc.AuditEvent{}, err
+		}
+	}
+
+	if policy.Valid {
+		event.Policy = &atc.AuditPolicyDecision{}
+		err = json.Unmarshal([]byte(policy.String), event.Policy)
+		if err != nil {
+			return atc.AuditEvent{}, err
+		}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,132 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditLog", func() {
+	BeforeEach(func() {
+		events := []atc.AuditEvent{
+			{
+				Actor:      "some-user",
+				Action:     atc.SaveConfig,
+				Team:       "some-team",
+				Object:     "some-pipeline",
+				Method:     "PUT",
+				Path:       "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+				RemoteAddr: "1.2.3.4:5678",
+				Parameters: map[string][]string{"check_creds": {"true"}},
+			},
+			{
+				Actor:  "some-other-user",
+				Action: atc.GetPipeline,
+				Team:   "some-team",
+				Object: "some-pipeline",
+			},
+			{
+				Actor:  "some-user",
+				Action: atc.GetPipeline,
+				Team:   "other-team",
+				Object: "other-pipeline",
+				Policy: &atc.AuditPolicyDecision{
+					Severity: "audit",
+					Reasons:  []string{"some-reason"},
+				},
+			},
+		}
+
+		for _, event := range events {
+			err := auditLog.RecordEvent(event)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Describe("Events", func() {
+		It("returns the events, newest first", func() {
+			events, _, err := auditLog.Events(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+
+			Expect(events[0].Team).To(Equal("other-team"))
+			Expect(events[0].Policy).To(Equal(&atc.AuditPolicyDecision{
+				Severity: "audit",
+				Reasons:  []string{"some-reason"},
+			}))
+
+			Expect(events[2].Actor).To(Equal("some-user"))
+			Expect(events[2].Action).To(Equal(atc.SaveConfig))
+			Expect(events[2].Object).To(Equal("some-pipeline"))
+			Expect(events[2].Method).To(Equal("PUT"))
+			Expect(events[2].Path).To(Equal("/api/v1/teams/some-team/pipelines/some-pipeline/config"))
+			Expect(events[2].RemoteAddr).To(Equal("1.2.3.4:5678"))
+			Expect(events[2].Parameters).To(Equal(map[string][]string{"check_creds": {"true"}}))
+			Expect(events[2].Policy).To(BeNil())
+			Expect(events[2].Time).ToNot(BeZero())
+		})
+
+		It("filters by team", func() {
+			events, _, err := auditLog.Events(db.AuditEventFilter{Team: "some-team"}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+		})
+
+		It("filters by actor and action", func() {
+			events, _, err := auditLog.Events(db.AuditEventFilter{Actor: "some-user", Action: atc.GetPipeline}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Team).To(Equal("other-team"))
+		})
+
+		It("filters by time", func() {
+			events, _, err := auditLog.Events(db.AuditEventFilter{Until: time.Now().Add(-time.Hour)}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+
+			events, _, err = auditLog.Events(db.AuditEventFilter{Since: time.Now().Add(-time.Hour)}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+		})
+
+		It("paginates", func() {
+			firstPage, pagination, err := auditLog.Events(db.AuditEventFilter{}, db.Page{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firstPage).To(HaveLen(2))
+			Expect(pagination.Newer).To(BeNil())
+			Expect(pagination.Older).ToNot(BeNil())
+
+			secondPage, pagination, err := auditLog.Events(db.AuditEventFilter{}, *pagination.Older)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondPage).To(HaveLen(1))
+			Expect(secondPage[0].ID).To(BeNumerically("<", firstPage[1].ID))
+			Expect(pagination.Older).To(BeNil())
+			Expect(pagination.Newer).ToNot(BeNil())
+
+			newerPage, _, err := auditLog.Events(db.AuditEventFilter{}, *pagination.Newer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newerPage).To(Equal(firstPage))
+		})
+	})
+
+	Describe("RemoveEventsOlderThan", func() {
+		BeforeEach(func() {
+			_, err := dbConn.Exec("UPDATE audit_events SET created_at = now() - '2 days'::interval WHERE team_name = 'other-team'")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the events older than the retention", func() {
+			removed, err := auditLog.RemoveEventsOlderThan(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			events, _, err := auditLog.Events(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+		})
+	})
+})
@@ -93,7 +93,14 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		rb.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.pending_reason,
+		b.queue_position,
+		b.created_by,
+		b.status = 'started' AND EXISTS (
+			SELECT 1 FROM build_approvals ba
+			WHERE ba.build_id = b.id AND ba.approved_by IS NULL
+		)
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -143,6 +150,10 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	PendingReason() string
+	QueuePosition() int
+	CreatedBy() *string
+	AwaitingApproval() bool
 
 	LagerData() lager.Data
 	TracingAttrs() tracing.Attrs
@@ -171,6 +182,10 @@ type Build interface {
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
+	RequestApproval(planID atc.PlanID, name string, role string) (atc.BuildApproval, error)
+	Approvals() ([]atc.BuildApproval, error)
+	Approve(approvalID int, approvedBy string) (bool, error)
+
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
@@ -186,6 +201,8 @@ type Build interface {
 	IsDrained() bool
 	SetDrained(bool) error
 
+	SetPendingReason(string) error
+
 	SpanContext() propagation.HTTPSupplier
 
 	SavePipeline(
@@ -221,6 +238,22 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	// pendingReason is why the scheduler hasn't started the build yet, or why
+	// it errored without running when no worker could run its steps.
+	pendingReason string
+
+	// queuePosition is where the build is in the fair-share queue of pending
+	// builds, starting at 1, or 0 if it isn't queued.
+	queuePosition int
+
+	// createdBy is the subject of the user who triggered the build, if it was
+	// triggered by one.
+	createdBy *string
+
+	// awaitingApproval is whether the running build is waiting on one of its
+	// manual_approval steps.
+	awaitingApproval bool
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -340,19 +373,23 @@ func (b *build) HasPlan() bool                { return string(*b.publicPlan) !=
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
-func (b *build) StartTime() time.Time { return b.startTime }
-func (b *build) EndTime() time.Time   { return b.endTime }
-func (b *build) ReapTime() time.Time  { return b.reapTime }
-func (b *build) Status() BuildStatus  { return b.status }
-func (b *build) IsScheduled() bool    { return b.scheduled }
-func (b *build) IsDrained() bool      { return b.drained }
-func (b *build) IsRunning() bool      { return !b.completed }
-func (b *build) IsAborted() bool      { return b.aborted }
-func (b *build) IsCompleted() bool    { return b.completed }
-func (b *build) InputsReady() bool    { return b.inputsReady }
-func (b *build) RerunOf() int         { return b.rerunOf }
-func (b *build) RerunOfName() string  { return b.rerunOfName }
-func (b *build) RerunNumber() int     { return b.rerunNumber }
+func (b *build) StartTime() time.Time   { return b.startTime }
+func (b *build) EndTime() time.Time     { return b.endTime }
+func (b *build) ReapTime() time.Time    { return b.reapTime }
+func (b *build) Status() BuildStatus    { return b.status }
+func (b *build) IsScheduled() bool      { return b.scheduled }
+func (b *build) IsDrained() bool        { return b.drained }
+func (b *build) IsRunning() bool        { return !b.completed }
+func (b *build) IsAborted() bool        { return b.aborted }
+func (b *build) IsCompleted() bool      { return b.completed }
+func (b *build) InputsReady() bool      { return b.inputsReady }
+func (b *build) RerunOf() int           { return b.rerunOf }
+func (b *build) RerunOfName() string    { return b.rerunOfName }
+func (b *build) RerunNumber() int       { return b.rerunNumber }
+func (b *build) PendingReason() string  { return b.pendingReason }
+func (b *build) QueuePosition() int     { return b.queuePosition }
+func (b *build) CreatedBy() *string     { return b.createdBy }
+func (b *build) AwaitingApproval() bool { return b.awaitingApproval }
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -462,6 +499,8 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 		Set("private_plan", encryptedPlan).
 		Set("public_plan", plan.Public()).
 		Set("nonce", nonce).
+		Set("pending_reason", nil).
+		Set("queue_position", nil).
 		Where(sq.Eq{
 			"id":      b.id,
 			"status":  "pending",
@@ -520,6 +559,7 @@ func (b *build) Finish(status BuildStatus) error {
 		Set("completed", true).
 		Set("private_plan", nil).
 		Set("nonce", nil).
+		Set("queue_position", nil).
 		Where(sq.Eq{"id": b.id}).
 		Suffix("RETURNING end_time").
 		RunWith(tx).
@@ -749,6 +789,24 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+func (b *build) SetPendingReason(reason string) error {
+	var value interface{}
+	if reason != "" {
+		value = reason
+	}
+
+	_, err := psql.Update("builds").
+		Set("pending_reason", value).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+
+	if err == nil {
+		b.pendingReason = reason
+	}
+	return err
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -1114,6 +1172,124 @@ func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	return artifacts, nil
 }
 
+var buildApprovalsQuery = psql.Select(
+	"id",
+	"plan_id",
+	"name",
+	"role",
+	"requested_at",
+	"approved_by",
+	"approved_at",
+).From("build_approvals")
+
+// RequestApproval records that the build is waiting on the manual_approval
+// step, if it isn't already, and returns the state of its approval. As the
+// approval is stored, a build resumed after a restart keeps waiting on the
+// same approval rather than requesting it again.
+func (b *build) RequestApproval(planID atc.PlanID, name string, role string) (atc.BuildApproval, error) {
+	_, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id", "name", "role").
+		Values(b.id, string(planID), name, role).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return atc.BuildApproval{}, err
+	}
+
+	row := buildApprovalsQuery.
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow()
+
+	return scanBuildApproval(row)
+}
+
+func (b *build) Approvals() ([]atc.BuildApproval, error) {
+	rows, err := buildApprovalsQuery.
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	approvals := []atc.BuildApproval{}
+	for rows.Next() {
+		approval, err := scanBuildApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+// Approve approves one of the build's manual_approval steps. It returns false
+// if the step doesn't exist or has already been approved.
+func (b *build) Approve(approvalID int, approvedBy string) (bool, error) {
+	result, err := psql.Update("build_approvals").
+		Set("approved_by", approvedBy).
+		Set("approved_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"id":          approvalID,
+			"build_id":    b.id,
+			"approved_at": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func scanBuildApproval(row scannable) (atc.BuildApproval, error) {
+	var (
+		approval    atc.BuildApproval
+		planID      string
+		requestedAt time.Time
+		approvedBy  sql.NullString
+		approvedAt  pq.NullTime
+	)
+
+	err := row.Scan(
+		&approval.ID,
+		&planID,
+		&approval.Name,
+		&approval.Role,
+		&requestedAt,
+		&approvedBy,
+		&approvedAt,
+	)
+	if err != nil {
+		return atc.BuildApproval{}, err
+	}
+
+	approval.PlanID = atc.PlanID(planID)
+	approval.RequestedAt = requestedAt.Unix()
+	approval.ApprovedBy = approvedBy.String
+	if approvedAt.Valid {
+		approval.ApprovedAt = approvedAt.Time.Unix()
+	}
+
+	return approval, nil
+}
+
 func (b *build) SaveOutput(
 	resourceType string,
 	source atc.Source,
@@ -1713,10 +1889,10 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, resourceID, pipelineID, rerunOf, rerunNumber                               sql.NullInt64
+		jobID, resourceID, pipelineID, rerunOf, rerunNumber, queuePosition                sql.NullInt64
 		schema, privatePlan, jobName, resourceName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                                          pq.NullTime
-		nonce, spanContext                                                                sql.NullString
+		nonce, spanContext, pendingReason, createdBy                                      sql.NullString
 		drained, aborted, completed                                                       bool
 		status                                                                            string
 		pipelineInstanceVars                                                              sql.NullString
@@ -1753,6 +1929,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&pendingReason,
+		&queuePosition,
+		&createdBy,
+		&b.awaitingApproval,
 	)
 	if err != nil {
 		return err
@@ -1773,6 +1953,11 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.drained = drained
 	b.aborted = aborted
 	b.completed = completed
+	b.pendingReason = pendingReason.String
+	b.queuePosition = int(queuePosition.Int64)
+	if createdBy.Valid {
+		b.createdBy = &createdBy.String
+	}
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
@@ -105,10 +105,10 @@ var _ = Describe("BuildFactory", func() {
 		Context("pipeline builds", func() {
 
 			It("[#139963615] marks builds that aren't the latest as non-interceptible, ", func() {
-				build1, err := defaultJob.CreateBuild()
+				build1, err := defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				build2, err := defaultJob.CreateBuild()
+				build2, err := defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				err = build1.Finish(db.BuildStatusErrored)
@@ -129,10 +129,10 @@ var _ = Describe("BuildFactory", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				pb1, err := j.CreateBuild()
+				pb1, err := j.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				pb2, err := j.CreateBuild()
+				pb2, err := j.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				err = pb1.Finish(db.BuildStatusErrored)
@@ -164,7 +164,7 @@ var _ = Describe("BuildFactory", func() {
 
 			DescribeTable("completed builds",
 				func(status db.BuildStatus, matcher types.GomegaMatcher) {
-					b, err := defaultJob.CreateBuild()
+					b, err := defaultJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					var i bool
@@ -185,7 +185,7 @@ var _ = Describe("BuildFactory", func() {
 			)
 
 			It("does not mark non-completed builds", func() {
-				b, err := defaultJob.CreateBuild()
+				b, err := defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				var i bool
@@ -212,7 +212,7 @@ var _ = Describe("BuildFactory", func() {
 		Context("GC failed builds", func() {
 			It("marks failed builds non-interceptible after failed-grace-period", func() {
 				buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0, 2*time.Second) // 1 second could create a flaky test
-				build, err := defaultJob.CreateBuild()
+				build, err := defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				err = build.Finish(db.BuildStatusFailed)
@@ -258,7 +258,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build2, err = privateJob.CreateBuild()
+			build2, err = privateJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
@@ -270,7 +270,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build3, err = publicJob.CreateBuild()
+			build3, err = publicJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
@@ -279,7 +279,7 @@ var _ = Describe("BuildFactory", func() {
 			build4, err = otherTeam.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build5, err = privateJob.RerunBuild(build2)
+			build5, err = privateJob.RerunBuild(build2, "some-user")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -317,7 +317,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build2, err = privateJob.CreateBuild()
+			build2, err = privateJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
@@ -329,7 +329,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build3, err = publicJob.CreateBuild()
+			build3, err = publicJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
@@ -363,7 +363,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = privateJob.CreateBuild()
+			_, err = privateJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
@@ -375,7 +375,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			publicBuild, err = publicJob.CreateBuild()
+			publicBuild, err = publicJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -411,10 +411,10 @@ var _ = Describe("BuildFactory", func() {
 			build2DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build3DB, err = job.CreateBuild()
+			build3DB, err = job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
-			build4DB, err = job.CreateBuild()
+			build4DB, err = job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := build2DB.Start(atc.Plan{})
@@ -463,7 +463,7 @@ var _ = Describe("BuildFactory", func() {
 			build1DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build2DB, err = job.CreateBuild()
+			build2DB, err = job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = team.CreateOneOffBuild()
@@ -512,7 +512,7 @@ var _ = Describe("BuildFactory", func() {
 			build1DB, err = team.CreateOneOffBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			build2DB, err = job.CreateBuild()
+			build2DB, err = job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = team.CreateOneOffBuild()
@@ -0,0 +1,157 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// FairSharePendingReason is the pending reason of builds waiting in the
+// queue for their turn to start.
+const FairSharePendingReason = "waiting for a fair share of the build capacity"
+
+//go:generate counterfeiter . BuildQueue
+
+// BuildQueue stores the positions of pending builds in the queue which
+// decides the order they start in when the cluster is short of capacity.
+type BuildQueue interface {
+	// PendingBuilds returns the pending builds of unpaused jobs, oldest
+	// first. Builds which the scheduler is holding back for another reason,
+	// e.g. their inputs not being satisfied, are left out until they get to
+	// wait in the queue.
+	PendingBuilds() ([]QueuedBuild, error)
+
+	// RunningBuilds counts the started job builds of each team. Check builds
+	// and one-off builds don't go through the queue, so they don't count
+	// against it either.
+	RunningBuilds() (map[string]int, error)
+
+	// SavePositions saves the queue positions of builds by their ID, and
+	// clears the position of every other build.
+	SavePositions(map[int]int) error
+}
+
+// QueuedBuild is a pending build waiting in the queue.
+type QueuedBuild struct {
+	ID       int
+	TeamName string
+}
+
+type buildQueue struct {
+	conn Conn
+}
+
+func NewBuildQueue(conn Conn) BuildQueue {
+	return &buildQueue{
+		conn: conn,
+	}
+}
+
+func (q *buildQueue) PendingBuilds() ([]QueuedBuild, error) {
+	rows, err := psql.Select("b.id", "t.name").
+		From("builds b").
+		Join("jobs j ON j.id = b.job_id").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Join("teams t ON t.id = b.team_id").
+		Where(sq.Eq{
+			"b.status":   BuildStatusPending,
+			"b.aborted":  false,
+			"j.paused":   false,
+			"j.active":   true,
+			"p.paused":   false,
+			"p.archived": false,
+		}).
+		Where(sq.Or{
+			sq.Eq{"b.pending_reason": nil},
+			sq.Eq{"b.pending_reason": FairSharePendingReason},
+		}).
+		OrderBy("b.id ASC").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []QueuedBuild{}
+	for rows.Next() {
+		var build QueuedBuild
+		err = rows.Scan(&build.ID, &build.TeamName)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
+func (q *buildQueue) RunningBuilds() (map[string]int, error) {
+	rows, err := psql.Select("t.name", "COUNT(*)").
+		From("builds b").
+		Join("teams t ON t.id = b.team_id").
+		Where(sq.Eq{"b.status": BuildStatusStarted}).
+		Where(sq.NotEq{"b.job_id": nil}).
+		GroupBy("t.name").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	running := map[string]int{}
+	for rows.Next() {
+		var team string
+		var count int
+		err = rows.Scan(&team, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		running[team] = count
+	}
+
+	return running, nil
+}
+
+func (q *buildQueue) SavePositions(positions map[int]int) error {
+	ids := make([]int64, 0, len(positions))
+	values := make([]int64, 0, len(positions))
+	for id, position := range positions {
+		ids = append(ids, int64(id))
+		values = append(values, int64(position))
+	}
+
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
+		Set("queue_position", nil).
+		Where(sq.NotEq{"queue_position": nil}).
+		Where(sq.Expr("NOT (id = ANY(?))", pq.Array(ids))).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE builds b
+		SET queue_position = q.position
+		FROM unnest($1::int[], $2::int[]) AS q (id, position)
+		WHERE b.id = q.id
+		AND b.queue_position IS DISTINCT FROM q.position
+	`, pq.Array(ids), pq.Array(values))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
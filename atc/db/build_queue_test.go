@@ -0,0 +1,151 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var (
+		pendingBuild1 db.Build
+		pendingBuild2 db.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		pendingBuild1, err = defaultJob.CreateBuild("some-user")
+		Expect(err).ToNot(HaveOccurred())
+
+		pendingBuild2, err = defaultJob.CreateBuild("some-user")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("PendingBuilds", func() {
+		It("returns the pending builds of jobs, oldest first", func() {
+			builds, err := buildQueue.PendingBuilds()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(Equal([]db.QueuedBuild{
+				{ID: pendingBuild1.ID(), TeamName: "default-team"},
+				{ID: pendingBuild2.ID(), TeamName: "default-team"},
+			}))
+		})
+
+		It("leaves out builds that have started", func() {
+			started, err := pendingBuild1.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			builds, err := buildQueue.PendingBuilds()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(Equal([]db.QueuedBuild{
+				{ID: pendingBuild2.ID(), TeamName: "default-team"},
+			}))
+		})
+
+		It("leaves out builds held back for another reason", func() {
+			Expect(pendingBuild1.SetPendingReason("inputs not satisfied")).To(Succeed())
+			Expect(pendingBuild2.SetPendingReason(db.FairSharePendingReason)).To(Succeed())
+
+			builds, err := buildQueue.PendingBuilds()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(Equal([]db.QueuedBuild{
+				{ID: pendingBuild2.ID(), TeamName: "default-team"},
+			}))
+		})
+
+		Context("when the job is paused", func() {
+			BeforeEach(func() {
+				Expect(defaultJob.Pause()).To(Succeed())
+			})
+
+			It("leaves out its builds", func() {
+				builds, err := buildQueue.PendingBuilds()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
+
+		Context("when the pipeline is paused", func() {
+			BeforeEach(func() {
+				Expect(defaultPipeline.Pause()).To(Succeed())
+			})
+
+			It("leaves out its builds", func() {
+				builds, err := buildQueue.PendingBuilds()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("RunningBuilds", func() {
+		It("counts the started job builds of each team", func() {
+			started, err := pendingBuild1.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			oneOffBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err = oneOffBuild.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			running, err := buildQueue.RunningBuilds()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(running).To(Equal(map[string]int{"default-team": 1}))
+		})
+	})
+
+	Describe("SavePositions", func() {
+		It("saves the position of each build", func() {
+			err := buildQueue.SavePositions(map[int]int{
+				pendingBuild1.ID(): 2,
+				pendingBuild2.ID(): 1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pendingBuild1.Reload()).To(BeTrue())
+			Expect(pendingBuild1.QueuePosition()).To(Equal(2))
+
+			Expect(pendingBuild2.Reload()).To(BeTrue())
+			Expect(pendingBuild2.QueuePosition()).To(Equal(1))
+		})
+
+		It("clears the positions of builds that are no longer queued", func() {
+			err := buildQueue.SavePositions(map[int]int{
+				pendingBuild1.ID(): 1,
+				pendingBuild2.ID(): 2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = buildQueue.SavePositions(map[int]int{
+				pendingBuild2.ID(): 1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pendingBuild1.Reload()).To(BeTrue())
+			Expect(pendingBuild1.QueuePosition()).To(BeZero())
+
+			Expect(pendingBuild2.Reload()).To(BeTrue())
+			Expect(pendingBuild2.QueuePosition()).To(Equal(1))
+		})
+
+		It("clears the position of a build when it starts", func() {
+			err := buildQueue.SavePositions(map[int]int{
+				pendingBuild1.ID(): 1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := pendingBuild1.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			Expect(pendingBuild1.Reload()).To(BeTrue())
+			Expect(pendingBuild1.QueuePosition()).To(BeZero())
+		})
+	})
+})
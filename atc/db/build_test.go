@@ -57,7 +57,7 @@ var _ = Describe("Build", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(found).To(BeTrue())
 
-		build, err = job.CreateBuild()
+		build, err = job.CreateBuild("some-user")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -95,7 +95,7 @@ var _ = Describe("Build", func() {
 		Context("for a job build", func() {
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -156,7 +156,7 @@ var _ = Describe("Build", func() {
 		Context("for a job build", func() {
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -208,7 +208,7 @@ var _ = Describe("Build", func() {
 		Context("for a job build", func() {
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -278,6 +278,144 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("PendingReason", func() {
+		It("is empty in the beginning", func() {
+			Expect(build.PendingReason()).To(BeEmpty())
+		})
+
+		It("is set after a reload", func() {
+			err := build.SetPendingReason("max in flight reached")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.PendingReason()).To(Equal("max in flight reached"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.PendingReason()).To(Equal("max in flight reached"))
+		})
+
+		It("is cleared once the build starts", func() {
+			err := build.SetPendingReason("max in flight reached")
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.PendingReason()).To(BeEmpty())
+		})
+	})
+
+	Describe("CreatedBy", func() {
+		It("is the user who triggered the build", func() {
+			Expect(build.CreatedBy()).ToNot(BeNil())
+			Expect(*build.CreatedBy()).To(Equal("some-user"))
+
+			_, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.CreatedBy()).ToNot(BeNil())
+			Expect(*build.CreatedBy()).To(Equal("some-user"))
+		})
+
+		It("is nil for builds not triggered by a user", func() {
+			oneOff, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oneOff.CreatedBy()).To(BeNil())
+		})
+	})
+
+	Describe("Approvals", func() {
+		It("has none in the beginning", func() {
+			approvals, err := build.Approvals()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approvals).To(BeEmpty())
+		})
+
+		Context("when an approval is requested", func() {
+			var approval atc.BuildApproval
+
+			BeforeEach(func() {
+				var err error
+				approval, err = build.RequestApproval("some-plan-id", "deploy", "owner")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is awaiting approval", func() {
+				Expect(approval.PlanID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(approval.Name).To(Equal("deploy"))
+				Expect(approval.Role).To(Equal("owner"))
+				Expect(approval.RequestedAt).ToNot(BeZero())
+				Expect(approval.Approved()).To(BeFalse())
+
+				approvals, err := build.Approvals()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(approvals).To(Equal([]atc.BuildApproval{approval}))
+			})
+
+			It("returns the same approval when requested again", func() {
+				again, err := build.RequestApproval("some-plan-id", "deploy", "owner")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(again).To(Equal(approval))
+			})
+
+			It("marks the running build as awaiting approval", func() {
+				_, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.AwaitingApproval()).To(BeFalse())
+
+				started, err := build.Start(atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.AwaitingApproval()).To(BeTrue())
+
+				err = build.Finish(db.BuildStatusAborted)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.AwaitingApproval()).To(BeFalse())
+			})
+
+			Context("when it is approved", func() {
+				var approved bool
+
+				BeforeEach(func() {
+					var err error
+					approved, err = build.Approve(approval.ID, "some-approver")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("records who approved it", func() {
+					Expect(approved).To(BeTrue())
+
+					again, err := build.RequestApproval("some-plan-id", "deploy", "owner")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(again.Approved()).To(BeTrue())
+					Expect(again.ApprovedBy).To(Equal("some-approver"))
+				})
+
+				It("can't be approved again", func() {
+					approved, err := build.Approve(approval.ID, "someone-else")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(approved).To(BeFalse())
+				})
+
+				It("is no longer awaiting approval", func() {
+					_, err := build.Start(atc.Plan{})
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = build.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(build.AwaitingApproval()).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -474,7 +612,7 @@ var _ = Describe("Build", func() {
 			})
 			Expect(err).ToNot(HaveOccurred())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = job.SaveNextInputMapping(db.InputMapping{
@@ -591,13 +729,13 @@ var _ = Describe("Build", func() {
 
 			Context("when there is a pending build that is not a rerun", func() {
 				BeforeEach(func() {
-					pdBuild, err = job.CreateBuild()
+					pdBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				Context("when rerunning the latest completed build", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, "some-user")
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -622,7 +760,7 @@ var _ = Describe("Build", func() {
 
 					Context("when there is another pending build that is not a rerun and the first pending build finishes", func() {
 						BeforeEach(func() {
-							pdBuild2, err = job.CreateBuild()
+							pdBuild2, err = job.CreateBuild("some-user")
 							Expect(err).NotTo(HaveOccurred())
 
 							err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -641,7 +779,7 @@ var _ = Describe("Build", func() {
 
 				Context("when rerunning the pending build and the pending build finished", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(pdBuild)
+						rrBuild, err = job.RerunBuild(pdBuild, "some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -663,7 +801,7 @@ var _ = Describe("Build", func() {
 							err = rrBuild.Finish(db.BuildStatusSucceeded)
 							Expect(err).NotTo(HaveOccurred())
 
-							rrBuild2, err = job.RerunBuild(rrBuild)
+							rrBuild2, err = job.RerunBuild(rrBuild, "some-user")
 							Expect(err).NotTo(HaveOccurred())
 						})
 
@@ -682,7 +820,7 @@ var _ = Describe("Build", func() {
 						err = pdBuild.Finish(db.BuildStatusErrored)
 						Expect(err).NotTo(HaveOccurred())
 
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, "some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						err = rrBuild.Finish(db.BuildStatusSucceeded)
@@ -736,7 +874,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := downstreamJob.ScheduleRequestedTime()
@@ -755,7 +893,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := noRequestJob.ScheduleRequestedTime()
@@ -776,7 +914,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				By("creating a child pipeline")
-				build, _ := defaultJob.CreateBuild()
+				build, _ := defaultJob.CreateBuild("some-user")
 				childPipeline, _, _ = build.SavePipeline(atc.PipelineRef{Name: "child1-pipeline"}, defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
 				build.Finish(db.BuildStatusSucceeded)
 
@@ -787,7 +925,7 @@ var _ = Describe("Build", func() {
 			Context("build is successful", func() {
 				It("archives pipelines no longer set by the job", func() {
 					By("no longer setting the child pipeline")
-					build2, _ := defaultJob.CreateBuild()
+					build2, _ := defaultJob.CreateBuild("some-user")
 					build2.Finish(db.BuildStatusSucceeded)
 
 					childPipeline.Reload()
@@ -801,14 +939,14 @@ var _ = Describe("Build", func() {
 						By("creating a chain of pipelines, previous pipeline setting the next pipeline")
 						for i := 0; i < 5; i++ {
 							job, _, _ := childPipeline.Job("some-job")
-							build, _ := job.CreateBuild()
+							build, _ := job.CreateBuild("some-user")
 							childPipeline, _, _ = build.SavePipeline(atc.PipelineRef{Name: "child-pipeline-" + strconv.Itoa(i)}, defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
 							build.Finish(db.BuildStatusSucceeded)
 							childPipelines = append(childPipelines, childPipeline)
 						}
 
 						By("parent pipeline no longer sets child pipeline in most recent build")
-						build, _ := defaultJob.CreateBuild()
+						build, _ := defaultJob.CreateBuild("some-user")
 						build.Finish(db.BuildStatusSucceeded)
 
 						for _, pipeline := range childPipelines {
@@ -821,7 +959,7 @@ var _ = Describe("Build", func() {
 
 				Context("when the pipeline is not set by build", func() {
 					It("never gets archived", func() {
-						build, _ := defaultJob.CreateBuild()
+						build, _ := defaultJob.CreateBuild("some-user")
 						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false)
 						build.Finish(db.BuildStatusSucceeded)
 
@@ -833,7 +971,7 @@ var _ = Describe("Build", func() {
 			Context("build is not successful", func() {
 				It("does not archive pipelines", func() {
 					By("no longer setting the child pipeline")
-					build2, _ := defaultJob.CreateBuild()
+					build2, _ := defaultJob.CreateBuild("some-user")
 					build2.Finish(db.BuildStatusFailed)
 
 					childPipeline.Reload()
@@ -907,7 +1045,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1161,7 +1299,7 @@ var _ = Describe("Build", func() {
 
 		Context("when the version does not exist", func() {
 			It("can save a build's output", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -1190,7 +1328,7 @@ var _ = Describe("Build", func() {
 			It("requests schedule on all jobs using the resource config", func() {
 				atc.EnableGlobalResources = true
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -1269,7 +1407,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not increment the check order", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -1292,7 +1430,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not request schedule on all jobs using the resource config", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -1383,7 +1521,7 @@ var _ = Describe("Build", func() {
 						})
 
 						It("saves the output", func() {
-							build, err := job.CreateBuild()
+							build, err := job.CreateBuild("some-user")
 							Expect(err).ToNot(HaveOccurred())
 
 							err = build.SaveOutput(
@@ -1483,7 +1621,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("returns build inputs and outputs", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			// save a normal 'get'
@@ -1529,7 +1667,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				// save a normal 'get'
@@ -1588,7 +1726,7 @@ var _ = Describe("Build", func() {
 
 				BeforeEach(func() {
 					var err error
-					newBuild, err = job.CreateBuild()
+					newBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					// save a normal 'get'
@@ -1664,7 +1802,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1732,7 +1870,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedBuildPrep.BuildID = build.ID()
@@ -1888,7 +2026,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild("some-user")
 							Expect(err).NotTo(HaveOccurred())
 
 							err = job.SaveNextInputMapping(nil, true)
@@ -1993,7 +2131,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild("some-user")
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err := job.ScheduleBuild(build)
@@ -2280,17 +2418,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err = pipeline.Job("some-other-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherBuild2, err = otherJob.CreateBuild()
+			otherBuild2, err = otherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2543,17 +2681,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
-			retriggerBuild, err = job.RerunBuild(build)
+			retriggerBuild, err = job.RerunBuild(build, "some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2840,7 +2978,7 @@ var _ = Describe("Build", func() {
 			resourceConfigScope2, err = resource2.SetResourceConfig(atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{})
 			Expect(err).ToNot(HaveOccurred())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2901,7 +3039,7 @@ var _ = Describe("Build", func() {
 	Describe("SavePipeline", func() {
 		It("saves the parent job and build ids", func() {
 			By("creating a build")
-			build, err := defaultJob.CreateBuild()
+			build, err := defaultJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("saving a pipeline with the build")
@@ -2937,9 +3075,9 @@ var _ = Describe("Build", func() {
 
 		It("only saves the pipeline if it is the latest build", func() {
 			By("creating two builds")
-			buildOne, err := defaultJob.CreateBuild()
+			buildOne, err := defaultJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
-			buildTwo, err := defaultJob.CreateBuild()
+			buildTwo, err := defaultJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("saving a pipeline with the second build")
@@ -3004,7 +3142,7 @@ var _ = Describe("Build", func() {
 		Context("a pipeline is previously saved by team.SavePipeline", func() {
 			It("the parent job and build ID are updated", func() {
 				By("creating a build")
-				build, err := defaultJob.CreateBuild()
+				build, err := defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("re-saving the default pipeline with the build")
@@ -206,7 +206,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingContainer, err = defaultWorker.CreateContainer(
@@ -314,7 +314,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
@@ -382,7 +382,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
@@ -49,6 +49,12 @@ var (
 	workerTaskCacheFactory              db.WorkerTaskCacheFactory
 	userFactory                         db.UserFactory
 	dbWall                              db.Wall
+	teamPolicies                        db.TeamPolicies
+	apiTokenFactory                     db.APITokenFactory
+	auditLog                            db.AuditLog
+	localUserFactory                    db.LocalUserFactory
+	quotaChecker                        db.QuotaChecker
+	buildQueue                          db.BuildQueue
 	fakeClock                           dbfakes.FakeClock
 
 	defaultWorkerResourceType atc.WorkerResourceType
@@ -131,6 +137,12 @@ var _ = BeforeEach(func() {
 	workerTaskCacheFactory = db.NewWorkerTaskCacheFactory(dbConn)
 	userFactory = db.NewUserFactory(dbConn)
 	dbWall = db.NewWall(dbConn, &fakeClock)
+	teamPolicies = db.NewTeamPolicies(dbConn, &fakeClock)
+	apiTokenFactory = db.NewAPITokenFactory(dbConn, &fakeClock)
+	auditLog = db.NewAuditLog(dbConn)
+	localUserFactory = db.NewLocalUserFactory(dbConn)
+	quotaChecker = db.NewQuotaChecker(dbConn)
+	buildQueue = db.NewBuildQueue(dbConn)
 
 	var err error
 	defaultTeam, err = teamFactory.CreateTeam(atc.Team{Name: "default-team"})
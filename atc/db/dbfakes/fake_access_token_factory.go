@@ -35,6 +35,20 @@ type FakeAccessTokenFactory struct {
 		result2 bool
 		result3 error
 	}
+	ListAccessTokensStub        func(string, string) ([]db.AccessToken, error)
+	listAccessTokensMutex       sync.RWMutex
+	listAccessTokensArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listAccessTokensReturns struct {
+		result1 []db.AccessToken
+		result2 error
+	}
+	listAccessTokensReturnsOnCall map[int]struct {
+		result1 []db.AccessToken
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -166,6 +180,70 @@ func (fake *FakeAccessTokenFactory) GetAccessTokenReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeAccessTokenFactory) ListAccessTokens(arg1 string, arg2 string) ([]db.AccessToken, error) {
+	fake.listAccessTokensMutex.Lock()
+	ret, specificReturn := fake.listAccessTokensReturnsOnCall[len(fake.listAccessTokensArgsForCall)]
+	fake.listAccessTokensArgsForCall = append(fake.listAccessTokensArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListAccessTokens", []interface{}{arg1, arg2})
+	fake.listAccessTokensMutex.Unlock()
+	if fake.ListAccessTokensStub != nil {
+		return fake.ListAccessTokensStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAccessTokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenFactory) ListAccessTokensCallCount() int {
+	fake.listAccessTokensMutex.RLock()
+	defer fake.listAccessTokensMutex.RUnlock()
+	return len(fake.listAccessTokensArgsForCall)
+}
+
+func (fake *FakeAccessTokenFactory) ListAccessTokensCalls(stub func(string, string) ([]db.AccessToken, error)) {
+	fake.listAccessTokensMutex.Lock()
+	defer fake.listAccessTokensMutex.Unlock()
+	fake.ListAccessTokensStub = stub
+}
+
+func (fake *FakeAccessTokenFactory) ListAccessTokensArgsForCall(i int) (string, string) {
+	fake.listAccessTokensMutex.RLock()
+	defer fake.listAccessTokensMutex.RUnlock()
+	argsForCall := fake.listAccessTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccessTokenFactory) ListAccessTokensReturns(result1 []db.AccessToken, result2 error) {
+	fake.listAccessTokensMutex.Lock()
+	defer fake.listAccessTokensMutex.Unlock()
+	fake.ListAccessTokensStub = nil
+	fake.listAccessTokensReturns = struct {
+		result1 []db.AccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) ListAccessTokensReturnsOnCall(i int, result1 []db.AccessToken, result2 error) {
+	fake.listAccessTokensMutex.Lock()
+	defer fake.listAccessTokensMutex.Unlock()
+	fake.ListAccessTokensStub = nil
+	if fake.listAccessTokensReturnsOnCall == nil {
+		fake.listAccessTokensReturnsOnCall = make(map[int]struct {
+			result1 []db.AccessToken
+			result2 error
+		})
+	}
+	fake.listAccessTokensReturnsOnCall[i] = struct {
+		result1 []db.AccessToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAccessTokenFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -173,6 +251,8 @@ func (fake *FakeAccessTokenFactory) Invocations() map[string][][]interface{} {
 	defer fake.createAccessTokenMutex.RUnlock()
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
+	fake.listAccessTokensMutex.RLock()
+	defer fake.listAccessTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -22,6 +22,35 @@ type FakeAccessTokenLifecycle struct {
 		result1 int
 		result2 error
 	}
+	RevokeAccessTokenStub        func(string, string, int) (bool, error)
+	revokeAccessTokenMutex       sync.RWMutex
+	revokeAccessTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	revokeAccessTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAccessTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeAccessTokensStub        func(string, string) (int, error)
+	revokeAccessTokensMutex       sync.RWMutex
+	revokeAccessTokensArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeAccessTokensReturns struct {
+		result1 int
+		result2 error
+	}
+	revokeAccessTokensReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -89,11 +118,144 @@ func (fake *FakeAccessTokenLifecycle) RemoveExpiredAccessTokensReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeAccessTokenLifecycle) RevokeAccessToken(arg1 string, arg2 string, arg3 int) (bool, error) {
+	fake.revokeAccessTokenMutex.Lock()
+	ret, specificReturn := fake.revokeAccessTokenReturnsOnCall[len(fake.revokeAccessTokenArgsForCall)]
+	fake.revokeAccessTokenArgsForCall = append(fake.revokeAccessTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RevokeAccessToken", []interface{}{arg1, arg2, arg3})
+	fake.revokeAccessTokenMutex.Unlock()
+	if fake.RevokeAccessTokenStub != nil {
+		return fake.RevokeAccessTokenStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAccessTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokenCallCount() int {
+	fake.revokeAccessTokenMutex.RLock()
+	defer fake.revokeAccessTokenMutex.RUnlock()
+	return len(fake.revokeAccessTokenArgsForCall)
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokenCalls(stub func(string, string, int) (bool, error)) {
+	fake.revokeAccessTokenMutex.Lock()
+	defer fake.revokeAccessTokenMutex.Unlock()
+	fake.RevokeAccessTokenStub = stub
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokenArgsForCall(i int) (string, string, int) {
+	fake.revokeAccessTokenMutex.RLock()
+	defer fake.revokeAccessTokenMutex.RUnlock()
+	argsForCall := fake.revokeAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokenReturns(result1 bool, result2 error) {
+	fake.revokeAccessTokenMutex.Lock()
+	defer fake.revokeAccessTokenMutex.Unlock()
+	fake.RevokeAccessTokenStub = nil
+	fake.revokeAccessTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAccessTokenMutex.Lock()
+	defer fake.revokeAccessTokenMutex.Unlock()
+	fake.RevokeAccessTokenStub = nil
+	if fake.revokeAccessTokenReturnsOnCall == nil {
+		fake.revokeAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAccessTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokens(arg1 string, arg2 string) (int, error) {
+	fake.revokeAccessTokensMutex.Lock()
+	ret, specificReturn := fake.revokeAccessTokensReturnsOnCall[len(fake.revokeAccessTokensArgsForCall)]
+	fake.revokeAccessTokensArgsForCall = append(fake.revokeAccessTokensArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeAccessTokens", []interface{}{arg1, arg2})
+	fake.revokeAccessTokensMutex.Unlock()
+	if fake.RevokeAccessTokensStub != nil {
+		return fake.RevokeAccessTokensStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAccessTokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokensCallCount() int {
+	fake.revokeAccessTokensMutex.RLock()
+	defer fake.revokeAccessTokensMutex.RUnlock()
+	return len(fake.revokeAccessTokensArgsForCall)
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokensCalls(stub func(string, string) (int, error)) {
+	fake.revokeAccessTokensMutex.Lock()
+	defer fake.revokeAccessTokensMutex.Unlock()
+	fake.RevokeAccessTokensStub = stub
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokensArgsForCall(i int) (string, string) {
+	fake.revokeAccessTokensMutex.RLock()
+	defer fake.revokeAccessTokensMutex.RUnlock()
+	argsForCall := fake.revokeAccessTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokensReturns(result1 int, result2 error) {
+	fake.revokeAccessTokensMutex.Lock()
+	defer fake.revokeAccessTokensMutex.Unlock()
+	fake.RevokeAccessTokensStub = nil
+	fake.revokeAccessTokensReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenLifecycle) RevokeAccessTokensReturnsOnCall(i int, result1 int, result2 error) {
+	fake.revokeAccessTokensMutex.Lock()
+	defer fake.revokeAccessTokensMutex.Unlock()
+	fake.RevokeAccessTokensStub = nil
+	if fake.revokeAccessTokensReturnsOnCall == nil {
+		fake.revokeAccessTokensReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.revokeAccessTokensReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAccessTokenLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.removeExpiredAccessTokensMutex.RLock()
 	defer fake.removeExpiredAccessTokensMutex.RUnlock()
+	fake.revokeAccessTokenMutex.RLock()
+	defer fake.revokeAccessTokenMutex.RUnlock()
+	fake.revokeAccessTokensMutex.RLock()
+	defer fake.revokeAccessTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
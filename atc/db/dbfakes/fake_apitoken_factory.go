@@ -0,0 +1,432 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAPITokenFactory struct {
+	CreateAPITokenStub        func(string, atc.APIToken) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 string
+		arg2 atc.APIToken
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	DeleteAPITokenStub        func(string, string) (bool, error)
+	deleteAPITokenMutex       sync.RWMutex
+	deleteAPITokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetAPITokenStub        func(string) (atc.APIToken, bool, error)
+	getAPITokenMutex       sync.RWMutex
+	getAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	getAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	getAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	ListAPITokensStub        func(string) ([]atc.APIToken, error)
+	listAPITokensMutex       sync.RWMutex
+	listAPITokensArgsForCall []struct {
+		arg1 string
+	}
+	listAPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listAPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	RecordAPITokenUseStub        func(int) error
+	recordAPITokenUseMutex       sync.RWMutex
+	recordAPITokenUseArgsForCall []struct {
+		arg1 int
+	}
+	recordAPITokenUseReturns struct {
+		result1 error
+	}
+	recordAPITokenUseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPITokenFactory) CreateAPIToken(arg1 string, arg2 atc.APIToken) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 string
+		arg2 atc.APIToken
+	}{arg1, arg2})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1, arg2})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenCalls(stub func(string, atc.APIToken) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenArgsForCall(i int) (string, atc.APIToken) {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPIToken(arg1 string, arg2 string) (bool, error) {
+	fake.deleteAPITokenMutex.Lock()
+	ret, specificReturn := fake.deleteAPITokenReturnsOnCall[len(fake.deleteAPITokenArgsForCall)]
+	fake.deleteAPITokenArgsForCall = append(fake.deleteAPITokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteAPIToken", []interface{}{arg1, arg2})
+	fake.deleteAPITokenMutex.Unlock()
+	if fake.DeleteAPITokenStub != nil {
+		return fake.DeleteAPITokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPITokenCallCount() int {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	return len(fake.deleteAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPITokenCalls(stub func(string, string) (bool, error)) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPITokenArgsForCall(i int) (string, string) {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	argsForCall := fake.deleteAPITokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPITokenReturns(result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	fake.deleteAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) DeleteAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	if fake.deleteAPITokenReturnsOnCall == nil {
+		fake.deleteAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) GetAPIToken(arg1 string) (atc.APIToken, bool, error) {
+	fake.getAPITokenMutex.Lock()
+	ret, specificReturn := fake.getAPITokenReturnsOnCall[len(fake.getAPITokenArgsForCall)]
+	fake.getAPITokenArgsForCall = append(fake.getAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAPIToken", []interface{}{arg1})
+	fake.getAPITokenMutex.Unlock()
+	if fake.GetAPITokenStub != nil {
+		return fake.GetAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPITokenFactory) GetAPITokenCallCount() int {
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	return len(fake.getAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) GetAPITokenCalls(stub func(string) (atc.APIToken, bool, error)) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) GetAPITokenArgsForCall(i int) string {
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	argsForCall := fake.getAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) GetAPITokenReturns(result1 atc.APIToken, result2 bool, result3 error) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = nil
+	fake.getAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFactory) GetAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 bool, result3 error) {
+	fake.getAPITokenMutex.Lock()
+	defer fake.getAPITokenMutex.Unlock()
+	fake.GetAPITokenStub = nil
+	if fake.getAPITokenReturnsOnCall == nil {
+		fake.getAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokens(arg1 string) ([]atc.APIToken, error) {
+	fake.listAPITokensMutex.Lock()
+	ret, specificReturn := fake.listAPITokensReturnsOnCall[len(fake.listAPITokensArgsForCall)]
+	fake.listAPITokensArgsForCall = append(fake.listAPITokensArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListAPITokens", []interface{}{arg1})
+	fake.listAPITokensMutex.Unlock()
+	if fake.ListAPITokensStub != nil {
+		return fake.ListAPITokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokensCallCount() int {
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	return len(fake.listAPITokensArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokensCalls(stub func(string) ([]atc.APIToken, error)) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = stub
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokensArgsForCall(i int) string {
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	argsForCall := fake.listAPITokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	fake.listAPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) ListAPITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	if fake.listAPITokensReturnsOnCall == nil {
+		fake.listAPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listAPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUse(arg1 int) error {
+	fake.recordAPITokenUseMutex.Lock()
+	ret, specificReturn := fake.recordAPITokenUseReturnsOnCall[len(fake.recordAPITokenUseArgsForCall)]
+	fake.recordAPITokenUseArgsForCall = append(fake.recordAPITokenUseArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RecordAPITokenUse", []interface{}{arg1})
+	fake.recordAPITokenUseMutex.Unlock()
+	if fake.RecordAPITokenUseStub != nil {
+		return fake.RecordAPITokenUseStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordAPITokenUseReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUseCallCount() int {
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	return len(fake.recordAPITokenUseArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUseCalls(stub func(int) error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = stub
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUseArgsForCall(i int) int {
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	argsForCall := fake.recordAPITokenUseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUseReturns(result1 error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = nil
+	fake.recordAPITokenUseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAPITokenFactory) RecordAPITokenUseReturnsOnCall(i int, result1 error) {
+	fake.recordAPITokenUseMutex.Lock()
+	defer fake.recordAPITokenUseMutex.Unlock()
+	fake.RecordAPITokenUseStub = nil
+	if fake.recordAPITokenUseReturnsOnCall == nil {
+		fake.recordAPITokenUseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordAPITokenUseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAPITokenFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	fake.getAPITokenMutex.RLock()
+	defer fake.getAPITokenMutex.RUnlock()
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	fake.recordAPITokenUseMutex.RLock()
+	defer fake.recordAPITokenUseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPITokenFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.APITokenFactory = new(FakeAPITokenFactory)
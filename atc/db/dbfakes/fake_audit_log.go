@@ -0,0 +1,275 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAuditLog struct {
+	EventsStub        func(db.AuditEventFilter, db.Page) ([]atc.AuditEvent, db.Pagination, error)
+	eventsMutex       sync.RWMutex
+	eventsArgsForCall []struct {
+		arg1 db.AuditEventFilter
+		arg2 db.Page
+	}
+	eventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	eventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	RecordEventStub        func(atc.AuditEvent) error
+	recordEventMutex       sync.RWMutex
+	recordEventArgsForCall []struct {
+		arg1 atc.AuditEvent
+	}
+	recordEventReturns struct {
+		result1 error
+	}
+	recordEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveEventsOlderThanStub        func(time.Duration) (int, error)
+	removeEventsOlderThanMutex       sync.RWMutex
+	removeEventsOlderThanArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeEventsOlderThanReturns struct {
+		result1 int
+		result2 error
+	}
+	removeEventsOlderThanReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditLog) Events(arg1 db.AuditEventFilter, arg2 db.Page) ([]atc.AuditEvent, db.Pagination, error) {
+	fake.eventsMutex.Lock()
+	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
+	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
+		arg1 db.AuditEventFilter
+		arg2 db.Page
+	}{arg1, arg2})
+	fake.recordInvocation("Events", []interface{}{arg1, arg2})
+	fake.eventsMutex.Unlock()
+	if fake.EventsStub != nil {
+		return fake.EventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.eventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAuditLog) EventsCallCount() int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return len(fake.eventsArgsForCall)
+}
+
+func (fake *FakeAuditLog) EventsCalls(stub func(db.AuditEventFilter, db.Page) ([]atc.AuditEvent, db.Pagination, error)) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = stub
+}
+
+func (fake *FakeAuditLog) EventsArgsForCall(i int) (db.AuditEventFilter, db.Page) {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	argsForCall := fake.eventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditLog) EventsReturns(result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	fake.eventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditLog) EventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	if fake.eventsReturnsOnCall == nil {
+		fake.eventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 db.Pagination
+			result3 error
+		})
+	}
+	fake.eventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditLog) RecordEvent(arg1 atc.AuditEvent) error {
+	fake.recordEventMutex.Lock()
+	ret, specificReturn := fake.recordEventReturnsOnCall[len(fake.recordEventArgsForCall)]
+	fake.recordEventArgsForCall = append(fake.recordEventArgsForCall, struct {
+		arg1 atc.AuditEvent
+	}{arg1})
+	fake.recordInvocation("RecordEvent", []interface{}{arg1})
+	fake.recordEventMutex.Unlock()
+	if fake.RecordEventStub != nil {
+		return fake.RecordEventStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordEventReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditLog) RecordEventCallCount() int {
+	fake.recordEventMutex.RLock()
+	defer fake.recordEventMutex.RUnlock()
+	return len(fake.recordEventArgsForCall)
+}
+
+func (fake *FakeAuditLog) RecordEventCalls(stub func(atc.AuditEvent) error) {
+	fake.recordEventMutex.Lock()
+	defer fake.recordEventMutex.Unlock()
+	fake.RecordEventStub = stub
+}
+
+func (fake *FakeAuditLog) RecordEventArgsForCall(i int) atc.AuditEvent {
+	fake.recordEventMutex.RLock()
+	defer fake.recordEventMutex.RUnlock()
+	argsForCall := fake.recordEventArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditLog) RecordEventReturns(result1 error) {
+	fake.recordEventMutex.Lock()
+	defer fake.recordEventMutex.Unlock()
+	fake.RecordEventStub = nil
+	fake.recordEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditLog) RecordEventReturnsOnCall(i int, result1 error) {
+	fake.recordEventMutex.Lock()
+	defer fake.recordEventMutex.Unlock()
+	fake.RecordEventStub = nil
+	if fake.recordEventReturnsOnCall == nil {
+		fake.recordEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThan(arg1 time.Duration) (int, error) {
+	fake.removeEventsOlderThanMutex.Lock()
+	ret, specificReturn := fake.removeEventsOlderThanReturnsOnCall[len(fake.removeEventsOlderThanArgsForCall)]
+	fake.removeEventsOlderThanArgsForCall = append(fake.removeEventsOlderThanArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveEventsOlderThan", []interface{}{arg1})
+	fake.removeEventsOlderThanMutex.Unlock()
+	if fake.RemoveEventsOlderThanStub != nil {
+		return fake.RemoveEventsOlderThanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeEventsOlderThanReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThanCallCount() int {
+	fake.removeEventsOlderThanMutex.RLock()
+	defer fake.removeEventsOlderThanMutex.RUnlock()
+	return len(fake.removeEventsOlderThanArgsForCall)
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThanCalls(stub func(time.Duration) (int, error)) {
+	fake.removeEventsOlderThanMutex.Lock()
+	defer fake.removeEventsOlderThanMutex.Unlock()
+	fake.RemoveEventsOlderThanStub = stub
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThanArgsForCall(i int) time.Duration {
+	fake.removeEventsOlderThanMutex.RLock()
+	defer fake.removeEventsOlderThanMutex.RUnlock()
+	argsForCall := fake.removeEventsOlderThanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThanReturns(result1 int, result2 error) {
+	fake.removeEventsOlderThanMutex.Lock()
+	defer fake.removeEventsOlderThanMutex.Unlock()
+	fake.RemoveEventsOlderThanStub = nil
+	fake.removeEventsOlderThanReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) RemoveEventsOlderThanReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeEventsOlderThanMutex.Lock()
+	defer fake.removeEventsOlderThanMutex.Unlock()
+	fake.RemoveEventsOlderThanStub = nil
+	if fake.removeEventsOlderThanReturnsOnCall == nil {
+		fake.removeEventsOlderThanReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeEventsOlderThanReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	fake.recordEventMutex.RLock()
+	defer fake.recordEventMutex.RUnlock()
+	fake.removeEventsOlderThanMutex.RLock()
+	defer fake.removeEventsOlderThanMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditLog) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditLog = new(FakeAuditLog)
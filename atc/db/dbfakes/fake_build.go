@@ -74,6 +74,32 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalsStub        func() ([]atc.BuildApproval, error)
+	approvalsMutex       sync.RWMutex
+	approvalsArgsForCall []struct {
+	}
+	approvalsReturns struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}
+	approvalsReturnsOnCall map[int]struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}
+	ApproveStub        func(int, string) (bool, error)
+	approveMutex       sync.RWMutex
+	approveArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	approveReturns struct {
+		result1 bool
+		result2 error
+	}
+	approveReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -99,6 +125,26 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	AwaitingApprovalStub        func() bool
+	awaitingApprovalMutex       sync.RWMutex
+	awaitingApprovalArgsForCall []struct {
+	}
+	awaitingApprovalReturns struct {
+		result1 bool
+	}
+	awaitingApprovalReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	CreatedByStub        func() *string
+	createdByMutex       sync.RWMutex
+	createdByArgsForCall []struct {
+	}
+	createdByReturns struct {
+		result1 *string
+	}
+	createdByReturnsOnCall map[int]struct {
+		result1 *string
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -308,6 +354,16 @@ type FakeBuild struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PendingReasonStub        func() string
+	pendingReasonMutex       sync.RWMutex
+	pendingReasonArgsForCall []struct {
+	}
+	pendingReasonReturns struct {
+		result1 string
+	}
+	pendingReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -396,6 +452,16 @@ type FakeBuild struct {
 	publicPlanReturnsOnCall map[int]struct {
 		result1 *json.RawMessage
 	}
+	QueuePositionStub        func() int
+	queuePositionMutex       sync.RWMutex
+	queuePositionArgsForCall []struct {
+	}
+	queuePositionReturns struct {
+		result1 int
+	}
+	queuePositionReturnsOnCall map[int]struct {
+		result1 int
+	}
 	ReapTimeStub        func() time.Time
 	reapTimeMutex       sync.RWMutex
 	reapTimeArgsForCall []struct {
@@ -418,6 +484,21 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RequestApprovalStub        func(atc.PlanID, string, string) (atc.BuildApproval, error)
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}
+	requestApprovalReturns struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 atc.BuildApproval
+		result2 error
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -584,6 +665,17 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetPendingReasonStub        func(string) error
+	setPendingReasonMutex       sync.RWMutex
+	setPendingReasonArgsForCall []struct {
+		arg1 string
+	}
+	setPendingReasonReturns struct {
+		result1 error
+	}
+	setPendingReasonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SpanContextStub        func() propagation.HTTPSupplier
 	spanContextMutex       sync.RWMutex
 	spanContextArgsForCall []struct {
@@ -925,6 +1017,125 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approvals() ([]atc.BuildApproval, error) {
+	fake.approvalsMutex.Lock()
+	ret, specificReturn := fake.approvalsReturnsOnCall[len(fake.approvalsArgsForCall)]
+	fake.approvalsArgsForCall = append(fake.approvalsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Approvals", []interface{}{})
+	fake.approvalsMutex.Unlock()
+	if fake.ApprovalsStub != nil {
+		return fake.ApprovalsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approvalsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalsCallCount() int {
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	return len(fake.approvalsArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalsCalls(stub func() ([]atc.BuildApproval, error)) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = stub
+}
+
+func (fake *FakeBuild) ApprovalsReturns(result1 []atc.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	fake.approvalsReturns = struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalsReturnsOnCall(i int, result1 []atc.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	if fake.approvalsReturnsOnCall == nil {
+		fake.approvalsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildApproval
+			result2 error
+		})
+	}
+	fake.approvalsReturnsOnCall[i] = struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) Approve(arg1 int, arg2 string) (bool, error) {
+	fake.approveMutex.Lock()
+	ret, specificReturn := fake.approveReturnsOnCall[len(fake.approveArgsForCall)]
+	fake.approveArgsForCall = append(fake.approveArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Approve", []interface{}{arg1, arg2})
+	fake.approveMutex.Unlock()
+	if fake.ApproveStub != nil {
+		return fake.ApproveStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approveReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApproveCallCount() int {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	return len(fake.approveArgsForCall)
+}
+
+func (fake *FakeBuild) ApproveCalls(stub func(int, string) (bool, error)) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = stub
+}
+
+func (fake *FakeBuild) ApproveArgsForCall(i int) (int, string) {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	argsForCall := fake.approveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) ApproveReturns(result1 bool, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	fake.approveReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApproveReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	if fake.approveReturnsOnCall == nil {
+		fake.approveReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.approveReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -1043,6 +1254,110 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AwaitingApproval() bool {
+	fake.awaitingApprovalMutex.Lock()
+	ret, specificReturn := fake.awaitingApprovalReturnsOnCall[len(fake.awaitingApprovalArgsForCall)]
+	fake.awaitingApprovalArgsForCall = append(fake.awaitingApprovalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AwaitingApproval", []interface{}{})
+	fake.awaitingApprovalMutex.Unlock()
+	if fake.AwaitingApprovalStub != nil {
+		return fake.AwaitingApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.awaitingApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AwaitingApprovalCallCount() int {
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	return len(fake.awaitingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) AwaitingApprovalCalls(stub func() bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = stub
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturns(result1 bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	fake.awaitingApprovalReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturnsOnCall(i int, result1 bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	if fake.awaitingApprovalReturnsOnCall == nil {
+		fake.awaitingApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.awaitingApprovalReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedBy() *string {
+	fake.createdByMutex.Lock()
+	ret, specificReturn := fake.createdByReturnsOnCall[len(fake.createdByArgsForCall)]
+	fake.createdByArgsForCall = append(fake.createdByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatedBy", []interface{}{})
+	fake.createdByMutex.Unlock()
+	if fake.CreatedByStub != nil {
+		return fake.CreatedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createdByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreatedByCallCount() int {
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	return len(fake.createdByArgsForCall)
+}
+
+func (fake *FakeBuild) CreatedByCalls(stub func() *string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = stub
+}
+
+func (fake *FakeBuild) CreatedByReturns(result1 *string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	fake.createdByReturns = struct {
+		result1 *string
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedByReturnsOnCall(i int, result1 *string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	if fake.createdByReturnsOnCall == nil {
+		fake.createdByReturnsOnCall = make(map[int]struct {
+			result1 *string
+		})
+	}
+	fake.createdByReturnsOnCall[i] = struct {
+		result1 *string
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -2116,6 +2431,58 @@ func (fake *FakeBuild) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PendingReason() string {
+	fake.pendingReasonMutex.Lock()
+	ret, specificReturn := fake.pendingReasonReturnsOnCall[len(fake.pendingReasonArgsForCall)]
+	fake.pendingReasonArgsForCall = append(fake.pendingReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PendingReason", []interface{}{})
+	fake.pendingReasonMutex.Unlock()
+	if fake.PendingReasonStub != nil {
+		return fake.PendingReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pendingReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PendingReasonCallCount() int {
+	fake.pendingReasonMutex.RLock()
+	defer fake.pendingReasonMutex.RUnlock()
+	return len(fake.pendingReasonArgsForCall)
+}
+
+func (fake *FakeBuild) PendingReasonCalls(stub func() string) {
+	fake.pendingReasonMutex.Lock()
+	defer fake.pendingReasonMutex.Unlock()
+	fake.PendingReasonStub = stub
+}
+
+func (fake *FakeBuild) PendingReasonReturns(result1 string) {
+	fake.pendingReasonMutex.Lock()
+	defer fake.pendingReasonMutex.Unlock()
+	fake.PendingReasonStub = nil
+	fake.pendingReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) PendingReasonReturnsOnCall(i int, result1 string) {
+	fake.pendingReasonMutex.Lock()
+	defer fake.pendingReasonMutex.Unlock()
+	fake.PendingReasonStub = nil
+	if fake.pendingReasonReturnsOnCall == nil {
+		fake.pendingReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pendingReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2544,6 +2911,58 @@ func (fake *FakeBuild) PublicPlanReturnsOnCall(i int, result1 *json.RawMessage)
 	}{result1}
 }
 
+func (fake *FakeBuild) QueuePosition() int {
+	fake.queuePositionMutex.Lock()
+	ret, specificReturn := fake.queuePositionReturnsOnCall[len(fake.queuePositionArgsForCall)]
+	fake.queuePositionArgsForCall = append(fake.queuePositionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("QueuePosition", []interface{}{})
+	fake.queuePositionMutex.Unlock()
+	if fake.QueuePositionStub != nil {
+		return fake.QueuePositionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.queuePositionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) QueuePositionCallCount() int {
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
+	return len(fake.queuePositionArgsForCall)
+}
+
+func (fake *FakeBuild) QueuePositionCalls(stub func() int) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = stub
+}
+
+func (fake *FakeBuild) QueuePositionReturns(result1 int) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = nil
+	fake.queuePositionReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) QueuePositionReturnsOnCall(i int, result1 int) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = nil
+	if fake.queuePositionReturnsOnCall == nil {
+		fake.queuePositionReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.queuePositionReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) ReapTime() time.Time {
 	fake.reapTimeMutex.Lock()
 	ret, specificReturn := fake.reapTimeReturnsOnCall[len(fake.reapTimeArgsForCall)]
@@ -2651,6 +3070,71 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RequestApproval(arg1 atc.PlanID, arg2 string, arg3 string) (atc.BuildApproval, error) {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RequestApproval", []interface{}{arg1, arg2, arg3})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalCalls(stub func(atc.PlanID, string, string) (atc.BuildApproval, error)) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) (atc.PlanID, string, string) {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	argsForCall := fake.requestApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 atc.BuildApproval, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 atc.BuildApproval, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildApproval
+			result2 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -3452,6 +3936,66 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetPendingReason(arg1 string) error {
+	fake.setPendingReasonMutex.Lock()
+	ret, specificReturn := fake.setPendingReasonReturnsOnCall[len(fake.setPendingReasonArgsForCall)]
+	fake.setPendingReasonArgsForCall = append(fake.setPendingReasonArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetPendingReason", []interface{}{arg1})
+	fake.setPendingReasonMutex.Unlock()
+	if fake.SetPendingReasonStub != nil {
+		return fake.SetPendingReasonStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPendingReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetPendingReasonCallCount() int {
+	fake.setPendingReasonMutex.RLock()
+	defer fake.setPendingReasonMutex.RUnlock()
+	return len(fake.setPendingReasonArgsForCall)
+}
+
+func (fake *FakeBuild) SetPendingReasonCalls(stub func(string) error) {
+	fake.setPendingReasonMutex.Lock()
+	defer fake.setPendingReasonMutex.Unlock()
+	fake.SetPendingReasonStub = stub
+}
+
+func (fake *FakeBuild) SetPendingReasonArgsForCall(i int) string {
+	fake.setPendingReasonMutex.RLock()
+	defer fake.setPendingReasonMutex.RUnlock()
+	argsForCall := fake.setPendingReasonArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetPendingReasonReturns(result1 error) {
+	fake.setPendingReasonMutex.Lock()
+	defer fake.setPendingReasonMutex.Unlock()
+	fake.SetPendingReasonStub = nil
+	fake.setPendingReasonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetPendingReasonReturnsOnCall(i int, result1 error) {
+	fake.setPendingReasonMutex.Lock()
+	defer fake.setPendingReasonMutex.Unlock()
+	fake.SetPendingReasonStub = nil
+	if fake.setPendingReasonReturnsOnCall == nil {
+		fake.setPendingReasonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPendingReasonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SpanContext() propagation.HTTPSupplier {
 	fake.spanContextMutex.Lock()
 	ret, specificReturn := fake.spanContextReturnsOnCall[len(fake.spanContextArgsForCall)]
@@ -3963,10 +4507,18 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -4007,6 +4559,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.pendingReasonMutex.RLock()
+	defer fake.pendingReasonMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -4023,10 +4577,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.privatePlanMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -4055,6 +4613,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setPendingReasonMutex.RLock()
+	defer fake.setPendingReasonMutex.RUnlock()
 	fake.spanContextMutex.RLock()
 	defer fake.spanContextMutex.RUnlock()
 	fake.startMutex.RLock()
@@ -0,0 +1,248 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeBuildQueue struct {
+	PendingBuildsStub        func() ([]db.QueuedBuild, error)
+	pendingBuildsMutex       sync.RWMutex
+	pendingBuildsArgsForCall []struct {
+	}
+	pendingBuildsReturns struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}
+	pendingBuildsReturnsOnCall map[int]struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}
+	RunningBuildsStub        func() (map[string]int, error)
+	runningBuildsMutex       sync.RWMutex
+	runningBuildsArgsForCall []struct {
+	}
+	runningBuildsReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	runningBuildsReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
+	SavePositionsStub        func(map[int]int) error
+	savePositionsMutex       sync.RWMutex
+	savePositionsArgsForCall []struct {
+		arg1 map[int]int
+	}
+	savePositionsReturns struct {
+		result1 error
+	}
+	savePositionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildQueue) PendingBuilds() ([]db.QueuedBuild, error) {
+	fake.pendingBuildsMutex.Lock()
+	ret, specificReturn := fake.pendingBuildsReturnsOnCall[len(fake.pendingBuildsArgsForCall)]
+	fake.pendingBuildsArgsForCall = append(fake.pendingBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PendingBuilds", []interface{}{})
+	fake.pendingBuildsMutex.Unlock()
+	if fake.PendingBuildsStub != nil {
+		return fake.PendingBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildQueue) PendingBuildsCallCount() int {
+	fake.pendingBuildsMutex.RLock()
+	defer fake.pendingBuildsMutex.RUnlock()
+	return len(fake.pendingBuildsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) PendingBuildsCalls(stub func() ([]db.QueuedBuild, error)) {
+	fake.pendingBuildsMutex.Lock()
+	defer fake.pendingBuildsMutex.Unlock()
+	fake.PendingBuildsStub = stub
+}
+
+func (fake *FakeBuildQueue) PendingBuildsReturns(result1 []db.QueuedBuild, result2 error) {
+	fake.pendingBuildsMutex.Lock()
+	defer fake.pendingBuildsMutex.Unlock()
+	fake.PendingBuildsStub = nil
+	fake.pendingBuildsReturns = struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) PendingBuildsReturnsOnCall(i int, result1 []db.QueuedBuild, result2 error) {
+	fake.pendingBuildsMutex.Lock()
+	defer fake.pendingBuildsMutex.Unlock()
+	fake.PendingBuildsStub = nil
+	if fake.pendingBuildsReturnsOnCall == nil {
+		fake.pendingBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.QueuedBuild
+			result2 error
+		})
+	}
+	fake.pendingBuildsReturnsOnCall[i] = struct {
+		result1 []db.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) RunningBuilds() (map[string]int, error) {
+	fake.runningBuildsMutex.Lock()
+	ret, specificReturn := fake.runningBuildsReturnsOnCall[len(fake.runningBuildsArgsForCall)]
+	fake.runningBuildsArgsForCall = append(fake.runningBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RunningBuilds", []interface{}{})
+	fake.runningBuildsMutex.Unlock()
+	if fake.RunningBuildsStub != nil {
+		return fake.RunningBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runningBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildQueue) RunningBuildsCallCount() int {
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
+	return len(fake.runningBuildsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) RunningBuildsCalls(stub func() (map[string]int, error)) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = stub
+}
+
+func (fake *FakeBuildQueue) RunningBuildsReturns(result1 map[string]int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	fake.runningBuildsReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) RunningBuildsReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	if fake.runningBuildsReturnsOnCall == nil {
+		fake.runningBuildsReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.runningBuildsReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) SavePositions(arg1 map[int]int) error {
+	fake.savePositionsMutex.Lock()
+	ret, specificReturn := fake.savePositionsReturnsOnCall[len(fake.savePositionsArgsForCall)]
+	fake.savePositionsArgsForCall = append(fake.savePositionsArgsForCall, struct {
+		arg1 map[int]int
+	}{arg1})
+	fake.recordInvocation("SavePositions", []interface{}{arg1})
+	fake.savePositionsMutex.Unlock()
+	if fake.SavePositionsStub != nil {
+		return fake.SavePositionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.savePositionsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildQueue) SavePositionsCallCount() int {
+	fake.savePositionsMutex.RLock()
+	defer fake.savePositionsMutex.RUnlock()
+	return len(fake.savePositionsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) SavePositionsCalls(stub func(map[int]int) error) {
+	fake.savePositionsMutex.Lock()
+	defer fake.savePositionsMutex.Unlock()
+	fake.SavePositionsStub = stub
+}
+
+func (fake *FakeBuildQueue) SavePositionsArgsForCall(i int) map[int]int {
+	fake.savePositionsMutex.RLock()
+	defer fake.savePositionsMutex.RUnlock()
+	argsForCall := fake.savePositionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildQueue) SavePositionsReturns(result1 error) {
+	fake.savePositionsMutex.Lock()
+	defer fake.savePositionsMutex.Unlock()
+	fake.SavePositionsStub = nil
+	fake.savePositionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildQueue) SavePositionsReturnsOnCall(i int, result1 error) {
+	fake.savePositionsMutex.Lock()
+	defer fake.savePositionsMutex.Unlock()
+	fake.SavePositionsStub = nil
+	if fake.savePositionsReturnsOnCall == nil {
+		fake.savePositionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.savePositionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pendingBuildsMutex.RLock()
+	defer fake.pendingBuildsMutex.RUnlock()
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
+	fake.savePositionsMutex.RLock()
+	defer fake.savePositionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.BuildQueue = new(FakeBuildQueue)
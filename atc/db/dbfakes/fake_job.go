@@ -111,9 +111,10 @@ type FakeJob struct {
 		result1 atc.JobConfig
 		result2 error
 	}
-	CreateBuildStub        func() (db.Build, error)
+	CreateBuildStub        func(string) (db.Build, error)
 	createBuildMutex       sync.RWMutex
 	createBuildArgsForCall []struct {
+		arg1 string
 	}
 	createBuildReturns struct {
 		result1 db.Build
@@ -376,10 +377,11 @@ type FakeJob struct {
 	requestScheduleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RerunBuildStub        func(db.Build) (db.Build, error)
+	RerunBuildStub        func(db.Build, string) (db.Build, error)
 	rerunBuildMutex       sync.RWMutex
 	rerunBuildArgsForCall []struct {
 		arg1 db.Build
+		arg2 string
 	}
 	rerunBuildReturns struct {
 		result1 db.Build
@@ -939,15 +941,16 @@ func (fake *FakeJob) ConfigReturnsOnCall(i int, result1 atc.JobConfig, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeJob) CreateBuild() (db.Build, error) {
+func (fake *FakeJob) CreateBuild(arg1 string) (db.Build, error) {
 	fake.createBuildMutex.Lock()
 	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
 	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
-	}{})
-	fake.recordInvocation("CreateBuild", []interface{}{})
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateBuild", []interface{}{arg1})
 	fake.createBuildMutex.Unlock()
 	if fake.CreateBuildStub != nil {
-		return fake.CreateBuildStub()
+		return fake.CreateBuildStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -962,12 +965,19 @@ func (fake *FakeJob) CreateBuildCallCount() int {
 	return len(fake.createBuildArgsForCall)
 }
 
-func (fake *FakeJob) CreateBuildCalls(stub func() (db.Build, error)) {
+func (fake *FakeJob) CreateBuildCalls(stub func(string) (db.Build, error)) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
 	fake.CreateBuildStub = stub
 }
 
+func (fake *FakeJob) CreateBuildArgsForCall(i int) string {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	argsForCall := fake.createBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeJob) CreateBuildReturns(result1 db.Build, result2 error) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
@@ -2231,16 +2241,17 @@ func (fake *FakeJob) RequestScheduleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+func (fake *FakeJob) RerunBuild(arg1 db.Build, arg2 string) (db.Build, error) {
 	fake.rerunBuildMutex.Lock()
 	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
 	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
 		arg1 db.Build
-	}{arg1})
-	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1, arg2})
 	fake.rerunBuildMutex.Unlock()
 	if fake.RerunBuildStub != nil {
-		return fake.RerunBuildStub(arg1)
+		return fake.RerunBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2255,17 +2266,17 @@ func (fake *FakeJob) RerunBuildCallCount() int {
 	return len(fake.rerunBuildArgsForCall)
 }
 
-func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build, string) (db.Build, error)) {
 	fake.rerunBuildMutex.Lock()
 	defer fake.rerunBuildMutex.Unlock()
 	fake.RerunBuildStub = stub
 }
 
-func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+func (fake *FakeJob) RerunBuildArgsForCall(i int) (db.Build, string) {
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
 	argsForCall := fake.rerunBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
@@ -0,0 +1,523 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeLocalUserFactory struct {
+	CreateLocalUserStub        func(atc.LocalUser, []byte) (atc.LocalUser, error)
+	createLocalUserMutex       sync.RWMutex
+	createLocalUserArgsForCall []struct {
+		arg1 atc.LocalUser
+		arg2 []byte
+	}
+	createLocalUserReturns struct {
+		result1 atc.LocalUser
+		result2 error
+	}
+	createLocalUserReturnsOnCall map[int]struct {
+		result1 atc.LocalUser
+		result2 error
+	}
+	FindLocalUserStub        func(string) (atc.LocalUser, bool, error)
+	findLocalUserMutex       sync.RWMutex
+	findLocalUserArgsForCall []struct {
+		arg1 string
+	}
+	findLocalUserReturns struct {
+		result1 atc.LocalUser
+		result2 bool
+		result3 error
+	}
+	findLocalUserReturnsOnCall map[int]struct {
+		result1 atc.LocalUser
+		result2 bool
+		result3 error
+	}
+	LocalUserPasswordHashStub        func(string) ([]byte, bool, error)
+	localUserPasswordHashMutex       sync.RWMutex
+	localUserPasswordHashArgsForCall []struct {
+		arg1 string
+	}
+	localUserPasswordHashReturns struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	localUserPasswordHashReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}
+	LocalUsersStub        func() ([]atc.LocalUser, error)
+	localUsersMutex       sync.RWMutex
+	localUsersArgsForCall []struct {
+	}
+	localUsersReturns struct {
+		result1 []atc.LocalUser
+		result2 error
+	}
+	localUsersReturnsOnCall map[int]struct {
+		result1 []atc.LocalUser
+		result2 error
+	}
+	SetLocalUserDisabledStub        func(string, bool) (bool, error)
+	setLocalUserDisabledMutex       sync.RWMutex
+	setLocalUserDisabledArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	setLocalUserDisabledReturns struct {
+		result1 bool
+		result2 error
+	}
+	setLocalUserDisabledReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SetLocalUserPasswordStub        func(string, []byte) (bool, error)
+	setLocalUserPasswordMutex       sync.RWMutex
+	setLocalUserPasswordArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	setLocalUserPasswordReturns struct {
+		result1 bool
+		result2 error
+	}
+	setLocalUserPasswordReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUser(arg1 atc.LocalUser, arg2 []byte) (atc.LocalUser, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.createLocalUserMutex.Lock()
+	ret, specificReturn := fake.createLocalUserReturnsOnCall[len(fake.createLocalUserArgsForCall)]
+	fake.createLocalUserArgsForCall = append(fake.createLocalUserArgsForCall, struct {
+		arg1 atc.LocalUser
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("CreateLocalUser", []interface{}{arg1, arg2Copy})
+	fake.createLocalUserMutex.Unlock()
+	if fake.CreateLocalUserStub != nil {
+		return fake.CreateLocalUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createLocalUserReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUserCallCount() int {
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	return len(fake.createLocalUserArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUserCalls(stub func(atc.LocalUser, []byte) (atc.LocalUser, error)) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = stub
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUserArgsForCall(i int) (atc.LocalUser, []byte) {
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	argsForCall := fake.createLocalUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUserReturns(result1 atc.LocalUser, result2 error) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = nil
+	fake.createLocalUserReturns = struct {
+		result1 atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) CreateLocalUserReturnsOnCall(i int, result1 atc.LocalUser, result2 error) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = nil
+	if fake.createLocalUserReturnsOnCall == nil {
+		fake.createLocalUserReturnsOnCall = make(map[int]struct {
+			result1 atc.LocalUser
+			result2 error
+		})
+	}
+	fake.createLocalUserReturnsOnCall[i] = struct {
+		result1 atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUser(arg1 string) (atc.LocalUser, bool, error) {
+	fake.findLocalUserMutex.Lock()
+	ret, specificReturn := fake.findLocalUserReturnsOnCall[len(fake.findLocalUserArgsForCall)]
+	fake.findLocalUserArgsForCall = append(fake.findLocalUserArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FindLocalUser", []interface{}{arg1})
+	fake.findLocalUserMutex.Unlock()
+	if fake.FindLocalUserStub != nil {
+		return fake.FindLocalUserStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findLocalUserReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUserCallCount() int {
+	fake.findLocalUserMutex.RLock()
+	defer fake.findLocalUserMutex.RUnlock()
+	return len(fake.findLocalUserArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUserCalls(stub func(string) (atc.LocalUser, bool, error)) {
+	fake.findLocalUserMutex.Lock()
+	defer fake.findLocalUserMutex.Unlock()
+	fake.FindLocalUserStub = stub
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUserArgsForCall(i int) string {
+	fake.findLocalUserMutex.RLock()
+	defer fake.findLocalUserMutex.RUnlock()
+	argsForCall := fake.findLocalUserArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUserReturns(result1 atc.LocalUser, result2 bool, result3 error) {
+	fake.findLocalUserMutex.Lock()
+	defer fake.findLocalUserMutex.Unlock()
+	fake.FindLocalUserStub = nil
+	fake.findLocalUserReturns = struct {
+		result1 atc.LocalUser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLocalUserFactory) FindLocalUserReturnsOnCall(i int, result1 atc.LocalUser, result2 bool, result3 error) {
+	fake.findLocalUserMutex.Lock()
+	defer fake.findLocalUserMutex.Unlock()
+	fake.FindLocalUserStub = nil
+	if fake.findLocalUserReturnsOnCall == nil {
+		fake.findLocalUserReturnsOnCall = make(map[int]struct {
+			result1 atc.LocalUser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findLocalUserReturnsOnCall[i] = struct {
+		result1 atc.LocalUser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHash(arg1 string) ([]byte, bool, error) {
+	fake.localUserPasswordHashMutex.Lock()
+	ret, specificReturn := fake.localUserPasswordHashReturnsOnCall[len(fake.localUserPasswordHashArgsForCall)]
+	fake.localUserPasswordHashArgsForCall = append(fake.localUserPasswordHashArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("LocalUserPasswordHash", []interface{}{arg1})
+	fake.localUserPasswordHashMutex.Unlock()
+	if fake.LocalUserPasswordHashStub != nil {
+		return fake.LocalUserPasswordHashStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.localUserPasswordHashReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHashCallCount() int {
+	fake.localUserPasswordHashMutex.RLock()
+	defer fake.localUserPasswordHashMutex.RUnlock()
+	return len(fake.localUserPasswordHashArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHashCalls(stub func(string) ([]byte, bool, error)) {
+	fake.localUserPasswordHashMutex.Lock()
+	defer fake.localUserPasswordHashMutex.Unlock()
+	fake.LocalUserPasswordHashStub = stub
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHashArgsForCall(i int) string {
+	fake.localUserPasswordHashMutex.RLock()
+	defer fake.localUserPasswordHashMutex.RUnlock()
+	argsForCall := fake.localUserPasswordHashArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHashReturns(result1 []byte, result2 bool, result3 error) {
+	fake.localUserPasswordHashMutex.Lock()
+	defer fake.localUserPasswordHashMutex.Unlock()
+	fake.LocalUserPasswordHashStub = nil
+	fake.localUserPasswordHashReturns = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLocalUserFactory) LocalUserPasswordHashReturnsOnCall(i int, result1 []byte, result2 bool, result3 error) {
+	fake.localUserPasswordHashMutex.Lock()
+	defer fake.localUserPasswordHashMutex.Unlock()
+	fake.LocalUserPasswordHashStub = nil
+	if fake.localUserPasswordHashReturnsOnCall == nil {
+		fake.localUserPasswordHashReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 bool
+			result3 error
+		})
+	}
+	fake.localUserPasswordHashReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLocalUserFactory) LocalUsers() ([]atc.LocalUser, error) {
+	fake.localUsersMutex.Lock()
+	ret, specificReturn := fake.localUsersReturnsOnCall[len(fake.localUsersArgsForCall)]
+	fake.localUsersArgsForCall = append(fake.localUsersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LocalUsers", []interface{}{})
+	fake.localUsersMutex.Unlock()
+	if fake.LocalUsersStub != nil {
+		return fake.LocalUsersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.localUsersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocalUserFactory) LocalUsersCallCount() int {
+	fake.localUsersMutex.RLock()
+	defer fake.localUsersMutex.RUnlock()
+	return len(fake.localUsersArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) LocalUsersCalls(stub func() ([]atc.LocalUser, error)) {
+	fake.localUsersMutex.Lock()
+	defer fake.localUsersMutex.Unlock()
+	fake.LocalUsersStub = stub
+}
+
+func (fake *FakeLocalUserFactory) LocalUsersReturns(result1 []atc.LocalUser, result2 error) {
+	fake.localUsersMutex.Lock()
+	defer fake.localUsersMutex.Unlock()
+	fake.LocalUsersStub = nil
+	fake.localUsersReturns = struct {
+		result1 []atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) LocalUsersReturnsOnCall(i int, result1 []atc.LocalUser, result2 error) {
+	fake.localUsersMutex.Lock()
+	defer fake.localUsersMutex.Unlock()
+	fake.LocalUsersStub = nil
+	if fake.localUsersReturnsOnCall == nil {
+		fake.localUsersReturnsOnCall = make(map[int]struct {
+			result1 []atc.LocalUser
+			result2 error
+		})
+	}
+	fake.localUsersReturnsOnCall[i] = struct {
+		result1 []atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabled(arg1 string, arg2 bool) (bool, error) {
+	fake.setLocalUserDisabledMutex.Lock()
+	ret, specificReturn := fake.setLocalUserDisabledReturnsOnCall[len(fake.setLocalUserDisabledArgsForCall)]
+	fake.setLocalUserDisabledArgsForCall = append(fake.setLocalUserDisabledArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("SetLocalUserDisabled", []interface{}{arg1, arg2})
+	fake.setLocalUserDisabledMutex.Unlock()
+	if fake.SetLocalUserDisabledStub != nil {
+		return fake.SetLocalUserDisabledStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setLocalUserDisabledReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabledCallCount() int {
+	fake.setLocalUserDisabledMutex.RLock()
+	defer fake.setLocalUserDisabledMutex.RUnlock()
+	return len(fake.setLocalUserDisabledArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabledCalls(stub func(string, bool) (bool, error)) {
+	fake.setLocalUserDisabledMutex.Lock()
+	defer fake.setLocalUserDisabledMutex.Unlock()
+	fake.SetLocalUserDisabledStub = stub
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabledArgsForCall(i int) (string, bool) {
+	fake.setLocalUserDisabledMutex.RLock()
+	defer fake.setLocalUserDisabledMutex.RUnlock()
+	argsForCall := fake.setLocalUserDisabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabledReturns(result1 bool, result2 error) {
+	fake.setLocalUserDisabledMutex.Lock()
+	defer fake.setLocalUserDisabledMutex.Unlock()
+	fake.SetLocalUserDisabledStub = nil
+	fake.setLocalUserDisabledReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserDisabledReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setLocalUserDisabledMutex.Lock()
+	defer fake.setLocalUserDisabledMutex.Unlock()
+	fake.SetLocalUserDisabledStub = nil
+	if fake.setLocalUserDisabledReturnsOnCall == nil {
+		fake.setLocalUserDisabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setLocalUserDisabledReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPassword(arg1 string, arg2 []byte) (bool, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.setLocalUserPasswordMutex.Lock()
+	ret, specificReturn := fake.setLocalUserPasswordReturnsOnCall[len(fake.setLocalUserPasswordArgsForCall)]
+	fake.setLocalUserPasswordArgsForCall = append(fake.setLocalUserPasswordArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SetLocalUserPassword", []interface{}{arg1, arg2Copy})
+	fake.setLocalUserPasswordMutex.Unlock()
+	if fake.SetLocalUserPasswordStub != nil {
+		return fake.SetLocalUserPasswordStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setLocalUserPasswordReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPasswordCallCount() int {
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
+	return len(fake.setLocalUserPasswordArgsForCall)
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPasswordCalls(stub func(string, []byte) (bool, error)) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = stub
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPasswordArgsForCall(i int) (string, []byte) {
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
+	argsForCall := fake.setLocalUserPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPasswordReturns(result1 bool, result2 error) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = nil
+	fake.setLocalUserPasswordReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) SetLocalUserPasswordReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = nil
+	if fake.setLocalUserPasswordReturnsOnCall == nil {
+		fake.setLocalUserPasswordReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setLocalUserPasswordReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLocalUserFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	fake.findLocalUserMutex.RLock()
+	defer fake.findLocalUserMutex.RUnlock()
+	fake.localUserPasswordHashMutex.RLock()
+	defer fake.localUserPasswordHashMutex.RUnlock()
+	fake.localUsersMutex.RLock()
+	defer fake.localUsersMutex.RUnlock()
+	fake.setLocalUserDisabledMutex.RLock()
+	defer fake.setLocalUserDisabledMutex.RUnlock()
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLocalUserFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.LocalUserFactory = new(FakeLocalUserFactory)
@@ -33,6 +33,18 @@ type FakePipeline struct {
 	archivedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	BlockedBuildsStub        func() ([]db.Build, error)
+	blockedBuildsMutex       sync.RWMutex
+	blockedBuildsArgsForCall []struct {
+	}
+	blockedBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	blockedBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -122,10 +134,11 @@ type FakePipeline struct {
 		result1 db.Build
 		result2 error
 	}
-	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
+	CreateStartedBuildStub        func(atc.Plan, string) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
 		arg1 atc.Plan
+		arg2 string
 	}
 	createStartedBuildReturns struct {
 		result1 db.Build
@@ -158,6 +171,19 @@ type FakePipeline struct {
 	deleteBuildEventsByBuildIDsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeliveryMetricsStub        func(time.Time) ([]atc.JobDeliveryMetrics, error)
+	deliveryMetricsMutex       sync.RWMutex
+	deliveryMetricsArgsForCall []struct {
+		arg1 time.Time
+	}
+	deliveryMetricsReturns struct {
+		result1 []atc.JobDeliveryMetrics
+		result2 error
+	}
+	deliveryMetricsReturnsOnCall map[int]struct {
+		result1 []atc.JobDeliveryMetrics
+		result2 error
+	}
 	DestroyStub        func() error
 	destroyMutex       sync.RWMutex
 	destroyArgsForCall []struct {
@@ -662,6 +688,61 @@ func (fake *FakePipeline) ArchivedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePipeline) BlockedBuilds() ([]db.Build, error) {
+	fake.blockedBuildsMutex.Lock()
+	ret, specificReturn := fake.blockedBuildsReturnsOnCall[len(fake.blockedBuildsArgsForCall)]
+	fake.blockedBuildsArgsForCall = append(fake.blockedBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BlockedBuilds", []interface{}{})
+	fake.blockedBuildsMutex.Unlock()
+	if fake.BlockedBuildsStub != nil {
+		return fake.BlockedBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.blockedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) BlockedBuildsCallCount() int {
+	fake.blockedBuildsMutex.RLock()
+	defer fake.blockedBuildsMutex.RUnlock()
+	return len(fake.blockedBuildsArgsForCall)
+}
+
+func (fake *FakePipeline) BlockedBuildsCalls(stub func() ([]db.Build, error)) {
+	fake.blockedBuildsMutex.Lock()
+	defer fake.blockedBuildsMutex.Unlock()
+	fake.BlockedBuildsStub = stub
+}
+
+func (fake *FakePipeline) BlockedBuildsReturns(result1 []db.Build, result2 error) {
+	fake.blockedBuildsMutex.Lock()
+	defer fake.blockedBuildsMutex.Unlock()
+	fake.BlockedBuildsStub = nil
+	fake.blockedBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) BlockedBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.blockedBuildsMutex.Lock()
+	defer fake.blockedBuildsMutex.Unlock()
+	fake.BlockedBuildsStub = nil
+	if fake.blockedBuildsReturnsOnCall == nil {
+		fake.blockedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.blockedBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -1074,16 +1155,17 @@ func (fake *FakePipeline) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build
 	}{result1, result2}
 }
 
-func (fake *FakePipeline) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
+func (fake *FakePipeline) CreateStartedBuild(arg1 atc.Plan, arg2 string) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
 	fake.createStartedBuildArgsForCall = append(fake.createStartedBuildArgsForCall, struct {
 		arg1 atc.Plan
-	}{arg1})
-	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1, arg2})
 	fake.createStartedBuildMutex.Unlock()
 	if fake.CreateStartedBuildStub != nil {
-		return fake.CreateStartedBuildStub(arg1)
+		return fake.CreateStartedBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1098,17 +1180,17 @@ func (fake *FakePipeline) CreateStartedBuildCallCount() int {
 	return len(fake.createStartedBuildArgsForCall)
 }
 
-func (fake *FakePipeline) CreateStartedBuildCalls(stub func(atc.Plan) (db.Build, error)) {
+func (fake *FakePipeline) CreateStartedBuildCalls(stub func(atc.Plan, string) (db.Build, error)) {
 	fake.createStartedBuildMutex.Lock()
 	defer fake.createStartedBuildMutex.Unlock()
 	fake.CreateStartedBuildStub = stub
 }
 
-func (fake *FakePipeline) CreateStartedBuildArgsForCall(i int) atc.Plan {
+func (fake *FakePipeline) CreateStartedBuildArgsForCall(i int) (atc.Plan, string) {
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
 	argsForCall := fake.createStartedBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePipeline) CreateStartedBuildReturns(result1 db.Build, result2 error) {
@@ -1257,6 +1339,69 @@ func (fake *FakePipeline) DeleteBuildEventsByBuildIDsReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakePipeline) DeliveryMetrics(arg1 time.Time) ([]atc.JobDeliveryMetrics, error) {
+	fake.deliveryMetricsMutex.Lock()
+	ret, specificReturn := fake.deliveryMetricsReturnsOnCall[len(fake.deliveryMetricsArgsForCall)]
+	fake.deliveryMetricsArgsForCall = append(fake.deliveryMetricsArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("DeliveryMetrics", []interface{}{arg1})
+	fake.deliveryMetricsMutex.Unlock()
+	if fake.DeliveryMetricsStub != nil {
+		return fake.DeliveryMetricsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deliveryMetricsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) DeliveryMetricsCallCount() int {
+	fake.deliveryMetricsMutex.RLock()
+	defer fake.deliveryMetricsMutex.RUnlock()
+	return len(fake.deliveryMetricsArgsForCall)
+}
+
+func (fake *FakePipeline) DeliveryMetricsCalls(stub func(time.Time) ([]atc.JobDeliveryMetrics, error)) {
+	fake.deliveryMetricsMutex.Lock()
+	defer fake.deliveryMetricsMutex.Unlock()
+	fake.DeliveryMetricsStub = stub
+}
+
+func (fake *FakePipeline) DeliveryMetricsArgsForCall(i int) time.Time {
+	fake.deliveryMetricsMutex.RLock()
+	defer fake.deliveryMetricsMutex.RUnlock()
+	argsForCall := fake.deliveryMetricsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) DeliveryMetricsReturns(result1 []atc.JobDeliveryMetrics, result2 error) {
+	fake.deliveryMetricsMutex.Lock()
+	defer fake.deliveryMetricsMutex.Unlock()
+	fake.DeliveryMetricsStub = nil
+	fake.deliveryMetricsReturns = struct {
+		result1 []atc.JobDeliveryMetrics
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) DeliveryMetricsReturnsOnCall(i int, result1 []atc.JobDeliveryMetrics, result2 error) {
+	fake.deliveryMetricsMutex.Lock()
+	defer fake.deliveryMetricsMutex.Unlock()
+	fake.DeliveryMetricsStub = nil
+	if fake.deliveryMetricsReturnsOnCall == nil {
+		fake.deliveryMetricsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobDeliveryMetrics
+			result2 error
+		})
+	}
+	fake.deliveryMetricsReturnsOnCall[i] = struct {
+		result1 []atc.JobDeliveryMetrics
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Destroy() error {
 	fake.destroyMutex.Lock()
 	ret, specificReturn := fake.destroyReturnsOnCall[len(fake.destroyArgsForCall)]
@@ -3185,6 +3330,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.archiveMutex.RUnlock()
 	fake.archivedMutex.RLock()
 	defer fake.archivedMutex.RUnlock()
+	fake.blockedBuildsMutex.RLock()
+	defer fake.blockedBuildsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
@@ -3205,6 +3352,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.dashboardMutex.RUnlock()
 	fake.deleteBuildEventsByBuildIDsMutex.RLock()
 	defer fake.deleteBuildEventsByBuildIDsMutex.RUnlock()
+	fake.deliveryMetricsMutex.RLock()
+	defer fake.deliveryMetricsMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
 	fake.displayMutex.RLock()
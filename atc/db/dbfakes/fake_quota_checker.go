@@ -0,0 +1,189 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeQuotaChecker struct {
+	CheckContainerQuotaStub        func(int, db.ContainerOwner, db.Quota) error
+	checkContainerQuotaMutex       sync.RWMutex
+	checkContainerQuotaArgsForCall []struct {
+		arg1 int
+		arg2 db.ContainerOwner
+		arg3 db.Quota
+	}
+	checkContainerQuotaReturns struct {
+		result1 error
+	}
+	checkContainerQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckQuotaStub        func(int, db.Quota) error
+	checkQuotaMutex       sync.RWMutex
+	checkQuotaArgsForCall []struct {
+		arg1 int
+		arg2 db.Quota
+	}
+	checkQuotaReturns struct {
+		result1 error
+	}
+	checkQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuota(arg1 int, arg2 db.ContainerOwner, arg3 db.Quota) error {
+	fake.checkContainerQuotaMutex.Lock()
+	ret, specificReturn := fake.checkContainerQuotaReturnsOnCall[len(fake.checkContainerQuotaArgsForCall)]
+	fake.checkContainerQuotaArgsForCall = append(fake.checkContainerQuotaArgsForCall, struct {
+		arg1 int
+		arg2 db.ContainerOwner
+		arg3 db.Quota
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckContainerQuota", []interface{}{arg1, arg2, arg3})
+	fake.checkContainerQuotaMutex.Unlock()
+	if fake.CheckContainerQuotaStub != nil {
+		return fake.CheckContainerQuotaStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkContainerQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuotaCallCount() int {
+	fake.checkContainerQuotaMutex.RLock()
+	defer fake.checkContainerQuotaMutex.RUnlock()
+	return len(fake.checkContainerQuotaArgsForCall)
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuotaCalls(stub func(int, db.ContainerOwner, db.Quota) error) {
+	fake.checkContainerQuotaMutex.Lock()
+	defer fake.checkContainerQuotaMutex.Unlock()
+	fake.CheckContainerQuotaStub = stub
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuotaArgsForCall(i int) (int, db.ContainerOwner, db.Quota) {
+	fake.checkContainerQuotaMutex.RLock()
+	defer fake.checkContainerQuotaMutex.RUnlock()
+	argsForCall := fake.checkContainerQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuotaReturns(result1 error) {
+	fake.checkContainerQuotaMutex.Lock()
+	defer fake.checkContainerQuotaMutex.Unlock()
+	fake.CheckContainerQuotaStub = nil
+	fake.checkContainerQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuotaChecker) CheckContainerQuotaReturnsOnCall(i int, result1 error) {
+	fake.checkContainerQuotaMutex.Lock()
+	defer fake.checkContainerQuotaMutex.Unlock()
+	fake.CheckContainerQuotaStub = nil
+	if fake.checkContainerQuotaReturnsOnCall == nil {
+		fake.checkContainerQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkContainerQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuotaChecker) CheckQuota(arg1 int, arg2 db.Quota) error {
+	fake.checkQuotaMutex.Lock()
+	ret, specificReturn := fake.checkQuotaReturnsOnCall[len(fake.checkQuotaArgsForCall)]
+	fake.checkQuotaArgsForCall = append(fake.checkQuotaArgsForCall, struct {
+		arg1 int
+		arg2 db.Quota
+	}{arg1, arg2})
+	fake.recordInvocation("CheckQuota", []interface{}{arg1, arg2})
+	fake.checkQuotaMutex.Unlock()
+	if fake.CheckQuotaStub != nil {
+		return fake.CheckQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeQuotaChecker) CheckQuotaCallCount() int {
+	fake.checkQuotaMutex.RLock()
+	defer fake.checkQuotaMutex.RUnlock()
+	return len(fake.checkQuotaArgsForCall)
+}
+
+func (fake *FakeQuotaChecker) CheckQuotaCalls(stub func(int, db.Quota) error) {
+	fake.checkQuotaMutex.Lock()
+	defer fake.checkQuotaMutex.Unlock()
+	fake.CheckQuotaStub = stub
+}
+
+func (fake *FakeQuotaChecker) CheckQuotaArgsForCall(i int) (int, db.Quota) {
+	fake.checkQuotaMutex.RLock()
+	defer fake.checkQuotaMutex.RUnlock()
+	argsForCall := fake.checkQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeQuotaChecker) CheckQuotaReturns(result1 error) {
+	fake.checkQuotaMutex.Lock()
+	defer fake.checkQuotaMutex.Unlock()
+	fake.CheckQuotaStub = nil
+	fake.checkQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuotaChecker) CheckQuotaReturnsOnCall(i int, result1 error) {
+	fake.checkQuotaMutex.Lock()
+	defer fake.checkQuotaMutex.Unlock()
+	fake.CheckQuotaStub = nil
+	if fake.checkQuotaReturnsOnCall == nil {
+		fake.checkQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuotaChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkContainerQuotaMutex.RLock()
+	defer fake.checkContainerQuotaMutex.RUnlock()
+	fake.checkQuotaMutex.RLock()
+	defer fake.checkQuotaMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeQuotaChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.QuotaChecker = new(FakeQuotaChecker)
@@ -95,6 +95,16 @@ type FakeResource struct {
 	configPinnedVersionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	ConsecutiveCheckFailuresStub        func() int
+	consecutiveCheckFailuresMutex       sync.RWMutex
+	consecutiveCheckFailuresArgsForCall []struct {
+	}
+	consecutiveCheckFailuresReturns struct {
+		result1 int
+	}
+	consecutiveCheckFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CreateBuildStub        func(context.Context, bool) (db.Build, bool, error)
 	createBuildMutex       sync.RWMutex
 	createBuildArgsForCall []struct {
@@ -942,6 +952,58 @@ func (fake *FakeResource) ConfigPinnedVersionReturnsOnCall(i int, result1 atc.Ve
 	}{result1}
 }
 
+func (fake *FakeResource) ConsecutiveCheckFailures() int {
+	fake.consecutiveCheckFailuresMutex.Lock()
+	ret, specificReturn := fake.consecutiveCheckFailuresReturnsOnCall[len(fake.consecutiveCheckFailuresArgsForCall)]
+	fake.consecutiveCheckFailuresArgsForCall = append(fake.consecutiveCheckFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConsecutiveCheckFailures", []interface{}{})
+	fake.consecutiveCheckFailuresMutex.Unlock()
+	if fake.ConsecutiveCheckFailuresStub != nil {
+		return fake.ConsecutiveCheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.consecutiveCheckFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ConsecutiveCheckFailuresCallCount() int {
+	fake.consecutiveCheckFailuresMutex.RLock()
+	defer fake.consecutiveCheckFailuresMutex.RUnlock()
+	return len(fake.consecutiveCheckFailuresArgsForCall)
+}
+
+func (fake *FakeResource) ConsecutiveCheckFailuresCalls(stub func() int) {
+	fake.consecutiveCheckFailuresMutex.Lock()
+	defer fake.consecutiveCheckFailuresMutex.Unlock()
+	fake.ConsecutiveCheckFailuresStub = stub
+}
+
+func (fake *FakeResource) ConsecutiveCheckFailuresReturns(result1 int) {
+	fake.consecutiveCheckFailuresMutex.Lock()
+	defer fake.consecutiveCheckFailuresMutex.Unlock()
+	fake.ConsecutiveCheckFailuresStub = nil
+	fake.consecutiveCheckFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) ConsecutiveCheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.consecutiveCheckFailuresMutex.Lock()
+	defer fake.consecutiveCheckFailuresMutex.Unlock()
+	fake.ConsecutiveCheckFailuresStub = nil
+	if fake.consecutiveCheckFailuresReturnsOnCall == nil {
+		fake.consecutiveCheckFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.consecutiveCheckFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) CreateBuild(arg1 context.Context, arg2 bool) (db.Build, bool, error) {
 	fake.createBuildMutex.Lock()
 	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
@@ -3029,6 +3091,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.configPinnedVersionMutex.RLock()
 	defer fake.configPinnedVersionMutex.RUnlock()
+	fake.consecutiveCheckFailuresMutex.RLock()
+	defer fake.consecutiveCheckFailuresMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
 	fake.currentPinnedVersionMutex.RLock()
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc/db"
 )
@@ -35,6 +36,19 @@ type FakeResourceFactory struct {
 		result2 bool
 		result3 error
 	}
+	StaleResourcesStub        func(time.Time) ([]db.Resource, error)
+	staleResourcesMutex       sync.RWMutex
+	staleResourcesArgsForCall []struct {
+		arg1 time.Time
+	}
+	staleResourcesReturns struct {
+		result1 []db.Resource
+		result2 error
+	}
+	staleResourcesReturnsOnCall map[int]struct {
+		result1 []db.Resource
+		result2 error
+	}
 	VisibleResourcesStub        func([]string) ([]db.Resource, error)
 	visibleResourcesMutex       sync.RWMutex
 	visibleResourcesArgsForCall []struct {
@@ -173,6 +187,69 @@ func (fake *FakeResourceFactory) ResourceReturnsOnCall(i int, result1 db.Resourc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResourceFactory) StaleResources(arg1 time.Time) ([]db.Resource, error) {
+	fake.staleResourcesMutex.Lock()
+	ret, specificReturn := fake.staleResourcesReturnsOnCall[len(fake.staleResourcesArgsForCall)]
+	fake.staleResourcesArgsForCall = append(fake.staleResourcesArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("StaleResources", []interface{}{arg1})
+	fake.staleResourcesMutex.Unlock()
+	if fake.StaleResourcesStub != nil {
+		return fake.StaleResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.staleResourcesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceFactory) StaleResourcesCallCount() int {
+	fake.staleResourcesMutex.RLock()
+	defer fake.staleResourcesMutex.RUnlock()
+	return len(fake.staleResourcesArgsForCall)
+}
+
+func (fake *FakeResourceFactory) StaleResourcesCalls(stub func(time.Time) ([]db.Resource, error)) {
+	fake.staleResourcesMutex.Lock()
+	defer fake.staleResourcesMutex.Unlock()
+	fake.StaleResourcesStub = stub
+}
+
+func (fake *FakeResourceFactory) StaleResourcesArgsForCall(i int) time.Time {
+	fake.staleResourcesMutex.RLock()
+	defer fake.staleResourcesMutex.RUnlock()
+	argsForCall := fake.staleResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceFactory) StaleResourcesReturns(result1 []db.Resource, result2 error) {
+	fake.staleResourcesMutex.Lock()
+	defer fake.staleResourcesMutex.Unlock()
+	fake.StaleResourcesStub = nil
+	fake.staleResourcesReturns = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceFactory) StaleResourcesReturnsOnCall(i int, result1 []db.Resource, result2 error) {
+	fake.staleResourcesMutex.Lock()
+	defer fake.staleResourcesMutex.Unlock()
+	fake.StaleResourcesStub = nil
+	if fake.staleResourcesReturnsOnCall == nil {
+		fake.staleResourcesReturnsOnCall = make(map[int]struct {
+			result1 []db.Resource
+			result2 error
+		})
+	}
+	fake.staleResourcesReturnsOnCall[i] = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceFactory) VisibleResources(arg1 []string) ([]db.Resource, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -248,6 +325,8 @@ func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	defer fake.allResourcesMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.staleResourcesMutex.RLock()
+	defer fake.staleResourcesMutex.RUnlock()
 	fake.visibleResourcesMutex.RLock()
 	defer fake.visibleResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -86,10 +86,11 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
-	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
+	CreateStartedBuildStub        func(atc.Plan, string) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
 		arg1 atc.Plan
+		arg2 string
 	}
 	createStartedBuildReturns struct {
 		result1 db.Build
@@ -301,6 +302,16 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	PolicyProfileStub        func() string
+	policyProfileMutex       sync.RWMutex
+	policyProfileArgsForCall []struct {
+	}
+	policyProfileReturns struct {
+		result1 string
+	}
+	policyProfileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PrivateAndPublicBuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	privateAndPublicBuildsMutex       sync.RWMutex
 	privateAndPublicBuildsArgsForCall []struct {
@@ -328,6 +339,16 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	QuotasStub        func() atc.TeamQuotas
+	quotasMutex       sync.RWMutex
+	quotasArgsForCall []struct {
+	}
+	quotasReturns struct {
+		result1 atc.TeamQuotas
+	}
+	quotasReturnsOnCall map[int]struct {
+		result1 atc.TeamQuotas
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -371,6 +392,17 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdatePolicyProfileStub        func(string) error
+	updatePolicyProfileMutex       sync.RWMutex
+	updatePolicyProfileArgsForCall []struct {
+		arg1 string
+	}
+	updatePolicyProfileReturns struct {
+		result1 error
+	}
+	updatePolicyProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -382,6 +414,17 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateQuotasStub        func(atc.TeamQuotas) error
+	updateQuotasMutex       sync.RWMutex
+	updateQuotasArgsForCall []struct {
+		arg1 atc.TeamQuotas
+	}
+	updateQuotasReturns struct {
+		result1 error
+	}
+	updateQuotasReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -744,16 +787,17 @@ func (fake *FakeTeam) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
+func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan, arg2 string) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
 	fake.createStartedBuildArgsForCall = append(fake.createStartedBuildArgsForCall, struct {
 		arg1 atc.Plan
-	}{arg1})
-	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1, arg2})
 	fake.createStartedBuildMutex.Unlock()
 	if fake.CreateStartedBuildStub != nil {
-		return fake.CreateStartedBuildStub(arg1)
+		return fake.CreateStartedBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -768,17 +812,17 @@ func (fake *FakeTeam) CreateStartedBuildCallCount() int {
 	return len(fake.createStartedBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan) (db.Build, error)) {
+func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan, string) (db.Build, error)) {
 	fake.createStartedBuildMutex.Lock()
 	defer fake.createStartedBuildMutex.Unlock()
 	fake.CreateStartedBuildStub = stub
 }
 
-func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) atc.Plan {
+func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) (atc.Plan, string) {
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
 	argsForCall := fake.createStartedBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTeam) CreateStartedBuildReturns(result1 db.Build, result2 error) {
@@ -1739,6 +1783,58 @@ func (fake *FakeTeam) PipelinesReturnsOnCall(i int, result1 []db.Pipeline, resul
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) PolicyProfile() string {
+	fake.policyProfileMutex.Lock()
+	ret, specificReturn := fake.policyProfileReturnsOnCall[len(fake.policyProfileArgsForCall)]
+	fake.policyProfileArgsForCall = append(fake.policyProfileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PolicyProfile", []interface{}{})
+	fake.policyProfileMutex.Unlock()
+	if fake.PolicyProfileStub != nil {
+		return fake.PolicyProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.policyProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) PolicyProfileCallCount() int {
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	return len(fake.policyProfileArgsForCall)
+}
+
+func (fake *FakeTeam) PolicyProfileCalls(stub func() string) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = stub
+}
+
+func (fake *FakeTeam) PolicyProfileReturns(result1 string) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	fake.policyProfileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTeam) PolicyProfileReturnsOnCall(i int, result1 string) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	if fake.policyProfileReturnsOnCall == nil {
+		fake.policyProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.policyProfileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeTeam) PrivateAndPublicBuilds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.privateAndPublicBuildsMutex.Lock()
 	ret, specificReturn := fake.privateAndPublicBuildsReturnsOnCall[len(fake.privateAndPublicBuildsArgsForCall)]
@@ -1860,6 +1956,58 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Quotas() atc.TeamQuotas {
+	fake.quotasMutex.Lock()
+	ret, specificReturn := fake.quotasReturnsOnCall[len(fake.quotasArgsForCall)]
+	fake.quotasArgsForCall = append(fake.quotasArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Quotas", []interface{}{})
+	fake.quotasMutex.Unlock()
+	if fake.QuotasStub != nil {
+		return fake.QuotasStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.quotasReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) QuotasCallCount() int {
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
+	return len(fake.quotasArgsForCall)
+}
+
+func (fake *FakeTeam) QuotasCalls(stub func() atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = stub
+}
+
+func (fake *FakeTeam) QuotasReturns(result1 atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	fake.quotasReturns = struct {
+		result1 atc.TeamQuotas
+	}{result1}
+}
+
+func (fake *FakeTeam) QuotasReturnsOnCall(i int, result1 atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	if fake.quotasReturnsOnCall == nil {
+		fake.quotasReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamQuotas
+		})
+	}
+	fake.quotasReturnsOnCall[i] = struct {
+		result1 atc.TeamQuotas
+	}{result1}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -2053,6 +2201,66 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdatePolicyProfile(arg1 string) error {
+	fake.updatePolicyProfileMutex.Lock()
+	ret, specificReturn := fake.updatePolicyProfileReturnsOnCall[len(fake.updatePolicyProfileArgsForCall)]
+	fake.updatePolicyProfileArgsForCall = append(fake.updatePolicyProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UpdatePolicyProfile", []interface{}{arg1})
+	fake.updatePolicyProfileMutex.Unlock()
+	if fake.UpdatePolicyProfileStub != nil {
+		return fake.UpdatePolicyProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updatePolicyProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdatePolicyProfileCallCount() int {
+	fake.updatePolicyProfileMutex.RLock()
+	defer fake.updatePolicyProfileMutex.RUnlock()
+	return len(fake.updatePolicyProfileArgsForCall)
+}
+
+func (fake *FakeTeam) UpdatePolicyProfileCalls(stub func(string) error) {
+	fake.updatePolicyProfileMutex.Lock()
+	defer fake.updatePolicyProfileMutex.Unlock()
+	fake.UpdatePolicyProfileStub = stub
+}
+
+func (fake *FakeTeam) UpdatePolicyProfileArgsForCall(i int) string {
+	fake.updatePolicyProfileMutex.RLock()
+	defer fake.updatePolicyProfileMutex.RUnlock()
+	argsForCall := fake.updatePolicyProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdatePolicyProfileReturns(result1 error) {
+	fake.updatePolicyProfileMutex.Lock()
+	defer fake.updatePolicyProfileMutex.Unlock()
+	fake.UpdatePolicyProfileStub = nil
+	fake.updatePolicyProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdatePolicyProfileReturnsOnCall(i int, result1 error) {
+	fake.updatePolicyProfileMutex.Lock()
+	defer fake.updatePolicyProfileMutex.Unlock()
+	fake.UpdatePolicyProfileStub = nil
+	if fake.updatePolicyProfileReturnsOnCall == nil {
+		fake.updatePolicyProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updatePolicyProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2113,6 +2321,66 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateQuotas(arg1 atc.TeamQuotas) error {
+	fake.updateQuotasMutex.Lock()
+	ret, specificReturn := fake.updateQuotasReturnsOnCall[len(fake.updateQuotasArgsForCall)]
+	fake.updateQuotasArgsForCall = append(fake.updateQuotasArgsForCall, struct {
+		arg1 atc.TeamQuotas
+	}{arg1})
+	fake.recordInvocation("UpdateQuotas", []interface{}{arg1})
+	fake.updateQuotasMutex.Unlock()
+	if fake.UpdateQuotasStub != nil {
+		return fake.UpdateQuotasStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateQuotasReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateQuotasCallCount() int {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	return len(fake.updateQuotasArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateQuotasCalls(stub func(atc.TeamQuotas) error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = stub
+}
+
+func (fake *FakeTeam) UpdateQuotasArgsForCall(i int) atc.TeamQuotas {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	argsForCall := fake.updateQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateQuotasReturns(result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	fake.updateQuotasReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateQuotasReturnsOnCall(i int, result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	if fake.updateQuotasReturnsOnCall == nil {
+		fake.updateQuotasReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateQuotasReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2215,18 +2483,26 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
 	defer fake.pipelinesMutex.RUnlock()
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updatePolicyProfileMutex.RLock()
+	defer fake.updatePolicyProfileMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
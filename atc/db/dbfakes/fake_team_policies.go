@@ -0,0 +1,426 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeTeamPolicies struct {
+	ActiveExemptionStub        func(string, string) (atc.PolicyExemption, bool, error)
+	activeExemptionMutex       sync.RWMutex
+	activeExemptionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	activeExemptionReturns struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}
+	activeExemptionReturnsOnCall map[int]struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}
+	ActiveExemptionsStub        func() ([]atc.PolicyExemption, error)
+	activeExemptionsMutex       sync.RWMutex
+	activeExemptionsArgsForCall []struct {
+	}
+	activeExemptionsReturns struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}
+	activeExemptionsReturnsOnCall map[int]struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}
+	CreateExemptionStub        func(atc.PolicyExemption) (atc.PolicyExemption, error)
+	createExemptionMutex       sync.RWMutex
+	createExemptionArgsForCall []struct {
+		arg1 atc.PolicyExemption
+	}
+	createExemptionReturns struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}
+	createExemptionReturnsOnCall map[int]struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}
+	DeleteExemptionStub        func(int) (bool, error)
+	deleteExemptionMutex       sync.RWMutex
+	deleteExemptionArgsForCall []struct {
+		arg1 int
+	}
+	deleteExemptionReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteExemptionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PolicyProfileStub        func(string) (string, error)
+	policyProfileMutex       sync.RWMutex
+	policyProfileArgsForCall []struct {
+		arg1 string
+	}
+	policyProfileReturns struct {
+		result1 string
+		result2 error
+	}
+	policyProfileReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTeamPolicies) ActiveExemption(arg1 string, arg2 string) (atc.PolicyExemption, bool, error) {
+	fake.activeExemptionMutex.Lock()
+	ret, specificReturn := fake.activeExemptionReturnsOnCall[len(fake.activeExemptionArgsForCall)]
+	fake.activeExemptionArgsForCall = append(fake.activeExemptionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ActiveExemption", []interface{}{arg1, arg2})
+	fake.activeExemptionMutex.Unlock()
+	if fake.ActiveExemptionStub != nil {
+		return fake.ActiveExemptionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.activeExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionCallCount() int {
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	return len(fake.activeExemptionArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionCalls(stub func(string, string) (atc.PolicyExemption, bool, error)) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = stub
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionArgsForCall(i int) (string, string) {
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	argsForCall := fake.activeExemptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionReturns(result1 atc.PolicyExemption, result2 bool, result3 error) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = nil
+	fake.activeExemptionReturns = struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionReturnsOnCall(i int, result1 atc.PolicyExemption, result2 bool, result3 error) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = nil
+	if fake.activeExemptionReturnsOnCall == nil {
+		fake.activeExemptionReturnsOnCall = make(map[int]struct {
+			result1 atc.PolicyExemption
+			result2 bool
+			result3 error
+		})
+	}
+	fake.activeExemptionReturnsOnCall[i] = struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptions() ([]atc.PolicyExemption, error) {
+	fake.activeExemptionsMutex.Lock()
+	ret, specificReturn := fake.activeExemptionsReturnsOnCall[len(fake.activeExemptionsArgsForCall)]
+	fake.activeExemptionsArgsForCall = append(fake.activeExemptionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ActiveExemptions", []interface{}{})
+	fake.activeExemptionsMutex.Unlock()
+	if fake.ActiveExemptionsStub != nil {
+		return fake.ActiveExemptionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.activeExemptionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionsCallCount() int {
+	fake.activeExemptionsMutex.RLock()
+	defer fake.activeExemptionsMutex.RUnlock()
+	return len(fake.activeExemptionsArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionsCalls(stub func() ([]atc.PolicyExemption, error)) {
+	fake.activeExemptionsMutex.Lock()
+	defer fake.activeExemptionsMutex.Unlock()
+	fake.ActiveExemptionsStub = stub
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionsReturns(result1 []atc.PolicyExemption, result2 error) {
+	fake.activeExemptionsMutex.Lock()
+	defer fake.activeExemptionsMutex.Unlock()
+	fake.ActiveExemptionsStub = nil
+	fake.activeExemptionsReturns = struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionsReturnsOnCall(i int, result1 []atc.PolicyExemption, result2 error) {
+	fake.activeExemptionsMutex.Lock()
+	defer fake.activeExemptionsMutex.Unlock()
+	fake.ActiveExemptionsStub = nil
+	if fake.activeExemptionsReturnsOnCall == nil {
+		fake.activeExemptionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PolicyExemption
+			result2 error
+		})
+	}
+	fake.activeExemptionsReturnsOnCall[i] = struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) CreateExemption(arg1 atc.PolicyExemption) (atc.PolicyExemption, error) {
+	fake.createExemptionMutex.Lock()
+	ret, specificReturn := fake.createExemptionReturnsOnCall[len(fake.createExemptionArgsForCall)]
+	fake.createExemptionArgsForCall = append(fake.createExemptionArgsForCall, struct {
+		arg1 atc.PolicyExemption
+	}{arg1})
+	fake.recordInvocation("CreateExemption", []interface{}{arg1})
+	fake.createExemptionMutex.Unlock()
+	if fake.CreateExemptionStub != nil {
+		return fake.CreateExemptionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamPolicies) CreateExemptionCallCount() int {
+	fake.createExemptionMutex.RLock()
+	defer fake.createExemptionMutex.RUnlock()
+	return len(fake.createExemptionArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) CreateExemptionCalls(stub func(atc.PolicyExemption) (atc.PolicyExemption, error)) {
+	fake.createExemptionMutex.Lock()
+	defer fake.createExemptionMutex.Unlock()
+	fake.CreateExemptionStub = stub
+}
+
+func (fake *FakeTeamPolicies) CreateExemptionArgsForCall(i int) atc.PolicyExemption {
+	fake.createExemptionMutex.RLock()
+	defer fake.createExemptionMutex.RUnlock()
+	argsForCall := fake.createExemptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamPolicies) CreateExemptionReturns(result1 atc.PolicyExemption, result2 error) {
+	fake.createExemptionMutex.Lock()
+	defer fake.createExemptionMutex.Unlock()
+	fake.CreateExemptionStub = nil
+	fake.createExemptionReturns = struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) CreateExemptionReturnsOnCall(i int, result1 atc.PolicyExemption, result2 error) {
+	fake.createExemptionMutex.Lock()
+	defer fake.createExemptionMutex.Unlock()
+	fake.CreateExemptionStub = nil
+	if fake.createExemptionReturnsOnCall == nil {
+		fake.createExemptionReturnsOnCall = make(map[int]struct {
+			result1 atc.PolicyExemption
+			result2 error
+		})
+	}
+	fake.createExemptionReturnsOnCall[i] = struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) DeleteExemption(arg1 int) (bool, error) {
+	fake.deleteExemptionMutex.Lock()
+	ret, specificReturn := fake.deleteExemptionReturnsOnCall[len(fake.deleteExemptionArgsForCall)]
+	fake.deleteExemptionArgsForCall = append(fake.deleteExemptionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteExemption", []interface{}{arg1})
+	fake.deleteExemptionMutex.Unlock()
+	if fake.DeleteExemptionStub != nil {
+		return fake.DeleteExemptionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamPolicies) DeleteExemptionCallCount() int {
+	fake.deleteExemptionMutex.RLock()
+	defer fake.deleteExemptionMutex.RUnlock()
+	return len(fake.deleteExemptionArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) DeleteExemptionCalls(stub func(int) (bool, error)) {
+	fake.deleteExemptionMutex.Lock()
+	defer fake.deleteExemptionMutex.Unlock()
+	fake.DeleteExemptionStub = stub
+}
+
+func (fake *FakeTeamPolicies) DeleteExemptionArgsForCall(i int) int {
+	fake.deleteExemptionMutex.RLock()
+	defer fake.deleteExemptionMutex.RUnlock()
+	argsForCall := fake.deleteExemptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamPolicies) DeleteExemptionReturns(result1 bool, result2 error) {
+	fake.deleteExemptionMutex.Lock()
+	defer fake.deleteExemptionMutex.Unlock()
+	fake.DeleteExemptionStub = nil
+	fake.deleteExemptionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) DeleteExemptionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteExemptionMutex.Lock()
+	defer fake.deleteExemptionMutex.Unlock()
+	fake.DeleteExemptionStub = nil
+	if fake.deleteExemptionReturnsOnCall == nil {
+		fake.deleteExemptionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteExemptionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) PolicyProfile(arg1 string) (string, error) {
+	fake.policyProfileMutex.Lock()
+	ret, specificReturn := fake.policyProfileReturnsOnCall[len(fake.policyProfileArgsForCall)]
+	fake.policyProfileArgsForCall = append(fake.policyProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PolicyProfile", []interface{}{arg1})
+	fake.policyProfileMutex.Unlock()
+	if fake.PolicyProfileStub != nil {
+		return fake.PolicyProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.policyProfileReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileCallCount() int {
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	return len(fake.policyProfileArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileCalls(stub func(string) (string, error)) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = stub
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileArgsForCall(i int) string {
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	argsForCall := fake.policyProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileReturns(result1 string, result2 error) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	fake.policyProfileReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileReturnsOnCall(i int, result1 string, result2 error) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	if fake.policyProfileReturnsOnCall == nil {
+		fake.policyProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.policyProfileReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	fake.activeExemptionsMutex.RLock()
+	defer fake.activeExemptionsMutex.RUnlock()
+	fake.createExemptionMutex.RLock()
+	defer fake.createExemptionMutex.RUnlock()
+	fake.deleteExemptionMutex.RLock()
+	defer fake.deleteExemptionMutex.RUnlock()
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTeamPolicies) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.TeamPolicies = new(FakeTeamPolicies)
@@ -96,6 +96,16 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DrainDeadlineStub        func() time.Time
+	drainDeadlineMutex       sync.RWMutex
+	drainDeadlineArgsForCall []struct {
+	}
+	drainDeadlineReturns struct {
+		result1 time.Time
+	}
+	drainDeadlineReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -171,9 +181,10 @@ type FakeWorker struct {
 	increaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
-	LandStub        func() error
+	LandStub        func(time.Duration) error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
+		arg1 time.Duration
 	}
 	landReturns struct {
 		result1 error
@@ -762,6 +773,58 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) DrainDeadline() time.Time {
+	fake.drainDeadlineMutex.Lock()
+	ret, specificReturn := fake.drainDeadlineReturnsOnCall[len(fake.drainDeadlineArgsForCall)]
+	fake.drainDeadlineArgsForCall = append(fake.drainDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DrainDeadline", []interface{}{})
+	fake.drainDeadlineMutex.Unlock()
+	if fake.DrainDeadlineStub != nil {
+		return fake.DrainDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.drainDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DrainDeadlineCallCount() int {
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
+	return len(fake.drainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) DrainDeadlineCalls(stub func() time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = stub
+}
+
+func (fake *FakeWorker) DrainDeadlineReturns(result1 time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = nil
+	fake.drainDeadlineReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainDeadlineReturnsOnCall(i int, result1 time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = nil
+	if fake.drainDeadlineReturnsOnCall == nil {
+		fake.drainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.drainDeadlineReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1140,15 +1203,16 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeWorker) Land() error {
+func (fake *FakeWorker) Land(arg1 time.Duration) error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
 	fake.landArgsForCall = append(fake.landArgsForCall, struct {
-	}{})
-	fake.recordInvocation("Land", []interface{}{})
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("Land", []interface{}{arg1})
 	fake.landMutex.Unlock()
 	if fake.LandStub != nil {
-		return fake.LandStub()
+		return fake.LandStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1163,12 +1227,19 @@ func (fake *FakeWorker) LandCallCount() int {
 	return len(fake.landArgsForCall)
 }
 
-func (fake *FakeWorker) LandCalls(stub func() error) {
+func (fake *FakeWorker) LandCalls(stub func(time.Duration) error) {
 	fake.landMutex.Lock()
 	defer fake.landMutex.Unlock()
 	fake.LandStub = stub
 }
 
+func (fake *FakeWorker) LandArgsForCall(i int) time.Duration {
+	fake.landMutex.RLock()
+	defer fake.landMutex.RUnlock()
+	argsForCall := fake.landArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeWorker) LandReturns(result1 error) {
 	fake.landMutex.Lock()
 	defer fake.landMutex.Unlock()
@@ -1948,6 +2019,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
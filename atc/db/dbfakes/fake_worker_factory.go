@@ -22,6 +22,18 @@ type FakeWorkerFactory struct {
 		result1 map[string]int
 		result2 error
 	}
+	DrainingBuildsCountPerWorkerStub        func() (map[string]int, error)
+	drainingBuildsCountPerWorkerMutex       sync.RWMutex
+	drainingBuildsCountPerWorkerArgsForCall []struct {
+	}
+	drainingBuildsCountPerWorkerReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	drainingBuildsCountPerWorkerReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	FindWorkersForContainerByOwnerStub        func(db.ContainerOwner) ([]db.Worker, error)
 	findWorkersForContainerByOwnerMutex       sync.RWMutex
 	findWorkersForContainerByOwnerArgsForCall []struct {
@@ -162,6 +174,61 @@ func (fake *FakeWorkerFactory) BuildContainersCountPerWorkerReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerFactory) DrainingBuildsCountPerWorker() (map[string]int, error) {
+	fake.drainingBuildsCountPerWorkerMutex.Lock()
+	ret, specificReturn := fake.drainingBuildsCountPerWorkerReturnsOnCall[len(fake.drainingBuildsCountPerWorkerArgsForCall)]
+	fake.drainingBuildsCountPerWorkerArgsForCall = append(fake.drainingBuildsCountPerWorkerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DrainingBuildsCountPerWorker", []interface{}{})
+	fake.drainingBuildsCountPerWorkerMutex.Unlock()
+	if fake.DrainingBuildsCountPerWorkerStub != nil {
+		return fake.DrainingBuildsCountPerWorkerStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.drainingBuildsCountPerWorkerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerFactory) DrainingBuildsCountPerWorkerCallCount() int {
+	fake.drainingBuildsCountPerWorkerMutex.RLock()
+	defer fake.drainingBuildsCountPerWorkerMutex.RUnlock()
+	return len(fake.drainingBuildsCountPerWorkerArgsForCall)
+}
+
+func (fake *FakeWorkerFactory) DrainingBuildsCountPerWorkerCalls(stub func() (map[string]int, error)) {
+	fake.drainingBuildsCountPerWorkerMutex.Lock()
+	defer fake.drainingBuildsCountPerWorkerMutex.Unlock()
+	fake.DrainingBuildsCountPerWorkerStub = stub
+}
+
+func (fake *FakeWorkerFactory) DrainingBuildsCountPerWorkerReturns(result1 map[string]int, result2 error) {
+	fake.drainingBuildsCountPerWorkerMutex.Lock()
+	defer fake.drainingBuildsCountPerWorkerMutex.Unlock()
+	fake.DrainingBuildsCountPerWorkerStub = nil
+	fake.drainingBuildsCountPerWorkerReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerFactory) DrainingBuildsCountPerWorkerReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.drainingBuildsCountPerWorkerMutex.Lock()
+	defer fake.drainingBuildsCountPerWorkerMutex.Unlock()
+	fake.DrainingBuildsCountPerWorkerStub = nil
+	if fake.drainingBuildsCountPerWorkerReturnsOnCall == nil {
+		fake.drainingBuildsCountPerWorkerReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.drainingBuildsCountPerWorkerReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerFactory) FindWorkersForContainerByOwner(arg1 db.ContainerOwner) ([]db.Worker, error) {
 	fake.findWorkersForContainerByOwnerMutex.Lock()
 	ret, specificReturn := fake.findWorkersForContainerByOwnerReturnsOnCall[len(fake.findWorkersForContainerByOwnerArgsForCall)]
@@ -547,6 +614,8 @@ func (fake *FakeWorkerFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.buildContainersCountPerWorkerMutex.RLock()
 	defer fake.buildContainersCountPerWorkerMutex.RUnlock()
+	fake.drainingBuildsCountPerWorkerMutex.RLock()
+	defer fake.drainingBuildsCountPerWorkerMutex.RUnlock()
 	fake.findWorkersForContainerByOwnerMutex.RLock()
 	defer fake.findWorkersForContainerByOwnerMutex.RUnlock()
 	fake.getWorkerMutex.RLock()
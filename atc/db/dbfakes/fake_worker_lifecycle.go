@@ -44,6 +44,18 @@ type FakeWorkerLifecycle struct {
 		result1 map[string]db.WorkerState
 		result2 error
 	}
+	LandExpiredLandingWorkersStub        func() ([]string, error)
+	landExpiredLandingWorkersMutex       sync.RWMutex
+	landExpiredLandingWorkersArgsForCall []struct {
+	}
+	landExpiredLandingWorkersReturns struct {
+		result1 []string
+		result2 error
+	}
+	landExpiredLandingWorkersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	LandFinishedLandingWorkersStub        func() ([]string, error)
 	landFinishedLandingWorkersMutex       sync.RWMutex
 	landFinishedLandingWorkersArgsForCall []struct {
@@ -237,6 +249,61 @@ func (fake *FakeWorkerLifecycle) GetWorkerStateByNameReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerLifecycle) LandExpiredLandingWorkers() ([]string, error) {
+	fake.landExpiredLandingWorkersMutex.Lock()
+	ret, specificReturn := fake.landExpiredLandingWorkersReturnsOnCall[len(fake.landExpiredLandingWorkersArgsForCall)]
+	fake.landExpiredLandingWorkersArgsForCall = append(fake.landExpiredLandingWorkersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LandExpiredLandingWorkers", []interface{}{})
+	fake.landExpiredLandingWorkersMutex.Unlock()
+	if fake.LandExpiredLandingWorkersStub != nil {
+		return fake.LandExpiredLandingWorkersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.landExpiredLandingWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) LandExpiredLandingWorkersCallCount() int {
+	fake.landExpiredLandingWorkersMutex.RLock()
+	defer fake.landExpiredLandingWorkersMutex.RUnlock()
+	return len(fake.landExpiredLandingWorkersArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) LandExpiredLandingWorkersCalls(stub func() ([]string, error)) {
+	fake.landExpiredLandingWorkersMutex.Lock()
+	defer fake.landExpiredLandingWorkersMutex.Unlock()
+	fake.LandExpiredLandingWorkersStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) LandExpiredLandingWorkersReturns(result1 []string, result2 error) {
+	fake.landExpiredLandingWorkersMutex.Lock()
+	defer fake.landExpiredLandingWorkersMutex.Unlock()
+	fake.LandExpiredLandingWorkersStub = nil
+	fake.landExpiredLandingWorkersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) LandExpiredLandingWorkersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.landExpiredLandingWorkersMutex.Lock()
+	defer fake.landExpiredLandingWorkersMutex.Unlock()
+	fake.LandExpiredLandingWorkersStub = nil
+	if fake.landExpiredLandingWorkersReturnsOnCall == nil {
+		fake.landExpiredLandingWorkersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.landExpiredLandingWorkersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) LandFinishedLandingWorkers() ([]string, error) {
 	fake.landFinishedLandingWorkersMutex.Lock()
 	ret, specificReturn := fake.landFinishedLandingWorkersReturnsOnCall[len(fake.landFinishedLandingWorkersArgsForCall)]
@@ -356,6 +423,8 @@ func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	defer fake.deleteUnresponsiveEphemeralWorkersMutex.RUnlock()
 	fake.getWorkerStateByNameMutex.RLock()
 	defer fake.getWorkerStateByNameMutex.RUnlock()
+	fake.landExpiredLandingWorkersMutex.RLock()
+	defer fake.landExpiredLandingWorkersMutex.RUnlock()
 	fake.landFinishedLandingWorkersMutex.RLock()
 	defer fake.landFinishedLandingWorkersMutex.RUnlock()
 	fake.stallUnresponsiveWorkersMutex.RLock()
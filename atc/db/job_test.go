@@ -233,13 +233,13 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished).To(BeNil())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).NotTo(HaveOccurred())
 
-			otherFinishedBuild, err := otherJob.CreateBuild()
+			otherFinishedBuild, err := otherJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = otherFinishedBuild.Finish(db.BuildStatusSucceeded)
@@ -251,14 +251,14 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := nextBuild.Start(atc.Plan{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
-			otherNextBuild, err := otherJob.CreateBuild()
+			otherNextBuild, err := otherJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			otherStarted, err := otherNextBuild.Start(atc.Plan{})
@@ -271,7 +271,7 @@ var _ = Describe("Job", func() {
 			Expect(next.ID()).To(Equal(nextBuild.ID()))
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			anotherRunningBuild, err := job.CreateBuild()
+			anotherRunningBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			finished, next, err = job.FinishedAndNextBuild()
@@ -348,10 +348,10 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := someJob.CreateBuild()
+				build, err := someJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = someOtherJob.CreateBuild()
+				_, err = someOtherJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				builds[i] = build
@@ -450,7 +450,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -529,12 +529,12 @@ var _ = Describe("Job", func() {
 		Context("when a build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("finds the latest build", func() {
-				secondBuild, err := job.CreateBuild()
+				secondBuild, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				build, found, err := job.Build("latest")
@@ -573,7 +573,7 @@ var _ = Describe("Job", func() {
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.CreateBuild()
+				_, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -592,13 +592,13 @@ var _ = Describe("Job", func() {
 		var buildToRerun db.Build
 
 		JustBeforeEach(func() {
-			rerunBuild, rerunErr = job.RerunBuild(buildToRerun)
+			rerunBuild, rerunErr = job.RerunBuild(buildToRerun, "some-user")
 		})
 
 		Context("when the first build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				buildToRerun = firstBuild
@@ -619,7 +619,7 @@ var _ = Describe("Job", func() {
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.RerunBuild(buildToRerun)
+				_, err := job.RerunBuild(buildToRerun, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -634,7 +634,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, "some-user")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -652,7 +652,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, "some-user")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -856,7 +856,7 @@ var _ = Describe("Job", func() {
 		Context("when the scheduling build is created first", func() {
 			BeforeEach(func() {
 				var err error
-				schedulingBuild, err = job.CreateBuild()
+				schedulingBuild, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -947,7 +947,7 @@ var _ = Describe("Job", func() {
 
 					BeforeEach(func() {
 						var err error
-						startedBuild, err = job.CreateBuild()
+						startedBuild, err = job.CreateBuild("some-user")
 						Expect(err).ToNot(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -955,7 +955,7 @@ var _ = Describe("Job", func() {
 						_, err = startedBuild.Start(atc.Plan{})
 						Expect(err).NotTo(HaveOccurred())
 
-						scheduledBuild, err = job.CreateBuild()
+						scheduledBuild, err = job.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err = job.ScheduleBuild(scheduledBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -964,7 +964,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild("some-user")
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -979,7 +979,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						_, err = otherJob.CreateBuild()
+						_, err = otherJob.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -994,7 +994,7 @@ var _ = Describe("Job", func() {
 
 				Context("when there is 1 build running", func() {
 					BeforeEach(func() {
-						startedBuild, err := job.CreateBuild()
+						startedBuild, err := job.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).NotTo(HaveOccurred())
@@ -1003,7 +1003,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild("some-user")
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -1032,14 +1032,14 @@ var _ = Describe("Job", func() {
 				Context("when multiple jobs in the serial group is running", func() {
 					BeforeEach(func() {
 						var err error
-						_, err = job.CreateBuild()
+						_, err = job.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -1050,7 +1050,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -1072,7 +1072,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -1086,7 +1086,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild("some-user")
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -1112,7 +1112,7 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has inputs determined as false", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, false)
@@ -1134,13 +1134,13 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1159,14 +1159,14 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has it's inputs determined and created earlier", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1187,14 +1187,14 @@ var _ = Describe("Job", func() {
 			Context("when the job is paused but has inputs determined", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1221,7 +1221,7 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					succeededBuild, err := otherSerialJob.CreateBuild()
+					succeededBuild, err := otherSerialJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = succeededBuild.Finish(db.BuildStatusSucceeded)
@@ -1232,7 +1232,7 @@ var _ = Describe("Job", func() {
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 				})
 
@@ -1251,14 +1251,14 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					job, found, err = pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1730,7 +1730,7 @@ var _ = Describe("Job", func() {
 			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
-			build1DB, err = job.CreateBuild()
+			build1DB, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(build1DB.ID()).NotTo(BeZero())
@@ -1755,7 +1755,7 @@ var _ = Describe("Job", func() {
 
 		Context("and another build for a different pipeline is created with the same job name", func() {
 			BeforeEach(func() {
-				otherBuild, err := otherJob.CreateBuild()
+				otherBuild, err := otherJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(otherBuild.ID()).NotTo(BeZero())
@@ -1833,7 +1833,7 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				build2DB, err = job.CreateBuild()
+				build2DB, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(build2DB.ID()).NotTo(BeZero())
@@ -1860,16 +1860,16 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				err = newBuild.Finish(db.BuildStatusSucceeded)
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -1895,13 +1895,13 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -1930,19 +1930,19 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				newBuild, err = job.CreateBuild()
+				newBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				newerBuild, err = job.CreateBuild()
+				newerBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild3, err = job.RerunBuild(newerBuild)
+				rerunBuild3, err = job.RerunBuild(newerBuild, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild, err = job.RerunBuild(newBuild)
+				rerunBuild, err = job.RerunBuild(newBuild, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				rerunBuild2, err = job.RerunBuild(rerunBuild)
+				rerunBuild2, err = job.RerunBuild(rerunBuild, "some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(rerunBuild.ID()).NotTo(BeZero())
@@ -0,0 +1,187 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrLocalUserExists = errors.New("a local user with this username already exists")
+
+//go:generate counterfeiter . LocalUserFactory
+
+// LocalUserFactory stores the local users managed through the API. Only a
+// bcrypt hash of each password is stored.
+type LocalUserFactory interface {
+	CreateLocalUser(user atc.LocalUser, passwordHash []byte) (atc.LocalUser, error)
+	LocalUsers() ([]atc.LocalUser, error)
+	FindLocalUser(username string) (atc.LocalUser, bool, error)
+	LocalUserPasswordHash(username string) ([]byte, bool, error)
+	SetLocalUserPassword(username string, passwordHash []byte) (bool, error)
+	SetLocalUserDisabled(username string, disabled bool) (bool, error)
+}
+
+type localUserFactory struct {
+	conn Conn
+}
+
+func NewLocalUserFactory(conn Conn) LocalUserFactory {
+	return &localUserFactory{
+		conn: conn,
+	}
+}
+
+var localUsersQuery = psql.Select(
+	"u.username",
+	"u.disabled",
+	"u.created_by",
+	"u.created_at",
+	"u.updated_at",
+).
+	From("local_users u")
+
+func (f *localUserFactory) CreateLocalUser(user atc.LocalUser, passwordHash []byte) (atc.LocalUser, error) {
+	row := psql.Insert("local_users").
+		SetMap(map[string]interface{}{
+			"username":      user.Username,
+			"password_hash": string(passwordHash),
+			"created_by":    user.CreatedBy,
+		}).
+		Suffix("RETURNING username, disabled, created_by, created_at, updated_at").
+		RunWith(f.conn).
+		QueryRow()
+
+	created, err := scanLocalUser(row)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return atc.LocalUser{}, ErrLocalUserExists
+		}
+
+		return atc.LocalUser{}, err
+	}
+
+	return created, nil
+}
+
+func (f *localUserFactory) LocalUsers() ([]atc.LocalUser, error) {
+	rows, err := localUsersQuery.
+		OrderBy("LOWER(u.username)").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	users := []atc.LocalUser{}
+	for rows.Next() {
+		user, err := scanLocalUser(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+func (f *localUserFactory) FindLocalUser(username string) (atc.LocalUser, bool, error) {
+	row := localUsersQuery.
+		Where(sq.Eq{"LOWER(u.username)": strings.ToLower(username)}).
+		RunWith(f.conn).
+		QueryRow()
+
+	user, err := scanLocalUser(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.LocalUser{}, false, nil
+		}
+
+		return atc.LocalUser{}, false, err
+	}
+
+	return user, true, nil
+}
+
+// LocalUserPasswordHash returns the password hash of the user, as long as the
+// user hasn't been disabled.
+func (f *localUserFactory) LocalUserPasswordHash(username string) ([]byte, bool, error) {
+	var hash string
+	err := psql.Select("password_hash").
+		From("local_users").
+		Where(sq.Eq{
+			"LOWER(username)": strings.ToLower(username),
+			"disabled":        false,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&hash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return []byte(hash), true, nil
+}
+
+func (f *localUserFactory) SetLocalUserPassword(username string, passwordHash []byte) (bool, error) {
+	return f.update(username, map[string]interface{}{
+		"password_hash": string(passwordHash),
+	})
+}
+
+func (f *localUserFactory) SetLocalUserDisabled(username string, disabled bool) (bool, error) {
+	return f.update(username, map[string]interface{}{
+		"disabled": disabled,
+	})
+}
+
+func (f *localUserFactory) update(username string, values map[string]interface{}) (bool, error) {
+	result, err := psql.Update("local_users").
+		SetMap(values).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"LOWER(username)": strings.ToLower(username)}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func scanLocalUser(row scannable) (atc.LocalUser, error) {
+	var user atc.LocalUser
+	var createdAt, updatedAt time.Time
+	err := row.Scan(
+		&user.Username,
+		&user.Disabled,
+		&user.CreatedBy,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return atc.LocalUser{}, err
+	}
+
+	user.CreatedAt = createdAt.Unix()
+	user.UpdatedAt = updatedAt.Unix()
+
+	return user, nil
+}
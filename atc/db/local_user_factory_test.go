@@ -0,0 +1,120 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalUserFactory", func() {
+	var created atc.LocalUser
+
+	BeforeEach(func() {
+		var err error
+		created, err = localUserFactory.CreateLocalUser(atc.LocalUser{
+			Username:  "Some-User",
+			CreatedBy: "some-admin",
+		}, []byte("some-hash"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("CreateLocalUser", func() {
+		It("returns the created user", func() {
+			Expect(created.Username).To(Equal("Some-User"))
+			Expect(created.CreatedBy).To(Equal("some-admin"))
+			Expect(created.Disabled).To(BeFalse())
+			Expect(created.CreatedAt).ToNot(BeZero())
+			Expect(created.UpdatedAt).ToNot(BeZero())
+		})
+
+		Context("when a user with the same username exists", func() {
+			It("returns ErrLocalUserExists regardless of case", func() {
+				_, err := localUserFactory.CreateLocalUser(atc.LocalUser{Username: "some-user"}, []byte("other-hash"))
+				Expect(err).To(Equal(db.ErrLocalUserExists))
+			})
+		})
+	})
+
+	Describe("LocalUsers", func() {
+		BeforeEach(func() {
+			_, err := localUserFactory.CreateLocalUser(atc.LocalUser{Username: "another-user"}, []byte("other-hash"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the users ordered by username", func() {
+			users, err := localUserFactory.LocalUsers()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(HaveLen(2))
+			Expect(users[0].Username).To(Equal("another-user"))
+			Expect(users[1].Username).To(Equal("Some-User"))
+		})
+	})
+
+	Describe("FindLocalUser", func() {
+		It("finds the user regardless of case", func() {
+			user, found, err := localUserFactory.FindLocalUser("some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(user).To(Equal(created))
+		})
+
+		It("does not find users that don't exist", func() {
+			_, found, err := localUserFactory.FindLocalUser("bogus-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("LocalUserPasswordHash", func() {
+		It("returns the hash", func() {
+			hash, found, err := localUserFactory.LocalUserPasswordHash("some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(hash).To(Equal([]byte("some-hash")))
+		})
+
+		Context("when the user is disabled", func() {
+			BeforeEach(func() {
+				updated, err := localUserFactory.SetLocalUserDisabled("some-user", true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeTrue())
+			})
+
+			It("does not find the user", func() {
+				_, found, err := localUserFactory.LocalUserPasswordHash("some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("can be enabled again", func() {
+				updated, err := localUserFactory.SetLocalUserDisabled("some-user", false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeTrue())
+
+				_, found, err := localUserFactory.LocalUserPasswordHash("some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("SetLocalUserPassword", func() {
+		It("replaces the hash", func() {
+			updated, err := localUserFactory.SetLocalUserPassword("some-user", []byte("new-hash"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			hash, _, err := localUserFactory.LocalUserPasswordHash("some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal([]byte("new-hash")))
+		})
+
+		It("does not update users that don't exist", func() {
+			updated, err := localUserFactory.SetLocalUserPassword("bogus-user", []byte("new-hash"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+	})
+})
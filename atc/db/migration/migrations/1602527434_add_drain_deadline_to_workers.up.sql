@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN drain_deadline timestamp with time zone;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE IF EXISTS policy_exemptions;
+
+  ALTER TABLE teams DROP COLUMN policy_profile;
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+  ALTER TABLE teams ADD COLUMN policy_profile text;
+
+  CREATE TABLE policy_exemptions (
+      id serial PRIMARY KEY,
+      team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+      action text NOT NULL,
+      reason text NOT NULL,
+      created_by text NOT NULL DEFAULT '',
+      created_at timestamp with time zone NOT NULL DEFAULT now(),
+      expires_at timestamp with time zone NOT NULL
+  );
+
+  CREATE INDEX policy_exemptions_team_id_action_idx ON policy_exemptions (team_id, action);
+COMMIT;
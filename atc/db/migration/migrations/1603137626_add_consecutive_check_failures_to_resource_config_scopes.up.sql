@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes ADD COLUMN consecutive_check_failures integer NOT NULL DEFAULT 0;
+COMMIT;
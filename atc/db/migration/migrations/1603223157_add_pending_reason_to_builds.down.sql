@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds DROP COLUMN pending_reason;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN pending_reason text;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE IF EXISTS build_approvals;
+
+  ALTER TABLE builds DROP COLUMN created_by;
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN created_by text;
+
+  CREATE TABLE build_approvals (
+      id serial PRIMARY KEY,
+      build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+      plan_id text NOT NULL,
+      name text NOT NULL,
+      role text NOT NULL,
+      requested_at timestamp with time zone NOT NULL DEFAULT now(),
+      approved_by text,
+      approved_at timestamp with time zone
+  );
+
+  CREATE UNIQUE INDEX build_approvals_build_id_plan_id_key ON build_approvals (build_id, plan_id);
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+  CREATE TABLE audit_events (
+      id bigserial PRIMARY KEY,
+      created_at timestamp with time zone NOT NULL DEFAULT now(),
+      actor text NOT NULL DEFAULT '',
+      action text NOT NULL,
+      team_name text NOT NULL DEFAULT '',
+      object text NOT NULL DEFAULT '',
+      method text NOT NULL DEFAULT '',
+      path text NOT NULL DEFAULT '',
+      remote_addr text NOT NULL DEFAULT '',
+      parameters jsonb,
+      policy jsonb
+  );
+
+  CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+  CREATE INDEX audit_events_team_name_idx ON audit_events (team_name);
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE local_users (
+      id serial PRIMARY KEY,
+      username text NOT NULL,
+      password_hash text NOT NULL,
+      disabled boolean NOT NULL DEFAULT false,
+      created_by text NOT NULL DEFAULT '',
+      created_at timestamp with time zone NOT NULL DEFAULT now(),
+      updated_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE UNIQUE INDEX local_users_username_key ON local_users (LOWER(username));
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX IF EXISTS access_tokens_user_name_idx;
+
+  ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS id,
+    DROP COLUMN IF EXISTS created_at;
+COMMIT;
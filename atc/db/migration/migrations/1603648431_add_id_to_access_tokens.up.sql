@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE access_tokens
+    ADD COLUMN id bigserial UNIQUE,
+    ADD COLUMN created_at timestamp with time zone NOT NULL DEFAULT now();
+
+  CREATE INDEX access_tokens_user_name_idx ON access_tokens (LOWER(claims->'federated_claims'->>'user_name'));
+COMMIT;
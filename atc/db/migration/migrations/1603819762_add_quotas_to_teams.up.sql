@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE teams ADD COLUMN quotas jsonb;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP INDEX IF EXISTS builds_queue_position_idx;
+
+  ALTER TABLE builds DROP COLUMN queue_position;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN queue_position integer;
+
+  CREATE INDEX builds_queue_position_idx ON builds (id) WHERE queue_position IS NOT NULL;
+COMMIT;
@@ -61,10 +61,11 @@ type Pipeline interface {
 
 	GetBuildsWithVersionAsInput(int, int) ([]Build, error)
 	GetBuildsWithVersionAsOutput(int, int) ([]Build, error)
+	BlockedBuilds() ([]Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
@@ -72,6 +73,8 @@ type Pipeline interface {
 
 	LoadDebugVersionsDB() (*atc.DebugVersionsDB, error)
 
+	DeliveryMetrics(since time.Time) ([]atc.JobDeliveryMetrics, error)
+
 	Resource(name string) (Resource, bool, error)
 	ResourceByID(id int) (Resource, bool, error)
 	Resources() (Resources, error)
@@ -408,6 +411,43 @@ func (p *pipeline) GetBuildsWithVersionAsInput(resourceID, resourceConfigVersion
 	return builds, err
 }
 
+// BlockedBuilds returns the pending builds that the scheduler has given a
+// reason for not starting, the started builds with a step waiting for its
+// team's quota, and the latest builds of jobs that errored because no worker
+// could run them.
+func (p *pipeline) BlockedBuilds() ([]Build, error) {
+	rows, err := buildsQuery.
+		Where(sq.Eq{"b.pipeline_id": p.id}).
+		Where(sq.NotEq{"b.pending_reason": nil}).
+		Where(sq.Or{
+			sq.Eq{"b.status": []BuildStatus{BuildStatusPending, BuildStatusStarted}},
+			sq.And{
+				sq.Eq{"b.status": BuildStatusErrored},
+				sq.Expr("b.id = j.latest_completed_build_id"),
+			},
+		}).
+		OrderBy("b.id ASC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+	defer Close(rows)
+
+	builds := []Build{}
+	for rows.Next() {
+		build := newEmptyBuild(p.conn, p.lockFactory)
+		err = scanBuild(build, rows, p.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, err
+}
+
 func (p *pipeline) GetBuildsWithVersionAsOutput(resourceID, resourceConfigVersionID int) ([]Build, error) {
 	rows, err := buildsQuery.
 		Join("build_resource_config_version_outputs bo ON bo.build_id = b.id").
@@ -1046,7 +1086,7 @@ func (p *pipeline) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (p *pipeline) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -1075,6 +1115,7 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   createdBy,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,213 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+type deliveryBuild struct {
+	id        int
+	status    BuildStatus
+	startTime time.Time
+	endTime   time.Time
+}
+
+// DeliveryMetrics computes the delivery metrics of each active job of the
+// pipeline from the builds which finished since the given time.
+func (p *pipeline) DeliveryMetrics(since time.Time) ([]atc.JobDeliveryMetrics, error) {
+	rows, err := psql.Select("j.name", "b.id", "b.status", "b.start_time", "b.end_time").
+		From("jobs j").
+		LeftJoin("builds b ON b.job_id = j.id AND b.status IN (?, ?) AND b.end_time >= ?",
+			string(BuildStatusSucceeded), string(BuildStatusFailed), since).
+		Where(sq.Eq{
+			"j.pipeline_id": p.id,
+			"j.active":      true,
+		}).
+		OrderBy("j.id", "b.id").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var jobNames []string
+	jobBuilds := map[string][]deliveryBuild{}
+	var deploymentIDs []int
+
+	for rows.Next() {
+		var (
+			jobName   string
+			buildID   sql.NullInt64
+			status    sql.NullString
+			startTime pq.NullTime
+			endTime   pq.NullTime
+		)
+
+		err = rows.Scan(&jobName, &buildID, &status, &startTime, &endTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := jobBuilds[jobName]; !found {
+			jobNames = append(jobNames, jobName)
+			jobBuilds[jobName] = []deliveryBuild{}
+		}
+
+		if !buildID.Valid {
+			continue
+		}
+
+		build := deliveryBuild{
+			id:        int(buildID.Int64),
+			status:    BuildStatus(status.String),
+			startTime: startTime.Time,
+			endTime:   endTime.Time,
+		}
+
+		jobBuilds[jobName] = append(jobBuilds[jobName], build)
+
+		if build.status == BuildStatusSucceeded {
+			deploymentIDs = append(deploymentIDs, build.id)
+		}
+	}
+
+	firstUpstreamStarts, err := p.firstUpstreamBuildStarts(deploymentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]atc.JobDeliveryMetrics, len(jobNames))
+	for i, jobName := range jobNames {
+		metrics[i] = atc.JobDeliveryMetrics{
+			TeamName:             p.teamName,
+			PipelineName:         p.name,
+			PipelineInstanceVars: p.instanceVars,
+			JobName:              jobName,
+		}
+
+		computeDeliveryMetrics(&metrics[i], jobBuilds[jobName], firstUpstreamStarts)
+	}
+
+	return metrics, nil
+}
+
+// firstUpstreamBuildStarts follows the build pipes of each build, which link
+// a build to the builds that produced the versions of its passed inputs, and
+// returns the start time of the earliest build found along the way.
+func (p *pipeline) firstUpstreamBuildStarts(buildIDs []int) (map[int]time.Time, error) {
+	starts := map[int]time.Time{}
+	if len(buildIDs) == 0 {
+		return starts, nil
+	}
+
+	rows, err := p.conn.Query(`
+		WITH RECURSIVE upstream(build_id, id) AS (
+			SELECT id, id FROM builds WHERE id = ANY($1)
+			UNION
+			SELECT u.build_id, bp.from_build_id
+			FROM build_pipes bp
+			JOIN upstream u ON bp.to_build_id = u.id
+		)
+		SELECT u.build_id, MIN(b.start_time)
+		FROM upstream u
+		JOIN builds b ON b.id = u.id
+		WHERE b.start_time IS NOT NULL
+		GROUP BY u.build_id
+	`, pq.Array(buildIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var buildID int
+		var start time.Time
+
+		err = rows.Scan(&buildID, &start)
+		if err != nil {
+			return nil, err
+		}
+
+		starts[buildID] = start
+	}
+
+	return starts, nil
+}
+
+func computeDeliveryMetrics(metrics *atc.JobDeliveryMetrics, builds []deliveryBuild, firstUpstreamStarts map[int]time.Time) {
+	var leadTimes []float64
+	var recoveryTimes []float64
+	var failingSince *time.Time
+
+	for _, build := range builds {
+		switch build.status {
+		case BuildStatusSucceeded:
+			metrics.Deployments++
+
+			start, found := firstUpstreamStarts[build.id]
+			if !found {
+				start = build.startTime
+			}
+
+			leadTimes = append(leadTimes, build.endTime.Sub(start).Seconds())
+
+			if failingSince != nil {
+				recoveryTimes = append(recoveryTimes, build.endTime.Sub(*failingSince).Seconds())
+				failingSince = nil
+			}
+
+		case BuildStatusFailed:
+			metrics.Failures++
+
+			if failingSince == nil {
+				failedAt := build.endTime
+				failingSince = &failedAt
+			}
+		}
+	}
+
+	if finished := metrics.Deployments + metrics.Failures; finished > 0 {
+		metrics.ChangeFailureRate = float64(metrics.Failures) / float64(finished)
+	}
+
+	metrics.LeadTime = medianSeconds(leadTimes)
+	metrics.TimeToRecover = meanSeconds(recoveryTimes)
+}
+
+func medianSeconds(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+
+	return sorted[middle]
+}
+
+func meanSeconds(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
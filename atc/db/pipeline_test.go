@@ -819,7 +819,7 @@ var _ = Describe("Pipeline", func() {
 				}))
 
 				By("including outputs of successful builds")
-				build1DB, err := aJob.CreateBuild()
+				build1DB, err := aJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build1DB.SaveOutput("some-type", atc.Source{"source-config": "some-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-resource")
@@ -880,7 +880,7 @@ var _ = Describe("Pipeline", func() {
 				}))
 
 				By("not including outputs of failed builds")
-				build2DB, err := aJob.CreateBuild()
+				build2DB, err := aJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build2DB.SaveOutput("some-type", atc.Source{"source-config": "some-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-resource")
@@ -943,7 +943,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				otherPipelineBuild, err := anotherJob.CreateBuild()
+				otherPipelineBuild, err := anotherJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = otherPipelineBuild.SaveOutput("some-type", atc.Source{"other-source-config": "some-other-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-other-resource")
@@ -1019,7 +1019,7 @@ var _ = Describe("Pipeline", func() {
 					}}, true)
 				Expect(err).ToNot(HaveOccurred())
 
-				build1DB, err = aJob.CreateBuild()
+				build1DB, err = aJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				_, found, err = build1DB.AdoptInputsAndPipes()
@@ -1064,7 +1064,7 @@ var _ = Describe("Pipeline", func() {
 				}))
 
 				By("including build rerun mappings for builds")
-				build2DB, err = aJob.RerunBuild(build1DB)
+				build2DB, err = aJob.RerunBuild(build1DB, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				versions, err = dbPipeline.LoadDebugVersionsDB()
@@ -1164,7 +1164,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("populating build inputs")
@@ -1279,7 +1279,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			firstJobBuild, err := job.CreateBuild()
+			firstJobBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			actualDashboard, err = pipeline.Dashboard()
@@ -1309,7 +1309,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			secondJobBuild, err := job.CreateBuild()
+			secondJobBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			actualDashboard, err = pipeline.Dashboard()
@@ -1502,12 +1502,12 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build.ID())
 
-			secondBuild, err := job.CreateBuild()
+			secondBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild.ID())
 
@@ -1515,7 +1515,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = someOtherJob.CreateBuild()
+			_, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			dbBuild, found, err := buildFactory.Build(build.ID())
@@ -1655,11 +1655,11 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err = job.CreateBuild()
+			secondBuild, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1667,7 +1667,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = someOtherJob.CreateBuild()
+			_, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			dbBuild, found, err := buildFactory.Build(build.ID())
@@ -1767,11 +1767,11 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err := job.CreateBuild()
+			secondBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1779,7 +1779,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			thirdBuild, err := someOtherJob.CreateBuild()
+			thirdBuild, err := someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, thirdBuild)
 		})
@@ -1824,7 +1824,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 
-			startedBuild, err = pipeline.CreateStartedBuild(plan)
+			startedBuild, err = pipeline.CreateStartedBuild(plan, "some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2016,7 +2016,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -2035,7 +2035,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = otherJob.CreateBuild()
+			_, err = otherJob.CreateBuild("some-user")
 		})
 
 		Context("when not providing boundaries", func() {
@@ -2099,6 +2099,178 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("DeliveryMetrics", func() {
+		var (
+			pipeline   db.Pipeline
+			testJob    db.Job
+			deployJob  db.Job
+			since      time.Time
+			jobMetrics []atc.JobDeliveryMetrics
+		)
+
+		finishedBuild := func(job db.Job, status db.BuildStatus, start, end time.Time) db.Build {
+			build, err := job.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec("UPDATE builds SET status = $1, start_time = $2, end_time = $3 WHERE id = $4", string(status), start, end, build.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			return build
+		}
+
+		BeforeEach(func() {
+			var (
+				err   error
+				found bool
+			)
+
+			config := atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "test"},
+					{Name: "deploy"},
+					{Name: "idle"},
+				},
+			}
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "delivery-pipeline"}, config, db.ConfigVersion(1), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			testJob, found, err = pipeline.Job("test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			deployJob, found, err = pipeline.Job("deploy")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			since = time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+			at := func(hour int) time.Time { return since.Add(time.Duration(hour) * time.Hour) }
+
+			// finished before the window, and so left out
+			finishedBuild(deployJob, db.BuildStatusFailed, at(-3), at(-2))
+
+			tested := finishedBuild(testJob, db.BuildStatusSucceeded, at(0), at(1))
+			finishedBuild(deployJob, db.BuildStatusFailed, at(2), at(3))
+			finishedBuild(deployJob, db.BuildStatusFailed, at(4), at(5))
+			finishedBuild(deployJob, db.BuildStatusErrored, at(5), at(6))
+			deployed := finishedBuild(deployJob, db.BuildStatusSucceeded, at(6), at(7))
+			finishedBuild(deployJob, db.BuildStatusSucceeded, at(8), at(9))
+
+			_, err = dbConn.Exec("INSERT INTO build_pipes (from_build_id, to_build_id) VALUES ($1, $2)", tested.ID(), deployed.ID())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			jobMetrics, err = pipeline.DeliveryMetrics(since)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the metrics of every job", func() {
+			Expect(jobMetrics).To(HaveLen(3))
+			Expect(jobMetrics[0].JobName).To(Equal("test"))
+			Expect(jobMetrics[1].JobName).To(Equal("deploy"))
+			Expect(jobMetrics[2]).To(Equal(atc.JobDeliveryMetrics{
+				TeamName:     "some-team",
+				PipelineName: "delivery-pipeline",
+				JobName:      "idle",
+			}))
+		})
+
+		It("counts deployments and failures since the given time", func() {
+			Expect(jobMetrics[1].Deployments).To(Equal(2))
+			Expect(jobMetrics[1].Failures).To(Equal(2))
+			Expect(jobMetrics[1].ChangeFailureRate).To(Equal(0.5))
+		})
+
+		It("measures lead time from the first upstream build", func() {
+			Expect(jobMetrics[0].LeadTime).To(Equal(time.Hour.Seconds()))
+
+			// the median of 7 hours, from the start of the test build, and 1
+			// hour, for the deployment without upstream builds
+			Expect(jobMetrics[1].LeadTime).To(Equal((4 * time.Hour).Seconds()))
+		})
+
+		It("measures the time from the first failure to the next success", func() {
+			Expect(jobMetrics[0].TimeToRecover).To(BeZero())
+			Expect(jobMetrics[1].TimeToRecover).To(Equal((4 * time.Hour).Seconds()))
+		})
+	})
+
+	Describe("BlockedBuilds", func() {
+		var (
+			pipeline      db.Pipeline
+			pendingBuild  db.Build
+			startedBuild  db.Build
+			erroredBuild  db.Build
+			blockedBuilds []db.Build
+		)
+
+		createBuild := func(jobName string) db.Build {
+			job, found, err := pipeline.Job(jobName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			return build
+		}
+
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "blocked-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "waiting"},
+					{Name: "over-quota"},
+					{Name: "no-workers"},
+					{Name: "recovered"},
+				},
+			}, db.ConfigVersion(1), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			pendingBuild = createBuild("waiting")
+			Expect(pendingBuild.SetPendingReason("max in flight reached")).To(Succeed())
+			createBuild("waiting")
+
+			startedBuild = createBuild("over-quota")
+			found, err := startedBuild.Start(atc.Plan{ID: "some-id"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(startedBuild.SetPendingReason("team quota of 10 containers reached")).To(Succeed())
+
+			erroredBuild = createBuild("no-workers")
+			Expect(erroredBuild.SetPendingReason("no workers satisfying: tag 'gpu'")).To(Succeed())
+			Expect(erroredBuild.Finish(db.BuildStatusErrored)).To(Succeed())
+
+			recoveredBuild := createBuild("recovered")
+			Expect(recoveredBuild.SetPendingReason("no workers satisfying: tag 'gpu'")).To(Succeed())
+			Expect(recoveredBuild.Finish(db.BuildStatusErrored)).To(Succeed())
+			Expect(createBuild("recovered").Finish(db.BuildStatusSucceeded)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			blockedBuilds, err = pipeline.BlockedBuilds()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns pending and started builds with a reason and the latest builds that errored with one", func() {
+			Expect(blockedBuilds).To(HaveLen(3))
+
+			Expect(blockedBuilds[0].ID()).To(Equal(pendingBuild.ID()))
+			Expect(blockedBuilds[0].JobName()).To(Equal("waiting"))
+			Expect(blockedBuilds[0].PendingReason()).To(Equal("max in flight reached"))
+
+			Expect(blockedBuilds[1].ID()).To(Equal(startedBuild.ID()))
+			Expect(blockedBuilds[1].JobName()).To(Equal("over-quota"))
+			Expect(blockedBuilds[1].PendingReason()).To(Equal("team quota of 10 containers reached"))
+
+			Expect(blockedBuilds[2].ID()).To(Equal(erroredBuild.ID()))
+			Expect(blockedBuilds[2].JobName()).To(Equal("no-workers"))
+			Expect(blockedBuilds[2].PendingReason()).To(Equal("no workers satisfying: tag 'gpu'"))
+		})
+	})
+
 	Describe("Variables", func() {
 		var (
 			fakeGlobalSecrets *credsfakes.FakeSecrets
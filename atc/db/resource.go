@@ -34,6 +34,7 @@ type Resource interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	ConsecutiveCheckFailures() int
 	Tags() atc.Tags
 	CheckSetupError() error
 	CheckError() error
@@ -96,6 +97,7 @@ var resourcesQuery = psql.Select(
 	"t.id",
 	"t.name",
 	"rs.check_error",
+	"rs.consecutive_check_failures",
 	"rp.version",
 	"rp.comment_text",
 	"rp.config",
@@ -117,6 +119,7 @@ type resource struct {
 	type_                 string
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	consecutiveFailures   int
 	checkSetupError       error
 	checkError            error
 	config                atc.ResourceConfig
@@ -170,6 +173,7 @@ func (r *resource) CheckEvery() string               { return r.config.CheckEver
 func (r *resource) CheckTimeout() string             { return r.config.CheckTimeout }
 func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
 func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
+func (r *resource) ConsecutiveCheckFailures() int    { return r.consecutiveFailures }
 func (r *resource) Tags() atc.Tags                   { return r.config.Tags }
 func (r *resource) CheckSetupError() error           { return r.checkSetupError }
 func (r *resource) CheckError() error                { return r.checkError }
@@ -867,17 +871,19 @@ func scanResource(r *resource, row scannable) error {
 		configBlob                                                               sql.NullString
 		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, pinnedVersion, pinComment sql.NullString
 		lastCheckStartTime, lastCheckEndTime                                     pq.NullTime
+		consecutiveFailures                                                      sql.NullInt64
 		pinnedThroughConfig                                                      sql.NullBool
 		pipelineInstanceVars                                                     sql.NullString
 	)
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &rcsCheckErr, &consecutiveFailures, &pinnedVersion, &pinComment, &pinnedThroughConfig)
 	if err != nil {
 		return err
 	}
 
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
+	r.consecutiveFailures = int(consecutiveFailures.Int64)
 
 	es := r.conn.EncryptionStrategy()
 
@@ -28,7 +28,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 			}
 
 			resourceCacheForJobBuild := func() (db.UsedResourceCache, db.Build) {
-				build, err := defaultJob.CreateBuild()
+				build, err := defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				return createResourceCacheWithUser(db.ForBuild(build.ID())), build
 			}
@@ -178,7 +178,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 							By("creating an image resource cache tied to the job in the second pipeline")
 							job, _, err := secondPipeline.Job("some-job")
 							Expect(err).ToNot(HaveOccurred())
-							build, err := job.CreateBuild()
+							build, err := job.CreateBuild("some-user")
 							Expect(err).ToNot(HaveOccurred())
 							resourceCache := createResourceCacheWithUser(db.ForBuild(build.ID()))
 
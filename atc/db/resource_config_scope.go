@@ -184,18 +184,22 @@ func (r *resourceConfigScope) LatestVersion() (ResourceConfigVersion, bool, erro
 	return rcv, true, nil
 }
 
+// SetCheckError records the outcome of a check, counting the checks that
+// have failed in a row since the last one that succeeded.
 func (r *resourceConfigScope) SetCheckError(cause error) error {
 	var err error
 
 	if cause == nil {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", nil).
+			Set("consecutive_check_failures", 0).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
 	} else {
 		_, err = psql.Update("resource_config_scopes").
 			Set("check_error", cause.Error()).
+			Set("consecutive_check_failures", sq.Expr("consecutive_check_failures + 1")).
 			Where(sq.Eq{"id": r.id}).
 			RunWith(r.conn).
 			Exec()
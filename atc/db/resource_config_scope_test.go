@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"errors"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -409,6 +410,27 @@ var _ = Describe("Resource Config Scope", func() {
 		})
 	})
 
+	Describe("SetCheckError", func() {
+		It("counts the checks that failed since the last one that succeeded", func() {
+			Expect(resourceScope.SetCheckError(errors.New("nope"))).To(Succeed())
+			Expect(resourceScope.SetCheckError(errors.New("nope"))).To(Succeed())
+
+			found, err := resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.CheckError()).To(MatchError("nope"))
+			Expect(resource.ConsecutiveCheckFailures()).To(Equal(2))
+
+			Expect(resourceScope.SetCheckError(nil)).To(Succeed())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(resource.CheckError()).ToNot(HaveOccurred())
+			Expect(resource.ConsecutiveCheckFailures()).To(BeZero())
+		})
+	})
+
 	Describe("AcquireResourceCheckingLock", func() {
 		var (
 			someResource        db.Resource
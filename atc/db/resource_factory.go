@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -13,6 +14,7 @@ type ResourceFactory interface {
 	Resource(int) (Resource, bool, error)
 	VisibleResources([]string) ([]Resource, error)
 	AllResources() ([]Resource, error)
+	StaleResources(lastCheckedBefore time.Time) ([]Resource, error)
 }
 
 type resourceFactory struct {
@@ -76,6 +78,26 @@ func (r *resourceFactory) AllResources() ([]Resource, error) {
 	return scanResources(rows, r.conn, r.lockFactory)
 }
 
+// StaleResources returns the resources of unpaused pipelines that haven't
+// been checked successfully since the given time, including those that have
+// never been checked successfully.
+func (r *resourceFactory) StaleResources(lastCheckedBefore time.Time) ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"p.paused": false}).
+		Where(sq.Or{
+			sq.Eq{"rs.last_check_end_time": nil},
+			sq.Lt{"rs.last_check_end_time": lastCheckedBefore},
+		}).
+		OrderBy("r.id ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, r.conn, r.lockFactory)
+}
+
 func scanResources(resourceRows *sql.Rows, conn Conn, lockFactory lock.LockFactory) ([]Resource, error) {
 	var resources []Resource
 
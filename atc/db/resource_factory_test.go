@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
@@ -77,4 +79,56 @@ var _ = Describe("Resource Factory", func() {
 			})
 		})
 	})
+
+	Describe("StaleResources", func() {
+		var someResource db.Resource
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			someResource, found, err = defaultPipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("returns resources that have never been checked successfully", func() {
+			staleResources, err := resourceFactory.StaleResources(time.Now())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(staleResources).To(HaveLen(1))
+			Expect(staleResources[0].Name()).To(Equal("some-resource"))
+		})
+
+		Context("when the resource has been checked successfully", func() {
+			BeforeEach(func() {
+				scope, err := someResource.SetResourceConfig(someResource.Source(), atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = scope.UpdateLastCheckEndTime()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns it once the check is older than the given time", func() {
+				staleResources, err := resourceFactory.StaleResources(time.Now().Add(-time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(staleResources).To(BeEmpty())
+
+				staleResources, err = resourceFactory.StaleResources(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(staleResources).To(HaveLen(1))
+			})
+		})
+
+		Context("when the pipeline is paused", func() {
+			BeforeEach(func() {
+				Expect(defaultPipeline.Pause()).To(Succeed())
+			})
+
+			It("does not return its resources", func() {
+				staleResources, err := resourceFactory.StaleResources(time.Now())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(staleResources).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -28,6 +28,8 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	PolicyProfile() string
+	Quotas() atc.TeamQuotas
 
 	Delete() error
 	Rename(string) error
@@ -45,7 +47,7 @@ type Team interface {
 	OrderPipelines([]atc.PipelineRef) error
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
 	Builds(page Page) ([]Build, Pagination, error)
@@ -67,6 +69,8 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdatePolicyProfile(profile string) error
+	UpdateQuotas(quotas atc.TeamQuotas) error
 }
 
 type team struct {
@@ -78,6 +82,9 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	policyProfile string
+	quotas        atc.TeamQuotas
 }
 
 func (t *team) ID() int      { return t.id }
@@ -86,6 +93,10 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) PolicyProfile() string { return t.policyProfile }
+
+func (t *team) Quotas() atc.TeamQuotas { return t.quotas }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -377,6 +388,13 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	if !existingConfig {
+		err = checkQuota(tx, teamID, QuotaPipelines)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
 	groupsPayload, err := json.Marshal(config.Groups)
 	if err != nil {
 		return 0, false, err
@@ -712,7 +730,7 @@ func (t *team) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (t *team) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -740,6 +758,7 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   createdBy,
 	})
 	if err != nil {
 		return nil, err
@@ -821,7 +840,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, policy_profile, quotas
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -831,6 +850,41 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) UpdatePolicyProfile(profile string) error {
+	_, err := psql.Update("teams").
+		Set("policy_profile", sq.Expr("NULLIF(?, '')", profile)).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.policyProfile = profile
+
+	return nil
+}
+
+func (t *team) UpdateQuotas(quotas atc.TeamQuotas) error {
+	payload, err := marshalTeamQuotas(quotas)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("teams").
+		Set("quotas", payload).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.quotas = quotas
+
+	return nil
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1377,7 +1431,7 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, nonce, policyProfile, quotas sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
@@ -1385,11 +1439,20 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&policyProfile,
+		&quotas,
 	)
 	if err != nil {
 		return err
 	}
 
+	t.policyProfile = policyProfile.String
+
+	t.quotas, err = unmarshalTeamQuotas(quotas)
+	if err != nil {
+		return err
+	}
+
 	if providerAuth.Valid {
 		var auth atc.TeamAuth
 		err = json.Unmarshal([]byte(providerAuth.String), &auth)
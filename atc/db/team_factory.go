@@ -52,10 +52,18 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var quotas sql.NullString
+	if t.Quotas != nil {
+		quotas, err = marshalTeamQuotas(*t.Quotas)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, policy_profile, quotas").
+		Values(t.Name, auth, admin, sq.Expr("NULLIF(?, '')", t.PolicyProfile), quotas).
+		Suffix("RETURNING id, name, admin, auth, policy_profile, quotas").
 		RunWith(tx).
 		QueryRow()
 
@@ -91,7 +99,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, policy_profile, quotas").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +118,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, policy_profile, quotas").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,14 +184,26 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, policyProfile, quotas sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&policyProfile,
+		&quotas,
 	)
+	if err != nil {
+		return err
+	}
+
+	t.policyProfile = policyProfile.String
+
+	t.quotas, err = unmarshalTeamQuotas(quotas)
+	if err != nil {
+		return err
+	}
 
 	if providerAuth.Valid {
 		err = json.Unmarshal([]byte(providerAuth.String), &t.auth)
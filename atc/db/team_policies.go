@@ -0,0 +1,199 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrPolicyExemptionTeamNotFound = errors.New("team of policy exemption not found")
+
+//go:generate counterfeiter . TeamPolicies
+
+// TeamPolicies stores the policy metadata of teams: their policy profile, and
+// the exemptions that let their actions through policies until they expire.
+type TeamPolicies interface {
+	PolicyProfile(teamName string) (string, error)
+
+	CreateExemption(atc.PolicyExemption) (atc.PolicyExemption, error)
+	ActiveExemptions() ([]atc.PolicyExemption, error)
+	ActiveExemption(teamName string, action string) (atc.PolicyExemption, bool, error)
+	DeleteExemption(id int) (bool, error)
+}
+
+type teamPolicies struct {
+	conn  Conn
+	clock Clock
+}
+
+func NewTeamPolicies(conn Conn, clock Clock) TeamPolicies {
+	return &teamPolicies{
+		conn:  conn,
+		clock: clock,
+	}
+}
+
+var exemptionsQuery = psql.Select(
+	"e.id",
+	"t.name",
+	"e.action",
+	"e.reason",
+	"e.created_by",
+	"e.created_at",
+	"e.expires_at",
+).
+	From("policy_exemptions e").
+	Join("teams t ON t.id = e.team_id")
+
+func (p *teamPolicies) PolicyProfile(teamName string) (string, error) {
+	var profile sql.NullString
+	err := psql.Select("policy_profile").
+		From("teams").
+		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&profile)
+	if err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+
+	return profile.String, nil
+}
+
+func (p *teamPolicies) CreateExemption(exemption atc.PolicyExemption) (atc.PolicyExemption, error) {
+	var id int
+	var createdAt time.Time
+	err := psql.Insert("policy_exemptions").
+		Columns("team_id", "action", "reason", "created_by", "expires_at").
+		Select(
+			psql.Select("id").
+				Column("?::text", exemption.Action).
+				Column("?::text", exemption.Reason).
+				Column("?::text", exemption.CreatedBy).
+				Column("?::timestamptz", time.Unix(exemption.ExpiresAt, 0)).
+				From("teams").
+				Where(sq.Eq{"LOWER(name)": strings.ToLower(exemption.Team)}),
+		).
+		Suffix("RETURNING id, created_at").
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&id, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.PolicyExemption{}, ErrPolicyExemptionTeamNotFound
+		}
+
+		return atc.PolicyExemption{}, err
+	}
+
+	exemption.ID = id
+	exemption.CreatedAt = createdAt.Unix()
+
+	return exemption, p.notifyCacher()
+}
+
+func (p *teamPolicies) ActiveExemptions() ([]atc.PolicyExemption, error) {
+	rows, err := exemptionsQuery.
+		Where(sq.Gt{"e.expires_at": p.clock.Now()}).
+		OrderBy("t.name", "e.expires_at").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	exemptions := []atc.PolicyExemption{}
+	for rows.Next() {
+		exemption, err := scanExemption(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		exemptions = append(exemptions, exemption)
+	}
+
+	return exemptions, nil
+}
+
+// ActiveExemption finds an unexpired exemption of the team for the action,
+// or for all its actions. If several apply, the one expiring last is returned.
+func (p *teamPolicies) ActiveExemption(teamName string, action string) (atc.PolicyExemption, bool, error) {
+	row := exemptionsQuery.
+		Where(sq.Eq{
+			"LOWER(t.name)": strings.ToLower(teamName),
+			"e.action":      []string{action, atc.PolicyExemptionAllActions},
+		}).
+		Where(sq.Gt{"e.expires_at": p.clock.Now()}).
+		OrderBy("e.expires_at DESC").
+		Limit(1).
+		RunWith(p.conn).
+		QueryRow()
+
+	exemption, err := scanExemption(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.PolicyExemption{}, false, nil
+		}
+
+		return atc.PolicyExemption{}, false, err
+	}
+
+	return exemption, true, nil
+}
+
+func (p *teamPolicies) DeleteExemption(id int) (bool, error) {
+	result, err := psql.Delete("policy_exemptions").
+		Where(sq.Eq{"id": id}).
+		RunWith(p.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	return true, p.notifyCacher()
+}
+
+// notifyCacher clears the caches of team policies, so that policy checks see
+// the exemption right away.
+func (p *teamPolicies) notifyCacher() error {
+	return p.conn.Bus().Notify(atc.TeamCacheChannel)
+}
+
+func scanExemption(scan scannable) (atc.PolicyExemption, error) {
+	var exemption atc.PolicyExemption
+	var createdAt, expiresAt time.Time
+
+	err := scan.Scan(
+		&exemption.ID,
+		&exemption.Team,
+		&exemption.Action,
+		&exemption.Reason,
+		&exemption.CreatedBy,
+		&createdAt,
+		&expiresAt,
+	)
+	if err != nil {
+		return atc.PolicyExemption{}, err
+	}
+
+	exemption.CreatedAt = createdAt.Unix()
+	exemption.ExpiresAt = expiresAt.Unix()
+
+	return exemption, nil
+}
@@ -0,0 +1,148 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamPolicies", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+
+		fakeClock = dbfakes.FakeClock{}
+		fakeClock.NowReturns(now)
+	})
+
+	Describe("PolicyProfile", func() {
+		It("returns an empty profile by default", func() {
+			profile, err := teamPolicies.PolicyProfile("default-team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profile).To(BeEmpty())
+		})
+
+		Context("when the team has a profile", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdatePolicyProfile("strict")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the profile", func() {
+				profile, err := teamPolicies.PolicyProfile("default-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(profile).To(Equal("strict"))
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			It("returns an empty profile", func() {
+				profile, err := teamPolicies.PolicyProfile("bogus-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(profile).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("exemptions", func() {
+		var created atc.PolicyExemption
+
+		BeforeEach(func() {
+			var err error
+			created, err = teamPolicies.CreateExemption(atc.PolicyExemption{
+				Team:      "default-team",
+				Action:    "RunStep",
+				Reason:    "migrating",
+				CreatedBy: "some-admin",
+				ExpiresAt: now.Add(time.Hour).Unix(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("assigns an id and creation time", func() {
+			Expect(created.ID).ToNot(BeZero())
+			Expect(created.CreatedAt).ToNot(BeZero())
+		})
+
+		It("lists the exemption while it is active", func() {
+			exemptions, err := teamPolicies.ActiveExemptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exemptions).To(Equal([]atc.PolicyExemption{created}))
+
+			fakeClock.NowReturns(now.Add(2 * time.Hour))
+
+			exemptions, err = teamPolicies.ActiveExemptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exemptions).To(BeEmpty())
+		})
+
+		It("finds the exemption for its action only", func() {
+			exemption, found, err := teamPolicies.ActiveExemption("default-team", "RunStep")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(exemption).To(Equal(created))
+
+			_, found, err = teamPolicies.ActiveExemption("default-team", "SaveConfig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when an exemption covers all actions", func() {
+			BeforeEach(func() {
+				_, err := teamPolicies.CreateExemption(atc.PolicyExemption{
+					Team:      "default-team",
+					Action:    atc.PolicyExemptionAllActions,
+					Reason:    "incident",
+					ExpiresAt: now.Add(2 * time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("applies to any action, preferring the one expiring last", func() {
+				exemption, found, err := teamPolicies.ActiveExemption("default-team", "RunStep")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(exemption.Reason).To(Equal("incident"))
+			})
+		})
+
+		It("can be deleted", func() {
+			deleted, err := teamPolicies.DeleteExemption(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			deleted, err = teamPolicies.DeleteExemption(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+
+		It("tells the ATCs to forget the cached exemptions when one is deleted", func() {
+			notifier, err := dbConn.Bus().Listen(atc.TeamCacheChannel)
+			Expect(err).ToNot(HaveOccurred())
+			defer dbConn.Bus().Unlisten(atc.TeamCacheChannel, notifier)
+
+			_, err = teamPolicies.DeleteExemption(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(notifier).Should(Receive())
+		})
+
+		Context("when the team does not exist", func() {
+			It("returns an error", func() {
+				_, err := teamPolicies.CreateExemption(atc.PolicyExemption{
+					Team:      "bogus-team",
+					Action:    "RunStep",
+					Reason:    "migrating",
+					ExpiresAt: now.Add(time.Hour).Unix(),
+				})
+				Expect(err).To(Equal(db.ErrPolicyExemptionTeamNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,231 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Quota names one of the limits of atc.TeamQuotas.
+type Quota string
+
+const (
+	QuotaPipelines     Quota = "pipelines"
+	QuotaRunningBuilds Quota = "running builds"
+	QuotaContainers    Quota = "containers"
+	QuotaVolumes       Quota = "volumes"
+)
+
+// QuotaReachedError is returned when a team already uses as much of a quota
+// as it is allowed to.
+type QuotaReachedError struct {
+	Quota Quota
+	Limit int
+
+	// ReachedByBuild is set when the build creating a container uses the whole
+	// quota by itself, so it would wait on itself forever.
+	ReachedByBuild bool
+}
+
+func (err QuotaReachedError) Error() string {
+	if err.ReachedByBuild {
+		return fmt.Sprintf("build uses all of the team quota of %d %s", err.Limit, err.Quota)
+	}
+
+	return fmt.Sprintf("team quota of %d %s reached", err.Limit, err.Quota)
+}
+
+//go:generate counterfeiter . QuotaChecker
+
+// QuotaChecker checks the usage of teams against their quotas.
+type QuotaChecker interface {
+	// CheckQuota returns a QuotaReachedError if the team can't use any more of
+	// the quota.
+	CheckQuota(teamID int, quota Quota) error
+
+	// CheckContainerQuota is CheckQuota for a container the owner is about to
+	// create. Check containers are exempt, as resources would stop being
+	// checked otherwise.
+	CheckContainerQuota(teamID int, owner ContainerOwner, quota Quota) error
+}
+
+type quotaChecker struct {
+	conn Conn
+}
+
+func NewQuotaChecker(conn Conn) QuotaChecker {
+	return &quotaChecker{
+		conn: conn,
+	}
+}
+
+func (c *quotaChecker) CheckQuota(teamID int, quota Quota) error {
+	return checkQuota(c.conn, teamID, quota)
+}
+
+func (c *quotaChecker) CheckContainerQuota(teamID int, owner ContainerOwner, quota Quota) error {
+	switch owner.(type) {
+	case resourceConfigCheckSessionContainerOwner, imageCheckContainerOwner:
+		return nil
+	}
+
+	err := checkQuota(c.conn, teamID, quota)
+
+	var reached QuotaReachedError
+	if !errors.As(err, &reached) {
+		return err
+	}
+
+	buildOwner, ok := owner.(buildStepContainerOwner)
+	if !ok {
+		return err
+	}
+
+	usage, err := buildUsage(c.conn, buildOwner.BuildID, quota)
+	if err != nil {
+		return err
+	}
+
+	reached.ReachedByBuild = usage >= reached.Limit
+
+	return reached
+}
+
+// checkQuota counts what the team uses of the quota. The count and the
+// creation that follows it aren't atomic, so concurrent creations may go a
+// little over the quota.
+func checkQuota(runner sq.Runner, teamID int, quota Quota) error {
+	var payload sql.NullString
+	err := psql.Select("quotas").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	quotas, err := unmarshalTeamQuotas(payload)
+	if err != nil {
+		return err
+	}
+
+	var limit int
+	usage := psql.Select("COUNT(*)").Where(sq.Eq{"team_id": teamID})
+
+	switch quota {
+	case QuotaPipelines:
+		limit = quotas.MaxPipelines
+		usage = usage.From("pipelines")
+	case QuotaRunningBuilds:
+		limit = quotas.MaxRunningBuilds
+		// only job builds count, as check builds and one-off builds aren't
+		// held back by the quota
+		usage = usage.From("builds").Where(sq.And{
+			sq.Eq{"status": BuildStatusStarted},
+			sq.NotEq{"job_id": nil},
+		})
+	case QuotaContainers:
+		limit = quotas.MaxContainers
+		// containers and volumes on their way out don't count, so that a team
+		// at its quota doesn't have to wait for them to be collected
+		usage = usage.From("containers").Where(sq.Eq{
+			"state": []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+		})
+	case QuotaVolumes:
+		limit = quotas.MaxVolumes
+		usage = usage.From("volumes").Where(sq.Eq{
+			"state": []string{string(VolumeStateCreating), string(VolumeStateCreated)},
+		})
+	default:
+		return fmt.Errorf("unknown quota: %s", quota)
+	}
+
+	if limit == 0 {
+		return nil
+	}
+
+	var count int
+	err = usage.RunWith(runner).QueryRow().Scan(&count)
+	if err != nil {
+		return err
+	}
+
+	if count >= limit {
+		return QuotaReachedError{
+			Quota: quota,
+			Limit: limit,
+		}
+	}
+
+	return nil
+}
+
+// buildUsage counts what a build itself uses of a container or volume quota.
+func buildUsage(runner sq.Runner, buildID int, quota Quota) (int, error) {
+	var usage sq.SelectBuilder
+	switch quota {
+	case QuotaContainers:
+		usage = psql.Select("COUNT(*)").
+			From("containers c")
+	case QuotaVolumes:
+		usage = psql.Select("COUNT(*)").
+			From("volumes v").
+			Join("containers c ON v.container_id = c.id").
+			Where(sq.Eq{"v.state": []string{string(VolumeStateCreating), string(VolumeStateCreated)}})
+	default:
+		return 0, fmt.Errorf("unknown build quota: %s", quota)
+	}
+
+	var count int
+	err := usage.
+		Where(sq.Eq{
+			"c.build_id": buildID,
+			"c.state":    []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func marshalTeamQuotas(quotas atc.TeamQuotas) (sql.NullString, error) {
+	if quotas == (atc.TeamQuotas{}) {
+		return sql.NullString{}, nil
+	}
+
+	payload, err := json.Marshal(quotas)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(payload), Valid: true}, nil
+}
+
+func unmarshalTeamQuotas(payload sql.NullString) (atc.TeamQuotas, error) {
+	var quotas atc.TeamQuotas
+	if !payload.Valid {
+		return quotas, nil
+	}
+
+	err := json.Unmarshal([]byte(payload.String), &quotas)
+	if err != nil {
+		return atc.TeamQuotas{}, err
+	}
+
+	return quotas, nil
+}
@@ -0,0 +1,152 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaChecker", func() {
+	Describe("CheckQuota", func() {
+		It("allows teams without quotas", func() {
+			Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaPipelines)).To(Succeed())
+			Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaRunningBuilds)).To(Succeed())
+			Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaContainers)).To(Succeed())
+			Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaVolumes)).To(Succeed())
+		})
+
+		Context("when the team has a running builds quota", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxRunningBuilds: 1})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("allows builds until the quota is used up", func() {
+				Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaRunningBuilds)).To(Succeed())
+
+				build, err := defaultJob.CreateBuild("some-user")
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				err = quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaRunningBuilds)
+				Expect(err).To(Equal(db.QuotaReachedError{
+					Quota: db.QuotaRunningBuilds,
+					Limit: 1,
+				}))
+				Expect(err.Error()).To(Equal("team quota of 1 running builds reached"))
+			})
+
+			It("doesn't count one-off builds", func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaRunningBuilds)).To(Succeed())
+			})
+
+			It("doesn't limit other quotas", func() {
+				Expect(quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaPipelines)).To(Succeed())
+			})
+		})
+
+		Context("when the team has a pipelines quota", func() {
+			BeforeEach(func() {
+				err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxPipelines: 1})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("reports the quota as reached", func() {
+				err := quotaChecker.CheckQuota(defaultTeam.ID(), db.QuotaPipelines)
+				Expect(err).To(Equal(db.QuotaReachedError{
+					Quota: db.QuotaPipelines,
+					Limit: 1,
+				}))
+			})
+
+			It("refuses to save new pipelines", func() {
+				_, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, atc.Config{}, db.ConfigVersion(0), false)
+				Expect(err).To(Equal(db.QuotaReachedError{
+					Quota: db.QuotaPipelines,
+					Limit: 1,
+				}))
+			})
+
+			It("still updates existing pipelines", func() {
+				_, _, err := defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, defaultPipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CheckContainerQuota", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			err := defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxContainers: 2})
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		createContainer := func(build db.Build, planID atc.PlanID) {
+			_, err := defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), planID, defaultTeam.ID()),
+				db.ContainerMetadata{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("allows containers until the quota is used up", func() {
+			owner := db.NewBuildStepContainerOwner(build.ID(), "3", defaultTeam.ID())
+			Expect(quotaChecker.CheckContainerQuota(defaultTeam.ID(), owner, db.QuotaContainers)).To(Succeed())
+
+			otherBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			createContainer(build, "1")
+			createContainer(otherBuild, "1")
+
+			err = quotaChecker.CheckContainerQuota(defaultTeam.ID(), owner, db.QuotaContainers)
+			Expect(err).To(Equal(db.QuotaReachedError{
+				Quota: db.QuotaContainers,
+				Limit: 2,
+			}))
+		})
+
+		It("reports when the build uses the whole quota by itself", func() {
+			createContainer(build, "1")
+			createContainer(build, "2")
+
+			owner := db.NewBuildStepContainerOwner(build.ID(), "3", defaultTeam.ID())
+			err := quotaChecker.CheckContainerQuota(defaultTeam.ID(), owner, db.QuotaContainers)
+			Expect(err).To(Equal(db.QuotaReachedError{
+				Quota:          db.QuotaContainers,
+				Limit:          2,
+				ReachedByBuild: true,
+			}))
+			Expect(err.Error()).To(Equal("build uses all of the team quota of 2 containers"))
+		})
+
+		It("doesn't hold back check containers", func() {
+			createContainer(build, "1")
+			createContainer(build, "2")
+
+			owner := db.NewResourceConfigCheckSessionContainerOwner(1, 1, db.ContainerOwnerExpiries{
+				Min: time.Minute,
+				Max: time.Hour,
+			})
+			Expect(quotaChecker.CheckContainerQuota(defaultTeam.ID(), owner, db.QuotaContainers)).To(Succeed())
+		})
+	})
+})
@@ -284,7 +284,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			metaContainers = make(map[db.ContainerMetadata][]db.Container)
@@ -410,7 +410,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				firstContainerCreating, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -659,7 +659,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -845,6 +845,45 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("UpdateQuotas", func() {
+		quotas := atc.TeamQuotas{MaxPipelines: 5, MaxRunningBuilds: 2}
+
+		It("saves the quotas of the team", func() {
+			err := team.UpdateQuotas(quotas)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.Quotas()).To(Equal(quotas))
+
+			foundTeam, found, err := teamFactory.FindTeam(team.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(foundTeam.Quotas()).To(Equal(quotas))
+		})
+
+		It("keeps the quotas when updating auth", func() {
+			err := team.UpdateQuotas(quotas)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = team.UpdateProviderAuth(atc.TeamAuth{
+				"owner": {"users": []string{"local:username"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.Quotas()).To(Equal(quotas))
+		})
+
+		It("clears the quotas when they are all unlimited", func() {
+			err := team.UpdateQuotas(quotas)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = team.UpdateQuotas(atc.TeamQuotas{})
+			Expect(err).ToNot(HaveOccurred())
+
+			var payload sql.NullString
+			err = dbConn.QueryRow("SELECT quotas FROM teams WHERE id = $1", team.ID()).Scan(&payload)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payload.Valid).To(BeFalse())
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -1058,7 +1097,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			startedBuild, err = team.CreateStartedBuild(plan)
+			startedBuild, err = team.CreateStartedBuild(plan, "some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1078,6 +1117,14 @@ var _ = Describe("Team", func() {
 			Expect(startedBuild.PublicPlan()).To(Equal(plan.Public()))
 		})
 
+		It("records who triggered the build", func() {
+			found, err := startedBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(startedBuild.CreatedBy()).ToNot(BeNil())
+			Expect(*startedBuild.CreatedBy()).To(Equal("some-user"))
+		})
+
 		It("creates Start event", func() {
 			found, err := startedBuild.Reload()
 			Expect(err).NotTo(HaveOccurred())
@@ -1135,7 +1182,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 
 				for i := 3; i < 5; i++ {
-					build, err := job.CreateBuild()
+					build, err := job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 					allBuilds[i] = build
 					pipelineBuilds[i-3] = build
@@ -1286,7 +1333,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -1391,11 +1438,11 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err = job.CreateBuild()
+			secondBuild, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1403,7 +1450,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			thirdBuild, err = someOtherJob.CreateBuild()
+			thirdBuild, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, thirdBuild)
 		})
@@ -3519,7 +3566,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -266,6 +266,31 @@ func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID
 	return version, true, err
 }
 
+func (versions VersionsDB) VersionOfResource(ctx context.Context, resourceID int, versionMD5 ResourceVersion) (atc.Version, bool, error) {
+	var versionJSON []byte
+	err := versions.conn.QueryRowContext(ctx, `
+		SELECT v.version
+		FROM resource_config_versions v
+		JOIN resources r ON r.resource_config_scope_id = v.resource_config_scope_id
+		WHERE r.id = $1
+		AND v.version_md5 = $2`, resourceID, versionMD5).
+		Scan(&versionJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var version atc.Version
+	err = json.Unmarshal(versionJSON, &version)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return version, true, nil
+}
+
 func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int) (ResourceVersion, bool, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
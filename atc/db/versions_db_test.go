@@ -43,7 +43,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.Finish(db.BuildStatusSucceeded)
@@ -70,7 +70,7 @@ var _ = Describe("VersionsDB", func() {
 				builds = []db.Build{}
 
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -106,38 +106,38 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build6Succeeded, err = defaultJob.CreateBuild()
+				build6Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -197,32 +197,32 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build6Succeeded, err = defaultJob.CreateBuild()
+				build6Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -268,7 +268,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Failed, err = defaultJob.CreateBuild()
+				build1Failed, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
@@ -276,7 +276,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -285,7 +285,7 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -318,7 +318,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -326,7 +326,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -335,7 +335,7 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Succeeded)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Succeeded, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -375,7 +375,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Failed, err = defaultJob.CreateBuild()
+				build1Failed, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
@@ -383,7 +383,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -392,17 +392,17 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build7Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build7Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build7Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build8Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build8Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build8Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -458,7 +458,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -490,7 +490,7 @@ var _ = Describe("VersionsDB", func() {
 			BeforeEach(func() {
 				olderBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -500,7 +500,7 @@ var _ = Describe("VersionsDB", func() {
 				}
 
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -512,7 +512,7 @@ var _ = Describe("VersionsDB", func() {
 
 				newerBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -558,7 +558,7 @@ var _ = Describe("VersionsDB", func() {
 			BeforeEach(func() {
 				olderBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -568,7 +568,7 @@ var _ = Describe("VersionsDB", func() {
 				}
 
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -580,7 +580,7 @@ var _ = Describe("VersionsDB", func() {
 
 				newerBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -591,7 +591,7 @@ var _ = Describe("VersionsDB", func() {
 
 				rerunBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.RerunBuild(cursorBuild)
+					build, err := defaultJob.RerunBuild(cursorBuild, "some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -646,27 +646,27 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -679,12 +679,12 @@ var _ = Describe("VersionsDB", func() {
 					},
 				}
 
-				build6Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build6Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, "some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build7Succeeded, err = defaultJob.CreateBuild()
+				build7Succeeded, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				err = build7Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -764,4 +764,47 @@ var _ = Describe("VersionsDB", func() {
 			})
 		})
 	})
+
+	Describe("VersionOfResource", func() {
+		var (
+			dbVersion atc.Version
+			version   atc.Version
+			found     bool
+		)
+
+		BeforeEach(func() {
+			dbVersion = atc.Version{"tag": "v1", "commit": "v2"}
+
+			resourceScope, err := defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = resourceScope.SaveVersions(db.NewSpanContext(ctx), []atc.Version{dbVersion})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the version exists", func() {
+			JustBeforeEach(func() {
+				var err error
+				version, found, err = vdb.VersionOfResource(ctx, defaultResource.ID(), db.ResourceVersion(convertToMD5(dbVersion)))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the version", func() {
+				Expect(found).To(BeTrue())
+				Expect(version).To(Equal(dbVersion))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			JustBeforeEach(func() {
+				var err error
+				version, found, err = vdb.VersionOfResource(ctx, defaultResource.ID(), db.ResourceVersion(convertToMD5(atc.Version{"tag": "v2"})))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not find it", func() {
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
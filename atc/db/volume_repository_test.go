@@ -307,7 +307,7 @@ var _ = Describe("VolumeRepository", func() {
 
 		Context("when worker is landed", func() {
 			BeforeEach(func() {
-				err := defaultWorker.Land()
+				err := defaultWorker.Land(0)
 				Expect(err).NotTo(HaveOccurred())
 				landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
 				Expect(err).NotTo(HaveOccurred())
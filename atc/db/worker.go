@@ -69,10 +69,11 @@ type Worker interface {
 	StartTime() time.Time
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	DrainDeadline() time.Time
 
 	Reload() (bool, error)
 
-	Land() error
+	Land(drainTimeout time.Duration) error
 	Retire() error
 	Prune() error
 	Delete() error
@@ -108,6 +109,7 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+	drainDeadline    time.Time
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -129,8 +131,9 @@ func (worker *worker) TeamID() int                             { return worker.t
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
 
-func (worker *worker) StartTime() time.Time { return worker.startTime }
-func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
+func (worker *worker) StartTime() time.Time     { return worker.startTime }
+func (worker *worker) ExpiresAt() time.Time     { return worker.expiresAt }
+func (worker *worker) DrainDeadline() time.Time { return worker.drainDeadline }
 
 func (worker *worker) Reload() (bool, error) {
 	row := workersQuery.Where(sq.Eq{"w.name": worker.name}).
@@ -148,7 +151,10 @@ func (worker *worker) Reload() (bool, error) {
 	return true, nil
 }
 
-func (worker *worker) Land() error {
+// Land transitions the worker to 'landing'. If drainTimeout is non-zero, the
+// worker will be landed once it elapses, even if builds are still running on
+// it.
+func (worker *worker) Land(drainTimeout time.Duration) error {
 	cSQL, _, err := sq.Case("state").
 		When("'landed'::worker_state", "'landed'::worker_state").
 		Else("'landing'::worker_state").
@@ -157,9 +163,16 @@ func (worker *worker) Land() error {
 		return err
 	}
 
-	result, err := psql.Update("workers").
+	update := psql.Update("workers").
 		Set("state", sq.Expr("("+cSQL+")")).
-		Where(sq.Eq{"name": worker.name}).
+		Where(sq.Eq{"name": worker.name})
+
+	// landing again without a timeout keeps the deadline that was already set
+	if drainTimeout != 0 {
+		update = update.Set("drain_deadline", sq.Expr("NOW() + make_interval(secs => ?)", drainTimeout.Seconds()))
+	}
+
+	result, err := update.
 		RunWith(worker.conn).
 		Exec()
 
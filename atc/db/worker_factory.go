@@ -23,6 +23,7 @@ type WorkerFactory interface {
 
 	FindWorkersForContainerByOwner(ContainerOwner) ([]Worker, error)
 	BuildContainersCountPerWorker() (map[string]int, error)
+	DrainingBuildsCountPerWorker() (map[string]int, error)
 }
 
 type workerFactory struct {
@@ -54,7 +55,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.drain_deadline
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -140,6 +142,7 @@ func scanWorker(worker *worker, row scannable) error {
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+		drainDeadline pq.NullTime
 	)
 
 	err := row.Scan(
@@ -162,6 +165,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&drainDeadline,
 	)
 	if err != nil {
 		return err
@@ -186,6 +190,7 @@ func scanWorker(worker *worker, row scannable) error {
 	worker.state = WorkerState(state)
 	worker.startTime = startTime.Time
 	worker.expiresAt = expiresAt.Time
+	worker.drainDeadline = drainDeadline.Time
 
 	if httpProxyURL.Valid {
 		worker.httpProxyURL = httpProxyURL.String
@@ -364,6 +369,49 @@ func (f *workerFactory) BuildContainersCountPerWorker() (map[string]int, error)
 	return countByWorker, nil
 }
 
+// DrainingBuildsCountPerWorker counts the running builds which prevent each
+// landing or retiring worker from finishing: those with containers on it,
+// except builds of interruptible jobs.
+func (f *workerFactory) DrainingBuildsCountPerWorker() (map[string]int, error) {
+	rows, err := psql.Select("c.worker_name, COUNT(DISTINCT b.id)").
+		From("builds b").
+		Join("containers c ON b.id = c.build_id").
+		Join("workers w ON w.name = c.worker_name").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{
+			"w.state":     []string{string(WorkerStateLanding), string(WorkerStateRetiring)},
+			"b.completed": false,
+		}).
+		Where(sq.Or{
+			sq.Eq{"j.interruptible": false},
+			sq.Eq{"b.job_id": nil},
+		}).
+		GroupBy("c.worker_name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	countByWorker := make(map[string]int)
+
+	for rows.Next() {
+		var workerName string
+		var buildsCount int
+
+		err = rows.Scan(&workerName, &buildsCount)
+		if err != nil {
+			return nil, err
+		}
+
+		countByWorker[workerName] = buildsCount
+	}
+
+	return countByWorker, nil
+}
+
 func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, conn Conn) (Worker, error) {
 	resourceTypes, err := json.Marshal(atcWorker.ResourceTypes)
 	if err != nil {
@@ -466,7 +514,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				drain_deadline = NULL
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -798,4 +798,31 @@ var _ = Describe("WorkerFactory", func() {
 			Expect(containersCountByWorker[worker.Name()]).To(Equal(1))
 		})
 	})
+
+	Describe("DrainingBuildsCountPerWorker", func() {
+		BeforeEach(func() {
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			owner := db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID())
+
+			_, err = defaultWorker.CreateContainer(owner, db.ContainerMetadata{Type: "task"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = worker.CreateContainer(owner, db.ContainerMetadata{Type: "task"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = defaultWorker.Land(0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the running builds of landing workers only", func() {
+			countByWorker, err := workerFactory.DrainingBuildsCountPerWorker()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countByWorker).To(Equal(map[string]int{defaultWorker.Name(): 1}))
+		})
+	})
 })
@@ -12,6 +12,7 @@ type WorkerLifecycle interface {
 	DeleteUnresponsiveEphemeralWorkers() ([]string, error)
 	StallUnresponsiveWorkers() ([]string, error)
 	LandFinishedLandingWorkers() ([]string, error)
+	LandExpiredLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
 	GetWorkerStateByName() (map[string]WorkerState, error)
 }
@@ -147,6 +148,7 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 		Set("state", string(WorkerStateLanded)).
 		Set("addr", nil).
 		Set("baggageclaim_url", nil).
+		Set("drain_deadline", nil).
 		Where(sq.Eq{
 			"state": string(WorkerStateLanding),
 		}).
@@ -167,6 +169,33 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// LandExpiredLandingWorkers lands the workers whose drain deadline has passed
+// regardless of the builds still running on them. Their registrations then
+// exit, so that the builds are interrupted and, if enabled, rerun elsewhere.
+func (lifecycle *workerLifecycle) LandExpiredLandingWorkers() ([]string, error) {
+	query, args, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"state":            string(WorkerStateLanded),
+			"addr":             nil,
+			"baggageclaim_url": nil,
+			"drain_deadline":   nil,
+		}).
+		Where(sq.Eq{"state": string(WorkerStateLanding)}).
+		Where(sq.Expr("drain_deadline < NOW()")).
+		Suffix("RETURNING name").
+		ToSql()
+	if err != nil {
+		return []string{}, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
 func (lifecycle *workerLifecycle) GetWorkerStateByName() (map[string]WorkerState, error) {
 	rows, err := psql.Select(`
 		name,
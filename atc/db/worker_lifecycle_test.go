@@ -239,7 +239,7 @@ var _ = Describe("Worker Lifecycle", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					dbBuild, err = job.CreateBuild()
+					dbBuild, err = job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -271,7 +271,7 @@ var _ = Describe("Worker Lifecycle", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					dbBuild, err = job.CreateBuild()
+					dbBuild, err = job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -306,6 +306,96 @@ var _ = Describe("Worker Lifecycle", func() {
 		})
 	})
 
+	Describe("LandExpiredLandingWorkers", func() {
+		var dbWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the drain deadline of a landing worker has passed", func() {
+			BeforeEach(func() {
+				err := dbWorker.Land(-time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("lands the worker", func() {
+				landedWorkers, err := workerLifecycle.LandExpiredLandingWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(landedWorkers).To(ConsistOf(atcWorker.Name))
+
+				foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundWorker.State()).To(Equal(db.WorkerStateLanded))
+				Expect(foundWorker.GardenAddr()).To(BeNil())
+				Expect(foundWorker.DrainDeadline()).To(BeZero())
+			})
+
+			Context("when a build is still running on the worker", func() {
+				BeforeEach(func() {
+					dbBuild, err := defaultTeam.CreateOneOffBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = dbBuild.Start(atc.Plan{})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = dbWorker.CreateContainer(db.NewBuildStepContainerOwner(dbBuild.ID(), atc.PlanID("4"), defaultTeam.ID()), db.ContainerMetadata{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("lands the worker anyway so that the build can't reach it", func() {
+					landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(landedWorkers).To(BeEmpty())
+
+					landedWorkers, err = workerLifecycle.LandExpiredLandingWorkers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(landedWorkers).To(ConsistOf(atcWorker.Name))
+
+					foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(foundWorker.State()).To(Equal(db.WorkerStateLanded))
+					Expect(foundWorker.GardenAddr()).To(BeNil())
+				})
+			})
+		})
+
+		Context("when the drain deadline of a landing worker has not passed", func() {
+			BeforeEach(func() {
+				err := dbWorker.Land(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not land the worker", func() {
+				landedWorkers, err := workerLifecycle.LandExpiredLandingWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(landedWorkers).To(BeEmpty())
+
+				foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+			})
+		})
+
+		Context("when a landing worker has no drain deadline", func() {
+			BeforeEach(func() {
+				err := dbWorker.Land(0)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not land the worker", func() {
+				landedWorkers, err := workerLifecycle.LandExpiredLandingWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(landedWorkers).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("LandFinishedLandingWorkers", func() {
 		var (
 			dbWorker db.Worker
@@ -386,7 +476,7 @@ var _ = Describe("Worker Lifecycle", func() {
 					Expect(beforegardenAddr.Valid).To(BeTrue())
 					Expect(beforeBaggagaClaimUrl.Valid).To(BeTrue())
 
-					err = worker.Land()
+					err = worker.Land(0)
 					Expect(err).ToNot(HaveOccurred())
 					landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
 					Expect(err).ToNot(HaveOccurred())
@@ -486,7 +576,7 @@ var _ = Describe("Worker Lifecycle", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					dbBuild, err = job.CreateBuild()
+					dbBuild, err = job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -518,7 +608,7 @@ var _ = Describe("Worker Lifecycle", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					dbBuild, err = job.CreateBuild()
+					dbBuild, err = job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
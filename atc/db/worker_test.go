@@ -57,25 +57,53 @@ var _ = Describe("Worker", func() {
 
 		Context("when the worker is present", func() {
 			It("marks the worker as `landing`", func() {
-				err := worker.Land()
+				err := worker.Land(0)
 				Expect(err).NotTo(HaveOccurred())
 
 				_, err = worker.Reload()
 				Expect(err).NotTo(HaveOccurred())
 				Expect(worker.Name()).To(Equal(atcWorker.Name))
 				Expect(worker.State()).To(Equal(WorkerStateLanding))
+				Expect(worker.DrainDeadline()).To(BeZero())
+			})
+
+			Context("when a drain timeout is given", func() {
+				It("records the drain deadline", func() {
+					err := worker.Land(time.Hour)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = worker.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(worker.State()).To(Equal(WorkerStateLanding))
+					Expect(worker.DrainDeadline()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				})
+
+				Context("when the worker is landed again without a timeout", func() {
+					It("keeps the drain deadline", func() {
+						err := worker.Land(time.Hour)
+						Expect(err).NotTo(HaveOccurred())
+
+						err = worker.Land(0)
+						Expect(err).NotTo(HaveOccurred())
+
+						_, err = worker.Reload()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(worker.State()).To(Equal(WorkerStateLanding))
+						Expect(worker.DrainDeadline()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+					})
+				})
 			})
 
 			Context("when worker is already landed", func() {
 				BeforeEach(func() {
-					err := worker.Land()
+					err := worker.Land(0)
 					Expect(err).NotTo(HaveOccurred())
 					_, err = workerLifecycle.LandFinishedLandingWorkers()
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				It("keeps worker state as landed", func() {
-					err := worker.Land()
+					err := worker.Land(0)
 					Expect(err).NotTo(HaveOccurred())
 					_, err = worker.Reload()
 					Expect(err).NotTo(HaveOccurred())
@@ -91,7 +119,7 @@ var _ = Describe("Worker", func() {
 				err := worker.Delete()
 				Expect(err).NotTo(HaveOccurred())
 
-				err = worker.Land()
+				err = worker.Land(0)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(ErrWorkerNotPresent))
 			})
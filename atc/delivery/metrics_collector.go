@@ -0,0 +1,67 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type metricsCollector struct {
+	pipelineFactory db.PipelineFactory
+	monitor         *metric.Monitor
+	window          time.Duration
+}
+
+// NewMetricsCollector returns a component which periodically emits the
+// delivery metrics of every job, computed from the builds which finished
+// within the given window.
+func NewMetricsCollector(pipelineFactory db.PipelineFactory, monitor *metric.Monitor, window time.Duration) *metricsCollector {
+	return &metricsCollector{
+		pipelineFactory: pipelineFactory,
+		monitor:         monitor,
+		window:          window,
+	}
+}
+
+func (c *metricsCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("delivery-metrics-collector")
+
+	if !c.monitor.IsEmitting() {
+		return nil
+	}
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := c.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	since := time.Now().Add(-c.window)
+
+	for _, pipeline := range pipelines {
+		if pipeline.Archived() {
+			continue
+		}
+
+		jobMetrics, err := pipeline.DeliveryMetrics(since)
+		if err != nil {
+			logger.Error("failed-to-compute-delivery-metrics", err, lager.Data{
+				"pipeline": pipeline.Name(),
+			})
+			continue
+		}
+
+		for _, metrics := range jobMetrics {
+			metric.JobDeliveryMetrics{Metrics: metrics}.Emit(logger, c.monitor)
+		}
+	}
+
+	return nil
+}
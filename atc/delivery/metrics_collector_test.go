@@ -0,0 +1,114 @@
+package delivery_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/delivery"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MetricsCollector", func() {
+	var (
+		fakePipelineFactory *dbfakes.FakePipelineFactory
+		fakePipeline        *dbfakes.FakePipeline
+		fakeEmitter         *metricfakes.FakeEmitter
+		monitor             *metric.Monitor
+		logger              *lagertest.TestLogger
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.NameReturns("some-pipeline")
+		fakePipeline.DeliveryMetricsReturns([]atc.JobDeliveryMetrics{
+			{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "deploy",
+				Deployments:  3,
+			},
+		}, nil)
+
+		archivedPipeline := new(dbfakes.FakePipeline)
+		archivedPipeline.ArchivedReturns(true)
+
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, archivedPipeline}, nil)
+
+		fakeEmitter = new(metricfakes.FakeEmitter)
+		monitor = metric.NewMonitor()
+	})
+
+	JustBeforeEach(func() {
+		collector := delivery.NewMetricsCollector(fakePipelineFactory, monitor, 24*time.Hour)
+		runErr = collector.Run(lagerctx.NewContext(context.Background(), logger))
+	})
+
+	Context("when metrics are emitted", func() {
+		BeforeEach(func() {
+			emitterFactory := new(metricfakes.FakeEmitterFactory)
+			emitterFactory.IsConfiguredReturns(true)
+			emitterFactory.NewEmitterReturns(fakeEmitter, nil)
+
+			monitor.RegisterEmitter(emitterFactory)
+			monitor.Initialize(logger, "test", map[string]string{}, 1000)
+		})
+
+		It("computes the metrics of builds within the window", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakePipeline.DeliveryMetricsCallCount()).To(Equal(1))
+			Expect(fakePipeline.DeliveryMetricsArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+		})
+
+		It("emits the metrics of each job of unarchived pipelines", func() {
+			Eventually(fakeEmitter.EmitCallCount).Should(Equal(4))
+
+			_, event := fakeEmitter.EmitArgsForCall(0)
+			Expect(event.Name).To(Equal("job deployments"))
+			Expect(event.Value).To(Equal(float64(3)))
+			Expect(event.Attributes).To(HaveKeyWithValue("job", "deploy"))
+		})
+
+		Context("when computing a pipeline's metrics fails", func() {
+			BeforeEach(func() {
+				fakePipeline.DeliveryMetricsReturns(nil, errors.New("nope"))
+			})
+
+			It("carries on with the other pipelines", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Consistently(fakeEmitter.EmitCallCount).Should(BeZero())
+			})
+		})
+
+		Context("when getting the pipelines fails", func() {
+			BeforeEach(func() {
+				fakePipelineFactory.AllPipelinesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("nope"))
+			})
+		})
+	})
+
+	Context("when no emitter is configured", func() {
+		It("does not compute any metrics", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakePipelineFactory.AllPipelinesCallCount()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package atc
+
+// DeliveryMetrics describes how changes have flowed through the jobs of a
+// pipeline since a point in time, in the terms of the DORA metrics. Every
+// successful build of a job is considered a deployment by that job.
+type DeliveryMetrics struct {
+	Since int64                `json:"since"`
+	Jobs  []JobDeliveryMetrics `json:"jobs"`
+}
+
+type JobDeliveryMetrics struct {
+	TeamName             string       `json:"team_name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name"`
+
+	// Deployments and Failures count the succeeded and failed builds.
+	// Errored and aborted builds are not the fault of a change, and are left
+	// out.
+	Deployments int `json:"deployments"`
+	Failures    int `json:"failures"`
+
+	// ChangeFailureRate is the ratio of failed builds to all the succeeded
+	// and failed builds.
+	ChangeFailureRate float64 `json:"change_failure_rate"`
+
+	// LeadTime is the median time, in seconds, from the start of the first
+	// build upstream of a deployment, following the builds whose outputs
+	// satisfied its passed constraints, to the end of the deployment.
+	LeadTime float64 `json:"lead_time"`
+
+	// TimeToRecover is the mean time, in seconds, from the first failed
+	// build after a success to the next succeeded build.
+	TimeToRecover float64 `json:"time_to_recover"`
+}
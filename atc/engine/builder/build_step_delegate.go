@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 	"time"
@@ -209,6 +210,25 @@ func (delegate *buildStepDelegate) SelectedWorker(logger lager.Logger, workerNam
 		logger.Error("failed-to-save-selected-worker-event", err)
 		return
 	}
+
+	if delegate.build.PendingReason() != "" {
+		err = delegate.build.SetPendingReason("")
+		if err != nil {
+			logger.Error("failed-to-clear-pending-reason", err)
+		}
+	}
+}
+
+// Waiting records on the build why the step can't get a container yet, so
+// that it shows up alongside the reasons of pending builds, and tells the user
+// in the step's output. The reason is cleared once a worker is selected.
+func (delegate *buildStepDelegate) Waiting(logger lager.Logger, reason string) {
+	fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mwaiting: %s\x1b[0m\n", reason)
+
+	err := delegate.build.SetPendingReason(reason)
+	if err != nil {
+		logger.Error("failed-to-set-pending-reason", err)
+	}
 }
 
 func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string) {
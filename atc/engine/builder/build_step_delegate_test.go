@@ -71,6 +71,51 @@ var _ = Describe("BuildStepDelegate", func() {
 		})
 	})
 
+	Describe("Waiting", func() {
+		JustBeforeEach(func() {
+			delegate.Waiting(logger, "team quota of 10 containers reached")
+		})
+
+		It("saves the reason on the build", func() {
+			Expect(fakeBuild.SetPendingReasonCallCount()).To(Equal(1))
+			Expect(fakeBuild.SetPendingReasonArgsForCall(0)).To(Equal("team quota of 10 containers reached"))
+		})
+
+		It("tells the user why the step is waiting", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			log, ok := fakeBuild.SaveEventArgsForCall(0).(event.Log)
+			Expect(ok).To(BeTrue())
+			Expect(log.Origin.Source).To(Equal(event.OriginSourceStderr))
+			Expect(log.Payload).To(ContainSubstring("waiting: team quota of 10 containers reached"))
+		})
+	})
+
+	Describe("SelectedWorker", func() {
+		JustBeforeEach(func() {
+			delegate.SelectedWorker(logger, "some-worker")
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0).EventType()).To(Equal(atc.EventType("selected-worker")))
+		})
+
+		It("leaves the pending reason alone when there is none", func() {
+			Expect(fakeBuild.SetPendingReasonCallCount()).To(BeZero())
+		})
+
+		Context("when the step was waiting", func() {
+			BeforeEach(func() {
+				fakeBuild.PendingReasonReturns("team quota of 10 containers reached")
+			})
+
+			It("clears the pending reason", func() {
+				Expect(fakeBuild.SetPendingReasonCallCount()).To(Equal(1))
+				Expect(fakeBuild.SetPendingReasonArgsForCall(0)).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("ImageVersionDetermined", func() {
 		var fakeResourceCache *dbfakes.FakeUsedResourceCache
 
@@ -25,6 +25,7 @@ type StepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, DelegateFactory) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
+	ManualApprovalStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build) exec.Step
 }
@@ -117,6 +118,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan) exec.Step {
 		return builder.buildLoadVarStep(build, plan)
 	}
 
+	if plan.ManualApproval != nil {
+		return builder.buildManualApprovalStep(build, plan)
+	}
+
 	if plan.Check != nil {
 		return builder.buildCheckStep(build, plan)
 	}
@@ -388,6 +393,19 @@ func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan) exec
 	)
 }
 
+func (builder *stepBuilder) buildManualApprovalStep(build db.Build, plan atc.Plan) exec.Step {
+	stepMetadata := builder.stepMetadata(
+		build,
+		builder.externalURL,
+	)
+
+	return builder.stepFactory.ManualApprovalStep(
+		plan,
+		stepMetadata,
+		buildDelegateFactory(build, plan, builder.rateLimiter),
+	)
+}
+
 func (builder *stepBuilder) buildArtifactInputStep(build db.Build, plan atc.Plan) exec.Step {
 	return builder.stepFactory.ArtifactInputStep(
 		plan,
@@ -573,6 +573,21 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains a manual_approval step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.ManualApprovalPlan{
+								Name: "deploy",
+								Role: "owner",
+							})
+						})
+
+						It("constructs manual_approval correctly", func() {
+							plan, stepMetadata, _ := fakeStepFactory.ManualApprovalStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadata))
+						})
+					})
+
 					Context("that contains a check step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.CheckPlan{
@@ -76,6 +76,19 @@ type FakeStepFactory struct {
 	loadVarStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	ManualApprovalStepStub        func(atc.Plan, exec.StepMetadata, builder.DelegateFactory) exec.Step
+	manualApprovalStepMutex       sync.RWMutex
+	manualApprovalStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 builder.DelegateFactory
+	}
+	manualApprovalStepReturns struct {
+		result1 exec.Step
+	}
+	manualApprovalStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	PutStepStub        func(atc.Plan, exec.StepMetadata, db.ContainerMetadata, builder.DelegateFactory) exec.Step
 	putStepMutex       sync.RWMutex
 	putStepArgsForCall []struct {
@@ -431,6 +444,68 @@ func (fake *FakeStepFactory) LoadVarStepReturnsOnCall(i int, result1 exec.Step)
 	}{result1}
 }
 
+func (fake *FakeStepFactory) ManualApprovalStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 builder.DelegateFactory) exec.Step {
+	fake.manualApprovalStepMutex.Lock()
+	ret, specificReturn := fake.manualApprovalStepReturnsOnCall[len(fake.manualApprovalStepArgsForCall)]
+	fake.manualApprovalStepArgsForCall = append(fake.manualApprovalStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 builder.DelegateFactory
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ManualApprovalStep", []interface{}{arg1, arg2, arg3})
+	fake.manualApprovalStepMutex.Unlock()
+	if fake.ManualApprovalStepStub != nil {
+		return fake.ManualApprovalStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.manualApprovalStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) ManualApprovalStepCallCount() int {
+	fake.manualApprovalStepMutex.RLock()
+	defer fake.manualApprovalStepMutex.RUnlock()
+	return len(fake.manualApprovalStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) ManualApprovalStepCalls(stub func(atc.Plan, exec.StepMetadata, builder.DelegateFactory) exec.Step) {
+	fake.manualApprovalStepMutex.Lock()
+	defer fake.manualApprovalStepMutex.Unlock()
+	fake.ManualApprovalStepStub = stub
+}
+
+func (fake *FakeStepFactory) ManualApprovalStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, builder.DelegateFactory) {
+	fake.manualApprovalStepMutex.RLock()
+	defer fake.manualApprovalStepMutex.RUnlock()
+	argsForCall := fake.manualApprovalStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStepFactory) ManualApprovalStepReturns(result1 exec.Step) {
+	fake.manualApprovalStepMutex.Lock()
+	defer fake.manualApprovalStepMutex.Unlock()
+	fake.ManualApprovalStepStub = nil
+	fake.manualApprovalStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) ManualApprovalStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.manualApprovalStepMutex.Lock()
+	defer fake.manualApprovalStepMutex.Unlock()
+	fake.ManualApprovalStepStub = nil
+	if fake.manualApprovalStepReturnsOnCall == nil {
+		fake.manualApprovalStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.manualApprovalStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) PutStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 db.ContainerMetadata, arg4 builder.DelegateFactory) exec.Step {
 	fake.putStepMutex.Lock()
 	ret, specificReturn := fake.putStepReturnsOnCall[len(fake.putStepArgsForCall)]
@@ -632,6 +707,8 @@ func (fake *FakeStepFactory) Invocations() map[string][][]interface{} {
 	defer fake.getStepMutex.RUnlock()
 	fake.loadVarStepMutex.RLock()
 	defer fake.loadVarStepMutex.RUnlock()
+	fake.manualApprovalStepMutex.RLock()
+	defer fake.manualApprovalStepMutex.RUnlock()
 	fake.putStepMutex.RLock()
 	defer fake.putStepMutex.RUnlock()
 	fake.setPipelineStepMutex.RLock()
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -24,6 +25,7 @@ type stepFactory struct {
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	lockFactory           lock.LockFactory
+	policyChecker         exec.PolicyChecker
 }
 
 func NewStepFactory(
@@ -37,8 +39,9 @@ func NewStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker *policy.Checker,
 ) *stepFactory {
-	return &stepFactory{
+	factory := &stepFactory{
 		pool:                  pool,
 		client:                client,
 		resourceFactory:       resourceFactory,
@@ -50,6 +53,14 @@ func NewStepFactory(
 		strategy:              strategy,
 		lockFactory:           lockFactory,
 	}
+
+	// avoid a non-nil interface holding a nil checker when no policy agent is
+	// configured
+	if policyChecker != nil {
+		factory.policyChecker = policyChecker
+	}
+
+	return factory
 }
 
 func (factory *stepFactory) GetStep(
@@ -70,6 +81,7 @@ func (factory *stepFactory) GetStep(
 		factory.strategy,
 		delegateFactory,
 		factory.client,
+		factory.policyChecker,
 	)
 
 	getStep = exec.LogError(getStep, delegateFactory)
@@ -97,6 +109,7 @@ func (factory *stepFactory) PutStep(
 		factory.strategy,
 		factory.client,
 		delegateFactory,
+		factory.policyChecker,
 	)
 
 	putStep = exec.LogError(putStep, delegateFactory)
@@ -153,6 +166,7 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegateFactory,
 		factory.lockFactory,
+		factory.policyChecker,
 	)
 
 	taskStep = exec.LogError(taskStep, delegateFactory)
@@ -175,6 +189,7 @@ func (factory *stepFactory) SetPipelineStep(
 		factory.teamFactory,
 		factory.buildFactory,
 		factory.client,
+		factory.policyChecker,
 	)
 
 	spStep = exec.LogError(spStep, delegateFactory)
@@ -204,6 +219,23 @@ func (factory *stepFactory) LoadVarStep(
 	return loadVarStep
 }
 
+func (factory *stepFactory) ManualApprovalStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	approvalStep := exec.NewManualApprovalStep(
+		plan.ID,
+		*plan.ManualApproval,
+		stepMetadata,
+		delegateFactory,
+		factory.buildFactory,
+		exec.DefaultApprovalPollInterval,
+	)
+
+	return exec.LogError(approvalStep, delegateFactory)
+}
+
 func (factory *stepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
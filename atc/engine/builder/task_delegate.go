@@ -10,14 +10,22 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime"
 )
 
 func NewTaskDelegate(build db.Build, planID atc.PlanID, state exec.RunState, clock clock.Clock) exec.TaskDelegate {
+	stepDelegate := NewBuildStepDelegate(build, planID, state, clock)
+
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, state, clock),
+		BuildStepDelegate: stepDelegate,
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
-		build:       build,
+		eventOrigin:    event.Origin{ID: event.OriginID(planID)},
+		build:          build,
+		planID:         planID,
+		state:          state,
+		clock:          clock,
+		outputFilter:   stepDelegate.buildOutputFilter,
+		serviceWriters: map[event.Origin]io.Writer{},
 	}
 }
 
@@ -26,6 +34,46 @@ type taskDelegate struct {
 	config      atc.TaskConfig
 	build       db.Build
 	eventOrigin event.Origin
+
+	planID         atc.PlanID
+	state          exec.RunState
+	clock          clock.Clock
+	outputFilter   exec.BuildOutputFilter
+	serviceWriters map[event.Origin]io.Writer
+}
+
+// ServiceStdout returns a writer saving log events for the stdout of a
+// service, with an origin separate from the task's.
+func (d *taskDelegate) ServiceStdout(name string) io.Writer {
+	return d.serviceWriter(name, event.OriginSourceStdout)
+}
+
+// ServiceStderr returns a writer saving log events for the stderr of a
+// service, with an origin separate from the task's.
+func (d *taskDelegate) ServiceStderr(name string) io.Writer {
+	return d.serviceWriter(name, event.OriginSourceStderr)
+}
+
+func (d *taskDelegate) serviceWriter(name string, source event.OriginSource) io.Writer {
+	origin := event.Origin{
+		ID:     event.OriginID(d.planID.Service(name)),
+		Source: source,
+	}
+
+	writer, found := d.serviceWriters[origin]
+	if found {
+		return writer
+	}
+
+	if d.state.RedactionEnabled() {
+		writer = newDBEventWriterWithSecretRedaction(d.build, origin, d.clock, d.outputFilter)
+	} else {
+		writer = newDBEventWriter(d.build, origin, d.clock)
+	}
+
+	d.serviceWriters[origin] = writer
+
+	return writer
 }
 
 func (d *taskDelegate) SetTaskConfig(config atc.TaskConfig) {
@@ -60,11 +108,37 @@ func (d *taskDelegate) Starting(logger lager.Logger) {
 	logger.Debug("starting")
 }
 
+func (d *taskDelegate) ContainerUsage(logger lager.Logger, usage runtime.ContainerUsage) {
+	err := d.build.SaveEvent(event.ContainerUsage{
+		Origin:        d.eventOrigin,
+		Time:          d.clock.Now().Unix(),
+		Duration:      usage.Duration.Seconds(),
+		CPUTime:       usage.CPUTime.Seconds(),
+		CPUAverage:    usage.CPUAverage(),
+		MemoryPeak:    usage.MemoryPeak,
+		MemoryAverage: usage.MemoryAverage,
+		NetworkRx:     usage.NetworkRxBytes,
+		NetworkTx:     usage.NetworkTxBytes,
+		BlockIORead:   usage.BlockIOReadBytes,
+		BlockIOWrite:  usage.BlockIOWriteBytes,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-container-usage-event", err)
+		return
+	}
+
+	logger.Debug("container-usage", lager.Data{"usage": usage})
+}
+
 func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus) {
 	// PR#4398: close to flush stdout and stderr
 	d.Stdout().(io.Closer).Close()
 	d.Stderr().(io.Closer).Close()
 
+	for _, writer := range d.serviceWriters {
+		writer.(io.Closer).Close()
+	}
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
@@ -12,7 +12,9 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine/builder"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -101,4 +103,59 @@ var _ = Describe("TaskDelegate", func() {
 			Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 		})
 	})
+	Describe("ContainerUsage", func() {
+		JustBeforeEach(func() {
+			delegate.ContainerUsage(logger, runtime.ContainerUsage{
+				Samples:           3,
+				Duration:          time.Minute,
+				CPUTime:           30 * time.Second,
+				MemoryPeak:        2048,
+				MemoryAverage:     1024,
+				NetworkRxBytes:    10,
+				NetworkTxBytes:    20,
+				BlockIOReadBytes:  30,
+				BlockIOWriteBytes: 40,
+			})
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.ContainerUsage{
+				Time:          now.Unix(),
+				Origin:        event.Origin{ID: "some-plan-id"},
+				Duration:      60,
+				CPUTime:       30,
+				CPUAverage:    0.5,
+				MemoryPeak:    2048,
+				MemoryAverage: 1024,
+				NetworkRx:     10,
+				NetworkTx:     20,
+				BlockIORead:   30,
+				BlockIOWrite:  40,
+			}))
+		})
+	})
+
+	Describe("ServiceStdout", func() {
+		JustBeforeEach(func() {
+			_, err := delegate.ServiceStdout("postgres").Write([]byte("database system is ready\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("saves log events originating from the service", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Time:    now.Unix(),
+				Payload: "database system is ready\n",
+				Origin: event.Origin{
+					ID:     "some-plan-id/services/postgres",
+					Source: event.OriginSourceStdout,
+				},
+			}))
+		})
+
+		It("returns the same writer for the same service", func() {
+			Expect(delegate.ServiceStdout("postgres")).To(BeIdenticalTo(delegate.ServiceStdout("postgres")))
+		})
+	})
 })
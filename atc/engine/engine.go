@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 )
 
@@ -267,6 +268,7 @@ func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 		logger.Info("aborted")
 
 	} else if err != nil {
+		b.saveWorkerSelectionFailure(logger, err)
 		b.saveStatus(logger, atc.StatusErrored)
 		logger.Info("errored", lager.Data{"error": err.Error()})
 
@@ -280,6 +282,20 @@ func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 	}
 }
 
+// saveWorkerSelectionFailure records on the build that it errored because
+// no worker could run one of its steps, so that it shows up alongside the
+// builds that are pending for other reasons.
+func (b *engineBuild) saveWorkerSelectionFailure(logger lager.Logger, err error) {
+	if !errors.As(err, &worker.NoCompatibleWorkersError{}) &&
+		!errors.Is(err, worker.ErrNoWorkers) {
+		return
+	}
+
+	if err := b.build.SetPendingReason(err.Error()); err != nil {
+		logger.Error("failed-to-set-pending-reason", err)
+	}
+}
+
 func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	if err := b.build.Finish(db.BuildStatus(status)); err != nil {
 		logger.Error("failed-to-finish-build", err)
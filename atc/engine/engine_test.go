@@ -19,6 +19,7 @@ import (
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/vars"
 
 	. "github.com/onsi/ginkgo"
@@ -256,6 +257,26 @@ var _ = Describe("Engine", func() {
 										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
 										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
 									})
+
+									It("does not record a pending reason", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SetPendingReasonCallCount()).To(BeZero())
+									})
+								})
+
+								Context("when the build finishes because no worker could run a step", func() {
+									BeforeEach(func() {
+										fakeStep.RunReturns(worker.NoCompatibleWorkersError{
+											Spec: worker.WorkerSpec{Platform: "linux", Tags: []string{"gpu"}},
+										})
+									})
+
+									It("records why the build did not run", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SetPendingReasonCallCount()).To(Equal(1))
+										Expect(fakeBuild.SetPendingReasonArgsForCall(0)).To(Equal("no workers satisfying: platform 'linux', tag 'gpu'"))
+										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+									})
 								})
 
 								Context("when the build finishes with cancelled error", func() {
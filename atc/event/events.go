@@ -99,6 +99,23 @@ type SelectedWorker struct {
 func (SelectedWorker) EventType() atc.EventType  { return EventTypeSelectedWorker }
 func (SelectedWorker) Version() atc.EventVersion { return "1.0" }
 
+type ContainerUsage struct {
+	Time          int64   `json:"time"`
+	Origin        Origin  `json:"origin"`
+	Duration      float64 `json:"duration"`
+	CPUTime       float64 `json:"cpu_time"`
+	CPUAverage    float64 `json:"cpu_average"`
+	MemoryPeak    uint64  `json:"memory_peak"`
+	MemoryAverage uint64  `json:"memory_average"`
+	NetworkRx     uint64  `json:"network_rx"`
+	NetworkTx     uint64  `json:"network_tx"`
+	BlockIORead   uint64  `json:"block_io_read"`
+	BlockIOWrite  uint64  `json:"block_io_write"`
+}
+
+func (ContainerUsage) EventType() atc.EventType  { return EventTypeContainerUsage }
+func (ContainerUsage) Version() atc.EventVersion { return "1.1" }
+
 type Log struct {
 	Time    int64  `json:"time"`
 	Origin  Origin `json:"origin"`
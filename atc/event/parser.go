@@ -46,6 +46,7 @@ func init() {
 	RegisterEvent(SetPipelineChanged{})
 	RegisterEvent(Status{})
 	RegisterEvent(SelectedWorker{})
+	RegisterEvent(ContainerUsage{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
 
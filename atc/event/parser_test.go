@@ -68,6 +68,7 @@ var _ = Describe("ParseEvent", func() {
 		Entry("SetPipelineChanged", event.SetPipelineChanged{}),
 		Entry("Status", event.Status{}),
 		Entry("SelectedWorker", event.SelectedWorker{}),
+		Entry("ContainerUsage", event.ContainerUsage{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
 	)
@@ -39,6 +39,9 @@ const (
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// resources consumed by the container of a step
+	EventTypeContainerUsage atc.EventType = "container-usage"
+
 	EventTypeSetPipelineChanged atc.EventType = "set-pipeline-changed"
 
 	// initialize step
@@ -33,6 +33,7 @@ type BuildStepDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
 	SelectedWorker(lager.Logger, string)
+	Waiting(lager.Logger, string)
 	Errored(lager.Logger, string)
 }
 
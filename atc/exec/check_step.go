@@ -166,7 +166,11 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 			return fmt.Errorf("update check end time: %w", err)
 		}
 
+		checkStart := time.Now()
+
 		result, err := step.runCheck(ctx, logger, delegate, timeout, resourceConfig, source, resourceTypes, fromVersion)
+		step.emitCheckFinished(logger, err == nil, time.Since(checkStart))
+
 		if setErr := scope.SetCheckError(err); setErr != nil {
 			logger.Error("failed-to-set-check-error", setErr)
 		}
@@ -210,6 +214,23 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 	return nil
 }
 
+func (step *CheckStep) emitCheckFinished(logger lager.Logger, succeeded bool, duration time.Duration) {
+	if step.plan.Resource == "" {
+		return
+	}
+
+	metric.CheckFinished{
+		TeamName: step.metadata.TeamName,
+		Pipeline: atc.PipelineRef{
+			Name:         step.metadata.PipelineName,
+			InstanceVars: step.metadata.PipelineInstanceVars,
+		},
+		ResourceName: step.plan.Resource,
+		Succeeded:    succeeded,
+		Duration:     duration,
+	}.Emit(logger)
+}
+
 func (step *CheckStep) Succeeded() bool {
 	return step.succeeded
 }
@@ -102,6 +102,12 @@ type FakeBuildStepDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	WaitingStub        func(lager.Logger, string)
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -556,6 +562,38 @@ func (fake *FakeBuildStepDelegate) StdoutReturnsOnCall(i int, result1 io.Writer)
 	}{result1}
 }
 
+func (fake *FakeBuildStepDelegate) Waiting(arg1 lager.Logger, arg2 string) {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Waiting", []interface{}{arg1, arg2})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeBuildStepDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) WaitingCalls(stub func(lager.Logger, string)) {
+	fake.waitingMutex.Lock()
+	defer fake.waitingMutex.Unlock()
+	fake.WaitingStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) WaitingArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	argsForCall := fake.waitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -579,6 +617,8 @@ func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
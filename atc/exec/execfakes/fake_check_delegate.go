@@ -143,6 +143,12 @@ type FakeCheckDelegate struct {
 		result2 bool
 		result3 error
 	}
+	WaitingStub        func(lager.Logger, string)
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -787,6 +793,38 @@ func (fake *FakeCheckDelegate) WaitToRunReturnsOnCall(i int, result1 lock.Lock,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCheckDelegate) Waiting(arg1 lager.Logger, arg2 string) {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Waiting", []interface{}{arg1, arg2})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeCheckDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) WaitingCalls(stub func(lager.Logger, string)) {
+	fake.waitingMutex.Lock()
+	defer fake.waitingMutex.Unlock()
+	fake.WaitingStub = stub
+}
+
+func (fake *FakeCheckDelegate) WaitingArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	argsForCall := fake.waitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -816,6 +854,8 @@ func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.waitToRunMutex.RLock()
 	defer fake.waitToRunMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -111,6 +111,12 @@ type FakeGetDelegate struct {
 		arg2 atc.GetPlan
 		arg3 runtime.VersionResult
 	}
+	WaitingStub        func(lager.Logger, string)
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -599,6 +605,38 @@ func (fake *FakeGetDelegate) UpdateVersionArgsForCall(i int) (lager.Logger, atc.
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeGetDelegate) Waiting(arg1 lager.Logger, arg2 string) {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Waiting", []interface{}{arg1, arg2})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeGetDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakeGetDelegate) WaitingCalls(stub func(lager.Logger, string)) {
+	fake.waitingMutex.Lock()
+	defer fake.waitingMutex.Unlock()
+	fake.WaitingStub = stub
+}
+
+func (fake *FakeGetDelegate) WaitingArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	argsForCall := fake.waitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -624,6 +662,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.updateVersionMutex.RLock()
 	defer fake.updateVersionMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
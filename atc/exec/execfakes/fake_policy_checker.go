@@ -0,0 +1,189 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package execfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
+)
+
+type FakePolicyChecker struct {
+	CheckStub        func(policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		arg1 policy.PolicyCheckInput
+	}
+	checkReturns struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}
+	ShouldCheckActionStub        func(string) bool
+	shouldCheckActionMutex       sync.RWMutex
+	shouldCheckActionArgsForCall []struct {
+		arg1 string
+	}
+	shouldCheckActionReturns struct {
+		result1 bool
+	}
+	shouldCheckActionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePolicyChecker) Check(arg1 policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+		arg1 policy.PolicyCheckInput
+	}{arg1})
+	fake.recordInvocation("Check", []interface{}{arg1})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyChecker) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakePolicyChecker) CheckCalls(stub func(policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakePolicyChecker) CheckArgsForCall(i int) policy.PolicyCheckInput {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	argsForCall := fake.checkArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyChecker) CheckReturns(result1 policy.PolicyCheckOutput, result2 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePolicyChecker) CheckReturnsOnCall(i int, result1 policy.PolicyCheckOutput, result2 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 policy.PolicyCheckOutput
+			result2 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePolicyChecker) ShouldCheckAction(arg1 string) bool {
+	fake.shouldCheckActionMutex.Lock()
+	ret, specificReturn := fake.shouldCheckActionReturnsOnCall[len(fake.shouldCheckActionArgsForCall)]
+	fake.shouldCheckActionArgsForCall = append(fake.shouldCheckActionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ShouldCheckAction", []interface{}{arg1})
+	fake.shouldCheckActionMutex.Unlock()
+	if fake.ShouldCheckActionStub != nil {
+		return fake.ShouldCheckActionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.shouldCheckActionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyChecker) ShouldCheckActionCallCount() int {
+	fake.shouldCheckActionMutex.RLock()
+	defer fake.shouldCheckActionMutex.RUnlock()
+	return len(fake.shouldCheckActionArgsForCall)
+}
+
+func (fake *FakePolicyChecker) ShouldCheckActionCalls(stub func(string) bool) {
+	fake.shouldCheckActionMutex.Lock()
+	defer fake.shouldCheckActionMutex.Unlock()
+	fake.ShouldCheckActionStub = stub
+}
+
+func (fake *FakePolicyChecker) ShouldCheckActionArgsForCall(i int) string {
+	fake.shouldCheckActionMutex.RLock()
+	defer fake.shouldCheckActionMutex.RUnlock()
+	argsForCall := fake.shouldCheckActionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyChecker) ShouldCheckActionReturns(result1 bool) {
+	fake.shouldCheckActionMutex.Lock()
+	defer fake.shouldCheckActionMutex.Unlock()
+	fake.ShouldCheckActionStub = nil
+	fake.shouldCheckActionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePolicyChecker) ShouldCheckActionReturnsOnCall(i int, result1 bool) {
+	fake.shouldCheckActionMutex.Lock()
+	defer fake.shouldCheckActionMutex.Unlock()
+	fake.ShouldCheckActionStub = nil
+	if fake.shouldCheckActionReturnsOnCall == nil {
+		fake.shouldCheckActionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.shouldCheckActionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePolicyChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	fake.shouldCheckActionMutex.RLock()
+	defer fake.shouldCheckActionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePolicyChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.PolicyChecker = new(FakePolicyChecker)
@@ -113,6 +113,12 @@ type FakePutDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	WaitingStub        func(lager.Logger, string)
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -603,6 +609,38 @@ func (fake *FakePutDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakePutDelegate) Waiting(arg1 lager.Logger, arg2 string) {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Waiting", []interface{}{arg1, arg2})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePutDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakePutDelegate) WaitingCalls(stub func(lager.Logger, string)) {
+	fake.waitingMutex.Lock()
+	defer fake.waitingMutex.Unlock()
+	fake.WaitingStub = stub
+}
+
+func (fake *FakePutDelegate) WaitingArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	argsForCall := fake.waitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -628,6 +666,8 @@ func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
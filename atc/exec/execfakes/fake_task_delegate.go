@@ -10,11 +10,18 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/trace"
 )
 
 type FakeTaskDelegate struct {
+	ContainerUsageStub        func(lager.Logger, runtime.ContainerUsage)
+	containerUsageMutex       sync.RWMutex
+	containerUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 runtime.ContainerUsage
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -62,6 +69,28 @@ type FakeTaskDelegate struct {
 		arg1 lager.Logger
 		arg2 string
 	}
+	ServiceStderrStub        func(string) io.Writer
+	serviceStderrMutex       sync.RWMutex
+	serviceStderrArgsForCall []struct {
+		arg1 string
+	}
+	serviceStderrReturns struct {
+		result1 io.Writer
+	}
+	serviceStderrReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	ServiceStdoutStub        func(string) io.Writer
+	serviceStdoutMutex       sync.RWMutex
+	serviceStdoutArgsForCall []struct {
+		arg1 string
+	}
+	serviceStdoutReturns struct {
+		result1 io.Writer
+	}
+	serviceStdoutReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -107,10 +136,48 @@ type FakeTaskDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	WaitingStub        func(lager.Logger, string)
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) ContainerUsage(arg1 lager.Logger, arg2 runtime.ContainerUsage) {
+	fake.containerUsageMutex.Lock()
+	fake.containerUsageArgsForCall = append(fake.containerUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 runtime.ContainerUsage
+	}{arg1, arg2})
+	fake.recordInvocation("ContainerUsage", []interface{}{arg1, arg2})
+	fake.containerUsageMutex.Unlock()
+	if fake.ContainerUsageStub != nil {
+		fake.ContainerUsageStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) ContainerUsageCallCount() int {
+	fake.containerUsageMutex.RLock()
+	defer fake.containerUsageMutex.RUnlock()
+	return len(fake.containerUsageArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ContainerUsageCalls(stub func(lager.Logger, runtime.ContainerUsage)) {
+	fake.containerUsageMutex.Lock()
+	defer fake.containerUsageMutex.Unlock()
+	fake.ContainerUsageStub = stub
+}
+
+func (fake *FakeTaskDelegate) ContainerUsageArgsForCall(i int) (lager.Logger, runtime.ContainerUsage) {
+	fake.containerUsageMutex.RLock()
+	defer fake.containerUsageMutex.RUnlock()
+	argsForCall := fake.containerUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -361,6 +428,126 @@ func (fake *FakeTaskDelegate) SelectedWorkerArgsForCall(i int) (lager.Logger, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTaskDelegate) ServiceStderr(arg1 string) io.Writer {
+	fake.serviceStderrMutex.Lock()
+	ret, specificReturn := fake.serviceStderrReturnsOnCall[len(fake.serviceStderrArgsForCall)]
+	fake.serviceStderrArgsForCall = append(fake.serviceStderrArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ServiceStderr", []interface{}{arg1})
+	fake.serviceStderrMutex.Unlock()
+	if fake.ServiceStderrStub != nil {
+		return fake.ServiceStderrStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.serviceStderrReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrCallCount() int {
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	return len(fake.serviceStderrArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrCalls(stub func(string) io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = stub
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrArgsForCall(i int) string {
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	argsForCall := fake.serviceStderrArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrReturns(result1 io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = nil
+	fake.serviceStderrReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStderrReturnsOnCall(i int, result1 io.Writer) {
+	fake.serviceStderrMutex.Lock()
+	defer fake.serviceStderrMutex.Unlock()
+	fake.ServiceStderrStub = nil
+	if fake.serviceStderrReturnsOnCall == nil {
+		fake.serviceStderrReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.serviceStderrReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStdout(arg1 string) io.Writer {
+	fake.serviceStdoutMutex.Lock()
+	ret, specificReturn := fake.serviceStdoutReturnsOnCall[len(fake.serviceStdoutArgsForCall)]
+	fake.serviceStdoutArgsForCall = append(fake.serviceStdoutArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ServiceStdout", []interface{}{arg1})
+	fake.serviceStdoutMutex.Unlock()
+	if fake.ServiceStdoutStub != nil {
+		return fake.ServiceStdoutStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.serviceStdoutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutCallCount() int {
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
+	return len(fake.serviceStdoutArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutCalls(stub func(string) io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = stub
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutArgsForCall(i int) string {
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
+	argsForCall := fake.serviceStdoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutReturns(result1 io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = nil
+	fake.serviceStdoutReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) ServiceStdoutReturnsOnCall(i int, result1 io.Writer) {
+	fake.serviceStdoutMutex.Lock()
+	defer fake.serviceStdoutMutex.Unlock()
+	fake.ServiceStdoutStub = nil
+	if fake.serviceStdoutReturnsOnCall == nil {
+		fake.serviceStdoutReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.serviceStdoutReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -592,9 +779,43 @@ func (fake *FakeTaskDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeTaskDelegate) Waiting(arg1 lager.Logger, arg2 string) {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Waiting", []interface{}{arg1, arg2})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) WaitingCalls(stub func(lager.Logger, string)) {
+	fake.waitingMutex.Lock()
+	defer fake.waitingMutex.Unlock()
+	fake.WaitingStub = stub
+}
+
+func (fake *FakeTaskDelegate) WaitingArgsForCall(i int) (lager.Logger, string) {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	argsForCall := fake.waitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.containerUsageMutex.RLock()
+	defer fake.containerUsageMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
@@ -607,6 +828,10 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.redactImageSourceMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
 	defer fake.selectedWorkerMutex.RUnlock()
+	fake.serviceStderrMutex.RLock()
+	defer fake.serviceStderrMutex.RUnlock()
+	fake.serviceStdoutMutex.RLock()
+	defer fake.serviceStdoutMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startSpanMutex.RLock()
@@ -617,6 +842,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
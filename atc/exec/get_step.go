@@ -55,6 +55,7 @@ type GetDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	SelectedWorker(lager.Logger, string)
+	Waiting(lager.Logger, string)
 	Errored(lager.Logger, string)
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
@@ -72,6 +73,7 @@ type GetStep struct {
 	strategy             worker.ContainerPlacementStrategy
 	workerClient         worker.Client
 	delegateFactory      GetDelegateFactory
+	policyChecker        PolicyChecker
 	succeeded            bool
 }
 
@@ -85,6 +87,7 @@ func NewGetStep(
 	strategy worker.ContainerPlacementStrategy,
 	delegateFactory GetDelegateFactory,
 	client worker.Client,
+	policyChecker PolicyChecker,
 ) Step {
 	return &GetStep{
 		planID:               planID,
@@ -96,6 +99,7 @@ func NewGetStep(
 		strategy:             strategy,
 		delegateFactory:      delegateFactory,
 		workerClient:         client,
+		policyChecker:        policyChecker,
 	}
 }
 
@@ -161,6 +165,25 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		Delegate:      delegate,
 	}
 
+	resourceType, _ := resourceTypes.Lookup(step.plan.Type)
+
+	err = checkRunStepPolicy(logger, step.policyChecker, step.workerClient, delegate, step.metadata, runStepPolicy{
+		Step:       "get",
+		Name:       step.plan.Name,
+		Privileged: resourceType.Privileged,
+		Config: map[string]interface{}{
+			"resource": step.plan.Resource,
+			"type":     step.plan.Type,
+			"source":   source,
+			"params":   params,
+			"version":  version,
+		},
+		Worker: &workerSpec,
+	})
+	if err != nil {
+		return err
+	}
+
 	resourceCache, err := step.resourceCacheFactory.FindOrCreateResourceCache(
 		db.ForBuild(step.metadata.BuildID),
 		step.plan.Type,
@@ -207,6 +230,8 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		return err
 	}
 
+	reportOOMKill(logger, delegate, step.metadata.metricLabels(step.plan.Name), getResult.OOMKill)
+
 	if getResult.ExitStatus == 0 {
 		state.ArtifactRepository().RegisterArtifact(
 			build.ArtifactName(step.plan.Name),
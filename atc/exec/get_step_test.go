@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
@@ -43,6 +44,7 @@ var _ = Describe("GetStep", func() {
 
 		fakeDelegate        *execfakes.FakeGetDelegate
 		fakeDelegateFactory *execfakes.FakeGetDelegateFactory
+		fakePolicyChecker   *execfakes.FakePolicyChecker
 
 		spanCtx context.Context
 
@@ -104,6 +106,8 @@ var _ = Describe("GetStep", func() {
 		fakeDelegateFactory = new(execfakes.FakeGetDelegateFactory)
 		fakeDelegateFactory.GetDelegateReturns(fakeDelegate)
 
+		fakePolicyChecker = new(execfakes.FakePolicyChecker)
+
 		uninterpolatedResourceTypes := atc.VersionedResourceTypes{
 			{
 				ResourceType: atc.ResourceType{
@@ -162,6 +166,7 @@ var _ = Describe("GetStep", func() {
 			fakeStrategy,
 			fakeDelegateFactory,
 			fakeClient,
+			fakePolicyChecker,
 		)
 
 		getStepErr = getStep.Run(ctx, fakeState)
@@ -198,6 +203,62 @@ var _ = Describe("GetStep", func() {
 		})
 	})
 
+	Context("when the RunStep action is policy checked", func() {
+		BeforeEach(func() {
+			fakePolicyChecker.ShouldCheckActionReturns(true)
+			fakeDelegate.RedactImageSourceReturns(atc.Source{"source": atc.Source{"some": "((redacted))"}}, nil)
+		})
+
+		It("checks the interpolated config with credentials redacted", func() {
+			Expect(fakeDelegate.RedactImageSourceCallCount()).To(Equal(1))
+			Expect(fakeDelegate.RedactImageSourceArgsForCall(0)["source"]).To(Equal(map[string]interface{}{
+				"some": "super-secret-source",
+			}))
+
+			Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+			data := fakePolicyChecker.CheckArgsForCall(0).Data.(map[string]interface{})
+			Expect(data["step"]).To(Equal("get"))
+			Expect(data["config"]).To(Equal(atc.Source{"source": atc.Source{"some": "((redacted))"}}))
+			Expect(data["privileged"]).To(BeFalse())
+			Expect(data["worker"]).To(HaveKeyWithValue("tags", []string{"some", "tags"}))
+			Expect(data["worker"]).To(HaveKeyWithValue("shared", false))
+		})
+
+		Context("when the resource type is privileged", func() {
+			BeforeEach(func() {
+				getPlan.Type = "custom-resource"
+				getPlan.VersionedResourceTypes[0].Privileged = true
+			})
+
+			It("checks the step as privileged", func() {
+				data := fakePolicyChecker.CheckArgsForCall(0).Data.(map[string]interface{})
+				Expect(data["privileged"]).To(BeTrue())
+			})
+		})
+
+		Context("when the step would run on a shared worker", func() {
+			BeforeEach(func() {
+				fakeClient.RunsOnSharedWorkersReturns(true, nil)
+			})
+
+			It("tells the policy", func() {
+				data := fakePolicyChecker.CheckArgsForCall(0).Data.(map[string]interface{})
+				Expect(data["worker"]).To(HaveKeyWithValue("shared", true))
+			})
+		})
+
+		Context("when the policy check doesn't pass", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.FailedPolicyCheck(), nil)
+			})
+
+			It("fails without fetching the resource", func() {
+				Expect(getStepErr).To(BeAssignableToTypeOf(policy.PolicyCheckNotPass{}))
+				Expect(fakeClient.RunGetStepCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	It("calls RunGetStep with the correct ContainerOwner", func() {
 		_, _, actualContainerOwner, _, _, _, _, _, _, _, _, _ := fakeClient.RunGetStepArgsForCall(0)
 		Expect(actualContainerOwner).To(Equal(db.NewBuildStepContainerOwner(
@@ -382,5 +443,26 @@ var _ = Describe("GetStep", func() {
 		It("does not return an err", func() {
 			Expect(getStepErr).ToNot(HaveOccurred())
 		})
+
+		It("does not report an OOM kill", func() {
+			Expect(fakeDelegate.ErroredCallCount()).To(Equal(0))
+		})
+
+		Context("because it ran out of memory", func() {
+			BeforeEach(func() {
+				fakeClient.RunGetStepReturns(
+					worker.GetResult{
+						ExitStatus:    137,
+						VersionResult: runtime.VersionResult{},
+						OOMKill:       &runtime.OOMKill{},
+					}, nil)
+			})
+
+			It("reports the OOM kill via the delegate", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+				_, message := fakeDelegate.ErroredArgsForCall(0)
+				Expect(message).To(Equal("killed: out of memory"))
+			})
+		})
 	})
 })
@@ -0,0 +1,122 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// DefaultApprovalPollInterval is how often a ManualApprovalStep checks whether
+// it has been approved.
+const DefaultApprovalPollInterval = 5 * time.Second
+
+// ManualApprovalStep waits until a user approves the build. The approval is
+// stored with the build, so that it isn't lost when the build is resumed by
+// another ATC.
+type ManualApprovalStep struct {
+	planID          atc.PlanID
+	plan            atc.ManualApprovalPlan
+	metadata        StepMetadata
+	delegateFactory BuildStepDelegateFactory
+	buildFactory    db.BuildFactory
+	pollInterval    time.Duration
+	succeeded       bool
+}
+
+func NewManualApprovalStep(
+	planID atc.PlanID,
+	plan atc.ManualApprovalPlan,
+	metadata StepMetadata,
+	delegateFactory BuildStepDelegateFactory,
+	buildFactory db.BuildFactory,
+	pollInterval time.Duration,
+) Step {
+	return &ManualApprovalStep{
+		planID:          planID,
+		plan:            plan,
+		metadata:        metadata,
+		delegateFactory: delegateFactory,
+		buildFactory:    buildFactory,
+		pollInterval:    pollInterval,
+	}
+}
+
+func (step *ManualApprovalStep) Run(ctx context.Context, state RunState) error {
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "manual_approval", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	err := step.run(ctx, delegate)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *ManualApprovalStep) run(ctx context.Context, delegate BuildStepDelegate) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("manual-approval-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	delegate.Initializing(logger)
+	stdout := delegate.Stdout()
+
+	build, found, err := step.buildFactory.Build(step.metadata.BuildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build %d not found", step.metadata.BuildID)
+	}
+
+	approval, err := build.RequestApproval(step.planID, step.plan.Name, step.plan.Role)
+	if err != nil {
+		return err
+	}
+
+	delegate.Starting(logger)
+
+	if !approval.Approved() {
+		fmt.Fprintf(stdout, "awaiting approval from a user with the role '%s'.\n", step.plan.Role)
+		if step.metadata.JobName != "" {
+			fmt.Fprintf(stdout, "approve with: fly approve-build -j %s/%s -b %s\n", step.metadata.PipelineName, step.metadata.JobName, step.metadata.BuildName)
+		}
+
+		ticker := time.NewTicker(step.pollInterval)
+		defer ticker.Stop()
+
+		for !approval.Approved() {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+
+			approval, err = build.RequestApproval(step.planID, step.plan.Name, step.plan.Role)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Fprintf(stdout, "approved by %s.\n", approval.ApprovedBy)
+
+	step.succeeded = true
+	delegate.Finished(logger, step.succeeded)
+
+	return nil
+}
+
+func (step *ManualApprovalStep) Succeeded() bool {
+	return step.succeeded
+}
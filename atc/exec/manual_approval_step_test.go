@@ -0,0 +1,181 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"go.opentelemetry.io/otel/api/trace"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+)
+
+var _ = Describe("ManualApprovalStep", func() {
+	var (
+		ctx        context.Context
+		cancel     func()
+		testLogger *lagertest.TestLogger
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+		fakeBuildFactory    *dbfakes.FakeBuildFactory
+		fakeBuild           *dbfakes.FakeBuild
+
+		state *execfakes.FakeRunState
+
+		approvalStep exec.Step
+		stepErr      error
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:       123,
+			TeamName:     "some-team",
+			BuildID:      42,
+			BuildName:    "some-build",
+			PipelineID:   4567,
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+		}
+
+		stdout *gbytes.Buffer
+
+		planID = atc.PlanID("56")
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("manual-approval-step-test")
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, testLogger)
+
+		state = new(execfakes.FakeRunState)
+
+		stdout = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StartSpanReturns(ctx, trace.NoopSpan{})
+
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.RequestApprovalReturns(atc.BuildApproval{
+			ApprovedBy: "some-approver",
+			ApprovedAt: 1,
+		}, nil)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		approvalStep = exec.NewManualApprovalStep(
+			planID,
+			atc.ManualApprovalPlan{
+				Name: "deploy",
+				Role: "owner",
+			},
+			stepMetadata,
+			fakeDelegateFactory,
+			fakeBuildFactory,
+			time.Millisecond,
+		)
+
+		stepErr = approvalStep.Run(ctx, state)
+	})
+
+	It("requests the approval on the build", func() {
+		Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+
+		Expect(fakeBuild.RequestApprovalCallCount()).ToNot(BeZero())
+		requestedPlanID, name, role := fakeBuild.RequestApprovalArgsForCall(0)
+		Expect(requestedPlanID).To(Equal(planID))
+		Expect(name).To(Equal("deploy"))
+		Expect(role).To(Equal("owner"))
+	})
+
+	Context("when the build was approved before the step ran", func() {
+		It("succeeds without waiting", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(approvalStep.Succeeded()).To(BeTrue())
+			Expect(fakeBuild.RequestApprovalCallCount()).To(Equal(1))
+			Expect(stdout).To(gbytes.Say("approved by some-approver"))
+		})
+
+		It("finishes successfully", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+	})
+
+	Context("when the build is approved while waiting", func() {
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturnsOnCall(0, atc.BuildApproval{}, nil)
+			fakeBuild.RequestApprovalReturnsOnCall(1, atc.BuildApproval{}, nil)
+			fakeBuild.RequestApprovalReturnsOnCall(2, atc.BuildApproval{
+				ApprovedBy: "some-approver",
+				ApprovedAt: 1,
+			}, nil)
+		})
+
+		It("waits for the approval", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(approvalStep.Succeeded()).To(BeTrue())
+			Expect(fakeBuild.RequestApprovalCallCount()).To(Equal(3))
+		})
+
+		It("tells the user how to approve the build", func() {
+			Expect(stdout).To(gbytes.Say("awaiting approval from a user with the role 'owner'"))
+			Expect(stdout).To(gbytes.Say("fly approve-build -j some-pipeline/some-job -b some-build"))
+			Expect(stdout).To(gbytes.Say("approved by some-approver"))
+		})
+	})
+
+	Context("when the build is aborted while waiting", func() {
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalStub = func(atc.PlanID, string, string) (atc.BuildApproval, error) {
+				cancel()
+				return atc.BuildApproval{}, nil
+			}
+		})
+
+		It("returns the context error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+			Expect(approvalStep.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when requesting the approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturns(atc.BuildApproval{}, disaster)
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(approvalStep.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the build can't be found", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.BuildReturns(nil, false, nil)
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError("build 42 not found"))
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package exec
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+type erroredDelegate interface {
+	Errored(lager.Logger, string)
+}
+
+// reportOOMKill makes it explicit that processes of a step were killed for
+// exceeding their memory limit, which would otherwise only show up as an exit
+// status of 137.
+func reportOOMKill(logger lager.Logger, delegate erroredDelegate, labels metric.StepLabels, kill *runtime.OOMKill) {
+	if kill == nil {
+		return
+	}
+
+	logger.Info("oom-killed", lager.Data{"memory-limit": kill.MemoryLimit})
+
+	delegate.Errored(logger, kill.Error())
+
+	metric.StepOOMKilled{Labels: labels}.Emit(logger)
+}
@@ -37,6 +37,7 @@ type PutDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	SelectedWorker(lager.Logger, string)
+	Waiting(lager.Logger, string)
 	Errored(lager.Logger, string)
 
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
@@ -54,6 +55,7 @@ type PutStep struct {
 	strategy              worker.ContainerPlacementStrategy
 	workerClient          worker.Client
 	delegateFactory       PutDelegateFactory
+	policyChecker         PolicyChecker
 	succeeded             bool
 }
 
@@ -67,6 +69,7 @@ func NewPutStep(
 	strategy worker.ContainerPlacementStrategy,
 	workerClient worker.Client,
 	delegateFactory PutDelegateFactory,
+	policyChecker PolicyChecker,
 ) Step {
 	return &PutStep{
 		planID:                planID,
@@ -78,6 +81,7 @@ func NewPutStep(
 		workerClient:          workerClient,
 		strategy:              strategy,
 		delegateFactory:       delegateFactory,
+		policyChecker:         policyChecker,
 	}
 }
 
@@ -168,6 +172,24 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		ResourceTypes: resourceTypes,
 	}
 
+	resourceType, _ := resourceTypes.Lookup(step.plan.Type)
+
+	err = checkRunStepPolicy(logger, step.policyChecker, step.workerClient, delegate, step.metadata, runStepPolicy{
+		Step:       "put",
+		Name:       step.plan.Name,
+		Privileged: resourceType.Privileged,
+		Config: map[string]interface{}{
+			"resource": step.plan.Resource,
+			"type":     step.plan.Type,
+			"source":   source,
+			"params":   params,
+		},
+		Worker: &workerSpec,
+	})
+	if err != nil {
+		return err
+	}
+
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
 	containerSpec.BindMounts = []worker.BindMountSource{
@@ -206,6 +228,8 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		return err
 	}
 
+	reportOOMKill(logger, delegate, step.metadata.metricLabels(step.plan.Name), result.OOMKill)
+
 	if result.ExitStatus != 0 {
 		delegate.Finished(logger, ExitStatus(result.ExitStatus), runtime.VersionResult{})
 		return nil
@@ -39,6 +39,7 @@ var _ = Describe("PutStep", func() {
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeDelegate              *execfakes.FakePutDelegate
 		fakeDelegateFactory       *execfakes.FakePutDelegateFactory
+		fakePolicyChecker         *execfakes.FakePolicyChecker
 
 		spanCtx context.Context
 
@@ -79,6 +80,7 @@ var _ = Describe("PutStep", func() {
 		versionResult  runtime.VersionResult
 		clientErr      error
 		someExitStatus int
+		oomKill        *runtime.OOMKill
 	)
 
 	BeforeEach(func() {
@@ -101,6 +103,8 @@ var _ = Describe("PutStep", func() {
 		fakeDelegateFactory = new(execfakes.FakePutDelegateFactory)
 		fakeDelegateFactory.PutDelegateReturns(fakeDelegate)
 
+		fakePolicyChecker = new(execfakes.FakePolicyChecker)
+
 		spanCtx = context.Background()
 		fakeDelegate.StartSpanReturns(spanCtx, trace.NoopSpan{})
 
@@ -161,6 +165,7 @@ var _ = Describe("PutStep", func() {
 		fakeResourceFactory.NewResourceReturns(fakeResource)
 
 		someExitStatus = 0
+		oomKill = nil
 		clientErr = nil
 	})
 
@@ -175,7 +180,7 @@ var _ = Describe("PutStep", func() {
 		}
 
 		fakeClient.RunPutStepReturns(
-			worker.PutResult{ExitStatus: someExitStatus, VersionResult: versionResult},
+			worker.PutResult{ExitStatus: someExitStatus, VersionResult: versionResult, OOMKill: oomKill},
 			clientErr,
 		)
 
@@ -189,6 +194,7 @@ var _ = Describe("PutStep", func() {
 			fakeStrategy,
 			fakeClient,
 			fakeDelegateFactory,
+			fakePolicyChecker,
 		)
 
 		stepErr = putStep.Run(ctx, state)
@@ -442,6 +448,26 @@ var _ = Describe("PutStep", func() {
 		It("is not successful", func() {
 			Expect(putStep.Succeeded()).To(BeFalse())
 		})
+
+		It("does not report an OOM kill", func() {
+			Expect(fakeDelegate.ErroredCallCount()).To(Equal(0))
+		})
+
+		Context("because it ran out of memory", func() {
+			BeforeEach(func() {
+				oomKill = &runtime.OOMKill{MemoryLimit: 2 * 1024 * 1024 * 1024}
+			})
+
+			It("reports the OOM kill via the delegate", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+				_, message := fakeDelegate.ErroredArgsForCall(0)
+				Expect(message).To(Equal("killed: out of memory, limit 2GB"))
+			})
+
+			It("still finishes the step via the delegate", func() {
+				Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			})
+		})
 	})
 
 	Context("when RunPutStep exits with an error", func() {
@@ -98,6 +98,17 @@ var _ = Describe("RetryErrorStep", func() {
 			})
 		})
 
+		Context("when the worker was landed at its drain deadline", func() {
+			cause := transport.WorkerUnreachableError{WorkerName: "some-worker", WorkerState: "landed"}
+			BeforeEach(func() {
+				fakeStep.RunReturns(cause)
+			})
+
+			It("should return retriable", func() {
+				Expect(runErr).To(Equal(Retriable{cause}))
+			})
+		})
+
 		Context("when url.Error error happened", func() {
 			cause := &url.Error{Op: "error", URL: "err", Err: errors.New("error")}
 			BeforeEach(func() {
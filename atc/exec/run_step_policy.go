@@ -0,0 +1,155 @@
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+//go:generate counterfeiter . PolicyChecker
+
+// PolicyChecker is implemented by policy.Checker.
+type PolicyChecker interface {
+	ShouldCheckAction(string) bool
+	Check(policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)
+}
+
+type policyCheckDelegate interface {
+	RedactImageSource(atc.Source) (atc.Source, error)
+	Stderr() io.Writer
+}
+
+// workerPlacement tells where a step's container will be placed, before the
+// worker is chosen. It's implemented by worker.Client.
+type workerPlacement interface {
+	RunsOnSharedWorkers(lager.Logger, worker.WorkerSpec) (bool, error)
+}
+
+// runStepPolicy describes a step that is about to run, once its config has
+// been interpolated.
+type runStepPolicy struct {
+	Step   string
+	Name   string
+	Config interface{}
+
+	// Privileged is true if the step's container is privileged: for a task,
+	// if it's configured as privileged; for a get or put, if its resource
+	// type is.
+	Privileged bool
+
+	// Worker is nil for steps which don't run on a worker. Otherwise, the
+	// worker hasn't been chosen yet, so this is what it will have to satisfy.
+	Worker *worker.WorkerSpec
+
+	// Services are the containers a task runs alongside its own. They're
+	// never privileged.
+	Services []atc.TaskServiceConfig
+}
+
+// checkRunStepPolicy checks a step against the policies right before it runs,
+// returning policy.PolicyCheckNotPass if it must not run.
+//
+// Credentials are redacted from the config before it's sent to the policy
+// agent, just like they are from image sources.
+//
+// Whether the step will run on a worker shared by every team is found out
+// from the placement, so that e.g. privileged steps can be kept off shared
+// workers. It's unused for steps which don't run on a worker.
+func checkRunStepPolicy(logger lager.Logger, checker PolicyChecker, placement workerPlacement, delegate policyCheckDelegate, metadata StepMetadata, step runStepPolicy) error {
+	if checker == nil || !checker.ShouldCheckAction(policy.ActionRunStep) {
+		return nil
+	}
+
+	payload, err := json.Marshal(step.Config)
+	if err != nil {
+		return err
+	}
+
+	var config atc.Source
+	err = json.Unmarshal(payload, &config)
+	if err != nil {
+		return err
+	}
+
+	config, err = delegate.RedactImageSource(config)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"step":       step.Step,
+		"name":       step.Name,
+		"job":        metadata.JobName,
+		"build":      metadata.BuildName,
+		"config":     config,
+		"privileged": step.Privileged,
+	}
+
+	if step.Services != nil {
+		services := []interface{}{}
+		for _, service := range step.Services {
+			image := map[string]interface{}{}
+			if service.ImageResource != nil {
+				source, err := delegate.RedactImageSource(service.ImageResource.Source)
+				if err != nil {
+					return err
+				}
+
+				image["type"] = service.ImageResource.Type
+				image["source"] = source
+			}
+
+			services = append(services, map[string]interface{}{
+				"name":       service.Name,
+				"image":      image,
+				"privileged": false,
+			})
+		}
+
+		data["services"] = services
+	}
+
+	if step.Worker != nil {
+		tags := step.Worker.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		shared, err := placement.RunsOnSharedWorkers(logger, *step.Worker)
+		if err != nil {
+			return fmt.Errorf("policy check: %w", err)
+		}
+
+		data["worker"] = map[string]interface{}{
+			"platform":      step.Worker.Platform,
+			"resource_type": step.Worker.ResourceType,
+			"tags":          tags,
+			"shared":        shared,
+		}
+	}
+
+	result, err := checker.Check(policy.PolicyCheckInput{
+		Action:   policy.ActionRunStep,
+		Team:     metadata.TeamName,
+		Pipeline: metadata.PipelineName,
+		Data:     data,
+	})
+	if err != nil {
+		return fmt.Errorf("policy check: %w", err)
+	}
+
+	if result.Blocked() {
+		return policy.PolicyCheckNotPass{
+			Reasons: result.Reasons,
+		}
+	}
+
+	result.PrintWarnings(delegate.Stderr())
+
+	return nil
+}
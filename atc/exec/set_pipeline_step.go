@@ -34,6 +34,7 @@ type SetPipelineStep struct {
 	teamFactory     db.TeamFactory
 	buildFactory    db.BuildFactory
 	client          worker.Client
+	policyChecker   PolicyChecker
 	succeeded       bool
 }
 
@@ -45,6 +46,7 @@ func NewSetPipelineStep(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	client worker.Client,
+	policyChecker PolicyChecker,
 ) Step {
 	return &SetPipelineStep{
 		planID:          planID,
@@ -54,6 +56,7 @@ func NewSetPipelineStep(
 		teamFactory:     teamFactory,
 		buildFactory:    buildFactory,
 		client:          client,
+		policyChecker:   policyChecker,
 	}
 }
 
@@ -122,6 +125,27 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState, delegate S
 		return err
 	}
 
+	teamName := step.plan.Team
+	if teamName == "" {
+		teamName = step.metadata.TeamName
+	}
+
+	err = checkRunStepPolicy(logger, step.policyChecker, nil, delegate, step.metadata, runStepPolicy{
+		Step: "set_pipeline",
+		Name: step.plan.Name,
+		Config: map[string]interface{}{
+			"pipeline":      step.plan.Name,
+			"file":          step.plan.File,
+			"team":          teamName,
+			"instance_vars": step.plan.InstanceVars,
+			"vars":          step.plan.Vars,
+			"config":        atcConfig,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	delegate.Starting(logger)
 
 	warnings, errors := configvalidate.Validate(atcConfig)
@@ -140,16 +164,18 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState, delegate S
 		return nil
 	}
 
-	var team db.Team
+	var team, currentTeam db.Team
 	if step.plan.Team == "" {
 		team = step.teamFactory.GetByID(step.metadata.TeamID)
+		currentTeam = team
 	} else {
 		fmt.Fprintln(stderr, "\x1b[1;33mWARNING: specifying the team in a set_pipeline step is experimental and may be removed in the future!\x1b[0m")
 		fmt.Fprintln(stderr, "")
 		fmt.Fprintln(stderr, "\x1b[33mcontribute to discussion #5731 with feedback: https://github.com/concourse/concourse/discussions/5731\x1b[0m")
 		fmt.Fprintln(stderr, "")
 
-		currentTeam, found, err := step.teamFactory.FindTeam(step.metadata.TeamName)
+		var found bool
+		currentTeam, found, err = step.teamFactory.FindTeam(step.metadata.TeamName)
 		if err != nil {
 			return err
 		}
@@ -182,6 +208,11 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState, delegate S
 		team = targetTeam
 	}
 
+	err = checkPipelineScope(currentTeam, step.metadata.PipelineName, team, step.plan.Name)
+	if err != nil {
+		return err
+	}
+
 	pipelineRef := atc.PipelineRef{
 		Name:         step.plan.Name,
 		InstanceVars: step.plan.InstanceVars,
@@ -366,3 +397,43 @@ func (s setPipelineSource) retrieveFromArtifact(name, file string) (io.ReadClose
 
 	return stream, nil
 }
+
+// checkPipelineScope keeps roles granted on only some pipelines of a team from
+// reaching other pipelines through set_pipeline. Every binding that lets users
+// change the current pipeline must also apply to the pipeline being set.
+func checkPipelineScope(currentTeam db.Team, currentPipeline string, targetTeam db.Team, targetPipeline string) error {
+	if currentPipeline == "" {
+		return nil
+	}
+
+	currentBindings := currentTeam.Auth().PipelineBindings(currentPipeline)
+	if len(currentBindings) == 0 {
+		return nil
+	}
+
+	if targetTeam.ID() != currentTeam.ID() {
+		return fmt.Errorf(
+			"pipeline %s has pipeline-scoped roles and cannot set pipelines of other teams",
+			currentPipeline,
+		)
+	}
+
+	targetBindings := map[string]bool{}
+	for _, key := range targetTeam.Auth().PipelineBindings(targetPipeline) {
+		targetBindings[key] = true
+	}
+
+	for _, key := range currentBindings {
+		if !targetBindings[key] {
+			return fmt.Errorf(
+				"pipeline %s cannot set pipeline %s: the %s role granted on it does not apply to %s",
+				currentPipeline,
+				targetPipeline,
+				atc.BindingRole(key),
+				targetPipeline,
+			)
+		}
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/build/buildfakes"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
@@ -88,6 +89,7 @@ jobs:
 
 		fakeDelegate        *execfakes.FakeSetPipelineStepDelegate
 		fakeDelegateFactory *execfakes.FakeSetPipelineStepDelegateFactory
+		fakePolicyChecker   *execfakes.FakePolicyChecker
 
 		fakeWorkerClient *workerfakes.FakeClient
 
@@ -143,6 +145,8 @@ jobs:
 		fakeDelegateFactory = new(execfakes.FakeSetPipelineStepDelegateFactory)
 		fakeDelegateFactory.SetPipelineStepDelegateReturns(fakeDelegate)
 
+		fakePolicyChecker = new(execfakes.FakePolicyChecker)
+
 		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
 		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
 		fakeBuild = new(dbfakes.FakeBuild)
@@ -194,6 +198,7 @@ jobs:
 			fakeTeamFactory,
 			fakeBuildFactory,
 			fakeWorkerClient,
+			fakePolicyChecker,
 		)
 
 		stepErr = spStep.Run(ctx, state)
@@ -375,6 +380,49 @@ jobs:
 				})
 			})
 
+			Context("when the RunStep action is policy checked", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.ShouldCheckActionReturns(true)
+					fakeDelegate.RedactImageSourceStub = func(source atc.Source) (atc.Source, error) {
+						return source, nil
+					}
+
+					spPlan.Team = "other-team"
+				})
+
+				It("checks the step with the target team before saving the pipeline", func() {
+					Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+
+					input := fakePolicyChecker.CheckArgsForCall(0)
+					Expect(input.Action).To(Equal(policy.ActionRunStep))
+					Expect(input.Team).To(Equal("some-team"))
+
+					data := input.Data.(map[string]interface{})
+					Expect(data["step"]).To(Equal("set_pipeline"))
+					Expect(data).ToNot(HaveKey("worker"))
+
+					config := data["config"].(atc.Source)
+					Expect(config["pipeline"]).To(Equal("some-pipeline"))
+					Expect(config["team"]).To(Equal("other-team"))
+				})
+
+				Context("when the policy check doesn't pass", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+							Allowed: false,
+							Reasons: []string{"pipelines can only be set in the same team"},
+						}, nil)
+					})
+
+					It("fails without saving the pipeline", func() {
+						Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{
+							Reasons: []string{"pipelines can only be set in the same team"},
+						}))
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when set-pipeline self", func() {
 				BeforeEach(func() {
 					spPlan = &atc.SetPipelinePlan{
@@ -408,6 +456,51 @@ jobs:
 				})
 			})
 
+			Context("when the team grants roles on only some pipelines", func() {
+				BeforeEach(func() {
+					fakeTeam.AuthReturns(atc.TeamAuth{
+						"owner":  {"users": {"local:admin"}},
+						"member": {"users": {"local:dev"}, "pipelines": {"some-*"}},
+					})
+					fakeTeam.PipelineReturns(nil, false, nil)
+					fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+				})
+
+				Context("when the scoped roles also apply to the pipeline being set", func() {
+					BeforeEach(func() {
+						spPlan.Name = "some-other-pipeline"
+					})
+
+					It("saves the pipeline", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the scoped roles don't apply to the pipeline being set", func() {
+					BeforeEach(func() {
+						spPlan.Name = "prod-pipeline"
+					})
+
+					It("fails without saving the pipeline", func() {
+						Expect(stepErr).To(MatchError("pipeline some-pipeline cannot set pipeline prod-pipeline: the member role granted on it does not apply to prod-pipeline"))
+						Expect(fakeBuild.SavePipelineCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the current pipeline isn't covered by a scoped role", func() {
+					BeforeEach(func() {
+						stepMetadata.PipelineName = "parent"
+						spPlan.Name = "prod-pipeline"
+					})
+
+					It("saves the pipeline", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+					})
+				})
+			})
+
 			Context("when team is configured", func() {
 				var (
 					fakeUserCurrentTeam *dbfakes.FakeTeam
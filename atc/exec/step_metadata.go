@@ -3,6 +3,8 @@ package exec
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/concourse/concourse/atc/metric"
 )
 
 type StepMetadata struct {
@@ -64,3 +66,12 @@ func (metadata StepMetadata) Env() []string {
 
 	return env
 }
+
+func (metadata StepMetadata) metricLabels(stepName string) metric.StepLabels {
+	return metric.StepLabels{
+		TeamName:     metadata.TeamName,
+		PipelineName: metadata.PipelineName,
+		JobName:      metadata.JobName,
+		StepName:     stepName,
+	}
+}
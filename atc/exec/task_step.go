@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -15,6 +16,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
@@ -68,13 +70,18 @@ type TaskDelegate interface {
 	Stdout() io.Writer
 	Stderr() io.Writer
 
+	ServiceStdout(name string) io.Writer
+	ServiceStderr(name string) io.Writer
+
 	SetTaskConfig(config atc.TaskConfig)
 
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus)
 	SelectedWorker(lager.Logger, string)
+	Waiting(lager.Logger, string)
 	Errored(lager.Logger, string)
+	ContainerUsage(lager.Logger, runtime.ContainerUsage)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -89,6 +96,7 @@ type TaskStep struct {
 	workerClient      worker.Client
 	delegateFactory   TaskDelegateFactory
 	lockFactory       lock.LockFactory
+	policyChecker     PolicyChecker
 	succeeded         bool
 }
 
@@ -102,6 +110,7 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegateFactory TaskDelegateFactory,
 	lockFactory lock.LockFactory,
+	policyChecker PolicyChecker,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -113,6 +122,7 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegateFactory:   delegateFactory,
 		lockFactory:       lockFactory,
+		policyChecker:     policyChecker,
 	}
 }
 
@@ -230,6 +240,11 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 	}
 	tracing.Inject(ctx, &containerSpec)
 
+	containerSpec.Services, err = step.serviceSpecs(config, delegate)
+	if err != nil {
+		return err
+	}
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -243,6 +258,18 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		Delegate:      delegate,
 	}
 
+	err = checkRunStepPolicy(logger, step.policyChecker, step.workerClient, delegate, step.metadata, runStepPolicy{
+		Step:       "task",
+		Name:       step.plan.Name,
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Worker:     &workerSpec,
+		Services:   append([]atc.TaskServiceConfig{}, config.Services...),
+	})
+	if err != nil {
+		return err
+	}
+
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
 	result, err := step.workerClient.RunTaskStep(
@@ -259,6 +286,9 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		step.lockFactory,
 	)
 
+	step.recordUsage(logger, delegate, result.Usage)
+	reportOOMKill(logger, delegate, step.metadata.metricLabels(step.plan.Name), result.OOMKill)
+
 	if err != nil {
 		if err == context.Canceled || err == context.DeadlineExceeded {
 			step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
@@ -282,6 +312,24 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 	return nil
 }
 
+func (step *TaskStep) recordUsage(logger lager.Logger, delegate TaskDelegate, usage runtime.ContainerUsage) {
+	if usage.Samples == 0 {
+		return
+	}
+
+	delegate.ContainerUsage(logger, usage)
+
+	metric.StepContainerUsage{
+		Labels:            step.metadata.metricLabels(step.plan.Name),
+		CPUAverage:        usage.CPUAverage(),
+		MemoryPeak:        usage.MemoryPeak,
+		NetworkRxBytes:    usage.NetworkRxBytes,
+		NetworkTxBytes:    usage.NetworkTxBytes,
+		BlockIOReadBytes:  usage.BlockIOReadBytes,
+		BlockIOWriteBytes: usage.BlockIOWriteBytes,
+	}.Emit(logger)
+}
+
 func (step *TaskStep) Succeeded() bool {
 	return step.succeeded
 }
@@ -388,6 +436,7 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		Dir:       metadata.WorkingDirectory,
 		Env:       config.Params.Env(),
 		Type:      metadata.Type,
+		Egress:    config.Egress,
 
 		Outputs: worker.OutputPaths{},
 	}
@@ -405,6 +454,73 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 	return containerSpec, nil
 }
 
+// serviceSpecs describes the containers of the task's services. Services are
+// never privileged, even if the task is.
+func (step *TaskStep) serviceSpecs(config atc.TaskConfig, delegate TaskDelegate) ([]worker.ServiceSpec, error) {
+	var services []worker.ServiceSpec
+
+	for _, service := range config.Services {
+		metadata := step.containerMetadata
+		metadata.StepName = step.plan.Name + "/" + service.Name
+		metadata.WorkingDirectory = ""
+		metadata.User = service.Run.User
+
+		var readinessSpec *runtime.ProcessSpec
+		if service.Ready != nil {
+			readinessSpec = &runtime.ProcessSpec{
+				Path:         service.Ready.Path,
+				Args:         service.Ready.Args,
+				Dir:          service.Ready.Dir,
+				StdoutWriter: delegate.ServiceStdout(service.Name),
+				StderrWriter: delegate.ServiceStderr(service.Name),
+			}
+		}
+
+		var readinessTimeout time.Duration
+		if service.ReadyTimeout != "" {
+			var err error
+			readinessTimeout, err = time.ParseDuration(service.ReadyTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("parse ready_timeout of service %s: %w", service.Name, err)
+			}
+		}
+
+		services = append(services, worker.ServiceSpec{
+			Name: service.Name,
+
+			Owner:    db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID.Service(service.Name), step.metadata.TeamID),
+			Metadata: metadata,
+			ContainerSpec: worker.ContainerSpec{
+				Platform: config.Platform,
+				Tags:     step.plan.Tags,
+				TeamID:   step.metadata.TeamID,
+				ImageSpec: worker.ImageSpec{
+					ImageResource: &worker.ImageResource{
+						Type:    service.ImageResource.Type,
+						Source:  service.ImageResource.Source,
+						Params:  service.ImageResource.Params,
+						Version: service.ImageResource.Version,
+					},
+				},
+				User: service.Run.User,
+				Env:  service.Params.Env(),
+				Type: metadata.Type,
+			},
+			ProcessSpec: runtime.ProcessSpec{
+				Path:         service.Run.Path,
+				Args:         service.Run.Args,
+				Dir:          service.Run.Dir,
+				StdoutWriter: delegate.ServiceStdout(service.Name),
+				StderrWriter: delegate.ServiceStderr(service.Name),
+			},
+			ReadinessSpec:    readinessSpec,
+			ReadinessTimeout: readinessTimeout,
+		})
+	}
+
+	return services, nil
+}
+
 func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.VersionedResourceTypes, repository *build.Repository, config atc.TaskConfig) (worker.WorkerSpec, error) {
 	workerSpec := worker.WorkerSpec{
 		Platform:      config.Platform,
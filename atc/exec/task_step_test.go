@@ -3,6 +3,7 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -11,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -42,6 +44,7 @@ var _ = Describe("TaskStep", func() {
 		fakeDelegate *execfakes.FakeTaskDelegate
 
 		fakeDelegateFactory *execfakes.FakeTaskDelegateFactory
+		fakePolicyChecker   *execfakes.FakePolicyChecker
 
 		taskPlan *atc.TaskPlan
 
@@ -89,6 +92,8 @@ var _ = Describe("TaskStep", func() {
 		fakeDelegateFactory = new(execfakes.FakeTaskDelegateFactory)
 		fakeDelegateFactory.TaskDelegateReturns(fakeDelegate)
 
+		fakePolicyChecker = new(execfakes.FakePolicyChecker)
+
 		repo = build.NewRepository()
 		state = new(execfakes.FakeRunState)
 		state.ArtifactRepositoryReturns(repo)
@@ -144,6 +149,7 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegateFactory,
 			fakeLockFactory,
+			fakePolicyChecker,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -288,6 +294,175 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the RunStep action is policy checked", func() {
+			BeforeEach(func() {
+				stepMetadata.TeamName = "some-team"
+				stepMetadata.PipelineName = "some-pipeline"
+				stepMetadata.JobName = "some-job"
+
+				fakePolicyChecker.ShouldCheckActionReturns(true)
+				fakeDelegate.RedactImageSourceStub = func(source atc.Source) (atc.Source, error) {
+					return source, nil
+				}
+
+				taskPlan.Privileged = true
+				fakeClient.RunsOnSharedWorkersReturns(true, nil)
+			})
+
+			AfterEach(func() {
+				stepMetadata.TeamName = ""
+				stepMetadata.PipelineName = ""
+				stepMetadata.JobName = ""
+			})
+
+			It("checks the interpolated config of the task", func() {
+				Expect(fakePolicyChecker.ShouldCheckActionArgsForCall(0)).To(Equal(policy.ActionRunStep))
+				Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+
+				input := fakePolicyChecker.CheckArgsForCall(0)
+				Expect(input.Action).To(Equal(policy.ActionRunStep))
+				Expect(input.Team).To(Equal("some-team"))
+				Expect(input.Pipeline).To(Equal("some-pipeline"))
+
+				data := input.Data.(map[string]interface{})
+				Expect(data["step"]).To(Equal("task"))
+				Expect(data["name"]).To(Equal("some-task"))
+				Expect(data["job"]).To(Equal("some-job"))
+				Expect(data["privileged"]).To(BeTrue())
+				Expect(data["worker"]).To(Equal(map[string]interface{}{
+					"platform":      "some-platform",
+					"resource_type": "docker",
+					"tags":          []string{"step", "tags"},
+					"shared":        true,
+				}))
+
+				_, workerSpec := fakeClient.RunsOnSharedWorkersArgsForCall(0)
+				Expect(workerSpec.TeamID).To(Equal(stepMetadata.TeamID))
+				Expect(workerSpec.Tags).To(Equal([]string{"step", "tags"}))
+
+				config := data["config"].(atc.Source)
+				Expect(config["platform"]).To(Equal("some-platform"))
+				Expect(config["params"]).To(Equal(map[string]interface{}{"SECURE": "secret-task-param"}))
+
+				Expect(data["services"]).To(BeEmpty())
+			})
+
+			It("redacts the config", func() {
+				Expect(fakeDelegate.RedactImageSourceCallCount()).To(Equal(1))
+			})
+
+			Context("when the task has services", func() {
+				BeforeEach(func() {
+					taskPlan.Config.Services = []atc.TaskServiceConfig{
+						{
+							Name: "postgres",
+							ImageResource: &atc.ImageResource{
+								Type:   "registry-image",
+								Source: atc.Source{"repository": "postgres", "password": "secret"},
+							},
+							Run: atc.TaskRunConfig{Path: "docker-entrypoint.sh"},
+						},
+					}
+
+					fakeDelegate.RedactImageSourceStub = func(source atc.Source) (atc.Source, error) {
+						if _, ok := source["password"]; ok {
+							return atc.Source{"repository": "postgres", "password": "((redacted))"}, nil
+						}
+
+						return source, nil
+					}
+				})
+
+				It("checks the services with their redacted images, as unprivileged", func() {
+					data := fakePolicyChecker.CheckArgsForCall(0).Data.(map[string]interface{})
+					Expect(data["services"]).To(Equal([]interface{}{
+						map[string]interface{}{
+							"name": "postgres",
+							"image": map[string]interface{}{
+								"type":   "registry-image",
+								"source": atc.Source{"repository": "postgres", "password": "((redacted))"},
+							},
+							"privileged": false,
+						},
+					}))
+				})
+
+				Context("when the policy check passes", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+					})
+
+					It("doesn't make the service containers privileged", func() {
+						_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+						Expect(containerSpec.ImageSpec.Privileged).To(BeTrue())
+						Expect(containerSpec.Services[0].ContainerSpec.ImageSpec.Privileged).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when finding out where the task would run fails", func() {
+				BeforeEach(func() {
+					fakeClient.RunsOnSharedWorkersReturns(false, errors.New("nope"))
+				})
+
+				It("fails without checking or running the task", func() {
+					Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+					Expect(fakePolicyChecker.CheckCallCount()).To(Equal(0))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the policy check doesn't pass", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed: false,
+						Reasons: []string{"privileged tasks are not allowed"},
+					}, nil)
+				})
+
+				It("fails without running the task", func() {
+					Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{
+						Reasons: []string{"privileged tasks are not allowed"},
+					}))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the policy check only warns", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed:  false,
+						Reasons:  []string{"privileged tasks are discouraged"},
+						Severity: policy.SeverityWarn,
+					}, nil)
+				})
+
+				It("runs the task and prints the warnings", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(stderrBuf).To(gbytes.Say("WARNING: policy check: privileged tasks are discouraged"))
+				})
+			})
+
+			Context("when the policy check errors", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.FailedPolicyCheck(), errors.New("nope"))
+				})
+
+				It("fails without running the task", func() {
+					Expect(stepErr).To(MatchError("policy check: nope"))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the RunStep action is not policy checked", func() {
+			It("does not check the task", func() {
+				Expect(fakePolicyChecker.CheckCallCount()).To(Equal(0))
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("when the configuration specifies paths for inputs", func() {
 			var inputArtifact *runtimefakes.FakeArtifact
 			var otherInputArtifact *runtimefakes.FakeArtifact
@@ -783,6 +958,105 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when egress rules are specified", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Egress = []atc.TaskEgressRule{
+					{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []uint16{443}},
+				}
+			})
+
+			It("adds the egress rules to the container spec", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Egress).To(Equal([]atc.TaskEgressRule{
+					{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []uint16{443}},
+				}))
+			})
+		})
+
+		Context("when services are specified", func() {
+			var serviceStdout, serviceStderr *gbytes.Buffer
+
+			BeforeEach(func() {
+				serviceStdout = gbytes.NewBuffer()
+				serviceStderr = gbytes.NewBuffer()
+				fakeDelegate.ServiceStdoutReturns(serviceStdout)
+				fakeDelegate.ServiceStderrReturns(serviceStderr)
+
+				taskPlan.Config.Services = []atc.TaskServiceConfig{
+					{
+						Name: "postgres",
+						ImageResource: &atc.ImageResource{
+							Type:   "registry-image",
+							Source: atc.Source{"repository": "postgres"},
+						},
+						Params: atc.TaskEnv{"POSTGRES_PASSWORD": "password"},
+						Run: atc.TaskRunConfig{
+							Path: "docker-entrypoint.sh",
+							Args: []string{"postgres"},
+						},
+					},
+				}
+			})
+
+			It("adds a service spec to the container spec", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Services).To(HaveLen(1))
+
+				service := containerSpec.Services[0]
+				Expect(service.Name).To(Equal("postgres"))
+				Expect(service.Owner).To(Equal(db.NewBuildStepContainerOwner(1234, planID.Service("postgres"), 123)))
+				Expect(service.ContainerSpec.TeamID).To(Equal(123))
+				Expect(service.ContainerSpec.Env).To(Equal([]string{"POSTGRES_PASSWORD=password"}))
+				Expect(service.ContainerSpec.ImageSpec.ImageResource).To(Equal(&worker.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "postgres"},
+				}))
+				Expect(service.ProcessSpec.Path).To(Equal("docker-entrypoint.sh"))
+				Expect(service.ProcessSpec.Args).To(Equal([]string{"postgres"}))
+				Expect(service.ReadinessSpec).To(BeNil())
+				Expect(service.ReadinessTimeout).To(BeZero())
+			})
+
+			Context("when a service has a readiness timeout", func() {
+				BeforeEach(func() {
+					taskPlan.Config.Services[0].ReadyTimeout = "90s"
+				})
+
+				It("adds it to the service spec", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.Services[0].ReadinessTimeout).To(Equal(90 * time.Second))
+				})
+			})
+
+			Context("when a service has a readiness check", func() {
+				BeforeEach(func() {
+					taskPlan.Config.Services[0].Ready = &atc.TaskRunConfig{
+						Path: "pg_isready",
+						Args: []string{"-h", "localhost"},
+					}
+				})
+
+				It("adds it to the service spec", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.Services[0].ReadinessSpec).To(Equal(&runtime.ProcessSpec{
+						Path:         "pg_isready",
+						Args:         []string{"-h", "localhost"},
+						StdoutWriter: serviceStdout,
+						StderrWriter: serviceStderr,
+					}))
+				})
+			})
+
+			It("streams the service output through the delegate", func() {
+				Expect(fakeDelegate.ServiceStdoutArgsForCall(0)).To(Equal("postgres"))
+				Expect(fakeDelegate.ServiceStderrArgsForCall(0)).To(Equal("postgres"))
+
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Services[0].ProcessSpec.StdoutWriter).To(Equal(serviceStdout))
+				Expect(containerSpec.Services[0].ProcessSpec.StderrWriter).To(Equal(serviceStderr))
+			})
+		})
+
 		Context("when running the task succeeds", func() {
 			var taskStepStatus int
 			BeforeEach(func() {
@@ -806,6 +1080,37 @@ var _ = Describe("TaskStep", func() {
 				Expect(stepErr).ToNot(HaveOccurred())
 			})
 
+			Context("when the resource usage of the container was sampled", func() {
+				var usage runtime.ContainerUsage
+
+				BeforeEach(func() {
+					usage = runtime.ContainerUsage{
+						Samples:    3,
+						Duration:   time.Minute,
+						CPUTime:    30 * time.Second,
+						MemoryPeak: 1024,
+					}
+
+					fakeClient.RunTaskStepReturns(worker.TaskResult{Usage: usage}, nil)
+				})
+
+				It("reports it through the delegate", func() {
+					Expect(fakeDelegate.ContainerUsageCallCount()).To(Equal(1))
+					_, reported := fakeDelegate.ContainerUsageArgsForCall(0)
+					Expect(reported).To(Equal(usage))
+				})
+			})
+
+			Context("when the resource usage of the container could not be sampled", func() {
+				BeforeEach(func() {
+					fakeClient.RunTaskStepReturns(worker.TaskResult{}, nil)
+				})
+
+				It("does not report it", func() {
+					Expect(fakeDelegate.ContainerUsageCallCount()).To(BeZero())
+				})
+			})
+
 			Context("when the task exits with zero status", func() {
 				BeforeEach(func() {
 					taskStepStatus = 0
@@ -914,6 +1219,33 @@ var _ = Describe("TaskStep", func() {
 				It("returns successfully", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 				})
+
+				It("does not report an OOM kill", func() {
+					Expect(fakeDelegate.ErroredCallCount()).To(Equal(0))
+				})
+
+				Context("because it ran out of memory", func() {
+					BeforeEach(func() {
+						taskResult := worker.TaskResult{
+							ExitStatus:   137,
+							VolumeMounts: []worker.VolumeMount{},
+							OOMKill:      &runtime.OOMKill{MemoryLimit: 2 * 1024 * 1024 * 1024},
+						}
+						fakeClient.RunTaskStepReturns(taskResult, nil)
+					})
+
+					It("reports the OOM kill via the delegate", func() {
+						Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+						_, message := fakeDelegate.ErroredArgsForCall(0)
+						Expect(message).To(Equal("killed: out of memory, limit 2GB"))
+					})
+
+					It("finishes the task via the delegate", func() {
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+						_, status := fakeDelegate.FinishedArgsForCall(0)
+						Expect(status).To(Equal(exec.ExitStatus(137)))
+					})
+				})
 			})
 		})
 
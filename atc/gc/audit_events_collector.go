@@ -0,0 +1,47 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type auditEventsCollector struct {
+	auditLog  db.AuditLog
+	retention time.Duration
+}
+
+// NewAuditEventsCollector removes the audit events older than the retention.
+// A retention of zero keeps audit events forever.
+func NewAuditEventsCollector(auditLog db.AuditLog, retention time.Duration) *auditEventsCollector {
+	return &auditEventsCollector{
+		auditLog:  auditLog,
+		retention: retention,
+	}
+}
+
+func (c *auditEventsCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-events-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.retention == 0 {
+		return nil
+	}
+
+	removed, err := c.auditLog.RemoveEventsOlderThan(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-audit-events", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-audit-events", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventsCollector", func() {
+	var (
+		collector    GcCollector
+		fakeAuditLog *dbfakes.FakeAuditLog
+		retention    time.Duration
+	)
+
+	BeforeEach(func() {
+		fakeAuditLog = new(dbfakes.FakeAuditLog)
+		retention = 24 * time.Hour
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewAuditEventsCollector(fakeAuditLog, retention)
+	})
+
+	Describe("Run", func() {
+		It("removes the audit events older than the retention", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeAuditLog.RemoveEventsOlderThanCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RemoveEventsOlderThanArgsForCall(0)).To(Equal(24 * time.Hour))
+		})
+
+		Context("when removing the events fails", func() {
+			BeforeEach(func() {
+				fakeAuditLog.RemoveEventsOlderThanReturns(0, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+
+		Context("when there is no retention", func() {
+			BeforeEach(func() {
+				retention = 0
+			})
+
+			It("keeps every audit event", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeAuditLog.RemoveEventsOlderThanCallCount()).To(BeZero())
+			})
+		})
+	})
+})
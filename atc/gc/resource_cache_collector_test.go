@@ -52,7 +52,7 @@ var _ = Describe("ResourceCacheCollector", func() {
 				)
 				Expect(err).NotTo(HaveOccurred())
 
-				jobBuild, err = defaultJob.CreateBuild()
+				jobBuild, err = defaultJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				jobCache, err = resourceCacheFactory.FindOrCreateResourceCache(
@@ -168,7 +168,7 @@ var _ = Describe("ResourceCacheCollector", func() {
 						var secondJobCache db.UsedResourceCache
 
 						BeforeEach(func() {
-							secondJobBuild, err = defaultJob.CreateBuild()
+							secondJobBuild, err = defaultJob.CreateBuild("some-user")
 							Expect(err).ToNot(HaveOccurred())
 
 							secondJobCache, err = resourceCacheFactory.FindOrCreateResourceCache(
@@ -218,7 +218,7 @@ var _ = Describe("ResourceCacheCollector", func() {
 							Expect(err).NotTo(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							secondJobBuild, err = secondJob.CreateBuild()
+							secondJobBuild, err = secondJob.CreateBuild("some-user")
 							Expect(err).ToNot(HaveOccurred())
 
 							secondJobCache, err = resourceCacheFactory.FindOrCreateResourceCache(
@@ -26,6 +26,7 @@ var _ = Describe("WorkerCollector", func() {
 		fakeWorkerLifecycle.DeleteUnresponsiveEphemeralWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.DeleteFinishedRetiringWorkersReturns(nil, nil)
+		fakeWorkerLifecycle.LandExpiredLandingWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.LandFinishedLandingWorkersReturns(nil, nil)
 	})
 
@@ -51,6 +52,21 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.DeleteFinishedRetiringWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker factory to land landing workers past their drain deadline", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.LandExpiredLandingWorkersCallCount()).To(Equal(1))
+		})
+
+		It("returns an error if landing workers past their drain deadline fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.LandExpiredLandingWorkersReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
 		It("tells the worker factory to land finished landing workers", func() {
 			err := workerCollector.Run(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,31 @@
+package atc
+
+// InputsExplanation is the result of running the input resolution algorithm
+// for a job without saving anything, along with every decision it made.
+type InputsExplanation struct {
+	Resolved bool               `json:"resolved"`
+	Inputs   []ExplainedInput   `json:"inputs"`
+	Steps    []InputExplainStep `json:"steps"`
+}
+
+type ExplainedInput struct {
+	Name            string  `json:"name"`
+	Resource        string  `json:"resource"`
+	Version         Version `json:"version,omitempty"`
+	PassedBuildIDs  []int   `json:"passed_build_ids,omitempty"`
+	FirstOccurrence bool    `json:"first_occurrence"`
+	ResolveError    string  `json:"resolve_error,omitempty"`
+}
+
+// InputExplainStep is a single decision made while resolving an input, e.g.
+// a build of a passed job vouching for a version or a candidate version being
+// rejected because the other inputs could not be satisfied with it.
+type InputExplainStep struct {
+	Event    string  `json:"event"`
+	Input    string  `json:"input"`
+	Resource string  `json:"resource"`
+	JobName  string  `json:"job_name,omitempty"`
+	BuildID  int     `json:"build_id,omitempty"`
+	Version  Version `json:"version,omitempty"`
+	Detail   string  `json:"detail,omitempty"`
+}
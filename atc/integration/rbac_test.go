@@ -210,6 +210,64 @@ not-viewer:
 			})
 		})
 
+		Context("when defining a custom role with the name of a built-in role", func() {
+			BeforeEach(func() {
+				rbac = `
+---
+custom_roles:
+  viewer:
+  - PausePipeline
+`
+			})
+
+			It("errors", func() {
+				file := filepath.Join(tmp, "rbac-built-in-role.yml")
+				err := ioutil.WriteFile(file, []byte(rbac), 0755)
+				Expect(err).ToNot(HaveOccurred())
+
+				cmd.ConfigRBAC = flag.File(file)
+
+				// workaround to avoid panic due to registering http handlers multiple times
+				http.DefaultServeMux = new(http.ServeMux)
+
+				_, err = cmd.Runner([]string{})
+				Expect(err).To(MatchError(ContainSubstring("failed to define custom roles: role viewer is a built-in role")))
+			})
+		})
+
+		Context("when defining a custom role", func() {
+			BeforeEach(func() {
+				rbac = `
+---
+custom_roles:
+  pauser:
+  - PausePipeline
+`
+				file := filepath.Join(tmp, "rbac-custom-role.yml")
+				err := ioutil.WriteFile(file, []byte(rbac), 0755)
+				Expect(err).ToNot(HaveOccurred())
+
+				cmd.ConfigRBAC = flag.File(file)
+			})
+
+			It("users with the custom role can perform its actions", func() {
+				team.Auth["pauser"] = map[string][]string{
+					"users":  []string{"local:v-user"},
+					"groups": []string{},
+				}
+				setupTeam(atcURL, team)
+
+				ccClient := login(atcURL, "v-user", "v-user")
+
+				_, err := ccClient.Team(team.Name).PausePipeline(atc.PipelineRef{Name: "pipeline-name"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, _, err = ccClient.Team(team.Name).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-new"}, "0", pipelineData, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("forbidden"))
+			})
+		})
+
 		Context("when successfully customizing a role", func() {
 			BeforeEach(func() {
 				rbac = `
@@ -72,6 +72,14 @@ func (s *scanner) Run(ctx context.Context) error {
 			}()
 			defer waitGroup.Done()
 
+			metric.ResourceCheckHealth{
+				TeamName:            resource.TeamName(),
+				Pipeline:            resource.PipelineRef(),
+				ResourceName:        resource.Name(),
+				LastSuccessfulCheck: resource.LastCheckEndTime(),
+				ConsecutiveFailures: resource.ConsecutiveCheckFailures(),
+			}.Emit(logger)
+
 			err := s.check(spanCtx, resource, resourceTypes, resourceTypesChecked)
 			s.setCheckError(logger, resource, err)
 
@@ -0,0 +1,15 @@
+package atc
+
+// LocalUser is a user who logs in with a username and password stored by
+// Concourse. Users added with the --add-local-user flag are read-only; the
+// others are managed through the API.
+type LocalUser struct {
+	Username  string `json:"username"`
+	Disabled  bool   `json:"disabled,omitempty"`
+	ReadOnly  bool   `json:"read_only,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	UpdatedAt int64  `json:"updated_at,omitempty"`
+
+	Password string `json:"password,omitempty"`
+}
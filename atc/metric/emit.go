@@ -31,6 +31,14 @@ type EmitterFactory interface {
 	NewEmitter() (Emitter, error)
 }
 
+// ResourceEmitterFactory is implemented by emitter factories whose emitters
+// describe the emitting ATC as a resource, rather than by repeating its host
+// and --metrics-attribute attributes on every event.
+type ResourceEmitterFactory interface {
+	EmitterFactory
+	SetResource(host string, attributes map[string]string)
+}
+
 type Monitor struct {
 	emitter          Emitter
 	eventHost        string
@@ -121,6 +129,10 @@ func (m *Monitor) Initialize(logger lager.Logger, host string, attributes map[st
 
 	for _, factory := range m.emitterFactories {
 		if factory.IsConfigured() {
+			if resourceFactory, ok := factory.(ResourceEmitterFactory); ok {
+				resourceFactory.SetResource(host, attributes)
+			}
+
 			emitter, err = factory.NewEmitter()
 			if err != nil {
 				return err
@@ -142,6 +154,12 @@ func (m *Monitor) Initialize(logger lager.Logger, host string, attributes map[st
 	return nil
 }
 
+// IsEmitting reports whether an emitter has been configured, so that metrics
+// which are costly to compute can be skipped when nothing would receive them.
+func (m *Monitor) IsEmitting() bool {
+	return m.emitter != nil
+}
+
 func (m *Monitor) emit(logger lager.Logger, event Event) {
 	if m.emitter == nil {
 		return
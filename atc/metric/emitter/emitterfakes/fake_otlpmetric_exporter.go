@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package emitterfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/concourse/concourse/atc/metric/emitter"
+)
+
+type FakeOTLPMetricExporter struct {
+	ExportStub        func(context.Context, map[string]string, []emitter.OTLPDataPoint) error
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]string
+		arg3 []emitter.OTLPDataPoint
+	}
+	exportReturns struct {
+		result1 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOTLPMetricExporter) Export(arg1 context.Context, arg2 map[string]string, arg3 []emitter.OTLPDataPoint) error {
+	var arg3Copy []emitter.OTLPDataPoint
+	if arg3 != nil {
+		arg3Copy = make([]emitter.OTLPDataPoint, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]string
+		arg3 []emitter.OTLPDataPoint
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("Export", []interface{}{arg1, arg2, arg3Copy})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.exportReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeOTLPMetricExporter) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeOTLPMetricExporter) ExportCalls(stub func(context.Context, map[string]string, []emitter.OTLPDataPoint) error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = stub
+}
+
+func (fake *FakeOTLPMetricExporter) ExportArgsForCall(i int) (context.Context, map[string]string, []emitter.OTLPDataPoint) {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	argsForCall := fake.exportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOTLPMetricExporter) ExportReturns(result1 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOTLPMetricExporter) ExportReturnsOnCall(i int, result1 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOTLPMetricExporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOTLPMetricExporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ emitter.OTLPMetricExporter = new(FakeOTLPMetricExporter)
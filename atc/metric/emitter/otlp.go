@@ -0,0 +1,239 @@
+package emitter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/pkg/errors"
+	apimetric "go.opentelemetry.io/otel/api/metric"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	otlpMetricPrefix = "concourse."
+	otlpHostLabel    = "host.name"
+)
+
+type (
+	OTLPEmitter struct {
+		Exporter      OTLPMetricExporter
+		Resource      map[string]string
+		BatchSize     int
+		BatchDuration time.Duration
+		LastEmitTime  time.Time
+		OTLPBatch     []OTLPDataPoint
+	}
+
+	OTLPConfig struct {
+		Address       string            `long:"otlp-metrics-address" description:"OTLP collector address to emit metrics to over gRPC, as host:port."`
+		Headers       map[string]string `long:"otlp-metrics-header" description:"Header to attach to each request to the OTLP collector. Can be specified multiple times." value-name:"NAME:VALUE"`
+		UseTLS        bool              `long:"otlp-metrics-use-tls" description:"Use TLS to connect to the OTLP collector."`
+		BatchSize     uint64            `long:"otlp-metrics-batch-size" default:"1000" description:"Number of data points to batch together before emitting."`
+		BatchDuration time.Duration     `long:"otlp-metrics-batch-duration" default:"15s" description:"Length of time to wait between emitting until all currently batched data points are emitted."`
+
+		host       string
+		attributes map[string]string
+	}
+
+	// OTLPDataPoint is a single value of a metric, recorded at the time of
+	// the event it was emitted for.
+	OTLPDataPoint struct {
+		Name   string
+		Value  float64
+		Labels map[string]string
+		Time   time.Time
+	}
+)
+
+//go:generate counterfeiter . OTLPMetricExporter
+
+// OTLPMetricExporter sends a batch of data points, all emitted by the same
+// resource, to an OTLP collector.
+type OTLPMetricExporter interface {
+	Export(ctx context.Context, resource map[string]string, points []OTLPDataPoint) error
+}
+
+func init() {
+	metric.Metrics.RegisterEmitter(&OTLPConfig{})
+}
+
+func (config *OTLPConfig) Description() string { return "OTLP" }
+func (config *OTLPConfig) IsConfigured() bool  { return config.Address != "" }
+
+func (config *OTLPConfig) SetResource(host string, attributes map[string]string) {
+	config.host = host
+	config.attributes = attributes
+}
+
+func (config *OTLPConfig) NewEmitter() (metric.Emitter, error) {
+	options := []otlp.ExporterOption{
+		otlp.WithAddress(config.Address),
+		otlp.WithHeaders(config.Headers),
+	}
+
+	if config.UseTLS {
+		options = append(options, otlp.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	} else {
+		options = append(options, otlp.WithInsecure())
+	}
+
+	exporter, err := otlp.NewExporter(options...)
+	if err != nil {
+		return &OTLPEmitter{}, fmt.Errorf("failed to create otlp exporter: %w", err)
+	}
+
+	resourceAttributes := map[string]string{}
+	for k, v := range config.attributes {
+		resourceAttributes[k] = v
+	}
+
+	if config.host != "" {
+		resourceAttributes[otlpHostLabel] = config.host
+	}
+
+	return &OTLPEmitter{
+		Exporter:      &otlpMetricExporter{exporter: exporter},
+		Resource:      resourceAttributes,
+		BatchSize:     int(config.BatchSize),
+		BatchDuration: config.BatchDuration,
+		LastEmitTime:  time.Now(),
+		OTLPBatch:     make([]OTLPDataPoint, 0),
+	}, nil
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	logger = logger.Session("otlp")
+
+	emitter.OTLPBatch = append(emitter.OTLPBatch, emitter.dataPoint(event))
+
+	duration := time.Since(emitter.LastEmitTime)
+	if len(emitter.OTLPBatch) >= emitter.BatchSize || duration >= emitter.BatchDuration {
+		logger.Debug("pre-emit-batch", lager.Data{
+			"batch-size":         emitter.BatchSize,
+			"current-batch-size": len(emitter.OTLPBatch),
+			"batch-duration":     emitter.BatchDuration,
+			"current-duration":   duration,
+		})
+		emitter.submitBatch(logger)
+	}
+}
+
+// dataPoint names the event the way OpenTelemetry names metrics, and labels
+// it with the attributes that aren't already attributes of the resource.
+func (emitter *OTLPEmitter) dataPoint(event metric.Event) OTLPDataPoint {
+	labels := map[string]string{}
+	for k, v := range event.Attributes {
+		if value, found := emitter.Resource[k]; found && value == v {
+			continue
+		}
+
+		labels[k] = v
+	}
+
+	return OTLPDataPoint{
+		Name:   otlpMetricName(event.Name),
+		Value:  event.Value,
+		Labels: labels,
+		Time:   event.Time,
+	}
+}
+
+var otlpInvalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// otlpMetricName turns event names like "scheduling: full duration (ms)"
+// into "concourse.scheduling_full_duration_ms".
+func otlpMetricName(eventName string) string {
+	name := otlpInvalidNameChars.ReplaceAllString(strings.ToLower(eventName), "_")
+	return otlpMetricPrefix + strings.Trim(name, "_")
+}
+
+func (emitter *OTLPEmitter) submitBatch(logger lager.Logger) {
+	batchToSubmit := make([]OTLPDataPoint, len(emitter.OTLPBatch))
+	copy(batchToSubmit, emitter.OTLPBatch)
+	emitter.OTLPBatch = make([]OTLPDataPoint, 0)
+	emitter.LastEmitTime = time.Now()
+	go emitter.emitBatch(logger, batchToSubmit)
+}
+
+func (emitter *OTLPEmitter) emitBatch(logger lager.Logger, points []OTLPDataPoint) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := emitter.Exporter.Export(ctx, emitter.Resource, points)
+	if err != nil {
+		logger.Error("failed-to-export-batch",
+			errors.Wrap(metric.ErrFailedToEmit, err.Error()))
+		return
+	}
+}
+
+// otlpMetricExporter exports data points with the OpenTelemetry OTLP
+// exporter, which only knows how to send checkpointed aggregations.
+type otlpMetricExporter struct {
+	exporter *otlp.Exporter
+}
+
+func (e *otlpMetricExporter) Export(ctx context.Context, attributes map[string]string, points []OTLPDataPoint) error {
+	res := resource.New(otlpLabels(attributes)...)
+
+	records := make([]export.Record, len(points))
+	for i, point := range points {
+		descriptor := apimetric.NewDescriptor(point.Name, apimetric.ValueObserverKind, apimetric.Float64NumberKind,
+			apimetric.WithInstrumentationName("concourse"))
+		labels := label.NewSet(otlpLabels(point.Labels)...)
+
+		records[i] = export.NewRecord(&descriptor, &labels, res, otlpGauge(point.Value), point.Time, point.Time)
+	}
+
+	return e.exporter.Export(ctx, &otlpCheckpointSet{records: records})
+}
+
+func otlpLabels(attributes map[string]string) []label.KeyValue {
+	kvs := make([]label.KeyValue, 0, len(attributes))
+	for k, v := range attributes {
+		kvs = append(kvs, label.String(k, v))
+	}
+
+	return kvs
+}
+
+// otlpGauge is the value of a data point. The exporter sends a sum of a
+// float64 instrument as a non-monotonic DOUBLE metric, which is how its
+// version of the protocol represents a gauge.
+type otlpGauge float64
+
+func (g otlpGauge) Kind() aggregation.Kind { return aggregation.SumKind }
+
+func (g otlpGauge) Sum() (apimetric.Number, error) {
+	return apimetric.NewFloat64Number(float64(g)), nil
+}
+
+// otlpCheckpointSet hands a batch to the exporter the same way the SDK's
+// processors hand it their checkpoints.
+type otlpCheckpointSet struct {
+	sync.RWMutex
+
+	records []export.Record
+}
+
+func (cps *otlpCheckpointSet) ForEach(_ export.ExportKindSelector, f func(export.Record) error) error {
+	for _, record := range cps.records {
+		err := f(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package emitter_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/emitter"
+	"github.com/concourse/concourse/atc/metric/emitter/emitterfakes"
+	"github.com/concourse/concourse/tracing/tracingtest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLPEmitter", func() {
+	var (
+		testLogger *lagertest.TestLogger
+		eventTime  time.Time
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("otlp")
+		eventTime = time.Unix(123, 456)
+	})
+
+	Describe("IsConfigured", func() {
+		It("is configured once an address is set", func() {
+			Expect((&emitter.OTLPConfig{}).IsConfigured()).To(BeFalse())
+			Expect((&emitter.OTLPConfig{Address: "collector:55680"}).IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Emit", func() {
+		var (
+			otlpEmitter *emitter.OTLPEmitter
+			exporter    *emitterfakes.FakeOTLPMetricExporter
+		)
+
+		BeforeEach(func() {
+			exporter = new(emitterfakes.FakeOTLPMetricExporter)
+
+			otlpEmitter = &emitter.OTLPEmitter{
+				Exporter:      exporter,
+				Resource:      map[string]string{"environment": "prod", "host.name": "some-host"},
+				BatchSize:     2,
+				BatchDuration: time.Hour,
+				LastEmitTime:  time.Now(),
+			}
+		})
+
+		It("exports batches of events as data points of the resource", func() {
+			otlpEmitter.Emit(testLogger, metric.Event{
+				Name:       "scheduling: full duration (ms)",
+				Value:      12.5,
+				Attributes: map[string]string{"environment": "prod", "pipeline": "some-pipeline"},
+				Time:       eventTime,
+			})
+			Consistently(exporter.ExportCallCount).Should(BeZero())
+
+			otlpEmitter.Emit(testLogger, metric.Event{
+				Name:       "build started",
+				Value:      1,
+				Attributes: map[string]string{"environment": "dev"},
+				Time:       eventTime,
+			})
+			Eventually(exporter.ExportCallCount).Should(Equal(1))
+
+			_, resource, points := exporter.ExportArgsForCall(0)
+			Expect(resource).To(Equal(map[string]string{"environment": "prod", "host.name": "some-host"}))
+			Expect(points).To(Equal([]emitter.OTLPDataPoint{
+				{
+					Name:   "concourse.scheduling_full_duration_ms",
+					Value:  12.5,
+					Labels: map[string]string{"pipeline": "some-pipeline"},
+					Time:   eventTime,
+				},
+				{
+					Name:   "concourse.build_started",
+					Value:  1,
+					Labels: map[string]string{"environment": "dev"},
+					Time:   eventTime,
+				},
+			}))
+		})
+
+		Context("when the batch can't be exported", func() {
+			BeforeEach(func() {
+				exporter.ExportReturns(errors.New("disconnected"))
+			})
+
+			It("logs the failure", func() {
+				for i := 0; i < 2; i++ {
+					otlpEmitter.Emit(testLogger, metric.Event{Name: "build started", Value: 1, Time: eventTime})
+				}
+
+				Eventually(testLogger.LogMessages).Should(ContainElement("otlp.otlp.failed-to-export-batch"))
+			})
+		})
+	})
+
+	Describe("NewEmitter", func() {
+		var collector *tracingtest.GRPCCollector
+
+		BeforeEach(func() {
+			var err error
+			collector, err = tracingtest.NewGRPCCollector()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			collector.Stop()
+		})
+
+		It("exports events to the collector's metrics service", func() {
+			config := &emitter.OTLPConfig{
+				Address:       collector.Address(),
+				Headers:       map[string]string{"x-some-header": "some-value"},
+				BatchSize:     1,
+				BatchDuration: time.Hour,
+			}
+			config.SetResource("some-host", map[string]string{"environment": "prod"})
+
+			testEmitter, err := config.NewEmitter()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testEmitter.(*emitter.OTLPEmitter).Resource).To(Equal(map[string]string{
+				"environment": "prod",
+				"host.name":   "some-host",
+			}))
+
+			// batches emitted before the exporter is connected are dropped
+			Eventually(func() []string {
+				testEmitter.Emit(testLogger, metric.Event{
+					Name:       "build started",
+					Value:      1,
+					Attributes: map[string]string{"pipeline": "some-pipeline"},
+					Time:       eventTime,
+				})
+
+				return collector.Methods()
+			}).Should(ContainElement("/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"))
+
+			Expect(collector.Calls()[0].Metadata.Get("x-some-header")).To(ConsistOf("some-value"))
+		})
+	})
+})
@@ -38,6 +38,19 @@ type PrometheusEmitter struct {
 	buildsFinishedVec *prometheus.CounterVec
 	buildsSucceeded   prometheus.Counter
 
+	stepsCPUUsage           *prometheus.HistogramVec
+	stepsMemoryPeak         *prometheus.HistogramVec
+	stepsNetworkReceived    *prometheus.CounterVec
+	stepsNetworkTransmitted *prometheus.CounterVec
+	stepsBlockIORead        *prometheus.CounterVec
+	stepsBlockIOWritten     *prometheus.CounterVec
+	stepsOOMKilled          *prometheus.CounterVec
+
+	jobDeployments       *prometheus.GaugeVec
+	jobChangeFailureRate *prometheus.GaugeVec
+	jobLeadTime          *prometheus.GaugeVec
+	jobTimeToRecover     *prometheus.GaugeVec
+
 	dbConnections  *prometheus.GaugeVec
 	dbQueriesTotal prometheus.Counter
 
@@ -52,6 +65,10 @@ type PrometheusEmitter struct {
 	checksStarted   prometheus.Counter
 	checksEnqueued  prometheus.Counter
 
+	resourceCheckDuration            *prometheus.HistogramVec
+	resourceLastSuccessfulCheck      *prometheus.GaugeVec
+	resourceConsecutiveCheckFailures *prometheus.GaugeVec
+
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
 	workerVolumes           *prometheus.GaugeVec
@@ -252,6 +269,131 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	// step metrics
+	stepsCPUUsage := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "cpu_usage_cores",
+			Help:      "Average amount of CPUs used by the container of a step",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 4, 8, 16},
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsCPUUsage)
+
+	stepsMemoryPeak := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "memory_peak_bytes",
+			Help:      "Peak memory usage of the container of a step",
+			Buckets:   prometheus.ExponentialBuckets(64*1024*1024, 2, 9),
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsMemoryPeak)
+
+	stepsNetworkReceived := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "network_received_bytes_total",
+			Help:      "Total number of bytes received by the containers of a step",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsNetworkReceived)
+
+	stepsNetworkTransmitted := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "network_transmitted_bytes_total",
+			Help:      "Total number of bytes transmitted by the containers of a step",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsNetworkTransmitted)
+
+	stepsBlockIORead := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "block_io_read_bytes_total",
+			Help:      "Total number of bytes read from disk by the containers of a step",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsBlockIORead)
+
+	stepsBlockIOWritten := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "block_io_written_bytes_total",
+			Help:      "Total number of bytes written to disk by the containers of a step",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsBlockIOWritten)
+
+	stepsOOMKilled := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "oom_killed_total",
+			Help:      "Number of steps whose processes were killed for exceeding their memory limit",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsOOMKilled)
+
+	// delivery metrics
+	jobDeployments := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "deployments",
+			Help:      "Number of succeeded builds of a job within the delivery metrics window",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobDeployments)
+
+	jobChangeFailureRate := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "change_failure_rate",
+			Help:      "Ratio of failed builds to succeeded and failed builds of a job within the delivery metrics window",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobChangeFailureRate)
+
+	jobLeadTime := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "lead_time_seconds",
+			Help:      "Median time from the first upstream build of a succeeded build of a job to its end, within the delivery metrics window",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobLeadTime)
+
+	jobTimeToRecover := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "time_to_recover_seconds",
+			Help:      "Mean time from the first failed build of a job to its next succeeded build, within the delivery metrics window",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobTimeToRecover)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -391,6 +533,40 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checksEnqueued)
 
+	resourceCheckDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "resources",
+			Name:      "check_duration_seconds",
+			Help:      "Duration of the checks of a resource",
+			Buckets:   []float64{1, 5, 15, 30, 60, 300, 900, 1800, 3600},
+		},
+		[]string{"team", "pipeline", "resource", "status"},
+	)
+	prometheus.MustRegister(resourceCheckDuration)
+
+	resourceLastSuccessfulCheck := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "resources",
+			Name:      "last_successful_check_timestamp_seconds",
+			Help:      "Time of the last successful check of a resource, or zero if it has never been checked successfully",
+		},
+		[]string{"team", "pipeline", "resource"},
+	)
+	prometheus.MustRegister(resourceLastSuccessfulCheck)
+
+	resourceConsecutiveCheckFailures := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "resources",
+			Name:      "consecutive_check_failures",
+			Help:      "Number of checks of a resource that have failed since its last successful check",
+		},
+		[]string{"team", "pipeline", "resource"},
+	)
+	prometheus.MustRegister(resourceConsecutiveCheckFailures)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -419,6 +595,19 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsFinishedVec: buildsFinishedVec,
 		buildsSucceeded:   buildsSucceeded,
 
+		stepsCPUUsage:           stepsCPUUsage,
+		stepsMemoryPeak:         stepsMemoryPeak,
+		stepsNetworkReceived:    stepsNetworkReceived,
+		stepsNetworkTransmitted: stepsNetworkTransmitted,
+		stepsBlockIORead:        stepsBlockIORead,
+		stepsBlockIOWritten:     stepsBlockIOWritten,
+		stepsOOMKilled:          stepsOOMKilled,
+
+		jobDeployments:       jobDeployments,
+		jobChangeFailureRate: jobChangeFailureRate,
+		jobLeadTime:          jobLeadTime,
+		jobTimeToRecover:     jobTimeToRecover,
+
 		dbConnections:  dbConnections,
 		dbQueriesTotal: dbQueriesTotal,
 
@@ -433,6 +622,10 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checksStarted:   checksStarted,
 		checksEnqueued:  checksEnqueued,
 
+		resourceCheckDuration:            resourceCheckDuration,
+		resourceLastSuccessfulCheck:      resourceLastSuccessfulCheck,
+		resourceConsecutiveCheckFailures: resourceConsecutiveCheckFailures,
+
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
 		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
@@ -492,6 +685,39 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 			).Observe(event.Value)
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
+	case "step cpu usage":
+		emitter.stepsCPUUsage.
+			WithLabelValues(stepLabelValues(event)...).Observe(event.Value)
+	case "step memory peak":
+		emitter.stepsMemoryPeak.
+			WithLabelValues(stepLabelValues(event)...).Observe(event.Value)
+	case "step network received":
+		emitter.stepsNetworkReceived.
+			WithLabelValues(stepLabelValues(event)...).Add(event.Value)
+	case "step network transmitted":
+		emitter.stepsNetworkTransmitted.
+			WithLabelValues(stepLabelValues(event)...).Add(event.Value)
+	case "step block io read":
+		emitter.stepsBlockIORead.
+			WithLabelValues(stepLabelValues(event)...).Add(event.Value)
+	case "step block io written":
+		emitter.stepsBlockIOWritten.
+			WithLabelValues(stepLabelValues(event)...).Add(event.Value)
+	case "step oom killed":
+		emitter.stepsOOMKilled.
+			WithLabelValues(stepLabelValues(event)...).Add(event.Value)
+	case "job deployments":
+		emitter.jobDeployments.
+			WithLabelValues(jobLabelValues(event)...).Set(event.Value)
+	case "job change failure rate":
+		emitter.jobChangeFailureRate.
+			WithLabelValues(jobLabelValues(event)...).Set(event.Value)
+	case "job lead time":
+		emitter.jobLeadTime.
+			WithLabelValues(jobLabelValues(event)...).Set(event.Value)
+	case "job time to recover":
+		emitter.jobTimeToRecover.
+			WithLabelValues(jobLabelValues(event)...).Set(event.Value)
 	case "worker containers":
 		emitter.workerContainersMetric(logger, event)
 	case "worker volumes":
@@ -518,11 +744,46 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksEnqueued.Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "check duration":
+		emitter.resourceCheckDuration.
+			WithLabelValues(append(resourceLabelValues(event), event.Attributes["status"])...).
+			Observe(event.Value / 1000)
+	case "resource last successful check":
+		emitter.resourceLastSuccessfulCheck.
+			WithLabelValues(resourceLabelValues(event)...).Set(event.Value)
+	case "resource consecutive check failures":
+		emitter.resourceConsecutiveCheckFailures.
+			WithLabelValues(resourceLabelValues(event)...).Set(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
 }
 
+func stepLabelValues(event metric.Event) []string {
+	return []string{
+		event.Attributes["team_name"],
+		event.Attributes["pipeline"],
+		event.Attributes["job"],
+		event.Attributes["step_name"],
+	}
+}
+
+func jobLabelValues(event metric.Event) []string {
+	return []string{
+		event.Attributes["team_name"],
+		event.Attributes["pipeline"],
+		event.Attributes["job"],
+	}
+}
+
+func resourceLabelValues(event metric.Event) []string {
+	return []string{
+		event.Attributes["team_name"],
+		event.Attributes["pipeline"],
+		event.Attributes["resource"],
+	}
+}
+
 func (emitter *PrometheusEmitter) lock(logger lager.Logger, event metric.Event) {
 	lockType, exists := event.Attributes["type"]
 	if !exists {
@@ -782,7 +1043,7 @@ func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	}
 }
 
-//periodically remove stale metrics for workers
+// periodically remove stale metrics for workers
 func (emitter *PrometheusEmitter) periodicMetricGC() {
 	for {
 		emitter.mu.Lock()
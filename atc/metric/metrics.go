@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -37,6 +38,87 @@ func (event TasksWaitingDuration) Emit(logger lager.Logger) {
 	)
 }
 
+type StepLabels struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	StepName     string
+}
+
+func (labels StepLabels) attributes() map[string]string {
+	return map[string]string{
+		"team_name": labels.TeamName,
+		"pipeline":  labels.PipelineName,
+		"job":       labels.JobName,
+		"step_name": labels.StepName,
+	}
+}
+
+type StepContainerUsage struct {
+	Labels            StepLabels
+	CPUAverage        float64
+	MemoryPeak        uint64
+	NetworkRxBytes    uint64
+	NetworkTxBytes    uint64
+	BlockIOReadBytes  uint64
+	BlockIOWriteBytes uint64
+}
+
+func (event StepContainerUsage) Emit(logger lager.Logger) {
+	logger = logger.Session("step-container-usage")
+
+	Metrics.emit(logger, Event{
+		Name:       "step cpu usage",
+		Value:      event.CPUAverage,
+		Attributes: event.Labels.attributes(),
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "step memory peak",
+		Value:      float64(event.MemoryPeak),
+		Attributes: event.Labels.attributes(),
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "step network received",
+		Value:      float64(event.NetworkRxBytes),
+		Attributes: event.Labels.attributes(),
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "step network transmitted",
+		Value:      float64(event.NetworkTxBytes),
+		Attributes: event.Labels.attributes(),
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "step block io read",
+		Value:      float64(event.BlockIOReadBytes),
+		Attributes: event.Labels.attributes(),
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "step block io written",
+		Value:      float64(event.BlockIOWriteBytes),
+		Attributes: event.Labels.attributes(),
+	})
+}
+
+type StepOOMKilled struct {
+	Labels StepLabels
+}
+
+func (event StepOOMKilled) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("step-oom-killed"),
+		Event{
+			Name:       "step oom killed",
+			Value:      1,
+			Attributes: event.Labels.attributes(),
+		},
+	)
+}
+
 type BuildCollectorDuration struct {
 	Duration time.Duration
 }
@@ -459,6 +541,76 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type CheckFinished struct {
+	TeamName     string
+	Pipeline     atc.PipelineRef
+	ResourceName string
+	Succeeded    bool
+	Duration     time.Duration
+}
+
+func (event CheckFinished) Emit(logger lager.Logger) {
+	status := "succeeded"
+	if !event.Succeeded {
+		status = "failed"
+	}
+
+	Metrics.emit(
+		logger.Session("check-finished"),
+		Event{
+			Name:  "check duration",
+			Value: ms(event.Duration),
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.Pipeline.String(),
+				"resource":  event.ResourceName,
+				"status":    status,
+			},
+		},
+	)
+}
+
+// ResourceCheckHealth describes how recently a resource was checked
+// successfully, and how many of its checks have failed since.
+type ResourceCheckHealth struct {
+	TeamName            string
+	Pipeline            atc.PipelineRef
+	ResourceName        string
+	LastSuccessfulCheck time.Time
+	ConsecutiveFailures int
+}
+
+func (event ResourceCheckHealth) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"team_name": event.TeamName,
+		"pipeline":  event.Pipeline.String(),
+		"resource":  event.ResourceName,
+	}
+
+	var lastSuccessfulCheck float64
+	if !event.LastSuccessfulCheck.IsZero() {
+		lastSuccessfulCheck = float64(event.LastSuccessfulCheck.Unix())
+	}
+
+	Metrics.emit(
+		logger.Session("resource-check-health"),
+		Event{
+			Name:       "resource last successful check",
+			Value:      lastSuccessfulCheck,
+			Attributes: attributes,
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("resource-check-health"),
+		Event{
+			Name:       "resource consecutive check failures",
+			Value:      float64(event.ConsecutiveFailures),
+			Attributes: attributes,
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -600,3 +752,39 @@ func (event WorkersState) Emit(logger lager.Logger, m *Monitor) {
 		)
 	}
 }
+
+type JobDeliveryMetrics struct {
+	Metrics atc.JobDeliveryMetrics
+}
+
+func (event JobDeliveryMetrics) Emit(logger lager.Logger, m *Monitor) {
+	attributes := map[string]string{
+		"team_name": event.Metrics.TeamName,
+		"pipeline": atc.PipelineRef{
+			Name:         event.Metrics.PipelineName,
+			InstanceVars: event.Metrics.PipelineInstanceVars,
+		}.String(),
+		"job": event.Metrics.JobName,
+	}
+
+	values := []struct {
+		name  string
+		value float64
+	}{
+		{"job deployments", float64(event.Metrics.Deployments)},
+		{"job change failure rate", event.Metrics.ChangeFailureRate},
+		{"job lead time", event.Metrics.LeadTime},
+		{"job time to recover", event.Metrics.TimeToRecover},
+	}
+
+	for _, v := range values {
+		m.emit(
+			logger.Session("job-delivery-metrics"),
+			Event{
+				Name:       v.name,
+				Value:      v.value,
+				Attributes: attributes,
+			},
+		)
+	}
+}
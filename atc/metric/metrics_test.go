@@ -1,6 +1,7 @@
 package metric_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/metric/metricfakes"
@@ -49,6 +50,62 @@ var _ = Describe("Metrics", func() {
 			Expect(event.Value).To(Equal(float64(1)))
 		})
 	})
+
+	Describe("job delivery metrics", func() {
+		var (
+			emitter *metricfakes.FakeEmitter
+			monitor *metric.Monitor
+		)
+
+		BeforeEach(func() {
+			emitter = new(metricfakes.FakeEmitter)
+			monitor = metric.NewMonitor()
+
+			emitterFactory := new(metricfakes.FakeEmitterFactory)
+			emitterFactory.IsConfiguredReturns(true)
+			emitterFactory.NewEmitterReturns(emitter, nil)
+
+			monitor.RegisterEmitter(emitterFactory)
+			monitor.Initialize(testLogger, "test", map[string]string{}, 1000)
+		})
+
+		It("emits each metric labelled with the job", func() {
+			metric.JobDeliveryMetrics{
+				Metrics: atc.JobDeliveryMetrics{
+					TeamName:             "some-team",
+					PipelineName:         "some-pipeline",
+					PipelineInstanceVars: atc.InstanceVars{"branch": "main"},
+					JobName:              "deploy",
+					Deployments:          4,
+					Failures:             1,
+					ChangeFailureRate:    0.2,
+					LeadTime:             3600,
+					TimeToRecover:        600,
+				},
+			}.Emit(testLogger, monitor)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(4))
+
+			values := map[string]float64{}
+			for i := 0; i < emitter.EmitCallCount(); i++ {
+				_, event := emitter.EmitArgsForCall(i)
+				Expect(event.Attributes).To(Equal(map[string]string{
+					"team_name": "some-team",
+					"pipeline":  "some-pipeline/branch:main",
+					"job":       "deploy",
+				}))
+
+				values[event.Name] = event.Value
+			}
+
+			Expect(values).To(Equal(map[string]float64{
+				"job deployments":         4,
+				"job change failure rate": 0.2,
+				"job lead time":           3600,
+				"job time to recover":     600,
+			}))
+		})
+	})
 })
 
 type smartFakeEmitter struct {
@@ -1,5 +1,7 @@
 package atc
 
+import "strings"
+
 type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
@@ -11,6 +13,8 @@ type Plan struct {
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
 
+	ManualApproval *ManualApprovalPlan `json:"manual_approval,omitempty"`
+
 	Do         *DoPlan         `json:"do,omitempty"`
 	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
 	Aggregate  *AggregatePlan  `json:"aggregate,omitempty"`
@@ -112,6 +116,20 @@ func (id PlanID) String() string {
 	return string(id)
 }
 
+const servicePlanIDInfix = "/services/"
+
+// Service returns the ID identifying the containers and events of a service
+// running alongside the step with this ID.
+func (id PlanID) Service(name string) PlanID {
+	return id + servicePlanIDInfix + PlanID(name)
+}
+
+// IsService returns true if the ID identifies a service running alongside a
+// step rather than a step of the plan.
+func (id PlanID) IsService() bool {
+	return strings.Contains(string(id), servicePlanIDInfix)
+}
+
 type ArtifactInputPlan struct {
 	ArtifactID int    `json:"artifact_id"`
 	Name       string `json:"name"`
@@ -315,6 +333,11 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ManualApprovalPlan struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -2,13 +2,20 @@ package policy
 
 import (
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/atc"
 )
 
-const ActionUseImage = "UseImage"
+const (
+	ActionUseImage = "UseImage"
+	ActionRunStep  = "RunStep"
+)
 
 type PolicyCheckNotPass struct {
 	Reasons []string
@@ -34,12 +41,66 @@ type PolicyCheckInput struct {
 	Team           string      `json:"team,omitempty"`
 	Roles          []string    `json:"roles,omitempty"`
 	Pipeline       string      `json:"pipeline,omitempty"`
+	PolicyProfile  string      `json:"policy_profile,omitempty"`
 	Data           interface{} `json:"data,omitempty"`
 }
 
+// Severity determines what happens when a policy check doesn't pass.
+type Severity string
+
+const (
+	// SeverityDeny refuses the action. It's the default severity.
+	SeverityDeny Severity = "deny"
+
+	// SeverityWarn lets the action proceed, but shows the reasons to the user.
+	SeverityWarn Severity = "warn"
+
+	// SeverityAudit lets the action proceed, only recording the result in the
+	// audit log, so that a policy can be staged before enforcing it.
+	SeverityAudit Severity = "audit"
+)
+
+// ParseSeverity validates a severity returned by a policy agent. An empty
+// severity means SeverityDeny.
+func ParseSeverity(severity string) (Severity, error) {
+	switch Severity(severity) {
+	case "", SeverityDeny:
+		return SeverityDeny, nil
+	case SeverityWarn, SeverityAudit:
+		return Severity(severity), nil
+	default:
+		return "", fmt.Errorf("unknown policy severity: %s", severity)
+	}
+}
+
 type PolicyCheckOutput struct {
-	Allowed bool
-	Reasons []string
+	Allowed  bool
+	Reasons  []string
+	Severity Severity
+}
+
+// Blocked returns true if the check didn't pass and the action must be
+// refused.
+func (o PolicyCheckOutput) Blocked() bool {
+	return !o.Allowed && (o.Severity == "" || o.Severity == SeverityDeny)
+}
+
+// Warnings returns the reasons to show to the user if the check didn't pass
+// but only warns about it.
+func (o PolicyCheckOutput) Warnings() []string {
+	if o.Allowed || o.Severity != SeverityWarn {
+		return nil
+	}
+
+	return o.Reasons
+}
+
+// PrintWarnings prints the reasons to show to the user, e.g. in a build log,
+// if the check didn't pass but only warns about it.
+func (o PolicyCheckOutput) PrintWarnings(w io.Writer) {
+	for _, warning := range o.Warnings() {
+		fmt.Fprintf(w, "\x1b[1;33mWARNING: policy check: %s\x1b[0m\n", warning)
+	}
 }
 
 // FailedPolicyCheck creates a generic failed check
@@ -95,7 +156,24 @@ var (
 	clusterVersion string
 )
 
-func Initialize(logger lager.Logger, cluster string, version string, filter Filter) (*Checker, error) {
+//go:generate counterfeiter . Auditor
+
+// Auditor records the results of policy checks which didn't pass, but didn't
+// refuse the action either.
+type Auditor interface {
+	AuditPolicyCheck(PolicyCheckInput, PolicyCheckOutput)
+	AuditPolicyExemption(PolicyCheckInput, PolicyCheckOutput, atc.PolicyExemption)
+}
+
+//go:generate counterfeiter . TeamPolicies
+
+// TeamPolicies looks up the policy metadata of the team an action belongs to.
+type TeamPolicies interface {
+	PolicyProfile(teamName string) (string, error)
+	ActiveExemption(teamName string, action string) (atc.PolicyExemption, bool, error)
+}
+
+func Initialize(logger lager.Logger, cluster string, version string, filter Filter, auditor Auditor, teamPolicies TeamPolicies) (*Checker, error) {
 	logger.Debug("policy-checker-initialize")
 
 	clusterName = cluster
@@ -122,8 +200,10 @@ func Initialize(logger lager.Logger, cluster string, version string, filter Filt
 				lager.Data{"rfc": "https://github.com/concourse/rfcs/pull/41"})
 
 			return &Checker{
-				filter: filter,
-				agent:  agent,
+				filter:       filter,
+				agent:        agent,
+				auditor:      auditor,
+				teamPolicies: teamPolicies,
 			}, nil
 		}
 	}
@@ -133,8 +213,10 @@ func Initialize(logger lager.Logger, cluster string, version string, filter Filt
 }
 
 type Checker struct {
-	filter Filter
-	agent  Agent
+	filter       Filter
+	agent        Agent
+	auditor      Auditor
+	teamPolicies TeamPolicies
 }
 
 func (c *Checker) ShouldCheckHttpMethod(method string) bool {
@@ -164,5 +246,68 @@ func (c *Checker) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
 	input.Service = "concourse"
 	input.ClusterName = clusterName
 	input.ClusterVersion = clusterVersion
-	return c.agent.Check(input)
+
+	checkTeam := input.Team != "" && c.teamPolicies != nil
+
+	if checkTeam {
+		profile, err := c.teamPolicies.PolicyProfile(input.Team)
+		if err != nil {
+			return FailedPolicyCheck(), err
+		}
+
+		input.PolicyProfile = profile
+	}
+
+	output, err := c.agent.Check(input)
+	if err != nil {
+		return output, err
+	}
+
+	if !output.Blocked() {
+		if !output.Allowed && c.auditor != nil {
+			c.auditor.AuditPolicyCheck(input, output)
+		}
+
+		return output, nil
+	}
+
+	if !checkTeam {
+		return output, nil
+	}
+
+	exemption, found, err := c.teamPolicies.ActiveExemption(input.Team, input.Action)
+	if err != nil {
+		return FailedPolicyCheck(), err
+	}
+
+	if !found {
+		return output, nil
+	}
+
+	if c.auditor != nil {
+		c.auditor.AuditPolicyExemption(input, output, exemption)
+	}
+
+	return exemptedOutput(output, exemption), nil
+}
+
+// exemptedOutput turns a refusal into a warning, so that the user is aware
+// that the action is only let through by an exemption.
+func exemptedOutput(output PolicyCheckOutput, exemption atc.PolicyExemption) PolicyCheckOutput {
+	expiry := time.Unix(exemption.ExpiresAt, 0).UTC().Format(time.RFC3339)
+
+	reasons := []string{}
+	for _, reason := range output.Reasons {
+		reasons = append(reasons, fmt.Sprintf("%s (exempted until %s: %s)", reason, expiry, exemption.Reason))
+	}
+
+	if len(reasons) == 0 {
+		reasons = append(reasons, fmt.Sprintf("exempted until %s: %s", expiry, exemption.Reason))
+	}
+
+	return PolicyCheckOutput{
+		Allowed:  false,
+		Reasons:  reasons,
+		Severity: SeverityWarn,
+	}
 }
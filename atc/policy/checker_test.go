@@ -3,6 +3,7 @@ package policy_test
 import (
 	"errors"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/policy/policyfakes"
 
@@ -13,9 +14,11 @@ import (
 var _ = Describe("Policy checker", func() {
 
 	var (
-		checker *policy.Checker
-		filter  policy.Filter
-		err     error
+		checker      *policy.Checker
+		filter       policy.Filter
+		fakeAuditor  *policyfakes.FakeAuditor
+		fakePolicies *policyfakes.FakeTeamPolicies
+		err          error
 	)
 
 	BeforeEach(func() {
@@ -27,10 +30,13 @@ var _ = Describe("Policy checker", func() {
 
 		fakeAgent = new(policyfakes.FakeAgent)
 		fakeAgentFactory.NewAgentReturns(fakeAgent, nil)
+
+		fakeAuditor = new(policyfakes.FakeAuditor)
+		fakePolicies = new(policyfakes.FakeTeamPolicies)
 	})
 
 	JustBeforeEach(func() {
-		checker, err = policy.Initialize(testLogger, "some-cluster", "some-version", filter)
+		checker, err = policy.Initialize(testLogger, "some-cluster", "some-version", filter, fakeAuditor, fakePolicies)
 	})
 
 	// fakeAgent is configured in BeforeSuite.
@@ -117,6 +123,72 @@ var _ = Describe("Policy checker", func() {
 						Expect(checkErr).ToNot(HaveOccurred())
 						Expect(output.Allowed).To(BeFalse())
 					})
+
+					It("should block", func() {
+						Expect(output.Blocked()).To(BeTrue())
+						Expect(output.Warnings()).To(BeEmpty())
+					})
+
+					It("should not audit", func() {
+						Expect(fakeAuditor.AuditPolicyCheckCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when agent says not-pass with warn severity", func() {
+					BeforeEach(func() {
+						fakeAgent.CheckReturns(
+							policy.PolicyCheckOutput{
+								Allowed:  false,
+								Reasons:  []string{"a policy discourages that"},
+								Severity: policy.SeverityWarn,
+							},
+							nil,
+						)
+					})
+
+					It("should not block", func() {
+						Expect(checkErr).ToNot(HaveOccurred())
+						Expect(output.Blocked()).To(BeFalse())
+					})
+
+					It("should return warnings", func() {
+						Expect(output.Warnings()).To(ConsistOf("a policy discourages that"))
+					})
+
+					It("should audit", func() {
+						Expect(fakeAuditor.AuditPolicyCheckCallCount()).To(Equal(1))
+						_, audited := fakeAuditor.AuditPolicyCheckArgsForCall(0)
+						Expect(audited.Severity).To(Equal(policy.SeverityWarn))
+					})
+				})
+
+				Context("when agent says not-pass with audit severity", func() {
+					BeforeEach(func() {
+						fakeAgent.CheckReturns(
+							policy.PolicyCheckOutput{
+								Allowed:  false,
+								Reasons:  []string{"a policy will forbid that"},
+								Severity: policy.SeverityAudit,
+							},
+							nil,
+						)
+					})
+
+					It("should not block", func() {
+						Expect(checkErr).ToNot(HaveOccurred())
+						Expect(output.Blocked()).To(BeFalse())
+					})
+
+					It("should not return warnings", func() {
+						Expect(output.Warnings()).To(BeEmpty())
+					})
+
+					It("should audit", func() {
+						Expect(fakeAuditor.AuditPolicyCheckCallCount()).To(Equal(1))
+						input, audited := fakeAuditor.AuditPolicyCheckArgsForCall(0)
+						Expect(input.ClusterName).To(Equal("some-cluster"))
+						Expect(audited.Reasons).To(ConsistOf("a policy will forbid that"))
+					})
 				})
 
 				Context("when agent includes reasons", func() {
@@ -136,6 +208,112 @@ var _ = Describe("Policy checker", func() {
 					})
 				})
 
+				Context("when the action belongs to a team", func() {
+					BeforeEach(func() {
+						input.Team = "some-team"
+						input.Action = "RunStep"
+						fakePolicies.PolicyProfileReturns("strict", nil)
+					})
+
+					It("should inject the team's policy profile into input", func() {
+						Expect(fakePolicies.PolicyProfileArgsForCall(0)).To(Equal("some-team"))
+						Expect(fakeAgent.CheckArgsForCall(0).PolicyProfile).To(Equal("strict"))
+					})
+
+					Context("when looking up the policy profile fails", func() {
+						BeforeEach(func() {
+							fakePolicies.PolicyProfileReturns("", errors.New("some-error"))
+						})
+
+						It("should not pass", func() {
+							Expect(checkErr).To(HaveOccurred())
+							Expect(output.Allowed).To(BeFalse())
+							Expect(fakeAgent.CheckCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when agent says pass", func() {
+						BeforeEach(func() {
+							fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+						})
+
+						It("should not look up exemptions", func() {
+							Expect(fakePolicies.ActiveExemptionCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when agent says not-pass", func() {
+						BeforeEach(func() {
+							fakeAgent.CheckReturns(
+								policy.PolicyCheckOutput{
+									Allowed: false,
+									Reasons: []string{"a policy says you can't do that"},
+								},
+								nil,
+							)
+						})
+
+						It("should look up an exemption for the action", func() {
+							team, action := fakePolicies.ActiveExemptionArgsForCall(0)
+							Expect(team).To(Equal("some-team"))
+							Expect(action).To(Equal("RunStep"))
+						})
+
+						Context("when the team is not exempted", func() {
+							It("should block", func() {
+								Expect(checkErr).ToNot(HaveOccurred())
+								Expect(output.Blocked()).To(BeTrue())
+								Expect(fakeAuditor.AuditPolicyExemptionCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the team is exempted", func() {
+							var exemption atc.PolicyExemption
+
+							BeforeEach(func() {
+								exemption = atc.PolicyExemption{
+									ID:        1,
+									Team:      "some-team",
+									Action:    "RunStep",
+									Reason:    "migrating",
+									ExpiresAt: 1602871813,
+								}
+								fakePolicies.ActiveExemptionReturns(exemption, true, nil)
+							})
+
+							It("should not block", func() {
+								Expect(checkErr).ToNot(HaveOccurred())
+								Expect(output.Blocked()).To(BeFalse())
+							})
+
+							It("should warn about the exemption", func() {
+								Expect(output.Warnings()).To(ConsistOf(
+									"a policy says you can't do that (exempted until 2020-10-16T18:10:13Z: migrating)",
+								))
+							})
+
+							It("should audit the exemption", func() {
+								Expect(fakeAuditor.AuditPolicyExemptionCallCount()).To(Equal(1))
+								auditedInput, auditedOutput, auditedExemption := fakeAuditor.AuditPolicyExemptionArgsForCall(0)
+								Expect(auditedInput.Team).To(Equal("some-team"))
+								Expect(auditedOutput.Blocked()).To(BeTrue())
+								Expect(auditedExemption).To(Equal(exemption))
+							})
+						})
+
+						Context("when looking up exemptions fails", func() {
+							BeforeEach(func() {
+								fakePolicies.ActiveExemptionReturns(atc.PolicyExemption{}, false, errors.New("some-error"))
+							})
+
+							It("should not pass", func() {
+								Expect(checkErr).To(HaveOccurred())
+								Expect(output.Allowed).To(BeFalse())
+							})
+						})
+					})
+				})
+
 				Context("when agent says error", func() {
 					BeforeEach(func() {
 						fakeAgent.CheckReturns(policy.FailedPolicyCheck(), errors.New("some-error"))
@@ -21,5 +21,17 @@ func TeamAndPipelineFromContext(ctx context.Context) (string, string) {
 	return t, p
 }
 
+// RecordWarnings records the reasons of a policy check which only warns about
+// an action, so that they can be shown to the user once it's performed.
+func RecordWarnings(ctx context.Context, warnings []string) context.Context {
+	return context.WithValue(ctx, warningsContextKey{}, warnings)
+}
+
+func WarningsFromContext(ctx context.Context) []string {
+	warnings, _ := ctx.Value(warningsContextKey{}).([]string)
+	return warnings
+}
+
 type teamContextKey struct{}
 type pipelineContextKey struct{}
+type warningsContextKey struct{}
@@ -34,8 +34,9 @@ type opaInput struct {
 }
 
 type opaOuptut struct {
-	Allowed *bool    `json:"allowed,omitempty"`
-	Reasons []string `json:"reasons,omitempty"`
+	Allowed  *bool    `json:"allowed,omitempty"`
+	Reasons  []string `json:"reasons,omitempty"`
+	Severity string   `json:"severity,omitempty"`
 }
 
 type opaResult struct {
@@ -90,8 +91,14 @@ func (c opa) Check(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, err
 		return policy.FailedPolicyCheck(), fmt.Errorf("opa returned invalid response: %s", body)
 	}
 
+	severity, err := policy.ParseSeverity(result.Result.Severity)
+	if err != nil {
+		return policy.FailedPolicyCheck(), fmt.Errorf("opa returned invalid response: %s", err.Error())
+	}
+
 	return policy.PolicyCheckOutput{
-		Allowed: *result.Result.Allowed,
-		Reasons: result.Result.Reasons,
+		Allowed:  *result.Result.Allowed,
+		Reasons:  result.Result.Reasons,
+		Severity: severity,
 	}, nil
 }
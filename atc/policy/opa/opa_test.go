@@ -122,6 +122,37 @@ var _ = Describe("Policy checker", func() {
 		})
 	})
 
+	Context("when OPA returns not-allowed with a severity", func() {
+		BeforeEach(func() {
+			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"result": {"allowed": false, "reasons": ["a policy discourages that"], "severity": "warn"}}`)
+			}))
+		})
+
+		It("should return the severity", func() {
+			result, err := agent.Check(policy.PolicyCheckInput{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeFalse())
+			Expect(result.Severity).To(Equal(policy.SeverityWarn))
+			Expect(result.Warnings()).To(ConsistOf("a policy discourages that"))
+		})
+	})
+
+	Context("when OPA returns an unknown severity", func() {
+		BeforeEach(func() {
+			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"result": {"allowed": false, "severity": "shrug"}}`)
+			}))
+		})
+
+		It("should return an error", func() {
+			result, err := agent.Check(policy.PolicyCheckInput{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("opa returned invalid response: unknown policy severity: shrug"))
+			Expect(result.Allowed).To(BeFalse())
+		})
+	})
+
 	Context("when OPA is unreachable", func() {
 		BeforeEach(func() {
 			fakeOpa = httptest.NewUnstartedServer(http.NotFoundHandler())
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package policyfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+)
+
+type FakeAuditor struct {
+	AuditPolicyCheckStub        func(policy.PolicyCheckInput, policy.PolicyCheckOutput)
+	auditPolicyCheckMutex       sync.RWMutex
+	auditPolicyCheckArgsForCall []struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+	}
+	AuditPolicyExemptionStub        func(policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption)
+	auditPolicyExemptionMutex       sync.RWMutex
+	auditPolicyExemptionArgsForCall []struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+		arg3 atc.PolicyExemption
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditor) AuditPolicyCheck(arg1 policy.PolicyCheckInput, arg2 policy.PolicyCheckOutput) {
+	fake.auditPolicyCheckMutex.Lock()
+	fake.auditPolicyCheckArgsForCall = append(fake.auditPolicyCheckArgsForCall, struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+	}{arg1, arg2})
+	fake.recordInvocation("AuditPolicyCheck", []interface{}{arg1, arg2})
+	fake.auditPolicyCheckMutex.Unlock()
+	if fake.AuditPolicyCheckStub != nil {
+		fake.AuditPolicyCheckStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckCallCount() int {
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	return len(fake.auditPolicyCheckArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckCalls(stub func(policy.PolicyCheckInput, policy.PolicyCheckOutput)) {
+	fake.auditPolicyCheckMutex.Lock()
+	defer fake.auditPolicyCheckMutex.Unlock()
+	fake.AuditPolicyCheckStub = stub
+}
+
+func (fake *FakeAuditor) AuditPolicyCheckArgsForCall(i int) (policy.PolicyCheckInput, policy.PolicyCheckOutput) {
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	argsForCall := fake.auditPolicyCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditor) AuditPolicyExemption(arg1 policy.PolicyCheckInput, arg2 policy.PolicyCheckOutput, arg3 atc.PolicyExemption) {
+	fake.auditPolicyExemptionMutex.Lock()
+	fake.auditPolicyExemptionArgsForCall = append(fake.auditPolicyExemptionArgsForCall, struct {
+		arg1 policy.PolicyCheckInput
+		arg2 policy.PolicyCheckOutput
+		arg3 atc.PolicyExemption
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuditPolicyExemption", []interface{}{arg1, arg2, arg3})
+	fake.auditPolicyExemptionMutex.Unlock()
+	if fake.AuditPolicyExemptionStub != nil {
+		fake.AuditPolicyExemptionStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionCallCount() int {
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
+	return len(fake.auditPolicyExemptionArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionCalls(stub func(policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption)) {
+	fake.auditPolicyExemptionMutex.Lock()
+	defer fake.auditPolicyExemptionMutex.Unlock()
+	fake.AuditPolicyExemptionStub = stub
+}
+
+func (fake *FakeAuditor) AuditPolicyExemptionArgsForCall(i int) (policy.PolicyCheckInput, policy.PolicyCheckOutput, atc.PolicyExemption) {
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
+	argsForCall := fake.auditPolicyExemptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.auditPolicyCheckMutex.RLock()
+	defer fake.auditPolicyCheckMutex.RUnlock()
+	fake.auditPolicyExemptionMutex.RLock()
+	defer fake.auditPolicyExemptionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ policy.Auditor = new(FakeAuditor)
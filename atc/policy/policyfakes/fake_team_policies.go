@@ -0,0 +1,201 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package policyfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+)
+
+type FakeTeamPolicies struct {
+	ActiveExemptionStub        func(string, string) (atc.PolicyExemption, bool, error)
+	activeExemptionMutex       sync.RWMutex
+	activeExemptionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	activeExemptionReturns struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}
+	activeExemptionReturnsOnCall map[int]struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}
+	PolicyProfileStub        func(string) (string, error)
+	policyProfileMutex       sync.RWMutex
+	policyProfileArgsForCall []struct {
+		arg1 string
+	}
+	policyProfileReturns struct {
+		result1 string
+		result2 error
+	}
+	policyProfileReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTeamPolicies) ActiveExemption(arg1 string, arg2 string) (atc.PolicyExemption, bool, error) {
+	fake.activeExemptionMutex.Lock()
+	ret, specificReturn := fake.activeExemptionReturnsOnCall[len(fake.activeExemptionArgsForCall)]
+	fake.activeExemptionArgsForCall = append(fake.activeExemptionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ActiveExemption", []interface{}{arg1, arg2})
+	fake.activeExemptionMutex.Unlock()
+	if fake.ActiveExemptionStub != nil {
+		return fake.ActiveExemptionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.activeExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionCallCount() int {
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	return len(fake.activeExemptionArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionCalls(stub func(string, string) (atc.PolicyExemption, bool, error)) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = stub
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionArgsForCall(i int) (string, string) {
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	argsForCall := fake.activeExemptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionReturns(result1 atc.PolicyExemption, result2 bool, result3 error) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = nil
+	fake.activeExemptionReturns = struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeamPolicies) ActiveExemptionReturnsOnCall(i int, result1 atc.PolicyExemption, result2 bool, result3 error) {
+	fake.activeExemptionMutex.Lock()
+	defer fake.activeExemptionMutex.Unlock()
+	fake.ActiveExemptionStub = nil
+	if fake.activeExemptionReturnsOnCall == nil {
+		fake.activeExemptionReturnsOnCall = make(map[int]struct {
+			result1 atc.PolicyExemption
+			result2 bool
+			result3 error
+		})
+	}
+	fake.activeExemptionReturnsOnCall[i] = struct {
+		result1 atc.PolicyExemption
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeamPolicies) PolicyProfile(arg1 string) (string, error) {
+	fake.policyProfileMutex.Lock()
+	ret, specificReturn := fake.policyProfileReturnsOnCall[len(fake.policyProfileArgsForCall)]
+	fake.policyProfileArgsForCall = append(fake.policyProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PolicyProfile", []interface{}{arg1})
+	fake.policyProfileMutex.Unlock()
+	if fake.PolicyProfileStub != nil {
+		return fake.PolicyProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.policyProfileReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileCallCount() int {
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	return len(fake.policyProfileArgsForCall)
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileCalls(stub func(string) (string, error)) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = stub
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileArgsForCall(i int) string {
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	argsForCall := fake.policyProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileReturns(result1 string, result2 error) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	fake.policyProfileReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) PolicyProfileReturnsOnCall(i int, result1 string, result2 error) {
+	fake.policyProfileMutex.Lock()
+	defer fake.policyProfileMutex.Unlock()
+	fake.PolicyProfileStub = nil
+	if fake.policyProfileReturnsOnCall == nil {
+		fake.policyProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.policyProfileReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamPolicies) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.activeExemptionMutex.RLock()
+	defer fake.activeExemptionMutex.RUnlock()
+	fake.policyProfileMutex.RLock()
+	defer fake.policyProfileMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTeamPolicies) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ policy.TeamPolicies = new(FakeTeamPolicies)
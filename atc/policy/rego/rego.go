@@ -0,0 +1,210 @@
+package rego
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/open-policy-agent/opa/rego"
+
+	"github.com/concourse/concourse/atc/policy"
+)
+
+type RegoConfig struct {
+	PolicyDir      string        `long:"rego-policy-dir" description:"Directory of Rego policies and data files to evaluate in-process, instead of querying an OPA server."`
+	Query          string        `long:"rego-query" default:"data.concourse.decision" description:"Rego query whose result is the policy decision."`
+	ReloadInterval time.Duration `long:"rego-reload-interval" default:"10s" description:"Interval on which the policy directory is checked for changes. Set to 0 to never reload."`
+}
+
+func init() {
+	policy.RegisterAgent(&RegoConfig{})
+}
+
+func (c *RegoConfig) Description() string { return "Embedded Rego" }
+func (c *RegoConfig) IsConfigured() bool  { return c.PolicyDir != "" }
+
+func (c *RegoConfig) NewAgent(logger lager.Logger) (policy.Agent, error) {
+	agent := &regoAgent{
+		config: *c,
+		logger: logger,
+	}
+
+	err := agent.load()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ReloadInterval > 0 {
+		go agent.reloadPeriodically()
+	}
+
+	return agent, nil
+}
+
+type regoOutput struct {
+	Allowed  *bool    `json:"allowed,omitempty"`
+	Reasons  []string `json:"reasons,omitempty"`
+	Severity string   `json:"severity,omitempty"`
+}
+
+type regoAgent struct {
+	config RegoConfig
+	logger lager.Logger
+
+	// query holds the rego.PreparedEvalQuery currently in effect. It is
+	// swapped by the reload goroutine so that checks never wait on a compile.
+	query atomic.Value
+
+	// only accessed by the reload goroutine after load
+	fingerprint string
+}
+
+func (a *regoAgent) Check(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	query := a.query.Load().(rego.PreparedEvalQuery)
+
+	// round-trip the input so that it's evaluated exactly as an OPA server
+	// would see it
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	a.logger.Debug("rego-check", lager.Data{"input": string(jsonBytes)})
+
+	var regoInput interface{}
+	err = json.Unmarshal(jsonBytes, &regoInput)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	results, err := query.Eval(context.Background(), rego.EvalInput(regoInput))
+	if err != nil {
+		return policy.FailedPolicyCheck(), fmt.Errorf("rego evaluation failed: %w", err)
+	}
+
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return policy.FailedPolicyCheck(), fmt.Errorf("rego query %s is undefined", a.config.Query)
+	}
+
+	value, err := json.Marshal(results[0].Expressions[0].Value)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	var output regoOutput
+	err = json.Unmarshal(value, &output)
+	if err != nil || output.Allowed == nil {
+		return policy.FailedPolicyCheck(), fmt.Errorf("rego returned invalid result: %s", value)
+	}
+
+	severity, err := policy.ParseSeverity(output.Severity)
+	if err != nil {
+		return policy.FailedPolicyCheck(), fmt.Errorf("rego returned invalid result: %w", err)
+	}
+
+	return policy.PolicyCheckOutput{
+		Allowed:  *output.Allowed,
+		Reasons:  output.Reasons,
+		Severity: severity,
+	}, nil
+}
+
+func (a *regoAgent) reloadPeriodically() {
+	ticker := time.NewTicker(a.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		a.reloadIfChanged()
+	}
+}
+
+// reloadIfChanged recompiles the policies if any file in the policy directory
+// changed since they were last loaded.
+//
+// If the new policies fail to compile, the previous ones stay in effect so
+// that a bad edit doesn't cause every check to fail.
+func (a *regoAgent) reloadIfChanged() {
+	fingerprint, err := fingerprintDir(a.config.PolicyDir)
+	if err != nil {
+		a.logger.Error("failed-to-scan-policy-dir", err)
+		return
+	}
+
+	if fingerprint == a.fingerprint {
+		return
+	}
+
+	query, err := a.prepare()
+	if err != nil {
+		a.logger.Error("failed-to-reload-policies", err)
+
+		// don't retry until the files change again
+		a.fingerprint = fingerprint
+		return
+	}
+
+	a.logger.Info("reloaded-policies", lager.Data{"dir": a.config.PolicyDir})
+
+	a.query.Store(query)
+	a.fingerprint = fingerprint
+}
+
+func (a *regoAgent) load() error {
+	fingerprint, err := fingerprintDir(a.config.PolicyDir)
+	if err != nil {
+		return fmt.Errorf("scan policy dir: %w", err)
+	}
+
+	query, err := a.prepare()
+	if err != nil {
+		return err
+	}
+
+	a.query.Store(query)
+	a.fingerprint = fingerprint
+
+	return nil
+}
+
+func (a *regoAgent) prepare() (rego.PreparedEvalQuery, error) {
+	query, err := rego.New(
+		rego.Query(a.config.Query),
+		rego.Load([]string{a.config.PolicyDir}, nil),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		return rego.PreparedEvalQuery{}, fmt.Errorf("compile policies: %w", err)
+	}
+
+	return query, nil
+}
+
+// fingerprintDir summarizes the names, sizes and modification times of the
+// files under dir, so that changes can be detected without reading them.
+func fingerprintDir(dir string) (string, error) {
+	hash := sha256.New()
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		fmt.Fprintf(hash, "%s:%d:%d\n", path, info.Size(), info.ModTime().UnixNano())
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
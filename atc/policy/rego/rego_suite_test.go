@@ -0,0 +1,13 @@
+package rego_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRego(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rego Policy Agent Suite")
+}
@@ -0,0 +1,288 @@
+package rego_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/rego"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const denyDockerImage = `
+package concourse
+
+default decision = {"allowed": true}
+
+decision = {"allowed": false, "reasons": reasons} {
+  count(deny) > 0
+  reasons := deny
+}
+
+deny["cannot use docker-image types"] {
+  input.action == "UseImage"
+  input.data.image_type == "docker-image"
+}
+`
+
+var _ = Describe("Rego policy agent", func() {
+	var (
+		logger = lagertest.NewTestLogger("rego-test")
+
+		config    *rego.RegoConfig
+		policyDir string
+
+		agent policy.Agent
+		err   error
+	)
+
+	writePolicy := func(name, contents string) {
+		err := ioutil.WriteFile(filepath.Join(policyDir, name), []byte(contents), 0644)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	useImage := func(imageType string) policy.PolicyCheckInput {
+		return policy.PolicyCheckInput{
+			Action: policy.ActionUseImage,
+			Data:   map[string]interface{}{"image_type": imageType},
+		}
+	}
+
+	BeforeEach(func() {
+		policyDir, err = ioutil.TempDir("", "rego-policies")
+		Expect(err).ToNot(HaveOccurred())
+
+		config = &rego.RegoConfig{
+			PolicyDir:      policyDir,
+			Query:          "data.concourse.decision",
+			ReloadInterval: 10 * time.Millisecond,
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(policyDir)
+	})
+
+	JustBeforeEach(func() {
+		agent, err = config.NewAgent(logger)
+	})
+
+	It("is configured by a policy dir", func() {
+		Expect(config.IsConfigured()).To(BeTrue())
+		Expect((&rego.RegoConfig{}).IsConfigured()).To(BeFalse())
+	})
+
+	Context("with the policies used for local development", func() {
+		BeforeEach(func() {
+			config.PolicyDir = filepath.Join("..", "..", "..", "hack", "opa")
+		})
+
+		It("allows everything by default", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		})
+
+		Context("when its deny rules are enabled", func() {
+			BeforeEach(func() {
+				devPolicy, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "hack", "opa", "policy.rego"))
+				Expect(err).ToNot(HaveOccurred())
+
+				config.PolicyDir = policyDir
+				writePolicy("policy.rego", string(devPolicy))
+				writePolicy("enable.rego", `
+package concourse
+
+decision = {"allowed": false, "reasons": reasons} {
+  count(deny) > 0
+  reasons := deny
+}
+`)
+			})
+
+			It("denies docker images", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := agent.Check(useImage("docker-image"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeFalse())
+				Expect(result.Reasons).To(ConsistOf("cannot use docker-image types"))
+			})
+
+			It("denies privileged tasks in pipelines", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := agent.Check(policy.PolicyCheckInput{
+					Action: atc.SaveConfig,
+					Data: map[string]interface{}{
+						"jobs": []interface{}{
+							map[string]interface{}{
+								"plan": []interface{}{
+									map[string]interface{}{"task": "some-task", "privileged": true},
+								},
+							},
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeFalse())
+				Expect(result.Reasons).To(ConsistOf("cannot run privileged tasks"))
+			})
+
+			It("denies privileged steps on untagged workers", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := agent.Check(policy.PolicyCheckInput{
+					Action: policy.ActionRunStep,
+					Data: map[string]interface{}{
+						"privileged": true,
+						"worker":     map[string]interface{}{"tags": []string{}},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeFalse())
+				Expect(result.Reasons).To(ConsistOf("cannot run privileged tasks on untagged workers"))
+			})
+
+			It("allows everything else", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := agent.Check(useImage("registry-image"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when a policy denies the action", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", denyDockerImage)
+		})
+
+		It("should not be allowed and return reasons", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeFalse())
+			Expect(result.Reasons).To(ConsistOf("cannot use docker-image types"))
+		})
+
+		It("allows other actions", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("registry-image"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		})
+
+		Context("when the policies change", func() {
+			JustBeforeEach(func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err := agent.Check(useImage("docker-image"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeFalse())
+			})
+
+			It("reloads them", func() {
+				writePolicy("policy.rego", `
+package concourse
+
+decision = {"allowed": true}
+`)
+
+				Eventually(func() bool {
+					result, err := agent.Check(useImage("docker-image"))
+					Expect(err).ToNot(HaveOccurred())
+					return result.Allowed
+				}).Should(BeTrue())
+			})
+
+			It("keeps the previous policies if the new ones are invalid", func() {
+				writePolicy("policy.rego", `package concourse decision = {`)
+
+				Consistently(func() bool {
+					result, err := agent.Check(useImage("docker-image"))
+					Expect(err).ToNot(HaveOccurred())
+					return result.Allowed
+				}).Should(BeFalse())
+			})
+		})
+	})
+
+	Context("when the policies are invalid", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `package concourse decision = {`)
+		})
+
+		It("fails to create the agent", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the query is undefined", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", "package other\n\nfoo = 1\n")
+		})
+
+		It("should return an error", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).To(MatchError("rego query data.concourse.decision is undefined"))
+			Expect(result.Allowed).To(BeFalse())
+		})
+	})
+
+	Context("when the result has no allowed field", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", "package concourse\n\ndecision = {\"reasons\": [\"nope\"]}\n")
+		})
+
+		It("should return an error", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).To(MatchError(ContainSubstring("rego returned invalid result")))
+			Expect(result.Allowed).To(BeFalse())
+		})
+	})
+
+	Context("when the result has a severity", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", "package concourse\n\ndecision = {\"allowed\": false, \"reasons\": [\"nope\"], \"severity\": \"audit\"}\n")
+		})
+
+		It("should return the severity", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Severity).To(Equal(policy.SeverityAudit))
+			Expect(result.Blocked()).To(BeFalse())
+		})
+	})
+
+	Context("when the result has an unknown severity", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", "package concourse\n\ndecision = {\"allowed\": false, \"severity\": \"shrug\"}\n")
+		})
+
+		It("should return an error", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(useImage("docker-image"))
+			Expect(err).To(MatchError(ContainSubstring("unknown policy severity: shrug")))
+			Expect(result.Allowed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package atc
+
+// PolicyExemptionAllActions exempts a team from the policies of every action.
+const PolicyExemptionAllActions = "*"
+
+// PolicyExemption lets the actions of a team through even if the policies
+// would refuse them, until it expires.
+type PolicyExemption struct {
+	ID        int    `json:"id,omitempty"`
+	Team      string `json:"team"`
+	Action    string `json:"action"`
+	Reason    string `json:"reason"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	ExpiresAt int64  `json:"expires_at"`
+}
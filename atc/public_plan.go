@@ -16,6 +16,7 @@ func (plan Plan) Public() *json.RawMessage {
 		Task           *json.RawMessage `json:"task,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		ManualApproval *json.RawMessage `json:"manual_approval,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -71,6 +72,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.ManualApproval != nil {
+		public.ManualApproval = plan.ManualApproval.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -309,6 +314,16 @@ func (plan LoadVarPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ManualApprovalPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+		Role string `json:"role"`
+	}{
+		Name: plan.Name,
+		Role: plan.Role,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
@@ -10,9 +10,10 @@ type Resource struct {
 	LastChecked          int64        `json:"last_checked,omitempty"`
 	Icon                 string       `json:"icon,omitempty"`
 
-	FailingToCheck  bool   `json:"failing_to_check,omitempty"`
-	CheckSetupError string `json:"check_setup_error,omitempty"`
-	CheckError      string `json:"check_error,omitempty"`
+	FailingToCheck           bool   `json:"failing_to_check,omitempty"`
+	CheckSetupError          string `json:"check_setup_error,omitempty"`
+	CheckError               string `json:"check_error,omitempty"`
+	ConsecutiveCheckFailures int    `json:"consecutive_check_failures,omitempty"`
 
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
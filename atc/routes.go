@@ -14,25 +14,29 @@ const (
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
-
-	GetJob         = "GetJob"
-	CreateJobBuild = "CreateJobBuild"
-	RerunJobBuild  = "RerunJobBuild"
-	ListAllJobs    = "ListAllJobs"
-	ListJobs       = "ListJobs"
-	ListJobBuilds  = "ListJobBuilds"
-	ListJobInputs  = "ListJobInputs"
-	GetJobBuild    = "GetJobBuild"
-	PauseJob       = "PauseJob"
-	UnpauseJob     = "UnpauseJob"
-	ScheduleJob    = "ScheduleJob"
-	GetVersionsDB  = "GetVersionsDB"
-	JobBadge       = "JobBadge"
-	MainJobBadge   = "MainJobBadge"
+	ListBuildApprovals  = "ListBuildApprovals"
+	ApproveBuild        = "ApproveBuild"
+
+	GetJob           = "GetJob"
+	CreateJobBuild   = "CreateJobBuild"
+	RerunJobBuild    = "RerunJobBuild"
+	ListAllJobs      = "ListAllJobs"
+	ListJobs         = "ListJobs"
+	ListJobBuilds    = "ListJobBuilds"
+	ListJobInputs    = "ListJobInputs"
+	ExplainJobInputs = "ExplainJobInputs"
+	GetJobBuild      = "GetJobBuild"
+	PauseJob         = "PauseJob"
+	UnpauseJob       = "UnpauseJob"
+	ScheduleJob      = "ScheduleJob"
+	GetVersionsDB    = "GetVersionsDB"
+	JobBadge         = "JobBadge"
+	MainJobBadge     = "MainJobBadge"
 
 	ClearTaskCache = "ClearTaskCache"
 
 	ListAllResources     = "ListAllResources"
+	ListStaleResources   = "ListStaleResources"
 	ListResources        = "ListResources"
 	ListResourceTypes    = "ListResourceTypes"
 	GetResource          = "GetResource"
@@ -68,6 +72,9 @@ const (
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
+	GetPipelineDeliveryMetrics = "GetPipelineDeliveryMetrics"
+	ListPipelineBlockedBuilds  = "ListPipelineBlockedBuilds"
+
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
@@ -110,6 +117,29 @@ const (
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	ListPolicyExemptions  = "ListPolicyExemptions"
+	CreatePolicyExemption = "CreatePolicyExemption"
+	DeletePolicyExemption = "DeletePolicyExemption"
+
+	ListAPITokens  = "ListAPITokens"
+	CreateAPIToken = "CreateAPIToken"
+	DeleteAPIToken = "DeleteAPIToken"
+
+	ListAuditEvents     = "ListAuditEvents"
+	ListTeamAuditEvents = "ListTeamAuditEvents"
+
+	ListLocalUsers       = "ListLocalUsers"
+	CreateLocalUser      = "CreateLocalUser"
+	SetLocalUserPassword = "SetLocalUserPassword"
+	DisableLocalUser     = "DisableLocalUser"
+	EnableLocalUser      = "EnableLocalUser"
+
+	ListSessions       = "ListSessions"
+	RevokeSession      = "RevokeSession"
+	RevokeSessions     = "RevokeSessions"
+	ListUserSessions   = "ListUserSessions"
+	RevokeUserSessions = "RevokeUserSessions"
 )
 
 const (
@@ -131,6 +161,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/approvals", Method: "GET", Name: ListBuildApprovals},
+	{Path: "/api/v1/builds/:build_id/approvals/:approval_id", Method: "PUT", Name: ApproveBuild},
 
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
@@ -139,6 +171,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs/explain", Method: "GET", Name: ExplainJobInputs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -163,8 +196,11 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/delivery-metrics", Method: "GET", Name: GetPipelineDeliveryMetrics},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/blocked-builds", Method: "GET", Name: ListPipelineBlockedBuilds},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
+	{Path: "/api/v1/resources/stale", Method: "GET", Name: ListStaleResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types", Method: "GET", Name: ListResourceTypes},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
@@ -226,4 +262,27 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
+
+	{Path: "/api/v1/policy/exemptions", Method: "GET", Name: ListPolicyExemptions},
+	{Path: "/api/v1/policy/exemptions", Method: "POST", Name: CreatePolicyExemption},
+	{Path: "/api/v1/policy/exemptions/:exemption_id", Method: "DELETE", Name: DeletePolicyExemption},
+
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_name", Method: "DELETE", Name: DeleteAPIToken},
+
+	{Path: "/api/v1/audit_events", Method: "GET", Name: ListAuditEvents},
+	{Path: "/api/v1/teams/:team_name/audit_events", Method: "GET", Name: ListTeamAuditEvents},
+
+	{Path: "/api/v1/local_users", Method: "GET", Name: ListLocalUsers},
+	{Path: "/api/v1/local_users", Method: "POST", Name: CreateLocalUser},
+	{Path: "/api/v1/local_users/:local_user_name/password", Method: "PUT", Name: SetLocalUserPassword},
+	{Path: "/api/v1/local_users/:local_user_name/disable", Method: "PUT", Name: DisableLocalUser},
+	{Path: "/api/v1/local_users/:local_user_name/enable", Method: "PUT", Name: EnableLocalUser},
+
+	{Path: "/api/v1/user/sessions", Method: "GET", Name: ListSessions},
+	{Path: "/api/v1/user/sessions", Method: "DELETE", Name: RevokeSessions},
+	{Path: "/api/v1/user/sessions/:session_id", Method: "DELETE", Name: RevokeSession},
+	{Path: "/api/v1/users/:user_name/sessions", Method: "GET", Name: ListUserSessions},
+	{Path: "/api/v1/users/:user_name/sessions", Method: "DELETE", Name: RevokeUserSessions},
 })
@@ -1,6 +1,9 @@
 package runtime
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // FileNotFoundError is the error to return from StreamFile when the given path
 // does not exist.
@@ -36,3 +39,32 @@ func (err ErrResourceScriptFailed) Error() string {
 
 	return msg
 }
+
+// OOMKill is reported when processes of a container got killed by the kernel
+// for exceeding the memory limit of the container.
+type OOMKill struct {
+	MemoryLimit uint64
+}
+
+func (kill OOMKill) Error() string {
+	if kill.MemoryLimit == 0 {
+		return "killed: out of memory"
+	}
+
+	return fmt.Sprintf("killed: out of memory, limit %s", formatMemory(kill.MemoryLimit))
+}
+
+// formatMemory formats an amount of bytes using the units accepted in
+// `container_limits`.
+func formatMemory(bytes uint64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+
+	value := float64(bytes)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", value), ".0") + units[unit]
+}
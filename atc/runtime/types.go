@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -18,6 +19,7 @@ const (
 type StartingEventDelegate interface {
 	Starting(lager.Logger)
 	SelectedWorker(lager.Logger, string)
+	Waiting(lager.Logger, string)
 }
 
 type VersionResult struct {
@@ -82,6 +84,32 @@ type Runner interface {
 	) error
 }
 
+// ContainerUsage summarizes the resources consumed by a container while a step
+// ran in it, as observed from periodic samples of its metrics.
+type ContainerUsage struct {
+	Samples  int
+	Duration time.Duration
+
+	CPUTime       time.Duration
+	MemoryPeak    uint64
+	MemoryAverage uint64
+
+	NetworkRxBytes uint64
+	NetworkTxBytes uint64
+
+	BlockIOReadBytes  uint64
+	BlockIOWriteBytes uint64
+}
+
+// CPUAverage is the average amount of CPUs used over the sampled duration.
+func (usage ContainerUsage) CPUAverage() float64 {
+	if usage.Duration <= 0 {
+		return 0
+	}
+
+	return usage.CPUTime.Seconds() / usage.Duration.Seconds()
+}
+
 type ProcessSpec struct {
 	Path         string
 	Args         []string
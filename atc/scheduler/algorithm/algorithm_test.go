@@ -36,6 +36,38 @@ var _ = DescribeTable("Input resolving",
 		},
 	}),
 
+	Entry("explains the candidates considered when fanning in", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-b", BuildID: 2, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 3, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			Explanation: []ExplainStep{
+				{Event: "vouched", Input: "resource-x", Job: "simple-a", BuildID: 3, Version: "rxv2"},
+				{Event: "exhausted", Input: "resource-x", Job: "simple-b"},
+				{Event: "rejected", Input: "resource-x", Job: "simple-a", BuildID: 3, Version: "rxv2"},
+				{Event: "vouched", Input: "resource-x", Job: "simple-a", BuildID: 1, Version: "rxv1"},
+				{Event: "vouched", Input: "resource-x", Job: "simple-b", BuildID: 2, Version: "rxv1"},
+			},
+		},
+	}),
+
 	Entry("propagates resources together", Example{
 		DB: DB{
 			BuildOutputs: []DBRow{
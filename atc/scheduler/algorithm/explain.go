@@ -0,0 +1,167 @@
+package algorithm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// The events recorded while explaining how a job's inputs were resolved.
+const (
+	ExplainLatest      = "latest"
+	ExplainEvery       = "every"
+	ExplainPinned      = "pinned"
+	ExplainNotFound    = "not-found"
+	ExplainVouched     = "vouched"
+	ExplainMismatch    = "mismatch"
+	ExplainDisabled    = "disabled"
+	ExplainPinMismatch = "pin-mismatch"
+	ExplainMissing     = "missing"
+	ExplainDoomed      = "doomed"
+	ExplainRejected    = "rejected"
+	ExplainDeferred    = "deferred"
+	ExplainExhausted   = "exhausted"
+)
+
+type Explanation struct {
+	Resolved bool
+	Inputs   []ExplainedInput
+	Steps    []ExplainStep
+}
+
+type ExplainedInput struct {
+	Name            string
+	ResourceID      int
+	Version         atc.Version
+	PassedBuildIDs  []int
+	FirstOccurrence bool
+	ResolveError    db.ResolutionFailure
+}
+
+// ExplainStep is a single decision made by one of the resolvers, e.g. a
+// build of a passed job vouching for a candidate version or a candidate
+// being rejected.
+type ExplainStep struct {
+	Event      string
+	Input      string
+	ResourceID int
+	JobID      int
+	BuildID    int
+	Version    atc.Version
+	Detail     string
+
+	versionMD5 db.ResourceVersion
+}
+
+// Explain runs the same resolution as Compute but records every decision
+// made along the way. Nothing is saved, so the job's next build inputs are
+// left untouched.
+func (a *Algorithm) Explain(
+	ctx context.Context,
+	job db.Job,
+	inputs db.InputConfigs,
+) (Explanation, error) {
+	ctx, span := tracing.StartSpan(ctx, "Algorithm.Explain", tracing.Attrs{
+		"pipeline": job.PipelineName(),
+		"job":      job.Name(),
+	})
+	defer span.End()
+
+	resolvers, err := constructResolvers(a.versionsDB, inputs)
+	if err != nil {
+		return Explanation{}, fmt.Errorf("construct resolvers: %w", err)
+	}
+
+	trace := &explainTrace{}
+
+	mapping, resolved, _, err := a.computeResolvers(withExplainTrace(ctx, trace), resolvers)
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	versions := map[db.AlgorithmVersion]atc.Version{}
+	lookupVersion := func(resourceID int, versionMD5 db.ResourceVersion) (atc.Version, error) {
+		key := db.AlgorithmVersion{ResourceID: resourceID, Version: versionMD5}
+		if version, found := versions[key]; found {
+			return version, nil
+		}
+
+		version, _, err := a.versionsDB.VersionOfResource(ctx, resourceID, versionMD5)
+		if err != nil {
+			return nil, fmt.Errorf("find version: %w", err)
+		}
+
+		versions[key] = version
+		return version, nil
+	}
+
+	explanation := Explanation{
+		Resolved: resolved,
+		Inputs:   []ExplainedInput{},
+		Steps:    []ExplainStep{},
+	}
+
+	for _, input := range inputs {
+		result := mapping[input.Name]
+
+		explained := ExplainedInput{
+			Name:         input.Name,
+			ResourceID:   input.ResourceID,
+			ResolveError: result.ResolveError,
+		}
+
+		if result.Input != nil {
+			explained.Version, err = lookupVersion(input.ResourceID, result.Input.Version)
+			if err != nil {
+				return Explanation{}, err
+			}
+
+			explained.PassedBuildIDs = result.PassedBuildIDs
+			explained.FirstOccurrence = result.Input.FirstOccurrence
+		}
+
+		explanation.Inputs = append(explanation.Inputs, explained)
+	}
+
+	for _, step := range trace.steps {
+		if step.versionMD5 != "" {
+			step.Version, err = lookupVersion(step.ResourceID, step.versionMD5)
+			if err != nil {
+				return Explanation{}, err
+			}
+		}
+
+		explanation.Steps = append(explanation.Steps, step)
+	}
+
+	return explanation, nil
+}
+
+type explainTraceKey struct{}
+
+type explainTrace struct {
+	steps []ExplainStep
+}
+
+func withExplainTrace(ctx context.Context, trace *explainTrace) context.Context {
+	return context.WithValue(ctx, explainTraceKey{}, trace)
+}
+
+// explainTraceFrom returns nil when the algorithm is not being explained, in
+// which case recording steps is a no-op.
+func explainTraceFrom(ctx context.Context) *explainTrace {
+	trace, _ := ctx.Value(explainTraceKey{}).(*explainTrace)
+	return trace
+}
+
+func (t *explainTrace) record(step ExplainStep, version db.ResourceVersion) {
+	if t == nil {
+		return
+	}
+
+	step.versionMD5 = version
+	t.steps = append(t.steps, step)
+}
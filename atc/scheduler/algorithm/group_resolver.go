@@ -74,6 +74,7 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 
 		if !found {
 			notFoundErr := db.PinnedVersionNotFound{PinnedVersion: cfg.PinnedVersion}
+			explainTraceFrom(ctx).record(r.explainStep(ExplainNotFound, i, 0, 0, string(notFoundErr.String())), "")
 			span.SetStatus(codes.InvalidArgument, "")
 			return nil, notFoundErr.String(), nil
 		}
@@ -159,6 +160,9 @@ func (r *groupResolver) trySatisfyPassedConstraintsForInput(ctx context.Context,
 
 		if skip {
 			span.AddEvent(ctx, "deferring selection to other jobs", label.Int("passedJobID", passedJobID))
+			explainTraceFrom(ctx).record(r.explainStep(ExplainDeferred, inputIndex, passedJobID, 0,
+				"continuing from the builds used last time with the other passed jobs",
+			), "")
 			continue
 		}
 
@@ -172,6 +176,7 @@ func (r *groupResolver) trySatisfyPassedConstraintsForInput(ctx context.Context,
 			// resolving recursively worked!
 			break
 		} else {
+			explainTraceFrom(ctx).record(r.explainStep(ExplainExhausted, inputIndex, passedJobID, 0, string(db.NoSatisfiableBuilds)), "")
 			span.SetStatus(codes.NotFound, "")
 			return false, db.NoSatisfiableBuilds, nil
 		}
@@ -241,13 +246,17 @@ outputs:
 			}
 
 			var related bool
-			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID)
+			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID, buildID)
 			if err != nil {
 				tracing.End(span, err)
 				return false, err
 			}
 
 			if mismatch {
+				explainTraceFrom(ctx).record(r.explainStep(ExplainMismatch, c, jobID, buildID,
+					"build has a different version than the current candidate",
+				), output.Version)
+
 				// build contained a different version than the one we already have for
 				// that candidate, so let's try a different build
 				break outputs
@@ -264,6 +273,9 @@ outputs:
 				}
 
 				if !exists {
+					explainTraceFrom(ctx).record(r.explainStep(ExplainMissing, c, jobID, buildID,
+						"version is no longer available from the resource",
+					), output.Version)
 					break outputs
 				}
 			}
@@ -280,6 +292,8 @@ outputs:
 			)
 
 			r.candidates[c] = r.vouchForCandidate(candidate, output.Version, jobID, buildID, hasNext)
+
+			explainTraceFrom(ctx).record(r.explainStep(ExplainVouched, c, jobID, buildID, ""), output.Version)
 		}
 	}
 
@@ -290,6 +304,9 @@ outputs:
 				ctx,
 				"candidates are doomed",
 			)
+			explainTraceFrom(ctx).record(r.explainStep(ExplainDoomed, resolvingIdx, jobID, buildID,
+				"these candidates were already rejected",
+			), r.candidates[resolvingIdx].Version)
 		} else {
 			worked, _, err := r.tryResolve(ctx)
 			if err != nil {
@@ -303,6 +320,10 @@ outputs:
 				return true, nil
 			}
 
+			explainTraceFrom(ctx).record(r.explainStep(ExplainRejected, resolvingIdx, jobID, buildID,
+				"the other inputs could not be satisfied with this build's versions",
+			), r.candidates[resolvingIdx].Version)
+
 			r.doomCandidates()
 		}
 	}
@@ -423,7 +444,7 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	return constrainingCandidates
 }
 
-func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int) (bool, bool, error) {
+func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int, passedBuildID int) (bool, bool, error) {
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
@@ -456,6 +477,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 			label.Int("resourceID", output.ResourceID),
 			label.String("version", string(output.Version)),
 		)
+		explainTraceFrom(ctx).record(r.explainStep(ExplainDisabled, candidateIdx, passedJobID, passedBuildID, ""), output.Version)
 		return false, false, nil
 	}
 
@@ -470,6 +492,9 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 			label.String("outputHas", string(output.Version)),
 			label.String("pinHas", string(r.pins[candidateIdx])),
 		)
+		explainTraceFrom(ctx).record(r.explainStep(ExplainPinMismatch, candidateIdx, passedJobID, passedBuildID,
+			"version does not match the pinned version",
+		), output.Version)
 
 		return false, false, nil
 	}
@@ -506,6 +531,17 @@ func (r *groupResolver) vouchForCandidate(oldCandidate *versionCandidate, versio
 	return newCandidate
 }
 
+func (r *groupResolver) explainStep(event string, inputIdx int, jobID int, buildID int, detail string) ExplainStep {
+	return ExplainStep{
+		Event:      event,
+		Input:      r.inputConfigs[inputIdx].Name,
+		ResourceID: r.inputConfigs[inputIdx].ResourceID,
+		JobID:      jobID,
+		BuildID:    buildID,
+		Detail:     detail,
+	}
+}
+
 func (r *groupResolver) orderJobs(jobIDs map[int]bool) []int {
 	orderedJobs := []int{}
 	for id, _ := range jobIDs {
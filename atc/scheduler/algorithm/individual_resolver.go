@@ -46,11 +46,13 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 
 		if !found {
 			span.AddEvent(ctx, "next every version not found")
+			explainTraceFrom(ctx).record(r.explainStep(ExplainNotFound, string(db.VersionNotFound)), "")
 			span.SetStatus(codes.NotFound, "next every version not found")
 			return nil, db.VersionNotFound, nil
 		}
 
 		span.AddEvent(ctx, "found via every", label.String("version", string(version)))
+		explainTraceFrom(ctx).record(r.explainStep(ExplainEvery, ""), version)
 	} else {
 		// there are no passed constraints, so just take the latest version
 		var err error
@@ -63,11 +65,13 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 
 		if !found {
 			span.AddEvent(ctx, "latest version not found")
+			explainTraceFrom(ctx).record(r.explainStep(ExplainNotFound, string(db.LatestVersionNotFound)), "")
 			span.SetStatus(codes.NotFound, "latest version not found")
 			return nil, db.LatestVersionNotFound, nil
 		}
 
 		span.AddEvent(ctx, "found via latest", label.String("version", string(version)))
+		explainTraceFrom(ctx).record(r.explainStep(ExplainLatest, ""), version)
 	}
 
 	candidate := newCandidateVersion(version)
@@ -80,3 +84,12 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 	span.SetStatus(codes.OK, "")
 	return versionCandidates, "", nil
 }
+
+func (r *individualResolver) explainStep(event string, detail string) ExplainStep {
+	return ExplainStep{
+		Event:      event,
+		Input:      r.inputConfig.Name,
+		ResourceID: r.inputConfig.ResourceID,
+		Detail:     detail,
+	}
+}
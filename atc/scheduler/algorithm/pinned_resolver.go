@@ -38,12 +38,25 @@ func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandid
 	}
 
 	if !found {
+		failure := db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String()
+
 		span.AddEvent(ctx, "pinned version not found")
+		explainTraceFrom(ctx).record(ExplainStep{
+			Event:      ExplainNotFound,
+			Input:      r.inputConfig.Name,
+			ResourceID: r.inputConfig.ResourceID,
+			Detail:     string(failure),
+		}, "")
 		span.SetStatus(codes.NotFound, "pinned version not found")
-		return nil, db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String(), nil
+		return nil, failure, nil
 	}
 
 	span.AddEvent(ctx, "found via pin", label.String("version", string(version)))
+	explainTraceFrom(ctx).record(ExplainStep{
+		Event:      ExplainPinned,
+		Input:      r.inputConfig.Name,
+		ResourceID: r.inputConfig.ResourceID,
+	}, version)
 
 	versionCandidate := map[string]*versionCandidate{
 		r.inputConfig.Name: newCandidateVersion(version),
@@ -80,6 +80,15 @@ type Result struct {
 	ExpectedMigrated map[int]map[int][]string
 	HasNext          bool
 	NoNext           bool
+	Explanation      []ExplainStep
+}
+
+type ExplainStep struct {
+	Event   string
+	Input   string
+	Job     string
+	BuildID int
+	Version string
 }
 
 type StringMapping map[string]int
@@ -696,6 +705,30 @@ func (example Example) assert(
 		if example.Result.NoNext == true {
 			Expect(hasNext).To(Equal(false))
 		}
+
+		if example.Result.Explanation != nil {
+			explanation, err := alg.Explain(ctx, job, inputConfigs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(explanation.Resolved).To(Equal(ok))
+
+			steps := []ExplainStep{}
+			for _, step := range explanation.Steps {
+				var jobName string
+				if step.JobID != 0 {
+					jobName = setup.jobIDs.Name(step.JobID)
+				}
+
+				steps = append(steps, ExplainStep{
+					Event:   step.Event,
+					Input:   step.Input,
+					Job:     jobName,
+					BuildID: step.BuildID,
+					Version: step.Version["ver"],
+				})
+			}
+
+			Expect(steps).To(Equal(example.Result.Explanation))
+		}
 	}
 }
 
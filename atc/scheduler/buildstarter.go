@@ -2,7 +2,10 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -36,16 +39,22 @@ type Build interface {
 func NewBuildStarter(
 	planner BuildPlanner,
 	algorithm Algorithm,
+	quotaChecker db.QuotaChecker,
+	startQueue BuildStartQueue,
 ) BuildStarter {
 	return &buildStarter{
-		planner:   planner,
-		algorithm: algorithm,
+		planner:      planner,
+		algorithm:    algorithm,
+		quotaChecker: quotaChecker,
+		startQueue:   startQueue,
 	}
 }
 
 type buildStarter struct {
-	planner   BuildPlanner
-	algorithm Algorithm
+	planner      BuildPlanner
+	algorithm    Algorithm
+	quotaChecker db.QuotaChecker
+	startQueue   BuildStartQueue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -74,8 +83,10 @@ func (s *buildStarter) TryStartPendingBuildsForJob(
 		}
 
 		if !results.scheduled || !results.readyToDetermineInputs {
-			// If max in flight is reached or a manually triggered build has not
-			// checked all resources, stop scheduling and retry later
+			// If max in flight or the team's running builds quota is reached, a
+			// manually triggered build has not checked all resources, or the
+			// build has to wait its turn in the fair-share queue, stop scheduling
+			// and retry later
 			needsRetry = true
 			break
 		}
@@ -122,6 +133,14 @@ func (s *buildStarter) constructBuilds(job db.Job, jobInputs db.InputConfigs, bu
 	return buildsToSchedule
 }
 
+const (
+	pendingReasonJobPaused          = "job is paused"
+	pendingReasonMaxInFlightReached = "max in flight reached"
+	pendingReasonResourcesUnchecked = "waiting for resources to be checked"
+	pendingReasonRerunInputsMissing = "versions of the original build's inputs are not available"
+	pendingReasonInputsUnsatisfied  = "inputs not satisfied"
+)
+
 type startResults struct {
 	finished               bool
 	scheduled              bool
@@ -152,6 +171,19 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	err := s.quotaChecker.CheckQuota(job.TeamID(), db.QuotaRunningBuilds)
+	if errors.As(err, &db.QuotaReachedError{}) {
+		logger.Debug("team-quota-reached")
+
+		s.setPendingReason(logger, nextPendingBuild, err.Error())
+
+		return startResults{}, nil
+	}
+
+	if err != nil {
+		return startResults{}, fmt.Errorf("check running builds quota: %w", err)
+	}
+
 	scheduled, err := job.ScheduleBuild(nextPendingBuild)
 	if err != nil {
 		return startResults{}, fmt.Errorf("schedule build: %w", err)
@@ -159,6 +191,13 @@ func (s *buildStarter) tryStartNextPendingBuild(
 
 	if !scheduled {
 		logger.Debug("build-not-scheduled")
+
+		if job.Paused() {
+			s.setPendingReason(logger, nextPendingBuild, pendingReasonJobPaused)
+		} else {
+			s.setPendingReason(logger, nextPendingBuild, pendingReasonMaxInFlightReached)
+		}
+
 		return startResults{
 			scheduled: scheduled,
 		}, nil
@@ -170,6 +209,8 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	}
 
 	if !readyToDetermineInputs {
+		s.setPendingReason(logger, nextPendingBuild, pendingReasonResourcesUnchecked)
+
 		return startResults{
 			scheduled:              scheduled,
 			readyToDetermineInputs: readyToDetermineInputs,
@@ -184,6 +225,12 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	if !inputsDetermined {
 		logger.Debug("build-inputs-not-found")
 
+		if nextPendingBuild.RerunOf() != 0 {
+			s.setPendingReason(logger, nextPendingBuild, pendingReasonRerunInputsMissing)
+		} else {
+			s.setPendingReason(logger, nextPendingBuild, unsatisfiedInputsReason(logger, job))
+		}
+
 		// don't retry when build inputs are not found because this is due to the
 		// inputs being unsatisfiable
 		return startResults{
@@ -193,6 +240,15 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	admitted, position := s.startQueue.Admit(nextPendingBuild.ID())
+	if !admitted {
+		logger.Debug("waiting-in-fair-share-queue", lager.Data{"position": position})
+
+		s.setPendingReason(logger, nextPendingBuild, db.FairSharePendingReason)
+
+		return startResults{}, nil
+	}
+
 	config, err := job.Config()
 	if err != nil {
 		return startResults{}, fmt.Errorf("config: %w", err)
@@ -236,3 +292,41 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		finished: true,
 	}, nil
 }
+
+// setPendingReason records why a build is still pending. Failing to record it
+// shouldn't hold up scheduling, so the error is only logged.
+func (s *buildStarter) setPendingReason(logger lager.Logger, build Build, reason string) {
+	if build.PendingReason() == reason {
+		return
+	}
+
+	err := build.SetPendingReason(reason)
+	if err != nil {
+		logger.Error("failed-to-set-pending-reason", err)
+	}
+}
+
+// unsatisfiedInputsReason lists the inputs that the algorithm failed to
+// resolve, along with why.
+func unsatisfiedInputsReason(logger lager.Logger, job db.SchedulerJob) string {
+	inputs, err := job.GetNextBuildInputs()
+	if err != nil {
+		logger.Error("failed-to-get-next-build-inputs", err)
+		return pendingReasonInputsUnsatisfied
+	}
+
+	var failures []string
+	for _, input := range inputs {
+		if input.ResolveError != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", input.Name, input.ResolveError))
+		}
+	}
+
+	if len(failures) == 0 {
+		return pendingReasonInputsUnsatisfied
+	}
+
+	sort.Strings(failures)
+
+	return pendingReasonInputsUnsatisfied + ": " + strings.Join(failures, ", ")
+}
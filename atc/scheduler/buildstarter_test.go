@@ -22,6 +22,9 @@ var _ = Describe("BuildStarter", func() {
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
 
+		fakeQuotaChecker *dbfakes.FakeQuotaChecker
+		fakeStartQueue   *schedulerfakes.FakeBuildStartQueue
+
 		buildStarter scheduler.BuildStarter
 
 		jobInputs db.InputConfigs
@@ -34,7 +37,11 @@ var _ = Describe("BuildStarter", func() {
 		fakePlanner = new(schedulerfakes.FakeBuildPlanner)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 
-		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm)
+		fakeQuotaChecker = new(dbfakes.FakeQuotaChecker)
+		fakeStartQueue = new(schedulerfakes.FakeBuildStartQueue)
+		fakeStartQueue.AdmitReturns(true, 0)
+
+		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm, fakeQuotaChecker, fakeStartQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -204,6 +211,72 @@ var _ = Describe("BuildStarter", func() {
 						Expect(tryStartErr).ToNot(HaveOccurred())
 						Expect(needsReschedule).To(BeTrue())
 					})
+
+					It("records that max in flight has been reached", func() {
+						Expect(createdBuild.SetPendingReasonCallCount()).To(Equal(1))
+						Expect(createdBuild.SetPendingReasonArgsForCall(0)).To(Equal("max in flight reached"))
+					})
+
+					Context("when the job is paused", func() {
+						BeforeEach(func() {
+							job.PausedReturns(true)
+						})
+
+						It("records that the job is paused", func() {
+							Expect(createdBuild.SetPendingReasonCallCount()).To(Equal(1))
+							Expect(createdBuild.SetPendingReasonArgsForCall(0)).To(Equal("job is paused"))
+						})
+					})
+
+					Context("when the build already has the reason", func() {
+						BeforeEach(func() {
+							createdBuild.PendingReasonReturns("max in flight reached")
+						})
+
+						It("does not record it again", func() {
+							Expect(createdBuild.SetPendingReasonCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the team's running builds quota is reached", func() {
+					BeforeEach(func() {
+						job.TeamIDReturns(7)
+						fakeQuotaChecker.CheckQuotaReturns(db.QuotaReachedError{
+							Quota: db.QuotaRunningBuilds,
+							Limit: 3,
+						})
+					})
+
+					It("checks the quota of the job's team", func() {
+						Expect(fakeQuotaChecker.CheckQuotaCallCount()).To(Equal(1))
+						teamID, quota := fakeQuotaChecker.CheckQuotaArgsForCall(0)
+						Expect(teamID).To(Equal(7))
+						Expect(quota).To(Equal(db.QuotaRunningBuilds))
+					})
+
+					It("does not schedule the build and needs to be rescheduled", func() {
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+						Expect(createdBuild.StartCallCount()).To(BeZero())
+						Expect(tryStartErr).ToNot(HaveOccurred())
+						Expect(needsReschedule).To(BeTrue())
+					})
+
+					It("records that the quota has been reached", func() {
+						Expect(createdBuild.SetPendingReasonCallCount()).To(Equal(1))
+						Expect(createdBuild.SetPendingReasonArgsForCall(0)).To(Equal("team quota of 3 running builds reached"))
+					})
+				})
+
+				Context("when checking the team's quota fails", func() {
+					BeforeEach(func() {
+						fakeQuotaChecker.CheckQuotaReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(tryStartErr).To(Equal(fmt.Errorf("check running builds quota: %w", disaster)))
+						Expect(job.ScheduleBuildCallCount()).To(BeZero())
+					})
 				})
 
 				Context("when scheduling the build fails", func() {
@@ -253,6 +326,11 @@ var _ = Describe("BuildStarter", func() {
 						It("retries to schedule", func() {
 							Expect(needsReschedule).To(BeTrue())
 						})
+
+						It("records that it is waiting for the resources to be checked", func() {
+							Expect(createdBuild.SetPendingReasonCallCount()).To(Equal(1))
+							Expect(createdBuild.SetPendingReasonArgsForCall(0)).To(Equal("waiting for resources to be checked"))
+						})
 					})
 
 					Context("when all resources are checked after build create time or pinned", func() {
@@ -508,6 +586,11 @@ var _ = Describe("BuildStarter", func() {
 							Expect(tryStartErr).ToNot(HaveOccurred())
 							Expect(needsReschedule).To(BeFalse())
 						})
+
+						It("records that the original build's versions are not available", func() {
+							Expect(pendingBuild1.SetPendingReasonCallCount()).To(Equal(1))
+							Expect(pendingBuild1.SetPendingReasonArgsForCall(0)).To(Equal("versions of the original build's inputs are not available"))
+						})
 					})
 
 					Context("when adopting inputs and pipes for a normal scheduler build fails", func() {
@@ -530,12 +613,21 @@ var _ = Describe("BuildStarter", func() {
 							pendingBuild1.IDReturns(99)
 							pendingBuild1.AdoptInputsAndPipesReturns([]db.BuildInput{{Name: "some-input"}}, false, nil)
 							job.GetPendingBuildsReturns([]db.Build{pendingBuild1}, nil)
+							job.GetNextBuildInputsReturns([]db.BuildInput{
+								{Name: "some-input", ResolveError: "no satisfiable builds from passed jobs found for set of inputs"},
+								{Name: "some-other-input"},
+							}, nil)
 						})
 
 						It("returns the error and does not retry to schedule", func() {
 							Expect(tryStartErr).ToNot(HaveOccurred())
 							Expect(needsReschedule).To(BeFalse())
 						})
+
+						It("records why the inputs could not be resolved", func() {
+							Expect(pendingBuild1.SetPendingReasonCallCount()).To(Equal(1))
+							Expect(pendingBuild1.SetPendingReasonArgsForCall(0)).To(Equal("inputs not satisfied: some-input: no satisfiable builds from passed jobs found for set of inputs"))
+						})
 					})
 
 					Context("when there are several pending builds consisting of both retrigger and normal scheduler builds", func() {
@@ -588,6 +680,34 @@ var _ = Describe("BuildStarter", func() {
 						})
 
 						Context("when the build was scheduled successfully", func() {
+							Context("when the build has to wait in the fair-share queue", func() {
+								BeforeEach(func() {
+									fakeStartQueue.AdmitReturns(false, 4)
+								})
+
+								It("asks the queue to admit the first pending build", func() {
+									Expect(fakeStartQueue.AdmitCallCount()).To(Equal(1))
+									Expect(fakeStartQueue.AdmitArgsForCall(0)).To(Equal(99))
+								})
+
+								It("does not start the build and needs to be rescheduled", func() {
+									Expect(fakePlanner.CreateCallCount()).To(BeZero())
+									Expect(pendingBuild1.StartCallCount()).To(BeZero())
+									Expect(tryStartErr).NotTo(HaveOccurred())
+									Expect(needsReschedule).To(BeTrue())
+								})
+
+								It("does not try to start the next pending builds", func() {
+									Expect(rerunBuild.AdoptRerunInputsAndPipesCallCount()).To(BeZero())
+									Expect(pendingBuild2.AdoptInputsAndPipesCallCount()).To(BeZero())
+								})
+
+								It("records that the build is waiting in the queue", func() {
+									Expect(pendingBuild1.SetPendingReasonCallCount()).To(Equal(1))
+									Expect(pendingBuild1.SetPendingReasonArgsForCall(0)).To(Equal(db.FairSharePendingReason))
+								})
+							})
+
 							Context("when the resource types are successfully fetched", func() {
 								Context("when creating the build plan fails for the rerun build and the scheduler builds", func() {
 									BeforeEach(func() {
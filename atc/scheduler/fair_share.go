@@ -0,0 +1,245 @@
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . BuildStartQueue
+
+// BuildStartQueue decides which pending builds may start while the cluster is
+// short of build capacity.
+type BuildStartQueue interface {
+	// Refresh orders the queue from the builds that are pending and running
+	// now.
+	Refresh(lager.Logger) error
+
+	// Admit reports whether the build may start, taking up the capacity for
+	// it until the next refresh. A build that has to wait comes with its
+	// position in the queue, or 0 if it wasn't queued when the queue was last
+	// refreshed.
+	Admit(buildID int) (bool, int)
+}
+
+type FairShareConfig struct {
+	// BuildsPerWorker is how many builds each running worker counts for in
+	// the build capacity of the cluster. The queue admits every build when it
+	// is 0.
+	BuildsPerWorker int
+
+	// DefaultShare is the share of teams missing from TeamShares.
+	DefaultShare int
+
+	// TeamShares weighs teams against each other: a team with a share of 2
+	// gets to run twice as many builds as a team with a share of 1.
+	TeamShares map[string]int
+}
+
+func (config FairShareConfig) share(team string) int {
+	if share, found := config.TeamShares[team]; found {
+		return share
+	}
+
+	return config.DefaultShare
+}
+
+// NewFairShareQueue builds a queue which orders pending builds so that the
+// running builds of each team stay in proportion to the team's share, and
+// admits builds from the front of the queue while there is capacity.
+func NewFairShareQueue(
+	buildQueue db.BuildQueue,
+	workerFactory db.WorkerFactory,
+	config FairShareConfig,
+) BuildStartQueue {
+	return &fairShareQueue{
+		buildQueue:    buildQueue,
+		workerFactory: workerFactory,
+		config:        config,
+	}
+}
+
+type fairShareQueue struct {
+	buildQueue    db.BuildQueue
+	workerFactory db.WorkerFactory
+	config        FairShareConfig
+
+	lock      sync.Mutex
+	refreshed bool
+	positions map[int]int
+	admitted  map[int]bool
+	free      int
+	cleared   bool
+}
+
+func (q *fairShareQueue) Refresh(logger lager.Logger) error {
+	logger = logger.Session("refresh-fair-share-queue")
+
+	if q.config.BuildsPerWorker == 0 {
+		return q.clearPositions()
+	}
+
+	pending, err := q.buildQueue.PendingBuilds()
+	if err != nil {
+		return fmt.Errorf("get pending builds: %w", err)
+	}
+
+	running, err := q.buildQueue.RunningBuilds()
+	if err != nil {
+		return fmt.Errorf("get running builds: %w", err)
+	}
+
+	workers, err := q.workerFactory.Workers()
+	if err != nil {
+		return fmt.Errorf("get workers: %w", err)
+	}
+
+	capacity := 0
+	for _, worker := range workers {
+		if worker.State() == db.WorkerStateRunning {
+			capacity += q.config.BuildsPerWorker
+		}
+	}
+
+	free := capacity
+	for _, count := range running {
+		free -= count
+	}
+
+	positions := map[int]int{}
+	for i, buildID := range q.order(pending, running) {
+		positions[buildID] = i + 1
+	}
+
+	err = q.buildQueue.SavePositions(positions)
+	if err != nil {
+		return fmt.Errorf("save queue positions: %w", err)
+	}
+
+	logger.Debug("refreshed", lager.Data{
+		"capacity": capacity,
+		"free":     free,
+		"queued":   len(positions),
+	})
+
+	q.lock.Lock()
+	q.refreshed = true
+	q.positions = positions
+	q.admitted = map[int]bool{}
+	q.free = free
+	q.lock.Unlock()
+
+	return nil
+}
+
+// clearPositions forgets the positions saved while the queue was enabled, so
+// that builds don't keep showing them.
+func (q *fairShareQueue) clearPositions() error {
+	if q.cleared {
+		return nil
+	}
+
+	err := q.buildQueue.SavePositions(map[int]int{})
+	if err != nil {
+		return fmt.Errorf("clear queue positions: %w", err)
+	}
+
+	q.cleared = true
+
+	return nil
+}
+
+// order interleaves the pending builds of each team, oldest first within a
+// team. Each next build goes to the team which would be furthest below its
+// share of the running builds if the build started, with ties going to the
+// team with the oldest build.
+func (q *fairShareQueue) order(pending []db.QueuedBuild, running map[string]int) []int {
+	var teams []string
+	teamBuilds := map[string][]int{}
+	for _, build := range pending {
+		if _, found := teamBuilds[build.TeamName]; !found {
+			teams = append(teams, build.TeamName)
+		}
+
+		teamBuilds[build.TeamName] = append(teamBuilds[build.TeamName], build.ID)
+	}
+
+	usage := map[string]int{}
+	for team, count := range running {
+		usage[team] = count
+	}
+
+	ordered := make([]int, 0, len(pending))
+	for len(ordered) < len(pending) {
+		var next string
+		for _, team := range teams {
+			if len(teamBuilds[team]) == 0 {
+				continue
+			}
+
+			if next == "" || q.before(team, next, usage, teamBuilds) {
+				next = team
+			}
+		}
+
+		ordered = append(ordered, teamBuilds[next][0])
+		teamBuilds[next] = teamBuilds[next][1:]
+		usage[next]++
+	}
+
+	return ordered
+}
+
+func (q *fairShareQueue) before(team string, other string, usage map[string]int, teamBuilds map[string][]int) bool {
+	// compare (usage+1)/share between the teams without dividing
+	teamLoad := (usage[team] + 1) * q.config.share(other)
+	otherLoad := (usage[other] + 1) * q.config.share(team)
+	if teamLoad != otherLoad {
+		return teamLoad < otherLoad
+	}
+
+	return teamBuilds[team][0] < teamBuilds[other][0]
+}
+
+func (q *fairShareQueue) Admit(buildID int) (bool, int) {
+	if q.config.BuildsPerWorker == 0 {
+		return true, 0
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if !q.refreshed {
+		return true, 0
+	}
+
+	position, queued := q.positions[buildID]
+	if !queued {
+		// the build became pending after the queue was refreshed, so it may
+		// only use capacity that no queued build is waiting for
+		if len(q.positions)-len(q.admitted) >= q.free {
+			return false, 0
+		}
+
+		q.free--
+
+		return true, 0
+	}
+
+	if q.admitted[buildID] {
+		return true, position
+	}
+
+	// the queued builds admitted since the refresh were ahead of this one or
+	// have already taken capacity it was counting on
+	if position-len(q.admitted) > q.free {
+		return false, position
+	}
+
+	q.admitted[buildID] = true
+	q.free--
+
+	return true, position
+}
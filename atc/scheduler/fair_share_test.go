@@ -0,0 +1,184 @@
+package scheduler_test
+
+import (
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/scheduler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FairShareQueue", func() {
+	var (
+		fakeBuildQueue    *dbfakes.FakeBuildQueue
+		fakeWorkerFactory *dbfakes.FakeWorkerFactory
+		config            FairShareConfig
+
+		queue      BuildStartQueue
+		refreshErr error
+	)
+
+	runningWorker := func() db.Worker {
+		worker := new(dbfakes.FakeWorker)
+		worker.StateReturns(db.WorkerStateRunning)
+		return worker
+	}
+
+	BeforeEach(func() {
+		fakeBuildQueue = new(dbfakes.FakeBuildQueue)
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+
+		stalledWorker := new(dbfakes.FakeWorker)
+		stalledWorker.StateReturns(db.WorkerStateStalled)
+		fakeWorkerFactory.WorkersReturns([]db.Worker{runningWorker(), runningWorker(), stalledWorker}, nil)
+
+		fakeBuildQueue.RunningBuildsReturns(map[string]int{"team-a": 1}, nil)
+		fakeBuildQueue.PendingBuildsReturns([]db.QueuedBuild{
+			{ID: 1, TeamName: "team-a"},
+			{ID: 2, TeamName: "team-a"},
+			{ID: 3, TeamName: "team-a"},
+			{ID: 4, TeamName: "team-b"},
+			{ID: 5, TeamName: "team-b"},
+		}, nil)
+
+		config = FairShareConfig{
+			BuildsPerWorker: 2,
+			DefaultShare:    1,
+		}
+	})
+
+	JustBeforeEach(func() {
+		queue = NewFairShareQueue(fakeBuildQueue, fakeWorkerFactory, config)
+		refreshErr = queue.Refresh(lagertest.NewTestLogger("test"))
+	})
+
+	It("saves the positions of the pending builds, alternating between teams", func() {
+		Expect(refreshErr).ToNot(HaveOccurred())
+		Expect(fakeBuildQueue.SavePositionsCallCount()).To(Equal(1))
+		Expect(fakeBuildQueue.SavePositionsArgsForCall(0)).To(Equal(map[int]int{
+			4: 1,
+			1: 2,
+			5: 3,
+			2: 4,
+			3: 5,
+		}))
+	})
+
+	It("admits the builds at the front of the queue while running workers have capacity", func() {
+		admitted, position := queue.Admit(4)
+		Expect(admitted).To(BeTrue())
+		Expect(position).To(Equal(1))
+
+		admitted, position = queue.Admit(5)
+		Expect(admitted).To(BeTrue())
+		Expect(position).To(Equal(3))
+
+		admitted, position = queue.Admit(2)
+		Expect(admitted).To(BeFalse())
+		Expect(position).To(Equal(4))
+	})
+
+	It("does not admit builds that were not queued while others are waiting", func() {
+		admitted, position := queue.Admit(6)
+		Expect(admitted).To(BeFalse())
+		Expect(position).To(BeZero())
+	})
+
+	It("counts every admission against the capacity until the next refresh", func() {
+		admitted, _ := queue.Admit(4)
+		Expect(admitted).To(BeTrue())
+
+		admitted, _ = queue.Admit(4)
+		Expect(admitted).To(BeTrue())
+
+		admitted, _ = queue.Admit(1)
+		Expect(admitted).To(BeTrue())
+
+		admitted, _ = queue.Admit(5)
+		Expect(admitted).To(BeTrue())
+
+		admitted, _ = queue.Admit(2)
+		Expect(admitted).To(BeFalse())
+	})
+
+	Context("when there is capacity left beyond the queue", func() {
+		BeforeEach(func() {
+			fakeBuildQueue.PendingBuildsReturns([]db.QueuedBuild{
+				{ID: 1, TeamName: "team-a"},
+			}, nil)
+		})
+
+		It("admits builds that were not queued only until the capacity is used up", func() {
+			admitted, _ := queue.Admit(6)
+			Expect(admitted).To(BeTrue())
+
+			admitted, _ = queue.Admit(7)
+			Expect(admitted).To(BeTrue())
+
+			admitted, _ = queue.Admit(8)
+			Expect(admitted).To(BeFalse())
+
+			admitted, position := queue.Admit(1)
+			Expect(admitted).To(BeTrue())
+			Expect(position).To(Equal(1))
+		})
+	})
+
+	Context("when a team has a bigger share", func() {
+		BeforeEach(func() {
+			config.TeamShares = map[string]int{"team-a": 3}
+		})
+
+		It("gives the team more of the queue", func() {
+			Expect(fakeBuildQueue.SavePositionsArgsForCall(0)).To(Equal(map[int]int{
+				1: 1,
+				2: 2,
+				4: 3,
+				3: 4,
+				5: 5,
+			}))
+		})
+	})
+
+	Context("when builds per worker is not configured", func() {
+		BeforeEach(func() {
+			config.BuildsPerWorker = 0
+		})
+
+		It("admits every build", func() {
+			admitted, position := queue.Admit(5)
+			Expect(admitted).To(BeTrue())
+			Expect(position).To(BeZero())
+		})
+
+		It("clears the saved positions once", func() {
+			Expect(fakeBuildQueue.PendingBuildsCallCount()).To(BeZero())
+			Expect(fakeBuildQueue.SavePositionsCallCount()).To(Equal(1))
+			Expect(fakeBuildQueue.SavePositionsArgsForCall(0)).To(BeEmpty())
+
+			Expect(queue.Refresh(lagertest.NewTestLogger("test"))).To(Succeed())
+			Expect(fakeBuildQueue.SavePositionsCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when fetching the pending builds fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeBuildQueue.PendingBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(refreshErr).To(Equal(fmt.Errorf("get pending builds: %w", disaster)))
+		})
+
+		It("admits builds until the queue has been refreshed", func() {
+			admitted, _ := queue.Admit(5)
+			Expect(admitted).To(BeTrue())
+		})
+	})
+})
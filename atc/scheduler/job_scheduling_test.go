@@ -261,7 +261,10 @@ func (example Example) Run() {
 	fakeAlgorithm := new(schedulerfakes.FakeAlgorithm)
 	fakeAlgorithm.ComputeReturns(nil, true, false, nil)
 
-	buildStarter := scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm)
+	fakeStartQueue := new(schedulerfakes.FakeBuildStartQueue)
+	fakeStartQueue.AdmitReturns(true, 0)
+
+	buildStarter := scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm, new(dbfakes.FakeQuotaChecker), fakeStartQueue)
 
 	fakeJob := new(dbfakes.FakeJob)
 	fakeJob.ConfigReturns(atc.JobConfig{}, nil)
@@ -30,16 +30,18 @@ type Runner struct {
 	logger     lager.Logger
 	jobFactory db.JobFactory
 	scheduler  BuildScheduler
+	startQueue BuildStartQueue
 
 	guardJobScheduling chan struct{}
 	running            *sync.Map
 }
 
-func NewRunner(logger lager.Logger, jobFactory db.JobFactory, scheduler BuildScheduler, maxJobs uint64) *Runner {
+func NewRunner(logger lager.Logger, jobFactory db.JobFactory, scheduler BuildScheduler, startQueue BuildStartQueue, maxJobs uint64) *Runner {
 	return &Runner{
 		logger:     logger,
 		jobFactory: jobFactory,
 		scheduler:  scheduler,
+		startQueue: startQueue,
 
 		guardJobScheduling: make(chan struct{}, maxJobs),
 		running:            &sync.Map{},
@@ -59,6 +61,13 @@ func (s *Runner) Run(ctx context.Context) error {
 		return fmt.Errorf("find jobs to schedule: %w", err)
 	}
 
+	// builds keep being admitted in the order of the last refresh if this one
+	// fails, so it shouldn't hold up scheduling
+	err = s.startQueue.Refresh(sLog)
+	if err != nil {
+		sLog.Error("failed-to-refresh-start-queue", err)
+	}
+
 	for _, j := range jobs {
 		if _, exists := s.running.LoadOrStore(j.ID(), true); exists {
 			// already scheduling this job
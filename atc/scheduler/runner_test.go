@@ -25,6 +25,7 @@ var _ = Describe("Runner", func() {
 	var (
 		fakePipeline  *dbfakes.FakePipeline
 		fakeScheduler *schedulerfakes.FakeBuildScheduler
+		fakeQueue     *schedulerfakes.FakeBuildStartQueue
 		maxInFlight   uint64
 
 		lock *lockfakes.FakeLock
@@ -44,6 +45,7 @@ var _ = Describe("Runner", func() {
 
 	BeforeEach(func() {
 		fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
+		fakeQueue = new(schedulerfakes.FakeBuildStartQueue)
 		fakeJobFactory = new(dbfakes.FakeJobFactory)
 		maxInFlight = 1
 
@@ -55,6 +57,7 @@ var _ = Describe("Runner", func() {
 			lagertest.NewTestLogger("test"),
 			fakeJobFactory,
 			fakeScheduler,
+			fakeQueue,
 			maxInFlight,
 		)
 
@@ -65,6 +68,20 @@ var _ = Describe("Runner", func() {
 		Expect(fakeJobFactory.JobsToScheduleCallCount()).To(Equal(1))
 	})
 
+	It("refreshes the build start queue", func() {
+		Expect(fakeQueue.RefreshCallCount()).To(Equal(1))
+	})
+
+	Context("when refreshing the build start queue fails", func() {
+		BeforeEach(func() {
+			fakeQueue.RefreshReturns(errors.New("disaster"))
+		})
+
+		It("keeps scheduling", func() {
+			Expect(schedulerErr).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("when there is one pipeline and two jobs that need to be scheduled", func() {
 		BeforeEach(func() {
 			fakePipeline = new(dbfakes.FakePipeline)
@@ -548,5 +565,9 @@ var _ = Describe("Runner", func() {
 		It("returns an error", func() {
 			Expect(schedulerErr).To(Equal(fmt.Errorf("find jobs to schedule: %w", errors.New("disaster"))))
 		})
+
+		It("does not refresh the build start queue", func() {
+			Expect(fakeQueue.RefreshCallCount()).To(BeZero())
+		})
 	})
 })
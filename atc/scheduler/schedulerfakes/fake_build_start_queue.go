@@ -0,0 +1,189 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package schedulerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/scheduler"
+)
+
+type FakeBuildStartQueue struct {
+	AdmitStub        func(int) (bool, int)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		arg1 int
+	}
+	admitReturns struct {
+		result1 bool
+		result2 int
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 bool
+		result2 int
+	}
+	RefreshStub        func(lager.Logger) error
+	refreshMutex       sync.RWMutex
+	refreshArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	refreshReturns struct {
+		result1 error
+	}
+	refreshReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildStartQueue) Admit(arg1 int) (bool, int) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Admit", []interface{}{arg1})
+	fake.admitMutex.Unlock()
+	if fake.AdmitStub != nil {
+		return fake.AdmitStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.admitReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildStartQueue) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeBuildStartQueue) AdmitCalls(stub func(int) (bool, int)) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = stub
+}
+
+func (fake *FakeBuildStartQueue) AdmitArgsForCall(i int) int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	argsForCall := fake.admitArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildStartQueue) AdmitReturns(result1 bool, result2 int) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 bool
+		result2 int
+	}{result1, result2}
+}
+
+func (fake *FakeBuildStartQueue) AdmitReturnsOnCall(i int, result1 bool, result2 int) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 int
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 bool
+		result2 int
+	}{result1, result2}
+}
+
+func (fake *FakeBuildStartQueue) Refresh(arg1 lager.Logger) error {
+	fake.refreshMutex.Lock()
+	ret, specificReturn := fake.refreshReturnsOnCall[len(fake.refreshArgsForCall)]
+	fake.refreshArgsForCall = append(fake.refreshArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Refresh", []interface{}{arg1})
+	fake.refreshMutex.Unlock()
+	if fake.RefreshStub != nil {
+		return fake.RefreshStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.refreshReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildStartQueue) RefreshCallCount() int {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	return len(fake.refreshArgsForCall)
+}
+
+func (fake *FakeBuildStartQueue) RefreshCalls(stub func(lager.Logger) error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = stub
+}
+
+func (fake *FakeBuildStartQueue) RefreshArgsForCall(i int) lager.Logger {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	argsForCall := fake.refreshArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildStartQueue) RefreshReturns(result1 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	fake.refreshReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildStartQueue) RefreshReturnsOnCall(i int, result1 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	if fake.refreshReturnsOnCall == nil {
+		fake.refreshReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildStartQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildStartQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.BuildStartQueue = new(FakeBuildStartQueue)
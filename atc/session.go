@@ -0,0 +1,19 @@
+package atc
+
+// Session is an access token issued to a user when they logged in. The token
+// itself is never returned; the session is identified by its ID instead.
+type Session struct {
+	ID        int    `json:"id"`
+	Username  string `json:"username"`
+	Connector string `json:"connector,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+
+	// Current is set on the session which made the request.
+	Current bool `json:"current,omitempty"`
+}
+
+// RevokedSessions is returned after revoking a user's sessions.
+type RevokedSessions struct {
+	Revoked int `json:"revoked"`
+}
@@ -22,6 +22,10 @@ type StepRecursor struct {
 
 	// OnLoadVar will be invoked for any *LoadVarStep present in the StepConfig.
 	OnLoadVar func(*LoadVarStep) error
+
+	// OnManualApproval will be invoked for any *ManualApprovalStep present in
+	// the StepConfig.
+	OnManualApproval func(*ManualApprovalStep) error
 }
 
 // VisitTask calls the OnTask hook if configured.
@@ -69,6 +73,15 @@ func (recursor StepRecursor) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+// VisitManualApproval calls the OnManualApproval hook if configured.
+func (recursor StepRecursor) VisitManualApproval(step *ManualApprovalStep) error {
+	if recursor.OnManualApproval != nil {
+		return recursor.OnManualApproval(step)
+	}
+
+	return nil
+}
+
 // VisitTry recurses through to the wrapped step.
 func (recursor StepRecursor) VisitTry(step *TryStep) error {
 	return step.Step.Config.Visit(recursor)
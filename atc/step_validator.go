@@ -196,6 +196,18 @@ func (validator *StepValidator) VisitSetPipeline(step *SetPipelineStep) error {
 	return nil
 }
 
+func (validator *StepValidator) VisitManualApproval(step *ManualApprovalStep) error {
+	validator.pushContext(".manual_approval(%s)", step.Name)
+	defer validator.popContext()
+
+	warning := ValidateIdentifier(step.Name, validator.context...)
+	if warning != nil {
+		validator.recordWarning(*warning)
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) VisitLoadVar(step *LoadVarStep) error {
 	validator.pushContext(".load_var(%s)", step.Name)
 	defer validator.popContext()
@@ -193,6 +193,7 @@ type StepVisitor interface {
 	VisitPut(*PutStep) error
 	VisitSetPipeline(*SetPipelineStep) error
 	VisitLoadVar(*LoadVarStep) error
+	VisitManualApproval(*ManualApprovalStep) error
 	VisitTry(*TryStep) error
 	VisitDo(*DoStep) error
 	VisitInParallel(*InParallelStep) error
@@ -274,6 +275,10 @@ var StepPrecedence = []StepDetector{
 		Key: "load_var",
 		New: func() StepConfig { return &LoadVarStep{} },
 	},
+	{
+		Key: "manual_approval",
+		New: func() StepConfig { return &ManualApprovalStep{} },
+	},
 	{
 		Key: "try",
 		New: func() StepConfig { return &TryStep{} },
@@ -376,6 +381,17 @@ func (step *LoadVarStep) Visit(v StepVisitor) error {
 	return v.VisitLoadVar(step)
 }
 
+// ManualApprovalStep waits until a user with Role, other than the one who
+// triggered the build, approves it.
+type ManualApprovalStep struct {
+	Name string `json:"manual_approval"`
+	Role string `json:"role,omitempty"`
+}
+
+func (step *ManualApprovalStep) Visit(v StepVisitor) error {
+	return v.VisitManualApproval(step)
+}
+
 type TryStep struct {
 	Step Step `json:"try"`
 }
@@ -157,6 +157,19 @@ var factoryTests = []StepTest{
 			Reveal: true,
 		},
 	},
+	{
+		Title: "manual_approval step",
+
+		ConfigYAML: `
+			manual_approval: deploy
+			role: owner
+		`,
+
+		StepConfig: &atc.ManualApprovalStep{
+			Name: "deploy",
+			Role: "owner",
+		},
+	},
 	{
 		Title: "try step",
 
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 )
@@ -36,6 +38,16 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Destinations that the task is allowed to connect to. When present, any
+	// other outbound connection from the task's container is rejected.
+	Egress []TaskEgressRule `json:"egress,omitempty"`
+
+	// Containers to run alongside the task while it executes, e.g. databases
+	// needed by integration tests. With the containerd runtime, services share
+	// the task's network, so they can be reached on localhost; with Guardian,
+	// they get a network of their own.
+	Services []TaskServiceConfig `json:"services,omitempty"`
 }
 
 type ImageResource struct {
@@ -82,6 +94,8 @@ func (config TaskConfig) Validate() error {
 
 	errors = append(errors, config.validateInputContainsNames()...)
 	errors = append(errors, config.validateOutputContainsNames()...)
+	errors = append(errors, config.validateEgress()...)
+	errors = append(errors, config.validateServices()...)
 
 	if len(errors) > 0 {
 		return TaskValidationError{
@@ -104,6 +118,68 @@ func (config TaskConfig) validateOutputContainsNames() []string {
 	return messages
 }
 
+func (config TaskConfig) validateEgress() []string {
+	var messages []string
+
+	for i, rule := range config.Egress {
+		if rule.Network == "" {
+			messages = append(messages, fmt.Sprintf("  egress rule in position %d is missing a network", i))
+		} else if ipNet, err := rule.IPNet(); err != nil {
+			messages = append(messages, fmt.Sprintf("  egress rule in position %d has an invalid network '%s'", i, rule.Network))
+		} else if ipNet.IP.To4() == nil {
+			// containers only have an IPv4 address, so only IPv4 rules are
+			// enforced
+			messages = append(messages, fmt.Sprintf("  egress rule in position %d has an IPv6 network '%s', only IPv4 networks are supported", i, rule.Network))
+		}
+
+		switch rule.Protocol {
+		case "", EgressProtocolAll, EgressProtocolICMP:
+			if len(rule.Ports) > 0 {
+				messages = append(messages, fmt.Sprintf("  egress rule in position %d specifies ports, which requires protocol 'tcp' or 'udp'", i))
+			}
+		case EgressProtocolTCP, EgressProtocolUDP:
+		default:
+			messages = append(messages, fmt.Sprintf("  egress rule in position %d has an unknown protocol '%s'", i, rule.Protocol))
+		}
+	}
+
+	return messages
+}
+
+func (config TaskConfig) validateServices() []string {
+	var messages []string
+
+	names := map[string]bool{}
+	for i, service := range config.Services {
+		if service.Name == "" {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing a name", i))
+		} else if names[service.Name] {
+			messages = append(messages, fmt.Sprintf("  service '%s' is defined more than once", service.Name))
+		}
+		names[service.Name] = true
+
+		if service.ImageResource == nil {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing an image_resource", i))
+		}
+
+		if service.Run.Path == "" {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing path to executable to run", i))
+		}
+
+		if service.Ready != nil && service.Ready.Path == "" {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing path to executable checking it's ready", i))
+		}
+
+		if service.ReadyTimeout != "" {
+			if _, err := time.ParseDuration(service.ReadyTimeout); err != nil {
+				messages = append(messages, fmt.Sprintf("  service in position %d has an invalid ready_timeout '%s'", i, service.ReadyTimeout))
+			}
+		}
+	}
+
+	return messages
+}
+
 func (config TaskConfig) validateInputContainsNames() []string {
 	messages := []string{}
 
@@ -140,6 +216,71 @@ type TaskCacheConfig struct {
 	Path string `json:"path,omitempty"`
 }
 
+type TaskServiceConfig struct {
+	// The name of the service, identifying its container and logs.
+	Name string `json:"name"`
+
+	// The image to run the service in.
+	ImageResource *ImageResource `json:"image_resource,omitempty"`
+
+	// Parameters to pass to the service via environment variables.
+	Params TaskEnv `json:"params,omitempty"`
+
+	// The executable starting the service.
+	Run TaskRunConfig `json:"run,omitempty"`
+
+	// An executable run in the service's container until it succeeds, before
+	// the task is started. Without it, the task may start before the service
+	// accepts connections.
+	Ready *TaskRunConfig `json:"ready,omitempty"`
+
+	// How long to wait for the readiness check to succeed, as a duration
+	// (e.g. "1m"). Defaults to 5 minutes.
+	ReadyTimeout string `json:"ready_timeout,omitempty"`
+}
+
+const (
+	EgressProtocolAll  = "all"
+	EgressProtocolTCP  = "tcp"
+	EgressProtocolUDP  = "udp"
+	EgressProtocolICMP = "icmp"
+)
+
+type TaskEgressRule struct {
+	// The network (in CIDR notation) or single IP address to allow.
+	Network string `json:"network"`
+
+	// The protocol to allow (tcp, udp, icmp or all). Defaults to all.
+	Protocol string `json:"protocol,omitempty"`
+
+	// The destination ports to allow. Only valid for tcp and udp.
+	Ports []uint16 `json:"ports,omitempty"`
+}
+
+// IPNet parses the rule's network, treating a bare IP address as a network
+// containing only that address.
+func (rule TaskEgressRule) IPNet() (*net.IPNet, error) {
+	if !strings.Contains(rule.Network, "/") {
+		ip := net.ParseIP(rule.Network)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", rule.Network)
+		}
+
+		if ip.To4() != nil {
+			return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+		}
+
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(rule.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipNet, nil
+}
+
 type TaskEnv map[string]string
 
 func (te *TaskEnv) UnmarshalJSON(p []byte) error {
@@ -386,6 +386,132 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has egress rules", func() {
+			BeforeEach(func() {
+				validConfig.Egress = append(
+					validConfig.Egress,
+					TaskEgressRule{Network: "10.0.0.0/8"},
+					TaskEgressRule{Network: "1.2.3.4", Protocol: "tcp", Ports: []uint16{443}},
+				)
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when a network is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Egress = append(invalidConfig.Egress, TaskEgressRule{Network: "10.0.0.0/8"}, TaskEgressRule{})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("egress rule in position 1 is missing a network")))
+				})
+			})
+
+			Context("when a network is invalid", func() {
+				BeforeEach(func() {
+					invalidConfig.Egress = append(invalidConfig.Egress, TaskEgressRule{Network: "10.0.0.0/99"})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("egress rule in position 0 has an invalid network '10.0.0.0/99'")))
+				})
+			})
+
+			Context("when a network is IPv6", func() {
+				BeforeEach(func() {
+					invalidConfig.Egress = append(invalidConfig.Egress, TaskEgressRule{Network: "2001:db8::/32"})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("egress rule in position 0 has an IPv6 network '2001:db8::/32', only IPv4 networks are supported")))
+				})
+			})
+
+			Context("when a protocol is unknown", func() {
+				BeforeEach(func() {
+					invalidConfig.Egress = append(invalidConfig.Egress, TaskEgressRule{Network: "10.0.0.0/8", Protocol: "sctp"})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("egress rule in position 0 has an unknown protocol 'sctp'")))
+				})
+			})
+
+			Context("when ports are given without a protocol that has them", func() {
+				BeforeEach(func() {
+					invalidConfig.Egress = append(invalidConfig.Egress, TaskEgressRule{Network: "10.0.0.0/8", Ports: []uint16{22}})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("egress rule in position 0 specifies ports, which requires protocol 'tcp' or 'udp'")))
+				})
+			})
+		})
+
+		Context("when the task has services", func() {
+			BeforeEach(func() {
+				validConfig.Services = append(validConfig.Services, TaskServiceConfig{
+					Name:          "postgres",
+					ImageResource: &ImageResource{Type: "registry-image", Source: Source{"repository": "postgres"}},
+					Run:           TaskRunConfig{Path: "docker-entrypoint.sh", Args: []string{"postgres"}},
+				})
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when a service is missing a name, image and path", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(invalidConfig.Services, TaskServiceConfig{})
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 is missing a name")))
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 is missing an image_resource")))
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 is missing path to executable to run")))
+				})
+			})
+
+			Context("when the readiness check of a service is missing a path", func() {
+				BeforeEach(func() {
+					service := validConfig.Services[0]
+					service.Ready = &TaskRunConfig{}
+					invalidConfig.Services = append(invalidConfig.Services, service)
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service in position 0 is missing path to executable checking it's ready")))
+				})
+			})
+
+			Context("when the readiness timeout of a service is invalid", func() {
+				BeforeEach(func() {
+					service := validConfig.Services[0]
+					service.ReadyTimeout = "soon"
+					invalidConfig.Services = append(invalidConfig.Services, service)
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service in position 0 has an invalid ready_timeout 'soon'")))
+				})
+			})
+
+			Context("when a service is defined more than once", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(validConfig.Services, validConfig.Services...)
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'postgres' is defined more than once")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
@@ -2,6 +2,10 @@ package atc
 
 import (
 	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
 )
 
 var (
@@ -13,14 +17,78 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// PolicyProfile is passed to policy agents, which can use it to apply
+	// different policies to different teams. It's either PolicyProfileStrict
+	// or PolicyProfileDefault. Leaving it empty when setting a team keeps the
+	// current profile.
+	PolicyProfile string `json:"policy_profile,omitempty"`
+
+	// Quotas limit how much of the cluster the team can use. Leaving them out
+	// when setting a team keeps the current quotas.
+	Quotas *TeamQuotas `json:"quotas,omitempty"`
+}
+
+// TeamQuotas cap the pipelines, running builds, containers and volumes of a
+// team. A quota of 0 is unlimited.
+type TeamQuotas struct {
+	MaxPipelines     int `json:"max_pipelines,omitempty"`
+	MaxRunningBuilds int `json:"max_running_builds,omitempty"`
+	MaxContainers    int `json:"max_containers,omitempty"`
+	MaxVolumes       int `json:"max_volumes,omitempty"`
+}
+
+var ErrNegativeTeamQuota = errors.New("team quotas must not be negative")
+
+const (
+	PolicyProfileStrict  = "strict"
+	PolicyProfileDefault = "default"
+)
+
+var ErrInvalidPolicyProfile = fmt.Errorf("policy profile must be '%s' or '%s'", PolicyProfileStrict, PolicyProfileDefault)
+
+// ValidatePolicyProfile accepts the known profiles, or an empty profile which
+// keeps the current one.
+func ValidatePolicyProfile(profile string) error {
+	switch profile {
+	case "", PolicyProfileStrict, PolicyProfileDefault:
+		return nil
+	default:
+		return ErrInvalidPolicyProfile
+	}
+}
+
+func (quotas TeamQuotas) Validate() error {
+	if quotas.MaxPipelines < 0 || quotas.MaxRunningBuilds < 0 || quotas.MaxContainers < 0 || quotas.MaxVolumes < 0 {
+		return ErrNegativeTeamQuota
+	}
+
+	return nil
 }
 
 func (team Team) Validate() error {
+	if err := ValidatePolicyProfile(team.PolicyProfile); err != nil {
+		return err
+	}
+
+	if team.Quotas != nil {
+		if err := team.Quotas.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return team.Auth.Validate()
 }
 
+// TeamAuth binds roles to the users and groups who have them. Each binding is
+// keyed by its role, e.g. "member", and applies to the whole team unless it
+// lists "pipelines": globs matching the names of the only pipelines the role
+// applies to. A role bound more than once, e.g. to the whole team and to a
+// few pipelines, is keyed "<role>/<n>" after its first binding.
 type TeamAuth map[string]map[string][]string
 
+const TeamAuthPipelines = "pipelines"
+
 func (auth TeamAuth) Validate() error {
 	if len(auth) == 0 {
 		return ErrAuthConfigEmpty
@@ -33,7 +101,54 @@ func (auth TeamAuth) Validate() error {
 		if len(users) == 0 && len(groups) == 0 {
 			return ErrAuthConfigInvalid
 		}
+
+		for _, pattern := range config[TeamAuthPipelines] {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pipeline pattern '%s': %w", pattern, err)
+			}
+		}
 	}
 
 	return nil
 }
+
+// BindingRole is the role of the binding with the given key.
+func BindingRole(key string) string {
+	return strings.SplitN(key, "/", 2)[0]
+}
+
+// PipelineBindings returns the keys of the bindings which grant their role on
+// only some pipelines of the team, including the given one.
+func (auth TeamAuth) PipelineBindings(pipelineName string) []string {
+	var keys []string
+	for key, config := range auth {
+		if _, scoped := config[TeamAuthPipelines]; !scoped {
+			continue
+		}
+
+		if BindingAppliesToPipeline(config, pipelineName) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// BindingAppliesToPipeline returns whether the binding grants its role on the
+// given pipeline.
+func BindingAppliesToPipeline(config map[string][]string, pipelineName string) bool {
+	patterns, scoped := config[TeamAuthPipelines]
+	if !scoped {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, pipelineName); matched {
+			return true
+		}
+	}
+
+	return false
+}
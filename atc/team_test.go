@@ -0,0 +1,115 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamAuth", func() {
+	Describe("Validate", func() {
+		var auth atc.TeamAuth
+
+		BeforeEach(func() {
+			auth = atc.TeamAuth{
+				"owner": {"users": {"local:admin"}},
+				"member/1": {
+					"groups":    {"github:org:deployers"},
+					"pipelines": {"prod-*", "release"},
+				},
+			}
+		})
+
+		It("accepts pipeline-scoped bindings", func() {
+			Expect(auth.Validate()).To(Succeed())
+		})
+
+		Context("when a pipeline pattern is malformed", func() {
+			BeforeEach(func() {
+				auth["member/1"]["pipelines"] = []string{"prod-["}
+			})
+
+			It("returns an error", func() {
+				Expect(auth.Validate()).To(MatchError(ContainSubstring("invalid pipeline pattern 'prod-['")))
+			})
+		})
+
+		Context("when a binding has no users or groups", func() {
+			BeforeEach(func() {
+				auth["viewer"] = map[string][]string{"pipelines": {"prod-*"}}
+			})
+
+			It("returns an error", func() {
+				Expect(auth.Validate()).To(Equal(atc.ErrAuthConfigInvalid))
+			})
+		})
+	})
+
+	Describe("BindingRole", func() {
+		It("strips the suffix of repeated bindings", func() {
+			Expect(atc.BindingRole("member")).To(Equal("member"))
+			Expect(atc.BindingRole("member/2")).To(Equal("member"))
+		})
+	})
+
+	Describe("BindingAppliesToPipeline", func() {
+		It("applies bindings without pipelines to every pipeline", func() {
+			Expect(atc.BindingAppliesToPipeline(map[string][]string{"users": {"local:a"}}, "anything")).To(BeTrue())
+		})
+
+		It("applies scoped bindings to matching pipelines only", func() {
+			config := map[string][]string{"users": {"local:a"}, "pipelines": {"prod-*", "release"}}
+			Expect(atc.BindingAppliesToPipeline(config, "prod-eu")).To(BeTrue())
+			Expect(atc.BindingAppliesToPipeline(config, "release")).To(BeTrue())
+			Expect(atc.BindingAppliesToPipeline(config, "staging")).To(BeFalse())
+		})
+
+		It("applies bindings with an empty list of pipelines to none", func() {
+			config := map[string][]string{"users": {"local:a"}, "pipelines": {}}
+			Expect(atc.BindingAppliesToPipeline(config, "prod-eu")).To(BeFalse())
+		})
+	})
+
+	Describe("PipelineBindings", func() {
+		It("returns the scoped bindings which apply to the pipeline", func() {
+			auth := atc.TeamAuth{
+				"owner":    {"users": {"local:admin"}},
+				"member":   {"users": {"local:dev"}, "pipelines": {"dev-*"}},
+				"viewer":   {"users": {"local:ops"}, "pipelines": {"prod-*"}},
+				"member/1": {"users": {"local:qa"}, "pipelines": {"dev-*", "qa"}},
+			}
+
+			Expect(auth.PipelineBindings("dev-api")).To(Equal([]string{"member", "member/1"}))
+			Expect(auth.PipelineBindings("release")).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("ValidatePolicyProfile", func() {
+	It("accepts the known profiles", func() {
+		Expect(atc.ValidatePolicyProfile(atc.PolicyProfileStrict)).To(Succeed())
+		Expect(atc.ValidatePolicyProfile(atc.PolicyProfileDefault)).To(Succeed())
+	})
+
+	It("accepts an empty profile, which keeps the current one", func() {
+		Expect(atc.ValidatePolicyProfile("")).To(Succeed())
+	})
+
+	It("rejects any other profile", func() {
+		Expect(atc.ValidatePolicyProfile("lenient")).To(Equal(atc.ErrInvalidPolicyProfile))
+	})
+})
+
+var _ = Describe("TeamQuotas", func() {
+	Describe("Validate", func() {
+		It("accepts unlimited quotas", func() {
+			Expect(atc.TeamQuotas{}.Validate()).To(Succeed())
+		})
+
+		It("rejects negative quotas", func() {
+			quotas := atc.TeamQuotas{MaxPipelines: 2, MaxVolumes: -1}
+			Expect(quotas.Validate()).To(Equal(atc.ErrNegativeTeamQuota))
+		})
+	})
+})
@@ -17,4 +17,12 @@ type UserInfo struct {
 	IsAdmin  bool                `json:"is_admin"`
 	IsSystem bool                `json:"is_system"`
 	Teams    map[string][]string `json:"teams"`
+
+	// PipelineRoles are the roles the user has on only some of the pipelines
+	// of a team, by team and then by pipeline name pattern.
+	PipelineRoles map[string]map[string][]string `json:"pipeline_roles,omitempty"`
+
+	// Permissions are the actions the user's roles allow them to perform, by
+	// team. They're only included when requested.
+	Permissions map[string][]string `json:"permissions,omitempty"`
 }
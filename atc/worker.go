@@ -30,6 +30,9 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	DrainDeadline  int64 `json:"drain_deadline,omitempty"`
+	DrainingBuilds int   `json:"draining_builds,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
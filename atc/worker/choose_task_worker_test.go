@@ -85,7 +85,8 @@ var _ = Describe("RunTaskStep", func() {
 				fakeProvider,
 				fakeCompression,
 				workerInterval,
-				workerStatusInterval)
+				workerStatusInterval,
+				time.Second)
 		})
 
 		Context("worker is available", func() {
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"path"
@@ -24,6 +25,11 @@ import (
 )
 
 const taskProcessID = "task"
+const serviceProcessID = "service"
+
+// DefaultServiceReadinessTimeout is how long services have to become ready
+// unless they configure it.
+const DefaultServiceReadinessTimeout = 5 * time.Minute
 const taskExitStatusPropertyName = "concourse:exit-status"
 
 //go:generate counterfeiter . Client
@@ -31,6 +37,7 @@ const taskExitStatusPropertyName = "concourse:exit-status"
 type Client interface {
 	FindContainer(logger lager.Logger, teamID int, handle string) (Container, bool, error)
 	FindVolume(logger lager.Logger, teamID int, handle string) (Volume, bool, error)
+	RunsOnSharedWorkers(logger lager.Logger, workerSpec WorkerSpec) (bool, error)
 	CreateVolume(logger lager.Logger, vSpec VolumeSpec, wSpec WorkerSpec, volumeType db.VolumeType) (Volume, error)
 	StreamFileFromArtifact(
 		ctx context.Context,
@@ -103,6 +110,7 @@ func NewClient(pool Pool,
 	compression compression.Compression,
 	workerPollingInterval time.Duration,
 	workerStatusPublishInterval time.Duration,
+	containerMetricsInterval time.Duration,
 ) *client {
 	return &client{
 		pool:                        pool,
@@ -110,6 +118,7 @@ func NewClient(pool Pool,
 		compression:                 compression,
 		workerPollingInterval:       workerPollingInterval,
 		workerStatusPublishInterval: workerStatusPublishInterval,
+		containerMetricsInterval:    containerMetricsInterval,
 	}
 }
 
@@ -119,11 +128,14 @@ type client struct {
 	compression                 compression.Compression
 	workerPollingInterval       time.Duration
 	workerStatusPublishInterval time.Duration
+	containerMetricsInterval    time.Duration
 }
 
 type TaskResult struct {
 	ExitStatus   int
 	VolumeMounts []VolumeMount
+	Usage        runtime.ContainerUsage
+	OOMKill      *runtime.OOMKill
 }
 
 type CheckResult struct {
@@ -133,12 +145,14 @@ type CheckResult struct {
 type PutResult struct {
 	ExitStatus    int
 	VersionResult runtime.VersionResult
+	OOMKill       *runtime.OOMKill
 }
 
 type GetResult struct {
 	ExitStatus    int
 	VersionResult runtime.VersionResult
 	GetArtifact   runtime.GetArtifact
+	OOMKill       *runtime.OOMKill
 }
 
 type ImageFetcherSpec struct {
@@ -168,6 +182,10 @@ func (client *client) FindContainer(logger lager.Logger, teamID int, handle stri
 	return worker.FindContainerByHandle(logger, teamID, handle)
 }
 
+func (client *client) RunsOnSharedWorkers(logger lager.Logger, workerSpec WorkerSpec) (bool, error) {
+	return client.pool.RunsOnSharedWorkers(logger, workerSpec)
+}
+
 func (client *client) FindVolume(logger lager.Logger, teamID int, handle string) (Volume, bool, error) {
 	worker, found, err := client.provider.FindWorkerForVolume(
 		logger.Session("find-worker"),
@@ -208,13 +226,14 @@ func (client *client) RunCheckStep(
 	checkable resource.Resource,
 	timeout time.Duration,
 ) (CheckResult, error) {
-	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+	chosenWorker, err := client.findOrChooseWorkerWithinQuota(
 		ctx,
 		logger,
 		owner,
 		containerSpec,
 		workerSpec,
 		strategy,
+		eventDelegate,
 	)
 	if err != nil {
 		return CheckResult{}, fmt.Errorf("find or choose worker for container: %w", err)
@@ -285,6 +304,7 @@ func (client *client) RunTaskStep(
 		owner,
 		containerSpec,
 		workerSpec,
+		eventDelegate,
 		processSpec.StdoutWriter,
 	)
 	if err != nil {
@@ -328,11 +348,20 @@ func (client *client) RunTaskStep(
 		}, err
 	}
 
+	services, err := client.startServices(ctx, logger, chosenWorker, container, containerSpec.Services, imageFetcherSpec)
+	if err != nil {
+		return TaskResult{}, err
+	}
+
+	defer client.stopServices(logger, services)
+
 	processIO := garden.ProcessIO{
 		Stdout: processSpec.StdoutWriter,
 		Stderr: processSpec.StderrWriter,
 	}
 
+	oomKillsBefore := countOOMKills(logger, container)
+
 	process, err := container.Attach(context.Background(), taskProcessID, processIO)
 	if err == nil {
 		logger.Info("already-running")
@@ -366,6 +395,8 @@ func (client *client) RunTaskStep(
 
 	logger.Info("attached")
 
+	stopMonitoringUsage := monitorUsage(logger, container, client.containerMetricsInterval)
+
 	exitStatusChan := make(chan processStatus)
 
 	go func() {
@@ -385,28 +416,229 @@ func (client *client) RunTaskStep(
 		return TaskResult{
 			ExitStatus:   status.processStatus,
 			VolumeMounts: container.VolumeMounts(),
+			Usage:        stopMonitoringUsage(),
 		}, ctx.Err()
 
 	case status := <-exitStatusChan:
+		usage := stopMonitoringUsage()
+
 		if status.processErr != nil {
 			return TaskResult{
 				ExitStatus: status.processStatus,
+				Usage:      usage,
 			}, status.processErr
 		}
 
+		var oomKill *runtime.OOMKill
+		if status.processStatus != 0 {
+			oomKill = detectOOMKill(logger, container, oomKillsBefore)
+		}
+
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", status.processStatus))
 		if err != nil {
 			return TaskResult{
 				ExitStatus: status.processStatus,
+				Usage:      usage,
+				OOMKill:    oomKill,
 			}, err
 		}
 		return TaskResult{
 			ExitStatus:   status.processStatus,
 			VolumeMounts: container.VolumeMounts(),
+			Usage:        usage,
+			OOMKill:      oomKill,
 		}, err
 	}
 }
 
+// startServices creates the containers of the services running alongside the
+// given container on the same worker, joining its network, and runs (or
+// re-attaches to) their processes, waiting for each of them to be ready.
+func (client *client) startServices(
+	ctx context.Context,
+	logger lager.Logger,
+	chosenWorker Worker,
+	container Container,
+	services []ServiceSpec,
+	imageFetcherSpec ImageFetcherSpec,
+) ([]Container, error) {
+	var serviceContainers []Container
+
+	for _, service := range services {
+		logger := logger.Session("service", lager.Data{"service": service.Name})
+
+		containerSpec := service.ContainerSpec
+		containerSpec.NetworkOf = container.Handle()
+
+		serviceContainer, err := chosenWorker.FindOrCreateContainer(
+			ctx,
+			logger,
+			imageFetcherSpec.Delegate,
+			service.Owner,
+			service.Metadata,
+			containerSpec,
+			imageFetcherSpec.ResourceTypes,
+		)
+		if err != nil {
+			client.stopServices(logger, serviceContainers)
+			return nil, err
+		}
+
+		serviceContainers = append(serviceContainers, serviceContainer)
+
+		processIO := garden.ProcessIO{
+			Stdout: service.ProcessSpec.StdoutWriter,
+			Stderr: service.ProcessSpec.StderrWriter,
+		}
+
+		_, err = serviceContainer.Attach(context.Background(), serviceProcessID, processIO)
+		if err == nil {
+			logger.Info("already-running")
+		} else {
+			logger.Info("spawning")
+
+			_, err = serviceContainer.Run(
+				context.Background(),
+				garden.ProcessSpec{
+					ID: serviceProcessID,
+
+					Path: service.ProcessSpec.Path,
+					Args: service.ProcessSpec.Args,
+					Dir:  service.ProcessSpec.Dir,
+				},
+				processIO,
+			)
+			if err != nil {
+				client.stopServices(logger, serviceContainers)
+				return nil, err
+			}
+		}
+
+		err = client.waitForService(ctx, logger, serviceContainer, service)
+		if err != nil {
+			client.stopServices(logger, serviceContainers)
+			return nil, err
+		}
+	}
+
+	return serviceContainers, nil
+}
+
+// waitForService runs the readiness check of a service until it succeeds,
+// polling as often as for workers. A check still running when the build is
+// aborted or the readiness timeout passes is killed.
+func (client *client) waitForService(
+	ctx context.Context,
+	logger lager.Logger,
+	serviceContainer Container,
+	service ServiceSpec,
+) error {
+	if service.ReadinessSpec == nil {
+		return nil
+	}
+
+	timeout := service.ReadinessTimeout
+	if timeout == 0 {
+		timeout = DefaultServiceReadinessTimeout
+	}
+
+	readyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var readinessPollingTicker *time.Ticker
+
+	for {
+		status, err := client.checkReadiness(readyCtx, logger, serviceContainer, *service.ReadinessSpec)
+		if err != nil {
+			if ctx.Err() == nil && readyCtx.Err() != nil {
+				return fmt.Errorf("service %s not ready after %s", service.Name, timeout)
+			}
+
+			return err
+		}
+
+		if status == 0 {
+			logger.Info("ready")
+			return nil
+		}
+
+		if readinessPollingTicker == nil {
+			logger.Info("waiting-for-readiness")
+
+			readinessPollingTicker = time.NewTicker(client.workerPollingInterval)
+			defer readinessPollingTicker.Stop()
+		}
+
+		select {
+		case <-readyCtx.Done():
+			if ctx.Err() != nil {
+				logger.Info("aborted-waiting-for-readiness")
+				return ctx.Err()
+			}
+
+			return fmt.Errorf("service %s not ready after %s", service.Name, timeout)
+		case <-readinessPollingTicker.C:
+		}
+	}
+}
+
+// checkReadiness runs the readiness check of a service once, returning its
+// exit status.
+func (client *client) checkReadiness(
+	ctx context.Context,
+	logger lager.Logger,
+	serviceContainer Container,
+	readinessSpec runtime.ProcessSpec,
+) (int, error) {
+	process, err := serviceContainer.Run(
+		ctx,
+		garden.ProcessSpec{
+			Path: readinessSpec.Path,
+			Args: readinessSpec.Args,
+			Dir:  readinessSpec.Dir,
+		},
+		garden.ProcessIO{
+			Stdout: readinessSpec.StdoutWriter,
+			Stderr: readinessSpec.StderrWriter,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	exitStatusChan := make(chan processStatus, 1)
+
+	go func() {
+		status := processStatus{}
+		status.processStatus, status.processErr = process.Wait()
+		exitStatusChan <- status
+	}()
+
+	select {
+	case <-ctx.Done():
+		err = process.Signal(garden.SignalKill)
+		if err != nil {
+			logger.Error("failed-to-kill-readiness-check", err)
+		}
+
+		return 0, ctx.Err()
+
+	case status := <-exitStatusChan:
+		return status.processStatus, status.processErr
+	}
+}
+
+// stopServices kills the processes of the given service containers. The
+// containers themselves are garbage collected along with the build.
+func (client *client) stopServices(logger lager.Logger, serviceContainers []Container) {
+	for _, serviceContainer := range serviceContainers {
+		err := serviceContainer.Stop(true)
+		if err != nil {
+			logger.Error("failed-to-stop-service", err, lager.Data{"container": serviceContainer.Handle()})
+		}
+	}
+}
+
 func (client *client) RunGetStep(
 	ctx context.Context,
 	logger lager.Logger,
@@ -422,13 +654,14 @@ func (client *client) RunGetStep(
 	resource resource.Resource,
 ) (GetResult, error) {
 
-	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+	chosenWorker, err := client.findOrChooseWorkerWithinQuota(
 		ctx,
 		logger,
 		owner,
 		containerSpec,
 		workerSpec,
 		strategy,
+		eventDelegate,
 	)
 	if err != nil {
 		return GetResult{}, err
@@ -482,13 +715,14 @@ func (client *client) RunPutStep(
 		return PutResult{}, err
 	}
 
-	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+	chosenWorker, err := client.findOrChooseWorkerWithinQuota(
 		ctx,
 		logger,
 		owner,
 		containerSpec,
 		workerSpec,
 		strategy,
+		eventDelegate,
 	)
 	if err != nil {
 		return PutResult{}, err
@@ -527,12 +761,15 @@ func (client *client) RunPutStep(
 
 	eventDelegate.Starting(logger)
 
+	oomKillsBefore := countOOMKills(logger, container)
+
 	vr, err = resource.Put(ctx, spec, container)
 	if err != nil {
 		if failErr, ok := err.(runtime.ErrResourceScriptFailed); ok {
 			return PutResult{
 				ExitStatus:    failErr.ExitStatus,
 				VersionResult: runtime.VersionResult{},
+				OOMKill:       detectOOMKill(logger, container, oomKillsBefore),
 			}, nil
 		} else {
 			return PutResult{}, err
@@ -574,6 +811,7 @@ func (client *client) chooseTaskWorker(
 	owner db.ContainerOwner,
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
+	eventDelegate runtime.StartingEventDelegate,
 	outputWriter io.Writer,
 ) (Worker, error) {
 	var (
@@ -597,13 +835,14 @@ func (client *client) chooseTaskWorker(
 	}
 
 	for {
-		if chosenWorker, err = client.pool.FindOrChooseWorkerForContainer(
+		if chosenWorker, err = client.findOrChooseWorkerWithinQuota(
 			ctx,
 			logger,
 			owner,
 			containerSpec,
 			workerSpec,
 			strategy,
+			eventDelegate,
 		); err != nil {
 			return nil, err
 		}
@@ -673,6 +912,52 @@ func (client *client) chooseTaskWorker(
 	}
 }
 
+// findOrChooseWorkerWithinQuota finds or chooses a worker for the container,
+// waiting for as long as the team can't have any more containers or volumes
+// rather than failing the step. If the build uses the whole quota by itself,
+// waiting would never end, so the step fails instead.
+func (client *client) findOrChooseWorkerWithinQuota(
+	ctx context.Context,
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	strategy ContainerPlacementStrategy,
+	eventDelegate runtime.StartingEventDelegate,
+) (Worker, error) {
+	var quotaPollingTicker *time.Ticker
+
+	for {
+		chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
+			ctx,
+			logger,
+			owner,
+			containerSpec,
+			workerSpec,
+			strategy,
+		)
+		var quotaErr db.QuotaReachedError
+		if !errors.As(err, &quotaErr) || quotaErr.ReachedByBuild {
+			return chosenWorker, err
+		}
+
+		if quotaPollingTicker == nil {
+			logger.Info("waiting-for-quota", lager.Data{"reason": err.Error()})
+			eventDelegate.Waiting(logger, err.Error())
+
+			quotaPollingTicker = time.NewTicker(client.workerPollingInterval)
+			defer quotaPollingTicker.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("aborted-waiting-for-quota")
+			return nil, ctx.Err()
+		case <-quotaPollingTicker.C:
+		}
+	}
+}
+
 // TODO (runtime) don't modify spec inside here, Specs don't change after you write them
 func (client *client) wireInputsAndCaches(logger lager.Logger, spec *ContainerSpec) error {
 	var inputs []InputSource
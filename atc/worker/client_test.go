@@ -49,8 +49,9 @@ var _ = Describe("Client", func() {
 		fakeCompression = new(compressionfakes.FakeCompression)
 		workerPolling := 1 * time.Second
 		workerStatus := 2 * time.Second
+		containerMetrics := 1 * time.Second
 
-		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus)
+		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus, containerMetrics)
 	})
 
 	Describe("FindContainer", func() {
@@ -323,6 +324,26 @@ var _ = Describe("Client", func() {
 					Expect(name).To(Equal("some-worker"))
 				})
 
+				Context("when the team's container quota is reached", func() {
+					BeforeEach(func() {
+						fakePool.FindOrChooseWorkerForContainerReturnsOnCall(0, nil, db.QuotaReachedError{
+							Quota: db.QuotaContainers,
+							Limit: 10,
+						})
+					})
+
+					It("waits for the quota instead of erroring", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(2))
+
+						Expect(fakeEventDelegate.WaitingCallCount()).To(Equal(1))
+						_, reason := fakeEventDelegate.WaitingArgsForCall(0)
+						Expect(reason).To(Equal("team quota of 10 containers reached"))
+
+						Expect(fakeEventDelegate.SelectedWorkerCallCount()).To(Equal(1))
+					})
+				})
+
 				It("runs check w/ timeout", func() {
 					ctx, _, _ := fakeResource.CheckArgsForCall(0)
 					_, hasDeadline := ctx.Deadline()
@@ -744,6 +765,40 @@ var _ = Describe("Client", func() {
 				})
 			})
 
+			Context("when the team's container quota is reached", func() {
+				BeforeEach(func() {
+					fakePool.FindOrChooseWorkerForContainerReturnsOnCall(0, nil, db.QuotaReachedError{
+						Quota: db.QuotaContainers,
+						Limit: 10,
+					})
+				})
+
+				It("waits for the quota", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(2))
+					Expect(fakeEventDelegate.WaitingCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the build needs more containers than its quota", func() {
+				quotaErr := db.QuotaReachedError{
+					Quota:          db.QuotaContainers,
+					Limit:          2,
+					ReachedByBuild: true,
+				}
+
+				BeforeEach(func() {
+					fakePool.FindOrChooseWorkerForContainerReturns(nil, quotaErr)
+				})
+
+				It("fails instead of waiting on itself", func() {
+					Expect(err).To(MatchError(quotaErr))
+					Expect(err).To(MatchError("build uses all of the team quota of 2 containers"))
+					Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(1))
+					Expect(fakeEventDelegate.WaitingCallCount()).To(BeZero())
+					Expect(fakeEventDelegate.SelectedWorkerCallCount()).To(BeZero())
+				})
+			})
 		})
 
 		It("finds or creates a container", func() {
@@ -1059,6 +1114,183 @@ var _ = Describe("Client", func() {
 					Expect(fakeEventDelegate.StartingCallCount()).Should((Equal(1)))
 				})
 
+				Context("when the task has services", func() {
+					var (
+						fakeServiceContainer *workerfakes.FakeContainer
+						serviceOwner         db.ContainerOwner
+						serviceStdout        *gbytes.Buffer
+					)
+
+					BeforeEach(func() {
+						fakeContainer.HandleReturns("task-handle")
+
+						fakeServiceContainer = new(workerfakes.FakeContainer)
+						fakeServiceContainer.AttachReturns(nil, errors.New("container not running"))
+						fakeWorker.FindOrCreateContainerReturnsOnCall(1, fakeServiceContainer, nil)
+
+						serviceOwner = db.NewBuildStepContainerOwner(1234, atc.PlanID("42").Service("postgres"), 123)
+						serviceStdout = new(gbytes.Buffer)
+
+						fakeContainerSpec.Services = []worker.ServiceSpec{
+							{
+								Name:     "postgres",
+								Owner:    serviceOwner,
+								Metadata: db.ContainerMetadata{StepName: "some-step/postgres"},
+								ContainerSpec: worker.ContainerSpec{
+									TeamID: 123,
+									Env:    []string{"POSTGRES_PASSWORD=password"},
+								},
+								ProcessSpec: runtime.ProcessSpec{
+									Path:         "docker-entrypoint.sh",
+									Args:         []string{"postgres"},
+									StdoutWriter: serviceStdout,
+								},
+							},
+						}
+					})
+
+					It("creates the service container in the network of the task container", func() {
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+
+						_, _, _, owner, metadata, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+						Expect(owner).To(Equal(serviceOwner))
+						Expect(metadata.StepName).To(Equal("some-step/postgres"))
+						Expect(containerSpec.NetworkOf).To(Equal("task-handle"))
+						Expect(containerSpec.Env).To(Equal([]string{"POSTGRES_PASSWORD=password"}))
+					})
+
+					It("runs the service process before the task process", func() {
+						Expect(fakeServiceContainer.RunCallCount()).To(Equal(1))
+
+						_, gardenProcessSpec, actualProcessIO := fakeServiceContainer.RunArgsForCall(0)
+						Expect(gardenProcessSpec.ID).To(Equal("service"))
+						Expect(gardenProcessSpec.Path).To(Equal("docker-entrypoint.sh"))
+						Expect(gardenProcessSpec.Args).To(Equal([]string{"postgres"}))
+						Expect(actualProcessIO.Stdout).To(Equal(serviceStdout))
+
+						Expect(fakeContainer.RunCallCount()).To(Equal(1))
+					})
+
+					It("stops the service container once the task finishes", func() {
+						Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+						Expect(fakeServiceContainer.StopArgsForCall(0)).To(BeTrue())
+					})
+
+					Context("when the service is already running", func() {
+						BeforeEach(func() {
+							fakeServiceContainer.AttachReturns(new(gardenfakes.FakeProcess), nil)
+						})
+
+						It("attaches to the service process instead of running a new one", func() {
+							_, processID, _ := fakeServiceContainer.AttachArgsForCall(0)
+							Expect(processID).To(Equal("service"))
+							Expect(fakeServiceContainer.RunCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the service has a readiness check", func() {
+						BeforeEach(func() {
+							fakeContainerSpec.Services[0].ReadinessSpec = &runtime.ProcessSpec{
+								Path:         "pg_isready",
+								Args:         []string{"-h", "localhost"},
+								StdoutWriter: serviceStdout,
+							}
+
+							notReady := new(gardenfakes.FakeProcess)
+							notReady.WaitReturns(2, nil)
+							ready := new(gardenfakes.FakeProcess)
+							ready.WaitReturns(0, nil)
+
+							fakeServiceContainer.RunReturnsOnCall(0, new(gardenfakes.FakeProcess), nil)
+							fakeServiceContainer.RunReturnsOnCall(1, notReady, nil)
+							fakeServiceContainer.RunReturnsOnCall(2, ready, nil)
+						})
+
+						It("runs the check until it succeeds before running the task process", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(fakeServiceContainer.RunCallCount()).To(Equal(3))
+
+							_, gardenProcessSpec, actualProcessIO := fakeServiceContainer.RunArgsForCall(1)
+							Expect(gardenProcessSpec.ID).To(BeEmpty())
+							Expect(gardenProcessSpec.Path).To(Equal("pg_isready"))
+							Expect(gardenProcessSpec.Args).To(Equal([]string{"-h", "localhost"}))
+							Expect(actualProcessIO.Stdout).To(Equal(serviceStdout))
+
+							Expect(fakeContainer.RunCallCount()).To(Equal(1))
+						})
+
+						Context("when the check doesn't finish within the readiness timeout", func() {
+							var hanging *gardenfakes.FakeProcess
+
+							BeforeEach(func() {
+								fakeContainerSpec.Services[0].ReadinessTimeout = 50 * time.Millisecond
+
+								killed := make(chan struct{})
+								hanging = new(gardenfakes.FakeProcess)
+								hanging.WaitStub = func() (int, error) {
+									<-killed
+									return 137, nil
+								}
+								hanging.SignalStub = func(garden.Signal) error {
+									close(killed)
+									return nil
+								}
+
+								fakeServiceContainer.RunReturnsOnCall(1, hanging, nil)
+							})
+
+							It("kills the check, stops the service and returns an error", func() {
+								Expect(err).To(MatchError("service postgres not ready after 50ms"))
+								Expect(hanging.SignalCallCount()).To(Equal(1))
+								Expect(hanging.SignalArgsForCall(0)).To(Equal(garden.SignalKill))
+								Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+								Expect(fakeContainer.RunCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the check can't be run", func() {
+							disaster := errors.New("nope")
+
+							BeforeEach(func() {
+								fakeServiceContainer.RunReturnsOnCall(1, nil, disaster)
+							})
+
+							It("stops the service container and returns the error", func() {
+								Expect(err).To(Equal(disaster))
+								Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+								Expect(fakeContainer.RunCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when creating the service container fails", func() {
+						disaster := errors.New("nope")
+
+						BeforeEach(func() {
+							fakeWorker.FindOrCreateContainerReturnsOnCall(1, nil, disaster)
+						})
+
+						It("returns the error without running the task", func() {
+							Expect(err).To(Equal(disaster))
+							Expect(fakeContainer.RunCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when running the service process fails", func() {
+						disaster := errors.New("nope")
+
+						BeforeEach(func() {
+							fakeServiceContainer.RunReturns(nil, disaster)
+						})
+
+						It("stops the service container and returns the error", func() {
+							Expect(err).To(Equal(disaster))
+							Expect(fakeServiceContainer.StopCallCount()).To(Equal(1))
+							Expect(fakeContainer.RunCallCount()).To(BeZero())
+						})
+					})
+				})
+
 				Context("when the process is interrupted", func() {
 					var stopped chan struct{}
 					BeforeEach(func() {
@@ -1109,6 +1341,69 @@ var _ = Describe("Client", func() {
 						Expect(err).ToNot(HaveOccurred())
 					})
 
+					Context("when the container reports its metrics", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturnsOnCall(0, garden.Metrics{
+								CPUStat:     garden.ContainerCPUStat{Usage: uint64(time.Second)},
+								MemoryStat:  garden.ContainerMemoryStat{TotalUsageTowardLimit: 100},
+								NetworkStat: garden.ContainerNetworkStat{RxBytes: 10, TxBytes: 5},
+							}, nil)
+							fakeContainer.MetricsReturnsOnCall(1, garden.Metrics{
+								CPUStat:     garden.ContainerCPUStat{Usage: uint64(3 * time.Second)},
+								MemoryStat:  garden.ContainerMemoryStat{TotalUsageTowardLimit: 300},
+								NetworkStat: garden.ContainerNetworkStat{RxBytes: 50, TxBytes: 10},
+							}, nil)
+						})
+
+						It("summarizes the resource usage of the container", func() {
+							Expect(fakeContainer.MetricsCallCount()).To(Equal(2))
+							Expect(taskResult.Usage.Samples).To(Equal(2))
+							Expect(taskResult.Usage.CPUTime).To(Equal(2 * time.Second))
+							Expect(taskResult.Usage.MemoryPeak).To(Equal(uint64(300)))
+							Expect(taskResult.Usage.MemoryAverage).To(Equal(uint64(200)))
+							Expect(taskResult.Usage.NetworkRxBytes).To(Equal(uint64(40)))
+							Expect(taskResult.Usage.NetworkTxBytes).To(Equal(uint64(5)))
+							Expect(taskResult.Usage.BlockIOReadBytes).To(BeZero())
+							Expect(taskResult.Usage.BlockIOWriteBytes).To(BeZero())
+						})
+
+						Context("when the container reports its lifetime usage", func() {
+							BeforeEach(func() {
+								fakeContainer.PropertyStub = func(name string) (string, error) {
+									switch name {
+									case atc.ContainerPropertyMemoryPeak:
+										return "1000", nil
+									case atc.ContainerPropertyBlockIORead:
+										return "120", nil
+									case atc.ContainerPropertyBlockIOWrite:
+										return "50", nil
+									}
+
+									return "", errors.New("unknown property")
+								}
+							})
+
+							It("reports the peak memory usage and the disk IO of its whole life", func() {
+								Expect(taskResult.Usage.MemoryPeak).To(Equal(uint64(1000)))
+								Expect(taskResult.Usage.MemoryAverage).To(Equal(uint64(200)))
+								Expect(taskResult.Usage.BlockIOReadBytes).To(Equal(uint64(120)))
+								Expect(taskResult.Usage.BlockIOWriteBytes).To(Equal(uint64(50)))
+							})
+						})
+					})
+
+					Context("when the container metrics cannot be retrieved", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturnsOnCall(0, garden.Metrics{}, errors.New("not implemented"))
+							fakeContainer.MetricsReturnsOnCall(1, garden.Metrics{}, errors.New("not implemented"))
+						})
+
+						It("does not report any usage", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(taskResult.Usage).To(BeZero())
+						})
+					})
+
 					It("saves the exit status property", func() {
 						Expect(fakeContainer.SetPropertyCallCount()).To(Equal(1))
 
@@ -1220,6 +1515,63 @@ var _ = Describe("Client", func() {
 					It("returns an unsuccessful result", func() {
 						Expect(status).To(Equal(fakeProcessExitCode))
 						Expect(err).ToNot(HaveOccurred())
+						Expect(taskResult.OOMKill).To(BeNil())
+					})
+
+					Context("when the containerd runtime counts an OOM kill while the process runs", func() {
+						BeforeEach(func() {
+							oomKills := []string{"1", "2"}
+							fakeContainer.PropertyStub = func(name string) (string, error) {
+								if name == atc.ContainerPropertyOOMKills {
+									kills := oomKills[0]
+									oomKills = oomKills[1:]
+									return kills, nil
+								}
+								return "", errors.New("unknown property")
+							}
+							fakeContainer.CurrentMemoryLimitsReturns(garden.MemoryLimits{LimitInBytes: 2 * 1024 * 1024 * 1024}, nil)
+						})
+
+						It("reports the OOM kill along with the memory limit", func() {
+							Expect(taskResult.OOMKill).To(Equal(&runtime.OOMKill{MemoryLimit: 2 * 1024 * 1024 * 1024}))
+							Expect(taskResult.OOMKill.Error()).To(Equal("killed: out of memory, limit 2GB"))
+						})
+					})
+
+					Context("when the containerd runtime only counts OOM kills from before the process ran", func() {
+						BeforeEach(func() {
+							fakeContainer.PropertyStub = func(name string) (string, error) {
+								if name == atc.ContainerPropertyOOMKills {
+									return "1", nil
+								}
+								return "", errors.New("unknown property")
+							}
+						})
+
+						It("doesn't report an OOM kill", func() {
+							Expect(taskResult.OOMKill).To(BeNil())
+						})
+					})
+
+					Context("when guardian reports an OOM kill while the process runs", func() {
+						BeforeEach(func() {
+							fakeContainer.InfoReturnsOnCall(0, garden.ContainerInfo{}, nil)
+							fakeContainer.InfoReturnsOnCall(1, garden.ContainerInfo{Events: []string{"Out of memory"}}, nil)
+						})
+
+						It("reports the OOM kill", func() {
+							Expect(taskResult.OOMKill).ToNot(BeNil())
+						})
+					})
+
+					Context("when guardian only reports OOM kills from before the process ran", func() {
+						BeforeEach(func() {
+							fakeContainer.InfoReturns(garden.ContainerInfo{Events: []string{"Out of memory"}}, nil)
+						})
+
+						It("doesn't report an OOM kill", func() {
+							Expect(taskResult.OOMKill).To(BeNil())
+						})
 					})
 
 					It("saves the exit status property", func() {
@@ -1493,6 +1845,26 @@ var _ = Describe("Client", func() {
 					It("returns a PutResult with the exit status from ErrResourceScriptFailed", func() {
 						Expect(status).To(Equal(10))
 						Expect(err).To(BeNil())
+						Expect(result.OOMKill).To(BeNil())
+					})
+
+					Context("when the container ran out of memory", func() {
+						BeforeEach(func() {
+							oomKills := []string{"0", "1"}
+							fakeContainer.PropertyStub = func(name string) (string, error) {
+								if name == atc.ContainerPropertyOOMKills {
+									kills := oomKills[0]
+									oomKills = oomKills[1:]
+									return kills, nil
+								}
+								return "", errors.New("unknown property")
+							}
+							fakeContainer.CurrentMemoryLimitsReturns(garden.MemoryLimits{LimitInBytes: 1024}, nil)
+						})
+
+						It("reports the OOM kill", func() {
+							Expect(result.OOMKill).To(Equal(&runtime.OOMKill{MemoryLimit: 1024}))
+						})
 					})
 				})
 
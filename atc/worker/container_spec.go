@@ -3,6 +3,7 @@ package worker
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
@@ -49,15 +50,45 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Destinations the container is allowed to connect to. Outbound
+	// connections are not restricted when empty.
+	Egress []atc.TaskEgressRule
+
+	// Handle of a container whose network namespace the container should join
+	// instead of getting a network of its own.
+	NetworkOf string
+
+	// Sidecar containers to run alongside the container, sharing its network.
+	Services []ServiceSpec
+}
+
+// ServiceSpec describes a sidecar container and the process to run in it.
+type ServiceSpec struct {
+	Name string
+
+	Owner         db.ContainerOwner
+	Metadata      db.ContainerMetadata
+	ContainerSpec ContainerSpec
+	ProcessSpec   runtime.ProcessSpec
+
+	// Process run until it succeeds to determine that the service is ready.
+	// The service is considered ready as soon as it's started when nil.
+	ReadinessSpec *runtime.ProcessSpec
+
+	// How long to wait for the service to be ready. Defaults to
+	// DefaultServiceReadinessTimeout when zero.
+	ReadinessTimeout time.Duration
 }
 
 // The below methods cause ContainerSpec to fulfill the
 // go.opentelemetry.io/otel/api/propagation.HTTPSupplier interface
 
 func (cs *ContainerSpec) Get(key string) string {
+	varName := strings.ToUpper(key)
 	for _, env := range cs.Env {
-		assignment := strings.SplitN("=", env, 2)
-		if assignment[0] == strings.ToUpper(key) {
+		assignment := strings.SplitN(env, "=", 2)
+		if len(assignment) == 2 && assignment[0] == varName {
 			return assignment[1]
 		}
 	}
@@ -68,7 +99,7 @@ func (cs *ContainerSpec) Set(key string, value string) {
 	varName := strings.ToUpper(key)
 	envVar := varName + "=" + value
 	for i, env := range cs.Env {
-		if strings.SplitN("=", env, 2)[0] == varName {
+		if strings.SplitN(env, "=", 2)[0] == varName {
 			cs.Env[i] = envVar
 			return
 		}
@@ -143,6 +174,40 @@ func (cl ContainerLimits) ToGardenLimits() garden.Limits {
 	return gardenLimits
 }
 
+func toGardenNetOutRules(egress []atc.TaskEgressRule) ([]garden.NetOutRule, error) {
+	var rules []garden.NetOutRule
+
+	for _, egressRule := range egress {
+		ipNet, err := egressRule.IPNet()
+		if err != nil {
+			return nil, err
+		}
+
+		rule := garden.NetOutRule{
+			Networks: []garden.IPRange{garden.IPRangeFromIPNet(ipNet)},
+		}
+
+		switch egressRule.Protocol {
+		case atc.EgressProtocolTCP:
+			rule.Protocol = garden.ProtocolTCP
+		case atc.EgressProtocolUDP:
+			rule.Protocol = garden.ProtocolUDP
+		case atc.EgressProtocolICMP:
+			rule.Protocol = garden.ProtocolICMP
+		default:
+			rule.Protocol = garden.ProtocolAll
+		}
+
+		for _, port := range egressRule.Ports {
+			rule.Ports = append(rule.Ports, garden.PortRangeFromPort(port))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 func (spec WorkerSpec) Description() string {
 	var attrs []string
 
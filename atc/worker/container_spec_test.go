@@ -0,0 +1,57 @@
+package worker_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/worker"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/api/trace/tracetest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ContainerSpec", func() {
+	var spec worker.ContainerSpec
+
+	BeforeEach(func() {
+		spec = worker.ContainerSpec{
+			Env: []string{"FOO=bar", "TRACEPARENT=stale=value"},
+		}
+	})
+
+	Describe("Get", func() {
+		It("returns the value of the env var, case insensitively", func() {
+			Expect(spec.Get("foo")).To(Equal("bar"))
+			Expect(spec.Get("traceparent")).To(Equal("stale=value"))
+		})
+
+		It("returns an empty string for missing env vars", func() {
+			Expect(spec.Get("bogus")).To(BeEmpty())
+		})
+	})
+
+	Describe("Set", func() {
+		It("replaces an existing env var", func() {
+			spec.Set("traceparent", "fresh")
+			Expect(spec.Env).To(Equal([]string{"FOO=bar", "TRACEPARENT=fresh"}))
+		})
+
+		It("adds a missing env var", func() {
+			spec.Set("tracestate", "some=state")
+			Expect(spec.Env).To(Equal([]string{"FOO=bar", "TRACEPARENT=stale=value", "TRACESTATE=some=state"}))
+		})
+	})
+
+	It("carries the trace context of a span as TRACEPARENT", func() {
+		ctx, span := tracetest.NewProvider().Tracer("test").Start(context.Background(), "some-step")
+		trace.TraceContext{}.Inject(ctx, &spec)
+
+		Expect(spec.Env).To(ContainElement(MatchRegexp(`^TRACEPARENT=00-[0-9a-f]{32}-[0-9a-f]{16}-0[01]$`)))
+		Expect(spec.Env).ToNot(ContainElement("TRACEPARENT=stale=value"))
+
+		extracted := trace.RemoteSpanContextFromContext(trace.TraceContext{}.Extract(context.Background(), &spec))
+		Expect(extracted.TraceID).To(Equal(span.SpanContext().TraceID))
+		Expect(extracted.SpanID).To(Equal(span.SpanContext().SpanID))
+	})
+})
@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// usageSampler aggregates samples of the metrics of a container into a
+// runtime.ContainerUsage.
+type usageSampler struct {
+	container Container
+
+	first, last     garden.Metrics
+	firstAt, lastAt time.Time
+
+	samples     int
+	memoryPeak  uint64
+	memoryTotal uint64
+
+	blockIORead  uint64
+	blockIOWrite uint64
+}
+
+func (sampler *usageSampler) sample(logger lager.Logger, at time.Time) {
+	metrics, err := sampler.container.Metrics()
+	if err != nil {
+		// not every runtime is able to report metrics; there's no point in
+		// making noise about it on every sample.
+		logger.Debug("failed-to-sample-container-metrics", lager.Data{"error": err.Error()})
+		return
+	}
+
+	if sampler.samples == 0 {
+		sampler.first = metrics
+		sampler.firstAt = at
+	}
+
+	sampler.last = metrics
+	sampler.lastAt = at
+	sampler.samples++
+
+	memory := metrics.MemoryStat.TotalUsageTowardLimit
+	if memory > sampler.memoryPeak {
+		sampler.memoryPeak = memory
+	}
+
+	sampler.memoryTotal += memory
+}
+
+// sampleLifetime reads the usage that the runtime accounted over the whole
+// life of the container. Only the peak memory usage that it reports is
+// accurate: samples miss any spike in between them.
+func (sampler *usageSampler) sampleLifetime(logger lager.Logger) {
+	peak, found := sampler.lifetimeProperty(logger, atc.ContainerPropertyMemoryPeak)
+	if !found {
+		// the runtime doesn't account it, so neither does it account the rest
+		return
+	}
+
+	if peak > sampler.memoryPeak {
+		sampler.memoryPeak = peak
+	}
+
+	sampler.blockIORead, _ = sampler.lifetimeProperty(logger, atc.ContainerPropertyBlockIORead)
+	sampler.blockIOWrite, _ = sampler.lifetimeProperty(logger, atc.ContainerPropertyBlockIOWrite)
+}
+
+func (sampler *usageSampler) lifetimeProperty(logger lager.Logger, name string) (uint64, bool) {
+	value, err := sampler.container.Property(name)
+	if err != nil {
+		logger.Debug("failed-to-get-lifetime-usage", lager.Data{"property": name, "error": err.Error()})
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		logger.Debug("failed-to-parse-lifetime-usage", lager.Data{"property": name, "value": value})
+		return 0, false
+	}
+
+	return parsed, true
+}
+
+func (sampler *usageSampler) usage() runtime.ContainerUsage {
+	if sampler.samples == 0 {
+		return runtime.ContainerUsage{}
+	}
+
+	return runtime.ContainerUsage{
+		Samples:  sampler.samples,
+		Duration: sampler.lastAt.Sub(sampler.firstAt),
+
+		CPUTime:       time.Duration(delta(sampler.first.CPUStat.Usage, sampler.last.CPUStat.Usage)),
+		MemoryPeak:    sampler.memoryPeak,
+		MemoryAverage: sampler.memoryTotal / uint64(sampler.samples),
+
+		NetworkRxBytes: delta(sampler.first.NetworkStat.RxBytes, sampler.last.NetworkStat.RxBytes),
+		NetworkTxBytes: delta(sampler.first.NetworkStat.TxBytes, sampler.last.NetworkStat.TxBytes),
+
+		BlockIOReadBytes:  sampler.blockIORead,
+		BlockIOWriteBytes: sampler.blockIOWrite,
+	}
+}
+
+// delta computes the increase of a counter, which might have been reset in
+// between, e.g. if the container was recreated by the runtime.
+func delta(from, to uint64) uint64 {
+	if to < from {
+		return to
+	}
+
+	return to - from
+}
+
+// monitorUsage samples the metrics of the container every interval until the
+// returned function is called, which takes a last sample and summarizes them.
+func monitorUsage(logger lager.Logger, container Container, interval time.Duration) func() runtime.ContainerUsage {
+	logger = logger.Session("monitor-usage")
+
+	sampler := &usageSampler{container: container}
+	sampler.sample(logger, time.Now())
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		if interval <= 0 {
+			<-stop
+			return
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case now := <-ticker.C:
+				sampler.sample(logger, now)
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() runtime.ContainerUsage {
+		close(stop)
+		<-stopped
+
+		sampler.sample(logger, time.Now())
+		sampler.sampleLifetime(logger)
+
+		return sampler.usage()
+	}
+}
@@ -167,6 +167,8 @@ func (s *fetchSource) Create(ctx context.Context) (GetResult, Volume, error) {
 		return GetResult{}, nil, err
 	}
 
+	oomKillsBefore := countOOMKills(sLog, container)
+
 	vr, err := s.resource.Get(ctx, s.processSpec, container)
 	if err != nil {
 		sLog.Error("failed-to-fetch-resource", err)
@@ -175,6 +177,7 @@ func (s *fetchSource) Create(ctx context.Context) (GetResult, Volume, error) {
 		if failErr, ok := err.(runtime.ErrResourceScriptFailed); ok {
 			return GetResult{
 				ExitStatus: failErr.ExitStatus,
+				OOMKill:    detectOOMKill(sLog, container, oomKillsBefore),
 			}, nil, nil
 		}
 		return GetResult{}, nil, err
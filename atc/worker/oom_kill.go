@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// gardenOOMEvent is the container event through which Guardian reports that
+// processes of a container were killed for running out of memory.
+const gardenOOMEvent = "Out of memory"
+
+// countOOMKills counts the processes of the container that were killed by the
+// kernel for exceeding its memory limit so far. Both runtimes only ever add to
+// the count, so it has to be read before running a process to tell whether
+// the process was killed.
+func countOOMKills(logger lager.Logger, container Container) uint64 {
+	property, err := container.Property(atc.ContainerPropertyOOMKills)
+	if err == nil {
+		kills, err := strconv.ParseUint(property, 10, 64)
+		if err == nil {
+			return kills
+		}
+
+		logger.Error("failed-to-parse-oom-kills-property", err)
+	} else {
+		// only the containerd runtime reports it
+		logger.Debug("failed-to-get-oom-kills-property", lager.Data{"error": err.Error()})
+	}
+
+	info, err := container.Info()
+	if err != nil {
+		// not every runtime implements it
+		return 0
+	}
+
+	var kills uint64
+	for _, event := range info.Events {
+		if event == gardenOOMEvent {
+			kills++
+		}
+	}
+
+	return kills
+}
+
+// detectOOMKill determines whether processes of the container were killed by
+// the kernel for exceeding its memory limit since the count of kills was
+// `before`, returning nil if they weren't.
+func detectOOMKill(logger lager.Logger, container Container, before uint64) *runtime.OOMKill {
+	if countOOMKills(logger, container) <= before {
+		return nil
+	}
+
+	kill := &runtime.OOMKill{}
+
+	limits, err := container.CurrentMemoryLimits()
+	if err != nil {
+		logger.Error("failed-to-get-memory-limits", err)
+	} else {
+		kill.MemoryLimit = limits.LimitInBytes
+	}
+
+	return kill
+}
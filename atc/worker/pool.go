@@ -68,6 +68,11 @@ type Pool interface {
 		WorkerSpec,
 	) (bool, error)
 
+	RunsOnSharedWorkers(
+		lager.Logger,
+		WorkerSpec,
+	) (bool, error)
+
 	FindOrChooseWorkerForContainer(
 		context.Context,
 		lager.Logger,
@@ -79,16 +84,19 @@ type Pool interface {
 }
 
 type pool struct {
-	provider WorkerProvider
-	rand     *rand.Rand
+	provider     WorkerProvider
+	quotaChecker db.QuotaChecker
+	rand         *rand.Rand
 }
 
 func NewPool(
 	provider WorkerProvider,
+	quotaChecker db.QuotaChecker,
 ) Pool {
 	return &pool{
-		provider: provider,
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		provider:     provider,
+		quotaChecker: quotaChecker,
+		rand:         rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
@@ -128,6 +136,24 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	}
 }
 
+// RunsOnSharedWorkers returns true if containers satisfying the spec would be
+// placed on the workers shared by every team, as the team has no compatible
+// worker of its own. This is also the case while there are no compatible
+// workers at all.
+func (pool *pool) RunsOnSharedWorkers(logger lager.Logger, workerSpec WorkerSpec) (bool, error) {
+	compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
+	if err != nil {
+		var noCompatibleWorkers NoCompatibleWorkersError
+		if errors.Is(err, ErrNoWorkers) || errors.As(err, &noCompatibleWorkers) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return !compatibleWorkers[0].IsOwnedByTeam(), nil
+}
+
 func (pool *pool) ContainerInWorker(logger lager.Logger, owner db.ContainerOwner, workerSpec WorkerSpec) (bool, error) {
 	workersWithContainer, err := pool.provider.FindWorkersForContainerByOwner(
 		logger.Session("find-worker"),
@@ -186,6 +212,11 @@ dance:
 	}
 
 	if worker == nil {
+		err = pool.checkContainerQuotas(containerSpec.TeamID, owner)
+		if err != nil {
+			return nil, err
+		}
+
 		worker, err = strategy.Choose(logger, compatibleWorkers, containerSpec)
 		if err != nil {
 			return nil, err
@@ -195,6 +226,19 @@ dance:
 	return worker, nil
 }
 
+// checkContainerQuotas makes sure the team may create another container,
+// along with the volumes it comes with.
+func (pool *pool) checkContainerQuotas(teamID int, owner db.ContainerOwner) error {
+	for _, quota := range []db.Quota{db.QuotaContainers, db.QuotaVolumes} {
+		err := pool.quotaChecker.CheckContainerQuota(teamID, owner, quota)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (pool *pool) FindOrChooseWorker(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
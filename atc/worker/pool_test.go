@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -17,16 +18,82 @@ import (
 
 var _ = Describe("Pool", func() {
 	var (
-		logger       *lagertest.TestLogger
-		pool         Pool
-		fakeProvider *workerfakes.FakeWorkerProvider
+		logger           *lagertest.TestLogger
+		pool             Pool
+		fakeProvider     *workerfakes.FakeWorkerProvider
+		fakeQuotaChecker *dbfakes.FakeQuotaChecker
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 
-		pool = NewPool(fakeProvider)
+		fakeQuotaChecker = new(dbfakes.FakeQuotaChecker)
+
+		pool = NewPool(fakeProvider, fakeQuotaChecker)
+	})
+
+	Describe("RunsOnSharedWorkers", func() {
+		var (
+			teamWorker    *workerfakes.FakeWorker
+			generalWorker *workerfakes.FakeWorker
+
+			shared bool
+			err    error
+		)
+
+		BeforeEach(func() {
+			teamWorker = new(workerfakes.FakeWorker)
+			teamWorker.IsOwnedByTeamReturns(true)
+
+			generalWorker = new(workerfakes.FakeWorker)
+			generalWorker.SatisfiesReturns(true)
+
+			fakeProvider.RunningWorkersReturns([]Worker{teamWorker, generalWorker}, nil)
+		})
+
+		JustBeforeEach(func() {
+			shared, err = pool.RunsOnSharedWorkers(logger, WorkerSpec{TeamID: 1})
+		})
+
+		Context("when the team has a compatible worker", func() {
+			BeforeEach(func() {
+				teamWorker.SatisfiesReturns(true)
+			})
+
+			It("returns false", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(shared).To(BeFalse())
+			})
+		})
+
+		Context("when only shared workers are compatible", func() {
+			It("returns true", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(shared).To(BeTrue())
+			})
+		})
+
+		Context("when no worker is compatible", func() {
+			BeforeEach(func() {
+				generalWorker.SatisfiesReturns(false)
+			})
+
+			It("returns true", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(shared).To(BeTrue())
+			})
+		})
+
+		Context("when the workers can't be listed", func() {
+			BeforeEach(func() {
+				fakeProvider.RunningWorkersReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("nope"))
+			})
+		})
 	})
 
 	Describe("FindOrChooseWorkerForContainer", func() {
@@ -181,6 +248,7 @@ var _ = Describe("Pool", func() {
 
 				It("succeeds and returns the compatible worker with the container", func() {
 					Expect(fakeStrategy.ChooseCallCount()).To(Equal(0))
+					Expect(fakeQuotaChecker.CheckContainerQuotaCallCount()).To(BeZero())
 
 					Expect(chooseErr).NotTo(HaveOccurred())
 					Expect(chosenWorker.Name()).To(Equal(workerA.Name()))
@@ -279,6 +347,37 @@ var _ = Describe("Pool", func() {
 					Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
 				})
 
+				It("checks the container and volume quotas of the team", func() {
+					Expect(fakeQuotaChecker.CheckContainerQuotaCallCount()).To(Equal(2))
+
+					teamID, owner, quota := fakeQuotaChecker.CheckContainerQuotaArgsForCall(0)
+					Expect(teamID).To(Equal(4567))
+					Expect(owner).To(Equal(fakeOwner))
+					Expect(quota).To(Equal(db.QuotaContainers))
+
+					teamID, owner, quota = fakeQuotaChecker.CheckContainerQuotaArgsForCall(1)
+					Expect(teamID).To(Equal(4567))
+					Expect(owner).To(Equal(fakeOwner))
+					Expect(quota).To(Equal(db.QuotaVolumes))
+				})
+
+				Context("when the team's container quota is reached", func() {
+					BeforeEach(func() {
+						fakeQuotaChecker.CheckContainerQuotaReturns(db.QuotaReachedError{
+							Quota: db.QuotaContainers,
+							Limit: 10,
+						})
+					})
+
+					It("returns the error without choosing a worker", func() {
+						Expect(chooseErr).To(Equal(db.QuotaReachedError{
+							Quota: db.QuotaContainers,
+							Limit: 10,
+						}))
+						Expect(fakeStrategy.ChooseCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when no workers satisfy the spec", func() {
 					BeforeEach(func() {
 						workerA.SatisfiesReturns(false)
@@ -124,6 +124,24 @@ var _ = Describe("GardenRoundTripper #RoundTrip", func() {
 			})
 		})
 
+		Context("when the worker was landed at its drain deadline", func() {
+			BeforeEach(func() {
+				landedWorker := new(dbfakes.FakeWorker)
+				landedWorker.StateReturns(db.WorkerStateLanded)
+				landedWorker.GardenAddrReturns(nil)
+
+				fakeDB.GetWorkerReturns(landedWorker, true, nil)
+			})
+
+			It("returns an unreachable error so that the step is rerun", func() {
+				_, err := roundTripper.RoundTrip(&request)
+				Expect(err).To(Equal(transport.WorkerUnreachableError{
+					WorkerName:  "some-worker",
+					WorkerState: "landed",
+				}))
+			})
+		})
+
 		Context("when the worker is not found in the db", func() {
 			BeforeEach(func() {
 				fakeDB.GetWorkerReturns(nil, false, nil)
@@ -296,12 +296,14 @@ func (worker *gardenWorker) FindOrCreateContainer(
 	if err != nil {
 		return nil, err
 	}
-	if !result.Allowed {
+	if result.Blocked() {
 		return nil, policy.PolicyCheckNotPass{
 			Reasons: result.Reasons,
 		}
 	}
 
+	result.PrintWarnings(delegate.Stderr())
+
 	// ensure either creatingContainer or createdContainer exists
 	creatingContainer, createdContainer, err = worker.dbWorker.FindContainer(owner)
 	if err != nil {
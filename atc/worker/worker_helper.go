@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker/gclient"
 )
@@ -33,6 +34,10 @@ func (w workerHelper) createGardenContainer(
 		gardenProperties[userPropertyName] = fetchedImage.Metadata.User
 	}
 
+	if containerSpec.NetworkOf != "" {
+		gardenProperties[atc.ContainerPropertyNetworkOf] = containerSpec.NetworkOf
+	}
+
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
 	if w.dbWorker.HTTPProxyURL() != "" {
@@ -47,6 +52,11 @@ func (w workerHelper) createGardenContainer(
 		env = append(env, fmt.Sprintf("no_proxy=%s", w.dbWorker.NoProxy()))
 	}
 
+	netOutRules, err := toGardenNetOutRules(containerSpec.Egress)
+	if err != nil {
+		return nil, err
+	}
+
 	return w.gardenClient.Create(
 		garden.ContainerSpec{
 			Handle:     handleToCreate,
@@ -56,6 +66,7 @@ func (w workerHelper) createGardenContainer(
 			Limits:     containerSpec.Limits.ToGardenLimits(),
 			Env:        env,
 			Properties: gardenProperties,
+			NetOut:     netOutRules,
 		})
 }
 
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -1002,6 +1003,39 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				Context("when egress rules are specified", func() {
+					BeforeEach(func() {
+						containerSpec.Egress = []atc.TaskEgressRule{
+							{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []uint16{443, 8080}},
+							{Network: "1.2.3.4"},
+						}
+					})
+
+					It("creates the container in garden with net out rules", func() {
+						Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.NetOut).To(Equal([]garden.NetOutRule{
+							{
+								Protocol: garden.ProtocolTCP,
+								Networks: []garden.IPRange{
+									{Start: net.ParseIP("10.0.0.0").To4(), End: net.ParseIP("10.255.255.255").To4()},
+								},
+								Ports: []garden.PortRange{
+									{Start: 443, End: 443},
+									{Start: 8080, End: 8080},
+								},
+							},
+							{
+								Protocol: garden.ProtocolAll,
+								Networks: []garden.IPRange{
+									{Start: net.ParseIP("1.2.3.4").To4(), End: net.ParseIP("1.2.3.4").To4()},
+								},
+							},
+						}))
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
@@ -160,6 +160,20 @@ type FakeClient struct {
 		result1 worker.TaskResult
 		result2 error
 	}
+	RunsOnSharedWorkersStub        func(lager.Logger, worker.WorkerSpec) (bool, error)
+	runsOnSharedWorkersMutex       sync.RWMutex
+	runsOnSharedWorkersArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}
+	runsOnSharedWorkersReturns struct {
+		result1 bool
+		result2 error
+	}
+	runsOnSharedWorkersReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	StreamFileFromArtifactStub        func(context.Context, lager.Logger, runtime.Artifact, string) (io.ReadCloser, error)
 	streamFileFromArtifactMutex       sync.RWMutex
 	streamFileFromArtifactArgsForCall []struct {
@@ -676,6 +690,70 @@ func (fake *FakeClient) RunTaskStepReturnsOnCall(i int, result1 worker.TaskResul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) RunsOnSharedWorkers(arg1 lager.Logger, arg2 worker.WorkerSpec) (bool, error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	ret, specificReturn := fake.runsOnSharedWorkersReturnsOnCall[len(fake.runsOnSharedWorkersArgsForCall)]
+	fake.runsOnSharedWorkersArgsForCall = append(fake.runsOnSharedWorkersArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}{arg1, arg2})
+	fake.recordInvocation("RunsOnSharedWorkers", []interface{}{arg1, arg2})
+	fake.runsOnSharedWorkersMutex.Unlock()
+	if fake.RunsOnSharedWorkersStub != nil {
+		return fake.RunsOnSharedWorkersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runsOnSharedWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RunsOnSharedWorkersCallCount() int {
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
+	return len(fake.runsOnSharedWorkersArgsForCall)
+}
+
+func (fake *FakeClient) RunsOnSharedWorkersCalls(stub func(lager.Logger, worker.WorkerSpec) (bool, error)) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = stub
+}
+
+func (fake *FakeClient) RunsOnSharedWorkersArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
+	argsForCall := fake.runsOnSharedWorkersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RunsOnSharedWorkersReturns(result1 bool, result2 error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = nil
+	fake.runsOnSharedWorkersReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RunsOnSharedWorkersReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = nil
+	if fake.runsOnSharedWorkersReturnsOnCall == nil {
+		fake.runsOnSharedWorkersReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.runsOnSharedWorkersReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) StreamFileFromArtifact(arg1 context.Context, arg2 lager.Logger, arg3 runtime.Artifact, arg4 string) (io.ReadCloser, error) {
 	fake.streamFileFromArtifactMutex.Lock()
 	ret, specificReturn := fake.streamFileFromArtifactReturnsOnCall[len(fake.streamFileFromArtifactArgsForCall)]
@@ -759,6 +837,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.runPutStepMutex.RUnlock()
 	fake.runTaskStepMutex.RLock()
 	defer fake.runTaskStepMutex.RUnlock()
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
 	fake.streamFileFromArtifactMutex.RLock()
 	defer fake.streamFileFromArtifactMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -58,6 +58,20 @@ type FakePool struct {
 		result1 worker.Worker
 		result2 error
 	}
+	RunsOnSharedWorkersStub        func(lager.Logger, worker.WorkerSpec) (bool, error)
+	runsOnSharedWorkersMutex       sync.RWMutex
+	runsOnSharedWorkersArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}
+	runsOnSharedWorkersReturns struct {
+		result1 bool
+		result2 error
+	}
+	runsOnSharedWorkersReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -259,6 +273,70 @@ func (fake *FakePool) FindOrChooseWorkerForContainerReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakePool) RunsOnSharedWorkers(arg1 lager.Logger, arg2 worker.WorkerSpec) (bool, error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	ret, specificReturn := fake.runsOnSharedWorkersReturnsOnCall[len(fake.runsOnSharedWorkersArgsForCall)]
+	fake.runsOnSharedWorkersArgsForCall = append(fake.runsOnSharedWorkersArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+	}{arg1, arg2})
+	fake.recordInvocation("RunsOnSharedWorkers", []interface{}{arg1, arg2})
+	fake.runsOnSharedWorkersMutex.Unlock()
+	if fake.RunsOnSharedWorkersStub != nil {
+		return fake.RunsOnSharedWorkersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runsOnSharedWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePool) RunsOnSharedWorkersCallCount() int {
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
+	return len(fake.runsOnSharedWorkersArgsForCall)
+}
+
+func (fake *FakePool) RunsOnSharedWorkersCalls(stub func(lager.Logger, worker.WorkerSpec) (bool, error)) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = stub
+}
+
+func (fake *FakePool) RunsOnSharedWorkersArgsForCall(i int) (lager.Logger, worker.WorkerSpec) {
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
+	argsForCall := fake.runsOnSharedWorkersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePool) RunsOnSharedWorkersReturns(result1 bool, result2 error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = nil
+	fake.runsOnSharedWorkersReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePool) RunsOnSharedWorkersReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.runsOnSharedWorkersMutex.Lock()
+	defer fake.runsOnSharedWorkersMutex.Unlock()
+	fake.RunsOnSharedWorkersStub = nil
+	if fake.runsOnSharedWorkersReturnsOnCall == nil {
+		fake.runsOnSharedWorkersReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.runsOnSharedWorkersReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePool) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -268,6 +346,8 @@ func (fake *FakePool) Invocations() map[string][][]interface{} {
 	defer fake.findOrChooseWorkerMutex.RUnlock()
 	fake.findOrChooseWorkerForContainerMutex.RLock()
 	defer fake.findOrChooseWorkerForContainerMutex.RUnlock()
+	fake.runsOnSharedWorkersMutex.RLock()
+	defer fake.runsOnSharedWorkersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
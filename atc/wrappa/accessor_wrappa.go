@@ -12,12 +12,14 @@ func NewAccessorWrappa(
 	accessFactory accessor.AccessFactory,
 	auditor auditor.Auditor,
 	customRoles map[string]string,
+	roleActions accessor.RoleActions,
 ) *AccessorWrappa {
 	return &AccessorWrappa{
 		logger:        logger,
 		accessFactory: accessFactory,
 		auditor:       auditor,
 		customRoles:   customRoles,
+		roleActions:   roleActions,
 	}
 }
 
@@ -26,6 +28,7 @@ type AccessorWrappa struct {
 	accessFactory accessor.AccessFactory
 	auditor       auditor.Auditor
 	customRoles   map[string]string
+	roleActions   accessor.RoleActions
 }
 
 func (w *AccessorWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
@@ -39,6 +42,7 @@ func (w *AccessorWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			w.accessFactory,
 			w.auditor,
 			w.customRoles,
+			w.roleActions,
 		)
 	}
 
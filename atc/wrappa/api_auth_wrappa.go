@@ -45,11 +45,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
-			atc.ListBuildArtifacts:
+			atc.ListBuildArtifacts,
+			atc.ListBuildApprovals:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.ApproveBuild:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -71,6 +73,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
+			atc.GetPipelineDeliveryMetrics,
+			atc.ListPipelineBlockedBuilds,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
 			atc.ListBuildsWithVersionAsOutput,
@@ -96,7 +100,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes,
-			atc.GetUser:
+			atc.GetUser,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeSessions,
+			atc.ListPolicyExemptions,
+			atc.ListStaleResources:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		// unauthenticated / delegating to handler (validate token if provided)
@@ -118,7 +127,17 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.CreatePolicyExemption,
+			atc.DeletePolicyExemption,
+			atc.ListAuditEvents,
+			atc.ListLocalUsers,
+			atc.CreateLocalUser,
+			atc.SetLocalUserPassword,
+			atc.DisableLocalUser,
+			atc.EnableLocalUser,
+			atc.ListUserSessions,
+			atc.RevokeUserSessions:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -137,6 +156,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.ExplainJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
@@ -150,7 +170,11 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.DeleteAPIToken,
+			atc.ListTeamAuditEvents:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
@@ -125,9 +125,11 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
+				atc.ListBuildApprovals:  checksIfPrivateJob(inputHandlers[atc.ListBuildApprovals]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:   checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.ApproveBuild: checkWritePermissionForBuild(inputHandlers[atc.ApproveBuild]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -147,6 +149,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
+				atc.GetPipelineDeliveryMetrics:    openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipelineDeliveryMetrics]),
+				atc.ListPipelineBlockedBuilds:     openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBlockedBuilds]),
 				atc.GetResource:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResource]),
 				atc.ListBuildsWithVersionAsInput:  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListBuildsWithVersionAsInput]),
 				atc.ListBuildsWithVersionAsOutput: openForPublicPipelineOrAuthorized(inputHandlers[atc.ListBuildsWithVersionAsOutput]),
@@ -173,6 +177,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
 
+				atc.ListSessions:   authenticated(inputHandlers[atc.ListSessions]),
+				atc.RevokeSession:  authenticated(inputHandlers[atc.RevokeSession]),
+				atc.RevokeSessions: authenticated(inputHandlers[atc.RevokeSessions]),
+
+				atc.ListPolicyExemptions: authenticated(inputHandlers[atc.ListPolicyExemptions]),
+				atc.ListStaleResources:   authenticated(inputHandlers[atc.ListStaleResources]),
+
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
 				atc.DownloadCLI:          authenticateIfTokenProvided(inputHandlers[atc.DownloadCLI]),
@@ -194,6 +205,19 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SetWall:              authenticatedAndAdmin(inputHandlers[atc.SetWall]),
 				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
 
+				atc.CreatePolicyExemption: authenticatedAndAdmin(inputHandlers[atc.CreatePolicyExemption]),
+				atc.DeletePolicyExemption: authenticatedAndAdmin(inputHandlers[atc.DeletePolicyExemption]),
+
+				atc.ListAuditEvents:      authenticatedAndAdmin(inputHandlers[atc.ListAuditEvents]),
+				atc.ListLocalUsers:       authenticatedAndAdmin(inputHandlers[atc.ListLocalUsers]),
+				atc.CreateLocalUser:      authenticatedAndAdmin(inputHandlers[atc.CreateLocalUser]),
+				atc.SetLocalUserPassword: authenticatedAndAdmin(inputHandlers[atc.SetLocalUserPassword]),
+				atc.DisableLocalUser:     authenticatedAndAdmin(inputHandlers[atc.DisableLocalUser]),
+				atc.EnableLocalUser:      authenticatedAndAdmin(inputHandlers[atc.EnableLocalUser]),
+
+				atc.ListUserSessions:   authenticatedAndAdmin(inputHandlers[atc.ListUserSessions]),
+				atc.RevokeUserSessions: authenticatedAndAdmin(inputHandlers[atc.RevokeUserSessions]),
+
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
@@ -209,6 +233,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
 				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
+				atc.ExplainJobInputs:        authorized(inputHandlers[atc.ExplainJobInputs]),
 				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
 				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
 				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
@@ -224,6 +249,10 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.ListAPITokens:           authorized(inputHandlers[atc.ListAPITokens]),
+				atc.CreateAPIToken:          authorized(inputHandlers[atc.CreateAPIToken]),
+				atc.DeleteAPIToken:          authorized(inputHandlers[atc.DeleteAPIToken]),
+				atc.ListTeamAuditEvents:     authorized(inputHandlers[atc.ListTeamAuditEvents]),
 			}
 		})
 
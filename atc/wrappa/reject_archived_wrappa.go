@@ -49,6 +49,8 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
+			atc.ListBuildApprovals,
+			atc.ApproveBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
@@ -64,6 +66,8 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
+			atc.GetPipelineDeliveryMetrics,
+			atc.ListPipelineBlockedBuilds,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
 			atc.ListBuildsWithVersionAsOutput,
@@ -95,6 +99,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListPipelines,
 			atc.ListAllJobs,
 			atc.ListAllResources,
+			atc.ListStaleResources,
 			atc.ListTeams,
 			atc.MainJobBadge,
 			atc.GetWall,
@@ -104,10 +109,14 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListActiveUsersSince,
 			atc.SetWall,
 			atc.ClearWall,
+			atc.ListPolicyExemptions,
+			atc.CreatePolicyExemption,
+			atc.DeletePolicyExemption,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.ExplainJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.ArchivePipeline,
@@ -119,7 +128,22 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.DeleteAPIToken,
+			atc.ListAuditEvents,
+			atc.ListTeamAuditEvents,
+			atc.ListLocalUsers,
+			atc.CreateLocalUser,
+			atc.SetLocalUserPassword,
+			atc.DisableLocalUser,
+			atc.EnableLocalUser,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeSessions,
+			atc.ListUserSessions,
+			atc.RevokeUserSessions:
 
 		default:
 			panic("how do archived pipelines affect your endpoint?")
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type ApproveBuildCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to approve"`
+	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to approve. If job not specified: build id"`
+	Step  string              `short:"s" long:"step" description:"Name of the manual_approval step to approve, if the build is awaiting more than one"`
+}
+
+func (command *ApproveBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	buildID := strconv.Itoa(build.ID)
+
+	approvals, err := target.Client().ListBuildApprovals(buildID)
+	if err != nil {
+		return err
+	}
+
+	var pending []atc.BuildApproval
+	for _, approval := range approvals {
+		if approval.Approved() {
+			continue
+		}
+
+		if command.Step != "" && approval.Name != command.Step {
+			continue
+		}
+
+		pending = append(pending, approval)
+	}
+
+	switch {
+	case len(pending) == 0 && command.Step != "":
+		return fmt.Errorf("build is not awaiting approval of step '%s'", command.Step)
+	case len(pending) == 0:
+		return errors.New("build is not awaiting approval")
+	case len(pending) > 1:
+		names := []string{}
+		for _, approval := range pending {
+			names = append(names, approval.Name)
+		}
+
+		return fmt.Errorf("build is awaiting approval of several steps, specify one with --step: %s", strings.Join(names, ", "))
+	}
+
+	approval := pending[0]
+
+	err = target.Client().ApproveBuild(buildID, approval.ID)
+	if err == concourse.ErrForbidden {
+		return fmt.Errorf("not allowed to approve '%s': it must be approved by a user with the role '%s' who did not trigger the build", approval.Name, approval.Role)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("approved '%s'\n", approval.Name)
+	return nil
+}
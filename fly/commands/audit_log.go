@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditLogCommand struct {
+	AllTeams bool   `short:"a" long:"all-teams" description:"Show the audit events of every team (admins only)"`
+	Team     string `long:"team" description:"Name of the team to show the audit events of, if different from the target default"`
+	Actor    string `long:"actor" description:"Only show the events of this user"`
+	Action   string `long:"action" description:"Only show the events of this action, e.g. SaveConfig"`
+	Since    string `long:"since" description:"Start of the range to filter events"`
+	Until    string `long:"until" description:"End of the range to filter events"`
+	Count    int    `short:"c" long:"count" default:"50" description:"Number of events you want to limit the return to"`
+	Json     bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditLogCommand) Execute([]string) error {
+	if command.AllTeams && command.Team != "" {
+		return errors.New("Cannot specify both --all-teams and --team")
+	}
+
+	filter := concourse.AuditEventFilter{
+		Actor:  command.Actor,
+		Action: command.Action,
+	}
+
+	if command.Since != "" {
+		since, err := time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+		filter.Since = since
+	}
+
+	if command.Until != "" {
+		until, err := time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+		filter.Until = until
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	page := concourse.Page{Limit: command.Count}
+
+	var events []atc.AuditEvent
+	if command.AllTeams {
+		events, _, err = target.Client().AuditEvents(filter, page)
+	} else {
+		var team concourse.Team
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team)
+			if err != nil {
+				return err
+			}
+		} else {
+			team = target.Team()
+		}
+
+		events, _, err = team.AuditEvents(filter, page)
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(events)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "time", Color: color.New(color.Bold)},
+		{Contents: "team", Color: color.New(color.Bold)},
+		{Contents: "actor", Color: color.New(color.Bold)},
+		{Contents: "action", Color: color.New(color.Bold)},
+		{Contents: "object", Color: color.New(color.Bold)},
+		{Contents: "policy", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, event := range events {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(event.ID)},
+			timestampCell(event.Time, "n/a"),
+			optionalCell(event.Team),
+			optionalCell(event.Actor),
+			{Contents: event.Action},
+			optionalCell(event.Object),
+			policyDecisionCell(event.Policy),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func policyDecisionCell(decision *atc.AuditPolicyDecision) ui.TableCell {
+	if decision == nil {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	if decision.ExemptionID != 0 {
+		return ui.TableCell{Contents: fmt.Sprintf("exempted by %s (#%d)", decision.ExemptedBy, decision.ExemptionID)}
+	}
+
+	return ui.TableCell{Contents: decision.Severity}
+}
@@ -214,6 +214,9 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 
 		var statusCell ui.TableCell
 		statusCell.Contents = b.Status
+		if b.AwaitingApproval {
+			statusCell.Contents += " (awaiting approval)"
+		}
 
 		switch b.Status {
 		case "pending":
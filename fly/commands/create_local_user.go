@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type CreateLocalUserCommand struct {
+	Username string `short:"u" long:"username" required:"true" description:"Username of the new user"`
+	Password string `short:"p" long:"password" description:"Password of the new user. Prompted for if not given"`
+}
+
+func (command *CreateLocalUserCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	password, err := localUserPassword(command.Password)
+	if err != nil {
+		return err
+	}
+
+	created, err := target.Client().CreateLocalUser(command.Username, password)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created local user '%s'\n", created.Username)
+
+	return nil
+}
+
+// localUserPassword prompts for the password unless it was given as a flag.
+func localUserPassword(password string) (string, error) {
+	if password != "" {
+		return password, nil
+	}
+
+	var interactivePassword interact.Password
+	err := interact.NewInteraction("password").Resolve(interact.Required(&interactivePassword))
+	if err != nil {
+		return "", err
+	}
+
+	return string(interactivePassword), nil
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CreateTokenCommand struct {
+	Name      string        `short:"n" long:"name" required:"true" description:"Name of the token"`
+	Role      string        `short:"r" long:"role" default:"member" description:"Role on the team that the token authenticates with: owner, member, pipeline-operator, viewer or a custom role from the RBAC config"`
+	ExpiresIn time.Duration `short:"e" long:"expires-in" description:"How long until the token expires, e.g. 720h. Never expires by default"`
+	Team      string        `long:"team" description:"Name of the team to create the token on, if different from the target default"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	if command.ExpiresIn < 0 {
+		return errors.New("expires-in must be positive")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	apiToken := atc.APIToken{
+		Name: command.Name,
+		Role: command.Role,
+	}
+
+	if command.ExpiresIn > 0 {
+		apiToken.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	created, err := team.CreateAPIToken(apiToken)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created token '%s' with role '%s' on team '%s'\n", created.Name, created.Role, created.TeamName)
+	fmt.Println("store it now, as it can't be shown again:")
+	fmt.Println()
+	fmt.Println(created.Token)
+
+	return nil
+}
@@ -34,7 +34,7 @@ func (command *CurlCommand) Execute([]string) error {
 		return err
 	}
 
-	argsList := command.makeArgsList(target.Token(), fullUrl, command.Args.Rest)
+	argsList := command.makeArgsList(target.Token(), target.ClientCertPath(), target.ClientKeyPath(), fullUrl, command.Args.Rest)
 
 	cmd := exec.Command("curl", argsList...)
 	cmd.Stdout = os.Stdout
@@ -67,9 +67,14 @@ func (command *CurlCommand) makeFullUrl(host, path string) (string, error) {
 	return u.String(), nil
 }
 
-func (command *CurlCommand) makeArgsList(token *rc.TargetToken, url string, options []string) (args []string) {
-	authTokenHeader := []string{"-H", fmt.Sprintf("Authorization: %s %s", token.Type, token.Value)}
-	args = append(args, authTokenHeader...)
+func (command *CurlCommand) makeArgsList(token *rc.TargetToken, clientCertPath string, clientKeyPath string, url string, options []string) (args []string) {
+	if token != nil && token.Value != "" {
+		authTokenHeader := []string{"-H", fmt.Sprintf("Authorization: %s %s", token.Type, token.Value)}
+		args = append(args, authTokenHeader...)
+	}
+	if clientCertPath != "" {
+		args = append(args, "--cert", clientCertPath, "--key", clientKeyPath)
+	}
 	args = append(args, options...)
 	args = append(args, url)
 	return
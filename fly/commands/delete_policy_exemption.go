@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DeletePolicyExemptionCommand struct {
+	ID int `short:"i" long:"id" required:"true" description:"ID of the exemption to delete"`
+}
+
+func (command *DeletePolicyExemptionCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().DeletePolicyExemption(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("policy exemption %d not found", command.ID)
+	}
+
+	fmt.Printf("deleted policy exemption %d\n", command.ID)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type DeliveryMetricsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to show the delivery metrics of"`
+	Since    time.Duration            `short:"s" long:"since" default:"720h" description:"How far back to look at builds"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *DeliveryMetricsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+	metrics, found, err := team.PipelineDeliveryMetrics(pipelineRef, time.Now().Add(-command.Since))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found", pipelineRef.String())
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(metrics)
+	}
+
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range []string{"job", "deployments", "failures", "change failure rate", "lead time", "time to recover"} {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, job := range metrics.Jobs {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: job.JobName},
+			{Contents: strconv.Itoa(job.Deployments)},
+			{Contents: strconv.Itoa(job.Failures)},
+			{Contents: fmt.Sprintf("%.0f%%", job.ChangeFailureRate*100)},
+			deliveryDurationCell(job.Deployments, job.LeadTime),
+			deliveryDurationCell(job.Failures, job.TimeToRecover),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// deliveryDurationCell shows n/a for a duration which has nothing to be
+// measured from.
+func deliveryDurationCell(count int, seconds float64) ui.TableCell {
+	if count == 0 || seconds == 0 {
+		return ui.TableCell{Contents: "n/a"}
+	}
+
+	return ui.TableCell{Contents: (time.Duration(seconds) * time.Second).String()}
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DisableLocalUserCommand struct {
+	Username string `short:"u" long:"username" required:"true" description:"Username of the user to disable"`
+}
+
+func (command *DisableLocalUserCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().DisableLocalUser(command.Username)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("local user '%s' not found", command.Username)
+	}
+
+	fmt.Printf("disabled local user '%s'\n", command.Username)
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
@@ -11,6 +12,9 @@ type EditTargetCommand struct {
 	NewName rc.TargetName `long:"target-name" description:"Update target name"`
 	Url     string        `short:"u" long:"concourse-url" description:"Update concourse URL"`
 	Team    string        `short:"n" long:"team-name" description:"Update team name"`
+
+	ClientCertPath atc.PathFlag `long:"client-cert" description:"Update the path to the PEM-encoded client certificate file"`
+	ClientKeyPath  atc.PathFlag `long:"client-key" description:"Update the path to the PEM-encoded client key file"`
 }
 
 func (command *EditTargetCommand) Execute([]string) error {
@@ -19,15 +23,21 @@ func (command *EditTargetCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.NewName == "" && command.Url == "" && command.Team == "" {
+	if command.NewName == "" && command.Url == "" && command.Team == "" && command.ClientCertPath == "" && command.ClientKeyPath == "" {
 		return errors.New("error: no attributes specified to update")
 	}
 
+	if (command.ClientCertPath == "") != (command.ClientKeyPath == "") {
+		return errors.New("error: --client-cert and --client-key must be updated together")
+	}
+
 	targetProps := rc.TargetProps{}
 	targetProps.API = command.Url
 	targetProps.TeamName = command.Team
+	targetProps.ClientCertPath = string(command.ClientCertPath)
+	targetProps.ClientKeyPath = string(command.ClientKeyPath)
 
-	if command.Url != "" || command.Team != "" {
+	if command.Url != "" || command.Team != "" || command.ClientCertPath != "" {
 		err = rc.UpdateTargetProps(Fly.Target, targetProps)
 		if err != nil {
 			return err
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type EnableLocalUserCommand struct {
+	Username string `short:"u" long:"username" required:"true" description:"Username of the user to enable"`
+}
+
+func (command *EnableLocalUserCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().EnableLocalUser(command.Username)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("local user '%s' not found", command.Username)
+	}
+
+	fmt.Printf("enabled local user '%s'\n", command.Username)
+
+	return nil
+}
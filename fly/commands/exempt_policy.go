@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ExemptPolicyCommand struct {
+	Team     flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to exempt"`
+	Action   string               `short:"a" long:"action" default:"*" description:"The action to exempt, e.g. RunStep or SaveConfig. Defaults to all actions"`
+	Reason   string               `short:"r" long:"reason" required:"true" description:"Why the team is exempted"`
+	Duration time.Duration        `short:"d" long:"duration" required:"true" description:"How long the exemption lasts, e.g. 24h"`
+}
+
+func (command *ExemptPolicyCommand) Execute([]string) error {
+	if command.Duration <= 0 {
+		return errors.New("duration must be positive")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	exemption, err := target.Client().CreatePolicyExemption(atc.PolicyExemption{
+		Team:      command.Team.Name(),
+		Action:    command.Action,
+		Reason:    command.Reason,
+		ExpiresAt: time.Now().Add(command.Duration).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"exempted team '%s' from policies for '%s' until %s (id: %d)\n",
+		exemption.Team,
+		exemption.Action,
+		time.Unix(exemption.ExpiresAt, 0).Format(time.RFC3339),
+		exemption.ID,
+	)
+
+	return nil
+}
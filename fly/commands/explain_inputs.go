@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ExplainInputsCommand struct {
+	Job  flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to explain the inputs of"`
+	Json bool                `long:"json" description:"Print command result as JSON"`
+	Team string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+}
+
+func (command *ExplainInputsCommand) Execute([]string) error {
+	jobName := command.Job.JobName
+	pipelineRef := command.Job.PipelineRef
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	explanation, found, err := team.ExplainBuildInputsForJob(pipelineRef, jobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found on team %s", pipelineRef.String(), jobName, team.Name())
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(explanation)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	inputsTable := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "input", Color: color.New(color.Bold)},
+			{Contents: "resource", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "passed builds", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, input := range explanation.Inputs {
+		versionCell := ui.TableCell{Contents: presentExplainedVersion(input.Version)}
+		if input.ResolveError != "" {
+			versionCell = ui.TableCell{Contents: input.ResolveError, Color: ui.FailedColor}
+		}
+
+		passedBuilds := []string{}
+		for _, buildID := range input.PassedBuildIDs {
+			passedBuilds = append(passedBuilds, strconv.Itoa(buildID))
+		}
+
+		passedBuildsCell := ui.TableCell{Contents: strings.Join(passedBuilds, ",")}
+		if len(passedBuilds) == 0 {
+			passedBuildsCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		inputsTable.Data = append(inputsTable.Data, ui.TableRow{
+			{Contents: input.Name},
+			{Contents: input.Resource},
+			versionCell,
+			passedBuildsCell,
+		})
+	}
+
+	err = inputsTable.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	stepsTable := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "input", Color: color.New(color.Bold)},
+			{Contents: "job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "detail", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, step := range explanation.Steps {
+		jobCell := ui.TableCell{Contents: step.JobName}
+		if step.JobName == "" {
+			jobCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		buildCell := ui.TableCell{Contents: strconv.Itoa(step.BuildID)}
+		if step.BuildID == 0 {
+			buildCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		stepsTable.Data = append(stepsTable.Data, ui.TableRow{
+			{Contents: step.Event},
+			{Contents: step.Input},
+			jobCell,
+			buildCell,
+			{Contents: presentExplainedVersion(step.Version)},
+			{Contents: step.Detail},
+		})
+	}
+
+	return stepsTable.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func presentExplainedVersion(version atc.Version) string {
+	if len(version) == 0 {
+		return "n/a"
+	}
+
+	return presentMap(version)
+}
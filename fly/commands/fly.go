@@ -26,12 +26,31 @@ type FlyCommand struct {
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
 
+	Sessions       SessionsCommand       `command:"sessions"        alias:"ss" description:"List the sessions in which you, or another user, are logged in"`
+	RevokeSessions RevokeSessionsCommand `command:"revoke-sessions" alias:"rss" description:"Log out of one of your sessions, or every session of another user"`
+
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	PolicyExemptions      PolicyExemptionsCommand      `command:"policy-exemptions"       alias:"pes" description:"List the active policy exemptions"`
+	ExemptPolicy          ExemptPolicyCommand          `command:"exempt-policy"           alias:"xp"  description:"Exempt a team from policies for a limited time"`
+	DeletePolicyExemption DeletePolicyExemptionCommand `command:"delete-policy-exemption" alias:"dpe" description:"Delete a policy exemption before it expires"`
+
+	Tokens      TokensCommand      `command:"tokens"       alias:"tks" description:"List the API tokens of a team"`
+	CreateToken CreateTokenCommand `command:"create-token" alias:"ctk" description:"Create an API token which authenticates with a role on a team"`
+	RevokeToken RevokeTokenCommand `command:"revoke-token" alias:"rtk" description:"Revoke an API token"`
+
+	AuditLog AuditLogCommand `command:"audit-log" alias:"al" description:"List the audit events of a team"`
+
+	LocalUsers             LocalUsersCommand             `command:"local-users"                alias:"lus"  description:"List the local users"`
+	CreateLocalUser        CreateLocalUserCommand        `command:"create-local-user"          alias:"clu"  description:"Create a local user who logs in with a username and password"`
+	ResetLocalUserPassword ResetLocalUserPasswordCommand `command:"reset-local-user-password"  alias:"rlup" description:"Set a new password for a local user"`
+	DisableLocalUser       DisableLocalUserCommand       `command:"disable-local-user"         alias:"dlu"  description:"Prevent a local user from logging in"`
+	EnableLocalUser        EnableLocalUserCommand        `command:"enable-local-user"          alias:"elu"  description:"Allow a disabled local user to log in again"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -40,10 +59,11 @@ type FlyCommand struct {
 	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
 
-	Jobs        JobsCommand        `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
-	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
-	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
-	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	Jobs          JobsCommand          `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
+	PauseJob      PauseJobCommand      `command:"pause-job" alias:"pj" description:"Pause a job"`
+	UnpauseJob    UnpauseJobCommand    `command:"unpause-job" alias:"uj" description:"Unpause a job"`
+	ScheduleJob   ScheduleJobCommand   `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	ExplainInputs ExplainInputsCommand `command:"explain-inputs" alias:"ei" description:"Explain how the next build inputs of a job are resolved, without saving them"`
 
 	Pipelines        PipelinesCommand        `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
@@ -58,6 +78,7 @@ type FlyCommand struct {
 	ValidatePipeline ValidatePipelineCommand `command:"validate-pipeline"   alias:"vp"   description:"Validate a pipeline config"`
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
+	DeliveryMetrics  DeliveryMetricsCommand  `command:"delivery-metrics"    alias:"dm"   description:"Show the lead time, change failure rate and time to recover of a pipeline's jobs"`
 
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
@@ -71,9 +92,10 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds       BuildsCommand       `command:"builds"        alias:"bs"  description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build"   alias:"ab"  description:"Abort a build"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build"   alias:"rb"  description:"Rerun a build"`
+	ApproveBuild ApproveBuildCommand `command:"approve-build" alias:"apb" description:"Approve a manual_approval step of a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -50,7 +52,7 @@ func (command *GetTeamCommand) Execute(args []string) error {
 	table := ui.Table{Headers: headers}
 	for role, auth := range team.Auth() {
 		row := ui.TableRow{
-			{Contents: fmt.Sprintf("%s/%s", team.Name(), role)},
+			{Contents: presentRoleBinding(team.Name(), role, auth)},
 		}
 		var usersCell, groupsCell ui.TableCell
 		hasUsers := len(auth["users"]) != 0
@@ -78,5 +80,72 @@ func (command *GetTeamCommand) Execute(args []string) error {
 		table.Data = append(table.Data, row)
 	}
 	sort.Sort(table.Data)
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	quotas := team.ATCTeam().Quotas
+	if quotas == nil {
+		return nil
+	}
+
+	quotasTable := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "quota", Color: color.New(color.Bold)},
+			{Contents: "limit", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, quota := range presentTeamQuotas(*quotas) {
+		limitCell := ui.TableCell{Contents: quota.limit}
+		if quota.unlimited {
+			limitCell.Color = color.New(color.Faint)
+		}
+
+		quotasTable.Data = append(quotasTable.Data, ui.TableRow{
+			{Contents: quota.name},
+			limitCell,
+		})
+	}
+
+	fmt.Println()
+	return quotasTable.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type presentedQuota struct {
+	name      string
+	limit     string
+	unlimited bool
+}
+
+func presentTeamQuotas(quotas atc.TeamQuotas) []presentedQuota {
+	var presented []presentedQuota
+	for _, quota := range []struct {
+		name  string
+		limit int
+	}{
+		{"max pipelines", quotas.MaxPipelines},
+		{"max running builds", quotas.MaxRunningBuilds},
+		{"max containers", quotas.MaxContainers},
+		{"max volumes", quotas.MaxVolumes},
+	} {
+		if quota.limit == 0 {
+			presented = append(presented, presentedQuota{quota.name, "unlimited", true})
+		} else {
+			presented = append(presented, presentedQuota{quota.name, strconv.Itoa(quota.limit), false})
+		}
+	}
+
+	return presented
+}
+
+func presentRoleBinding(teamName string, key string, auth map[string][]string) string {
+	binding := fmt.Sprintf("%s/%s", teamName, atc.BindingRole(key))
+	if pipelines, scoped := auth[atc.TeamAuthPipelines]; scoped {
+		binding += fmt.Sprintf(" (pipelines: %s)", strings.Join(pipelines, ","))
+	}
+
+	return binding
 }
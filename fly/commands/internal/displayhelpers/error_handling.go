@@ -32,11 +32,36 @@ func ShowErrors(errorHeader string, errorMessages []string) {
 }
 
 func ShowWarnings(warnings []concourse.ConfigWarning) {
+	var deprecations, policyWarnings []concourse.ConfigWarning
+	for _, warning := range warnings {
+		if warning.Type == "policy" {
+			policyWarnings = append(policyWarnings, warning)
+		} else {
+			deprecations = append(deprecations, warning)
+		}
+	}
+
+	if len(policyWarnings) > 0 {
+		fmt.Fprintln(ui.Stderr, "")
+		PrintWarningHeader()
+
+		fmt.Fprintln(ui.Stderr, "policy check:")
+		for _, warning := range policyWarnings {
+			fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
+		}
+
+		fmt.Fprintln(ui.Stderr, "")
+	}
+
+	if len(deprecations) == 0 {
+		return
+	}
+
 	fmt.Fprintln(ui.Stderr, "")
 	PrintDeprecationWarningHeader()
 
 	warningTypes := make(map[string]bool)
-	for _, warning := range warnings {
+	for _, warning := range deprecations {
 		warningTypes[warning.Type] = true
 		fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
 	}
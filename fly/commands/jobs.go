@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
 	"github.com/concourse/concourse/atc"
@@ -16,6 +18,8 @@ type JobsCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
 	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+
+	PendingReasons bool `long:"pending-reasons" description:"Show why each job's next build isn't running"`
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -58,6 +62,17 @@ func (command *JobsCommand) Execute([]string) error {
 	}
 
 	headers = []string{"name", "paused", "status", "next"}
+
+	var pendingReasons map[string]string
+	if command.PendingReasons {
+		pendingReasons, err = command.pendingReasons(team)
+		if err != nil {
+			return err
+		}
+
+		headers = append(headers, "pending reason")
+	}
+
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -115,8 +130,51 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		if command.PendingReasons {
+			if reason, found := pendingReasons[p.Name]; found {
+				row = append(row, ui.TableCell{Contents: reason})
+			} else {
+				row = append(row, ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)})
+			}
+		}
+
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+// pendingReasons maps each job to why its builds aren't running. A reason
+// given for a pending build takes precedence over a worker-selection failure
+// of the job's latest build. Builds waiting in the fair-share queue come with
+// their position in it.
+func (command *JobsCommand) pendingReasons(team concourse.Team) (map[string]string, error) {
+	builds, found, err := team.PipelineBlockedBuilds(command.Pipeline.Ref())
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.New("pipeline not found")
+	}
+
+	reasons := map[string]string{}
+	pending := map[string]bool{}
+	for _, build := range builds {
+		isPending := build.Status == string(atc.StatusPending)
+
+		if pending[build.JobName] || (!isPending && reasons[build.JobName] != "") {
+			continue
+		}
+
+		reason := build.PendingReason
+		if build.QueuePosition > 0 {
+			reason = fmt.Sprintf("%s (position %d)", reason, build.QueuePosition)
+		}
+
+		reasons[build.JobName] = reason
+		pending[build.JobName] = isPending
+	}
+
+	return reasons, nil
+}
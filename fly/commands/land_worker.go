@@ -2,13 +2,15 @@ package commands
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type LandWorkerCommand struct {
-	Worker flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Worker       flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	DrainTimeout time.Duration          `long:"drain-timeout" description:"Duration after which the worker lands even if builds are still running on it"`
 }
 
 func (command *LandWorkerCommand) Execute(args []string) error {
@@ -24,7 +26,7 @@ func (command *LandWorkerCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = target.Client().LandWorker(workerName)
+	err = target.Client().LandWorkerWithDrainTimeout(workerName, command.DrainTimeout)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type LocalUsersCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *LocalUsersCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	users, err := target.Client().ListLocalUsers()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(users)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "username", Color: color.New(color.Bold)},
+		{Contents: "status", Color: color.New(color.Bold)},
+		{Contents: "source", Color: color.New(color.Bold)},
+		{Contents: "created by", Color: color.New(color.Bold)},
+		{Contents: "updated", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, user := range users {
+		status := ui.TableCell{Contents: "enabled"}
+		if user.Disabled {
+			status = ui.TableCell{Contents: "disabled", Color: color.New(color.FgRed)}
+		}
+
+		source := ui.TableCell{Contents: "api"}
+		if user.ReadOnly {
+			source = ui.TableCell{Contents: "flag (read-only)", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: user.Username},
+			status,
+			source,
+			optionalCell(user.CreatedBy),
+			timestampCell(user.UpdatedAt, "n/a"),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
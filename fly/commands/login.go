@@ -33,6 +33,8 @@ type LoginCommand struct {
 	ClientCertPath atc.PathFlag `long:"client-cert" description:"Path to a PEM-encoded client certificate file."`
 	ClientKeyPath  atc.PathFlag `long:"client-key" description:"Path to a PEM-encoded client key file."`
 	OpenBrowser    bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	APIToken       string       `long:"api-token" description:"API token of the team to authenticate with, instead of logging in as a user"`
+	ClientCertAuth bool         `long:"client-cert-auth" description:"Authenticate with the client certificate on every request, instead of logging in as a user"`
 
 	BrowserOnly bool
 }
@@ -98,6 +100,10 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.ClientCertAuth {
+		return command.clientCertAuth(target)
+	}
+
 	var tokenType string
 	var tokenValue string
 
@@ -134,7 +140,9 @@ func (command *LoginCommand) Execute(args []string) error {
 		}
 	}
 
-	if semver.Compare(legacySemver) <= 0 && semver.Compare(devSemver) != 0 {
+	if command.APIToken != "" {
+		tokenType, tokenValue = "Bearer", command.APIToken
+	} else if semver.Compare(legacySemver) <= 0 && semver.Compare(devSemver) != 0 {
 		// Legacy Auth Support
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly, isRawMode)
 	} else {
@@ -156,7 +164,7 @@ func (command *LoginCommand) Execute(args []string) error {
 
 	fmt.Println("")
 
-	err = command.verifyTeamExists(client.URL(), rc.TargetToken{
+	err = command.verifyTeamExists(client.URL(), &rc.TargetToken{
 		Type:  tokenType,
 		Value: tokenValue,
 	}, target.CACert(), target.ClientCertPath(), target.ClientKeyPath())
@@ -177,6 +185,30 @@ func (command *LoginCommand) Execute(args []string) error {
 	)
 }
 
+// clientCertAuth saves a target without a token, so that the web node
+// authenticates every request by its client certificate instead.
+func (command *LoginCommand) clientCertAuth(target rc.Target) error {
+	if target.ClientCertPath() == "" {
+		return errors.New("--client-cert-auth requires --client-cert and --client-key")
+	}
+
+	err := command.verifyTeamExists(target.URL(), nil, target.CACert(), target.ClientCertPath(), target.ClientKeyPath())
+	if err == concourse.ErrUnauthorized {
+		return errors.New("the client certificate was not accepted; is --tls-client-ca configured on the web node?")
+	}
+	if err != nil {
+		return err
+	}
+
+	return command.saveTarget(
+		target.URL(),
+		nil,
+		target.CACert(),
+		target.ClientCertPath(),
+		target.ClientKeyPath(),
+	)
+}
+
 func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string) (string, string, error) {
 
 	oauth2Config := oauth2.Config{
@@ -321,10 +353,7 @@ func (command *LoginCommand) saveTarget(url string, token *rc.TargetToken, caCer
 		url,
 		command.Insecure,
 		command.TeamName,
-		&rc.TargetToken{
-			Type:  token.Type,
-			Value: token.Value,
-		},
+		token,
 		caCert,
 		clientCertPath,
 		clientKeyPath,
@@ -516,13 +545,13 @@ func (command *LoginCommand) legacyAuth(target rc.Target, browserOnly bool, isRa
 	return "", "", nil
 }
 
-func (command *LoginCommand) verifyTeamExists(clientUrl string, token rc.TargetToken, caCert string, clientCertPath string,
+func (command *LoginCommand) verifyTeamExists(clientUrl string, token *rc.TargetToken, caCert string, clientCertPath string,
 	clientKeyPath string) error {
 	verifyTarget, err := rc.NewAuthenticatedTarget("verify",
 		clientUrl,
 		command.TeamName,
 		command.Insecure,
-		&token,
+		token,
 		caCert,
 		clientCertPath,
 		clientKeyPath,
@@ -539,6 +568,9 @@ func (command *LoginCommand) verifyTeamExists(clientUrl string, token rc.TargetT
 	if !userInfo.IsAdmin {
 		if userInfo.Teams != nil {
 			_, ok := userInfo.Teams[command.TeamName]
+			if _, scoped := userInfo.PipelineRoles[command.TeamName]; scoped {
+				ok = true
+			}
 			if !ok {
 				return errors.New("you are not a member of '" + command.TeamName + "' or the team does not exist")
 			}
@@ -8,12 +8,23 @@ import (
 )
 
 type LogoutCommand struct {
-	All bool `short:"a" long:"all" description:"Logout of all targets"`
+	All         bool `short:"a" long:"all" description:"Logout of all targets"`
+	AllSessions bool `long:"all-sessions" description:"Also revoke every session you have on the target, e.g. on other machines"`
 }
 
 func (command *LogoutCommand) Execute(args []string) error {
 
+	if command.AllSessions && (Fly.Target == "" || command.All) {
+		return errors.New("--all-sessions requires --target and can't be combined with --all")
+	}
+
 	if Fly.Target != "" && !command.All {
+		if command.AllSessions {
+			if err := command.revokeSessions(); err != nil {
+				return err
+			}
+		}
+
 		if err := rc.LogoutTarget(Fly.Target); err != nil {
 			return err
 		}
@@ -39,3 +50,24 @@ func (command *LogoutCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *LogoutCommand) revokeSessions() error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	revoked, err := target.Client().RevokeSessions()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("revoked %d session(s)\n", revoked)
+
+	return nil
+}
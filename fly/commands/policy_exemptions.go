@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PolicyExemptionsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *PolicyExemptionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	exemptions, err := target.Client().ListPolicyExemptions()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(exemptions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "team", Color: color.New(color.Bold)},
+		{Contents: "action", Color: color.New(color.Bold)},
+		{Contents: "reason", Color: color.New(color.Bold)},
+		{Contents: "created by", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, exemption := range exemptions {
+		createdBy := ui.TableCell{Contents: exemption.CreatedBy}
+		if createdBy.Contents == "" {
+			createdBy.Contents = "n/a"
+			createdBy.Color = ui.OffColor
+		}
+
+		row := ui.TableRow{
+			{Contents: strconv.Itoa(exemption.ID)},
+			{Contents: exemption.Team},
+			{Contents: exemption.Action},
+			{Contents: exemption.Reason},
+			createdBy,
+			{Contents: time.Unix(exemption.ExpiresAt, 0).Format(time.RFC3339)},
+		}
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
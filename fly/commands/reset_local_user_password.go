@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ResetLocalUserPasswordCommand struct {
+	Username string `short:"u" long:"username" required:"true" description:"Username of the user"`
+	Password string `short:"p" long:"password" description:"New password of the user. Prompted for if not given"`
+}
+
+func (command *ResetLocalUserPasswordCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	password, err := localUserPassword(command.Password)
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().SetLocalUserPassword(command.Username, password)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("local user '%s' not found", command.Username)
+	}
+
+	fmt.Printf("reset the password of local user '%s'\n", command.Username)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RevokeSessionsCommand struct {
+	ID        int    `long:"id" description:"ID of one of your own sessions to revoke, as shown by 'fly sessions'"`
+	User      string `short:"u" long:"user" description:"Revoke every session of another user (admin only)"`
+	Connector string `short:"c" long:"connector" description:"Only revoke the user's sessions on this connector, e.g. 'github' (requires --user)"`
+}
+
+func (command *RevokeSessionsCommand) Execute([]string) error {
+	if (command.ID == 0) == (command.User == "") {
+		displayhelpers.Failf("must specify exactly one of --id or --user")
+	}
+
+	if command.Connector != "" && command.User == "" {
+		displayhelpers.Failf("--connector can only be used with --user")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.User != "" {
+		revoked, err := target.Client().RevokeUserSessions(command.User, command.Connector)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("revoked %d session(s) of '%s'\n", revoked, command.User)
+
+		return nil
+	}
+
+	found, err := target.Client().RevokeSession(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("session %d not found", command.ID)
+	}
+
+	fmt.Printf("revoked session %d\n", command.ID)
+
+	return nil
+}
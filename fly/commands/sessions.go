@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type SessionsCommand struct {
+	User      string `short:"u" long:"user" description:"List the sessions of another user (admin only)"`
+	Connector string `short:"c" long:"connector" description:"Only list the user's sessions on this connector, e.g. 'github' (requires --user)"`
+	Json      bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SessionsCommand) Execute([]string) error {
+	if command.Connector != "" && command.User == "" {
+		displayhelpers.Failf("--connector can only be used with --user")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var sessions []atc.Session
+	if command.User != "" {
+		sessions, err = target.Client().ListUserSessions(command.User, command.Connector)
+	} else {
+		sessions, err = target.Client().ListSessions()
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(sessions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "username", Color: color.New(color.Bold)},
+		{Contents: "connector", Color: color.New(color.Bold)},
+		{Contents: "created", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+		{Contents: "current", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, session := range sessions {
+		current := ui.TableCell{Contents: "no", Color: ui.OffColor}
+		if session.Current {
+			current = ui.TableCell{Contents: "yes", Color: color.New(color.FgGreen)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(session.ID)},
+			{Contents: session.Username},
+			optionalCell(session.Connector),
+			timestampCell(session.CreatedAt, "n/a"),
+			timestampCell(session.ExpiresAt, "never"),
+			current,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
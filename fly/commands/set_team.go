@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sort"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/concourse/concourse/skymarshal/skycmd"
 	"github.com/jessevdk/go-flags"
 	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
 )
 
 func WireTeamConnectors(command *flags.Command) {
@@ -28,7 +30,80 @@ func WireTeamConnectors(command *flags.Command) {
 type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
-	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	PolicyProfile   string               `long:"policy-profile" description:"Policy profile to check the team's actions against (admin only). Overrides 'policy_profile' in the config file"`
+
+	MaxPipelines     *int `long:"max-pipelines" description:"Maximum number of pipelines of the team (admin only). Overrides 'quotas' in the config file, where quotas left out are unlimited"`
+	MaxRunningBuilds *int `long:"max-running-builds" description:"Maximum number of builds of the team running at once (admin only)"`
+	MaxContainers    *int `long:"max-containers" description:"Maximum number of containers of the team (admin only)"`
+	MaxVolumes       *int `long:"max-volumes" description:"Maximum number of volumes of the team (admin only)"`
+
+	AuthFlags skycmd.AuthTeamFlags `group:"Authentication"`
+}
+
+type teamSettings struct {
+	PolicyProfile string          `json:"policy_profile"`
+	Quotas        *atc.TeamQuotas `json:"quotas"`
+}
+
+// settings reads the settings of the team other than its auth from the config
+// file, and applies the flags over them.
+func (command *SetTeamCommand) settings() (teamSettings, error) {
+	var settings teamSettings
+
+	path := command.AuthFlags.Config.Path()
+	if path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return teamSettings{}, err
+		}
+
+		err = yaml.Unmarshal(content, &settings)
+		if err != nil {
+			return teamSettings{}, err
+		}
+	}
+
+	if command.PolicyProfile != "" {
+		settings.PolicyProfile = command.PolicyProfile
+	}
+
+	quotas := func() *atc.TeamQuotas {
+		if settings.Quotas == nil {
+			settings.Quotas = &atc.TeamQuotas{}
+		}
+
+		return settings.Quotas
+	}
+
+	if command.MaxPipelines != nil {
+		quotas().MaxPipelines = *command.MaxPipelines
+	}
+
+	if command.MaxRunningBuilds != nil {
+		quotas().MaxRunningBuilds = *command.MaxRunningBuilds
+	}
+
+	if command.MaxContainers != nil {
+		quotas().MaxContainers = *command.MaxContainers
+	}
+
+	if command.MaxVolumes != nil {
+		quotas().MaxVolumes = *command.MaxVolumes
+	}
+
+	err := atc.ValidatePolicyProfile(settings.PolicyProfile)
+	if err != nil {
+		return teamSettings{}, err
+	}
+
+	if settings.Quotas != nil {
+		err = settings.Quotas.Validate()
+		if err != nil {
+			return teamSettings{}, err
+		}
+	}
+
+	return settings, nil
 }
 
 func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
@@ -64,6 +139,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	settings, err := command.settings()
+	if err != nil {
+		return err
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -78,7 +158,16 @@ func (command *SetTeamCommand) Execute([]string) error {
 		authGroups := authRoles[role]["groups"]
 
 		fmt.Println()
-		fmt.Printf("role %s:\n", ui.Embolden(role))
+		fmt.Printf("role %s:\n", ui.Embolden(atc.BindingRole(role)))
+
+		if pipelines, scoped := authRoles[role][atc.TeamAuthPipelines]; scoped {
+			fmt.Printf("  pipelines:\n")
+			for _, pipeline := range pipelines {
+				fmt.Printf("  - %s\n", pipeline)
+			}
+			fmt.Println()
+		}
+
 		fmt.Printf("  users:\n")
 		if len(authUsers) > 0 {
 			for _, user := range authUsers {
@@ -99,6 +188,19 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if settings.PolicyProfile != "" {
+		fmt.Println()
+		fmt.Printf("policy profile: %s\n", ui.Embolden("%s", settings.PolicyProfile))
+	}
+
+	if settings.Quotas != nil {
+		fmt.Println()
+		fmt.Printf("quotas:\n")
+		for _, quota := range presentTeamQuotas(*settings.Quotas) {
+			fmt.Printf("  %s: %s\n", quota.name, quota.limit)
+		}
+	}
+
 	if len(warnings) > 0 {
 		displayhelpers.ShowWarnings(warnings)
 	}
@@ -116,7 +218,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{
+		Auth:          authRoles,
+		PolicyProfile: settings.PolicyProfile,
+		Quotas:        settings.Quotas,
+	}
 
 	_, created, updated, warnings, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
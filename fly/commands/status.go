@@ -18,7 +18,18 @@ func (c *StatusCommand) Execute([]string) error {
 	tToken := target.Token()
 
 	if tToken == nil || tToken.Value == "" {
-		displayhelpers.Failf("logged out")
+		if target.ClientCertPath() == "" {
+			displayhelpers.Failf("logged out")
+			return nil
+		}
+
+		_, err = target.Client().UserInfo()
+		if err != nil {
+			displayhelpers.FailWithErrorf("the client certificate was not accepted", err)
+			return nil
+		}
+
+		fmt.Println("logged in with client certificate")
 		return nil
 	}
 
@@ -3,8 +3,10 @@ package commands
 import (
 	"os"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/skymarshal/token"
@@ -51,6 +53,10 @@ func getExpirationFromString(ttoken *rc.TargetToken) string {
 		return "n/a"
 	}
 
+	if strings.HasPrefix(ttoken.Value, atc.APITokenPrefix) {
+		return "n/a: api token"
+	}
+
 	expiry, err := token.Factory{}.ParseExpiry(ttoken.Value)
 	if err != nil {
 		return "n/a: invalid token"
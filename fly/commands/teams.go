@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"fmt"
 	"os"
 	"sort"
 
@@ -62,7 +61,7 @@ func (command *TeamsCommand) Execute([]string) error {
 		if command.Details {
 			for role, auth := range t.Auth {
 				row := ui.TableRow{
-					{Contents: fmt.Sprintf("%s/%s", t.Name, role)},
+					{Contents: presentRoleBinding(t.Name, role, auth)},
 				}
 				var usersCell, groupsCell ui.TableCell
 
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Team string `long:"team" description:"Name of the team to list the tokens of, if different from the target default"`
+	Json bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	apiTokens, err := team.APITokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(apiTokens)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "role", Color: color.New(color.Bold)},
+		{Contents: "created by", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+		{Contents: "last used", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, apiToken := range apiTokens {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(apiToken.ID)},
+			{Contents: apiToken.Name},
+			{Contents: apiToken.Role},
+			optionalCell(apiToken.CreatedBy),
+			timestampCell(apiToken.ExpiresAt, "never"),
+			timestampCell(apiToken.LastUsedAt, "never"),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func optionalCell(contents string) ui.TableCell {
+	if contents == "" {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: contents}
+}
+
+func timestampCell(timestamp int64, absent string) ui.TableCell {
+	if timestamp == 0 {
+		return ui.TableCell{Contents: absent, Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: time.Unix(timestamp, 0).Format(time.RFC3339)}
+}
@@ -12,7 +12,8 @@ import (
 )
 
 type UserinfoCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	Json        bool `long:"json" description:"Print command result as JSON"`
+	Permissions bool `short:"p" long:"permissions" description:"Print the actions the user may perform on each team"`
 }
 
 func (command *UserinfoCommand) Execute([]string) error {
@@ -26,6 +27,10 @@ func (command *UserinfoCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.Permissions {
+		return command.showPermissions(target)
+	}
+
 	userinfo, err := target.Client().UserInfo()
 	if err != nil {
 		return err
@@ -64,3 +69,34 @@ func (command *UserinfoCommand) Execute([]string) error {
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func (command *UserinfoCommand) showPermissions(target rc.Target) error {
+	permissions, err := target.Client().UserPermissions()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(permissions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+		},
+	}
+
+	for team, actions := range permissions {
+		for _, action := range actions {
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: team},
+				{Contents: action},
+			})
+		}
+	}
+
+	sort.Sort(table.Data)
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
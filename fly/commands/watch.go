@@ -19,6 +19,7 @@ type WatchCommand struct {
 	Url                      string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp                bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
 	IgnoreEventParsingErrors bool                `long:"ignore-event-parsing-errors"                      description:"Ignore event parsing errors"`
+	ServiceLogs              bool                `long:"service-logs"                                     description:"Print the output of task services alongside the build log"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -117,6 +118,7 @@ func (command *WatchCommand) Execute(args []string) error {
 	renderOptions := eventstream.RenderOptions{
 		ShowTimestamp:            command.Timestamp,
 		IgnoreEventParsingErrors: command.IgnoreEventParsingErrors,
+		ShowServiceLogs:          command.ServiceLogs,
 	}
 
 	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
@@ -144,7 +144,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			{Contents: w.Platform},
 			stringOrDefault(strings.Join(w.Tags, ", ")),
 			stringOrDefault(w.Team),
-			{Contents: w.State},
+			w.stateCell(),
 			w.versionCell(),
 			w.ageCell(),
 		}
@@ -195,6 +195,32 @@ func (w *worker) versionCell() ui.TableCell {
 	return column
 }
 
+func (w *worker) stateCell() ui.TableCell {
+	column := ui.TableCell{Contents: w.State}
+
+	var progress []string
+	if w.DrainingBuilds == 1 {
+		progress = append(progress, "1 build")
+	} else if w.DrainingBuilds > 1 {
+		progress = append(progress, fmt.Sprintf("%d builds", w.DrainingBuilds))
+	}
+
+	if w.DrainDeadline > 0 {
+		remaining := time.Until(time.Unix(w.DrainDeadline, 0)).Round(time.Minute)
+		if remaining > 0 {
+			progress = append(progress, "lands in "+strings.TrimSuffix(remaining.String(), "0s"))
+		} else {
+			progress = append(progress, "lands now")
+		}
+	}
+
+	if len(progress) > 0 {
+		column.Contents += " (" + strings.Join(progress, ", ") + ")"
+	}
+
+	return column
+}
+
 func (w *worker) ageCell() ui.TableCell {
 	var column ui.TableCell
 
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
@@ -14,6 +15,7 @@ import (
 type RenderOptions struct {
 	ShowTimestamp            bool
 	IgnoreEventParsingErrors bool
+	ShowServiceLogs          bool
 }
 
 func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) int {
@@ -37,6 +39,10 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 		switch e := ev.(type) {
 		case event.Log:
+			if atc.PlanID(e.Origin.ID).IsService() && !options.ShowServiceLogs {
+				continue
+			}
+
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "%s", e.Payload)
 
@@ -44,6 +50,20 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1mselected worker:\x1b[0m %s\n", e.WorkerName)
 
+		case event.ContainerUsage:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(
+				dstImpl,
+				"\x1b[1mresource usage:\x1b[0m cpu %.2f cores avg, memory %s peak (%s avg), network %s in / %s out, disk %s read / %s written\n",
+				e.CPUAverage,
+				formatBytes(e.MemoryPeak),
+				formatBytes(e.MemoryAverage),
+				formatBytes(e.NetworkRx),
+				formatBytes(e.NetworkTx),
+				formatBytes(e.BlockIORead),
+				formatBytes(e.BlockIOWrite),
+			)
+
 		case event.InitializeTask:
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1minitializing\x1b[0m\n")
@@ -111,3 +131,19 @@ func isEventParseError(err error) bool {
 	}
 	return false
 }
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -75,6 +75,56 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a Log event is received from a task service", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Log{
+				Origin:  event.Origin{ID: event.OriginID(atc.PlanID("42").Service("postgres"))},
+				Payload: "database system is ready",
+				Time:    time.Now().Unix(),
+			}
+			receivedEvents <- event.Log{
+				Origin:  event.Origin{ID: "42"},
+				Payload: "hello",
+				Time:    time.Now().Unix(),
+			}
+		})
+
+		It("does not print its payload", func() {
+			Expect(out.Contents()).ToNot(ContainSubstring("database system is ready"))
+			Expect(out).To(gbytes.Say("hello"))
+		})
+
+		Context("and service logs are enabled", func() {
+			BeforeEach(func() {
+				options.ShowServiceLogs = true
+			})
+
+			It("prints its payload", func() {
+				Expect(out).To(gbytes.Say("database system is ready"))
+				Expect(out).To(gbytes.Say("hello"))
+			})
+		})
+	})
+
+	Context("when a ContainerUsage event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.ContainerUsage{
+				Time:          time.Now().Unix(),
+				CPUAverage:    0.5,
+				MemoryPeak:    512 * 1024 * 1024,
+				MemoryAverage: 300 * 1024,
+				NetworkRx:     10,
+				NetworkTx:     2048,
+				BlockIORead:   3 * 1024 * 1024,
+				BlockIOWrite:  0,
+			}
+		})
+
+		It("prints a summary of the resource usage", func() {
+			Expect(out).To(gbytes.Say(`resource usage:.* cpu 0.50 cores avg, memory 512.0MiB peak \(300.0KiB avg\), network 10B in / 2.0KiB out, disk 3.0MiB read / 0B written`))
+		})
+	})
+
 	Context("when an Error event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.Error{
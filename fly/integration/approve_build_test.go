@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ApproveBuild", func() {
+	var (
+		expectedBuild = atc.Build{
+			ID:      23,
+			Name:    "42",
+			Status:  "started",
+			JobName: "my-job",
+			APIURL:  "api/v1/builds/23",
+		}
+
+		approvals []atc.BuildApproval
+		args      []string
+	)
+
+	BeforeEach(func() {
+		approvals = []atc.BuildApproval{
+			{ID: 1, Name: "deploy", Role: "member", ApprovedBy: "someone", ApprovedAt: 100},
+			{ID: 2, Name: "release", Role: "owner"},
+		}
+
+		args = []string{"-t", targetName, "approve-build", "-j", "my-pipeline/my-job", "-b", "42"}
+	})
+
+	JustBeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/builds/23/approvals"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, approvals),
+			),
+		)
+	})
+
+	Context("when the build is awaiting a single approval", func() {
+		var approveStatus int
+
+		BeforeEach(func() {
+			approveStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/2"),
+					ghttp.RespondWith(approveStatus, ""),
+				),
+			)
+		})
+
+		It("approves the pending step", func() {
+			Expect(func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("approved 'release'"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(4))
+		})
+
+		Context("when the user may not approve it", func() {
+			BeforeEach(func() {
+				approveStatus = http.StatusForbidden
+			})
+
+			It("explains who may approve it", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("error: not allowed to approve 'release': it must be approved by a user with the role 'owner' who did not trigger the build"))
+			})
+		})
+	})
+
+	Context("when the build is awaiting several approvals", func() {
+		BeforeEach(func() {
+			approvals = append(approvals, atc.BuildApproval{ID: 3, Name: "publish", Role: "member"})
+		})
+
+		It("asks the user to pick a step", func() {
+			sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("error: build is awaiting approval of several steps, specify one with --step: release, publish"))
+		})
+
+		Context("when a step is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "--step", "publish")
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/3"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("approves that step", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("approved 'publish'"))
+			})
+		})
+	})
+
+	Context("when the build is not awaiting approval", func() {
+		BeforeEach(func() {
+			approvals = approvals[:1]
+		})
+
+		It("errors", func() {
+			sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("error: build is not awaiting approval"))
+		})
+	})
+})
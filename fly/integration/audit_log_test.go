@@ -0,0 +1,148 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-log", func() {
+		var (
+			flyCmd    *exec.Cmd
+			eventTime int64
+			events    []atc.AuditEvent
+		)
+
+		BeforeEach(func() {
+			eventTime = time.Date(2020, 10, 23, 18, 10, 13, 0, time.UTC).Unix()
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit-log")
+
+			events = []atc.AuditEvent{
+				{
+					ID:     2,
+					Time:   eventTime,
+					Actor:  "some-user",
+					Action: atc.SaveConfig,
+					Team:   "main",
+					Object: "pipeline:some-pipeline",
+					Policy: &atc.AuditPolicyDecision{
+						Severity: "audit",
+						Reasons:  []string{"some-reason"},
+					},
+				},
+				{
+					ID:     1,
+					Time:   eventTime,
+					Action: atc.CreateBuild,
+					Team:   "main",
+					Policy: &atc.AuditPolicyDecision{
+						Severity:    "deny",
+						ExemptionID: 42,
+						ExemptedBy:  "some-admin",
+					},
+				},
+			}
+		})
+
+		Context("for the target's team", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/audit_events", "action=SaveConfig&actor=some-user&limit=50"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events),
+					),
+				)
+
+				flyCmd.Args = append(flyCmd.Args, "--actor", "some-user", "--action", "SaveConfig")
+			})
+
+			It("lists the events in a table", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				formattedTime := time.Unix(eventTime, 0).Format(time.RFC3339)
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "actor", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "object", Color: color.New(color.Bold)},
+						{Contents: "policy", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: formattedTime}, {Contents: "main"}, {Contents: "some-user"}, {Contents: "SaveConfig"}, {Contents: "pipeline:some-pipeline"}, {Contents: "audit"}},
+						{{Contents: "1"}, {Contents: formattedTime}, {Contents: "main"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "CreateBuild"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "exempted by some-admin (#42)"}},
+					},
+				}))
+			})
+		})
+
+		Context("when --all-teams is given", func() {
+			var status int
+
+			BeforeEach(func() {
+				status = http.StatusOK
+				flyCmd.Args = append(flyCmd.Args, "--all-teams", "-c", "5")
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit_events", "limit=5"),
+						ghttp.RespondWithJSONEncodedPtr(&status, &events),
+					),
+				)
+			})
+
+			It("lists the events of every team", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("SaveConfig"))
+			})
+
+			Context("when the user is not an admin", func() {
+				BeforeEach(func() {
+					status = http.StatusForbidden
+				})
+
+				It("errors", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("forbidden"))
+				})
+			})
+		})
+
+		Context("when both --all-teams and --team are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--all-teams", "--team", "other-team")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Cannot specify both --all-teams and --team"))
+			})
+		})
+	})
+})
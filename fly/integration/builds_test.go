@@ -270,6 +270,17 @@ var _ = Describe("Fly CLI", func() {
 				Eventually(session).Should(gexec.Exit(0))
 			})
 
+			Context("when a build is awaiting approval", func() {
+				BeforeEach(func() {
+					returnedBuilds[0].AwaitingApproval = true
+				})
+
+				It("shows it in the status", func() {
+					Eventually(session.Out).Should(gbytes.Say(`62\s+started \(awaiting approval\)`))
+					Eventually(session).Should(gexec.Exit(0))
+				})
+			})
+
 			Context("when the api returns an error", func() {
 				BeforeEach(func() {
 					returnedStatusCode = http.StatusInternalServerError
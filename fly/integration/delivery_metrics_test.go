@@ -0,0 +1,193 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("delivery-metrics", func() {
+		var (
+			flyCmd  *exec.Cmd
+			since   time.Time
+			metrics atc.DeliveryMetrics
+		)
+
+		expectedURL := "/api/v1/teams/main/pipelines/pipeline/delivery-metrics"
+
+		verifySince := func(expected time.Duration) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				unix, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+				Expect(err).ToNot(HaveOccurred())
+
+				since = time.Unix(unix, 0)
+				Expect(since).To(BeTemporally("~", time.Now().Add(-expected), time.Minute))
+			}
+		}
+
+		BeforeEach(func() {
+			since = time.Time{}
+
+			metrics = atc.DeliveryMetrics{
+				Since: 1600000000,
+				Jobs: []atc.JobDeliveryMetrics{
+					{
+						TeamName:          "main",
+						PipelineName:      "pipeline",
+						JobName:           "deploy",
+						Deployments:       4,
+						Failures:          1,
+						ChangeFailureRate: 0.2,
+						LeadTime:          5400,
+						TimeToRecover:     600,
+					},
+					{
+						TeamName:     "main",
+						PipelineName: "pipeline",
+						JobName:      "idle",
+					},
+				},
+			}
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "delivery-metrics", "-p", "pipeline")
+		})
+
+		Context("when not specifying a pipeline name", func() {
+			It("fails and says you should give a pipeline name", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "delivery-metrics")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+			})
+		})
+
+		Context("when the metrics are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						verifySince(30*24*time.Hour),
+						ghttp.RespondWithJSONEncoded(200, metrics),
+					),
+				)
+			})
+
+			It("shows the metrics of each job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "job", Color: color.New(color.Bold)},
+						{Contents: "deployments", Color: color.New(color.Bold)},
+						{Contents: "failures", Color: color.New(color.Bold)},
+						{Contents: "change failure rate", Color: color.New(color.Bold)},
+						{Contents: "lead time", Color: color.New(color.Bold)},
+						{Contents: "time to recover", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "deploy"}, {Contents: "4"}, {Contents: "1"}, {Contents: "20%"}, {Contents: "1h30m0s"}, {Contents: "10m0s"}},
+						{{Contents: "idle"}, {Contents: "0"}, {Contents: "0"}, {Contents: "0%"}, {Contents: "n/a"}, {Contents: "n/a"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the metrics as json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"since": 1600000000,
+						"jobs": [
+							{
+								"team_name": "main",
+								"pipeline_name": "pipeline",
+								"job_name": "deploy",
+								"deployments": 4,
+								"failures": 1,
+								"change_failure_rate": 0.2,
+								"lead_time": 5400,
+								"time_to_recover": 600
+							},
+							{
+								"team_name": "main",
+								"pipeline_name": "pipeline",
+								"job_name": "idle",
+								"deployments": 0,
+								"failures": 0,
+								"change_failure_rate": 0,
+								"lead_time": 0,
+								"time_to_recover": 0
+							}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("when --since is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "168h")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						verifySince(7*24*time.Hour),
+						ghttp.RespondWithJSONEncoded(200, metrics),
+					),
+				)
+			})
+
+			It("looks at builds since then", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(since).To(BeTemporally("~", time.Now().Add(-7*24*time.Hour), time.Minute))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,142 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-inputs", func() {
+		var (
+			flyCmd      *exec.Cmd
+			apiPath     string
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			apiPath = "/api/v1/teams/main/pipelines/pipeline/jobs/some-job/inputs/explain"
+			queryParams = "instance_vars=%7B%22branch%22%3A%22master%22%7D"
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "explain-inputs", "-j", "pipeline/branch:master/some-job")
+		})
+
+		Context("when the job exists", func() {
+			var explanation atc.InputsExplanation
+
+			BeforeEach(func() {
+				explanation = atc.InputsExplanation{
+					Resolved: false,
+					Inputs: []atc.ExplainedInput{
+						{Name: "some-input", Resource: "some-resource", Version: atc.Version{"ref": "v1"}, PassedBuildIDs: []int{42, 43}},
+						{Name: "other-input", Resource: "other-resource", ResolveError: "no satisfiable builds from passed jobs found for set of inputs"},
+					},
+					Steps: []atc.InputExplainStep{
+						{Event: "latest", Input: "some-input", Resource: "some-resource", Version: atc.Version{"ref": "v1"}},
+						{Event: "exhausted", Input: "other-input", Resource: "other-resource", JobName: "upstream", Detail: "no satisfiable builds from passed jobs found for set of inputs"},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, explanation),
+					),
+				)
+			})
+
+			It("prints the resolved inputs and the steps taken", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "some-input"}, {Contents: "some-resource"}, {Contents: "ref:v1"}, {Contents: "42,43"}},
+						{{Contents: "other-input"}, {Contents: "other-resource"}, {Contents: "no satisfiable builds from passed jobs found for set of inputs", Color: ui.FailedColor}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "latest"}, {Contents: "some-input"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "ref:v1"}, {Contents: ""}},
+						{{Contents: "exhausted"}, {Contents: "other-input"}, {Contents: "upstream"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a"}, {Contents: "no satisfiable builds from passed jobs found for set of inputs"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the explanation as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"resolved": false,
+						"inputs": [
+							{
+								"name": "some-input",
+								"resource": "some-resource",
+								"version": {"ref": "v1"},
+								"passed_build_ids": [42, 43],
+								"first_occurrence": false
+							},
+							{
+								"name": "other-input",
+								"resource": "other-resource",
+								"first_occurrence": false,
+								"resolve_error": "no satisfiable builds from passed jobs found for set of inputs"
+							}
+						],
+						"steps": [
+							{
+								"event": "latest",
+								"input": "some-input",
+								"resource": "some-resource",
+								"version": {"ref": "v1"}
+							},
+							{
+								"event": "exhausted",
+								"input": "other-input",
+								"resource": "other-resource",
+								"job_name": "upstream",
+								"detail": "no satisfiable builds from passed jobs found for set of inputs"
+							}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline/branch:master/some-job not found on team main"))
+			})
+		})
+	})
+})
@@ -102,6 +102,16 @@ var _ = Describe("Fly CLI", func() {
 					}))
 				})
 
+				It("does not print quotas", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "get-team", "-n", "myTeam")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).ToNot(gbytes.Say("max pipelines"))
+				})
+
 				It("produces structured JSON output if requested", func() {
 					flyCmd := exec.Command(flyPath, "-t", targetName, "get-team", "-n", "myTeam", "--json")
 
@@ -112,6 +122,55 @@ var _ = Describe("Fly CLI", func() {
 					Expect(sess.Out.Contents()).To(MatchJSON(`{"id": 1, "name": "myTeam", "auth": {"owner": {"groups": [], "users": ["local:username"] }}}`))
 				})
 			})
+
+			Context("when the team has quotas", func() {
+				BeforeEach(func() {
+					team.Quotas = &atc.TeamQuotas{MaxPipelines: 10, MaxContainers: 200}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", path),
+							ghttp.RespondWithJSONEncoded(200, team),
+						),
+					)
+				})
+
+				It("prints the quotas after the roles", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "get-team", "-n", "myTeam")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "quota", Color: color.New(color.Bold)},
+							{Contents: "limit", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "max pipelines"}, {Contents: "10"}},
+							{{Contents: "max running builds"}, {Contents: "unlimited", Color: color.New(color.Faint)}},
+							{{Contents: "max containers"}, {Contents: "200"}},
+							{{Contents: "max volumes"}, {Contents: "unlimited", Color: color.New(color.Faint)}},
+						},
+					}))
+				})
+
+				It("includes the quotas in JSON output", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "get-team", "-n", "myTeam", "--json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"id": 1,
+						"name": "myTeam",
+						"auth": {"owner": {"groups": [], "users": ["local:username"]}},
+						"quotas": {"max_pipelines": 10, "max_containers": 200}
+					}`))
+				})
+			})
 		})
 	})
 })
@@ -172,6 +172,102 @@ var _ = Describe("Fly CLI", func() {
 					},
 				}))
 			})
+
+			Context("when --pending-reasons is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--pending-reasons")
+				})
+
+				Context("when the pipeline has blocked builds", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/blocked-builds", "instance_vars=%7B%22branch%22%3A%22master%22%7D"),
+								ghttp.RespondWithJSONEncoded(200, []atc.Build{
+									{ID: 1, JobName: "job-1", Status: "errored", PendingReason: "no workers satisfying: tag 'gpu'"},
+									{ID: 2, JobName: "job-3", Status: "errored", PendingReason: "no workers satisfying: tag 'gpu'"},
+									{ID: 3, JobName: "job-1", Status: "pending", PendingReason: "max in flight reached"},
+									{ID: 4, JobName: "job-1", Status: "pending", PendingReason: "inputs not satisfied"},
+								}),
+							),
+						)
+					})
+
+					It("shows why each job's next build isn't running", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(0))
+
+						Expect(sess.Out).To(PrintTable(ui.Table{
+							Headers: ui.TableRow{
+								{Contents: "name", Color: color.New(color.Bold)},
+								{Contents: "paused", Color: color.New(color.Bold)},
+								{Contents: "status", Color: color.New(color.Bold)},
+								{Contents: "next", Color: color.New(color.Bold)},
+								{Contents: "pending reason", Color: color.New(color.Bold)},
+							},
+							Data: []ui.TableRow{
+								{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "max in flight reached"}},
+								{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+								{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "no workers satisfying: tag 'gpu'"}},
+							},
+						}))
+					})
+				})
+
+				Context("when a build is waiting in the fair-share queue", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/blocked-builds"),
+								ghttp.RespondWithJSONEncoded(200, []atc.Build{
+									{ID: 1, JobName: "job-1", Status: "pending", PendingReason: "waiting for a fair share of the build capacity", QueuePosition: 3},
+								}),
+							),
+						)
+					})
+
+					It("shows the build's position in the queue", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(0))
+
+						Expect(sess.Out).To(PrintTable(ui.Table{
+							Headers: ui.TableRow{
+								{Contents: "name", Color: color.New(color.Bold)},
+								{Contents: "paused", Color: color.New(color.Bold)},
+								{Contents: "status", Color: color.New(color.Bold)},
+								{Contents: "next", Color: color.New(color.Bold)},
+								{Contents: "pending reason", Color: color.New(color.Bold)},
+							},
+							Data: []ui.TableRow{
+								{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "waiting for a fair share of the build capacity (position 3)"}},
+								{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+								{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+							},
+						}))
+					})
+				})
+
+				Context("when the pipeline is not found", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/blocked-builds"),
+								ghttp.RespondWith(404, ""),
+							),
+						)
+					})
+
+					It("errors", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(1))
+
+						Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+					})
+				})
+			})
 		})
 
 		Context("when the api returns an internal server error", func() {
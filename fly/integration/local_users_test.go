@@ -0,0 +1,209 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("local-users", func() {
+		var (
+			flyCmd    *exec.Cmd
+			updatedAt int64
+		)
+
+		BeforeEach(func() {
+			updatedAt = time.Date(2020, 10, 24, 18, 10, 13, 0, time.UTC).Unix()
+			flyCmd = exec.Command(flyPath, "-t", targetName, "local-users")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/local_users"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.LocalUser{
+						{
+							Username:  "some-user",
+							CreatedBy: "some-admin",
+							CreatedAt: updatedAt,
+							UpdatedAt: updatedAt,
+						},
+						{
+							Username:  "disabled-user",
+							Disabled:  true,
+							CreatedAt: updatedAt,
+							UpdatedAt: updatedAt,
+						},
+						{
+							Username: "static-user",
+							ReadOnly: true,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the users in a table", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			formattedTime := time.Unix(updatedAt, 0).Format(time.RFC3339)
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "username", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+					{Contents: "source", Color: color.New(color.Bold)},
+					{Contents: "created by", Color: color.New(color.Bold)},
+					{Contents: "updated", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "some-user"}, {Contents: "enabled"}, {Contents: "api"}, {Contents: "some-admin"}, {Contents: formattedTime}},
+					{{Contents: "disabled-user"}, {Contents: "disabled", Color: color.New(color.FgRed)}, {Contents: "api"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: formattedTime}},
+					{{Contents: "static-user"}, {Contents: "enabled"}, {Contents: "flag (read-only)", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}},
+				},
+			}))
+		})
+	})
+
+	Describe("create-local-user", func() {
+		Context("when the password is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/local_users"),
+						ghttp.VerifyJSON(`{"username":"some-user","password":"some-password"}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.LocalUser{Username: "some-user"}),
+					),
+				)
+			})
+
+			It("creates the user", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-local-user", "-u", "some-user", "-p", "some-password")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("created local user 'some-user'"))
+			})
+		})
+
+		Context("when the user already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/local_users"),
+						ghttp.RespondWith(http.StatusConflict, "a local user with this username already exists"),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-local-user", "-u", "some-user", "-p", "some-password")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("a local user with this username already exists"))
+			})
+		})
+	})
+
+	Describe("reset-local-user-password", func() {
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/password"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "reset-local-user-password", "-u", "some-user", "-p", "new-password")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("local user 'some-user' not found"))
+			})
+		})
+
+		Context("when the user exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/password"),
+						ghttp.VerifyJSON(`{"username":"","password":"new-password"}`),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("resets the password", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "reset-local-user-password", "-u", "some-user", "-p", "new-password")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("reset the password of local user 'some-user'"))
+			})
+		})
+	})
+
+	Describe("disable-local-user", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/disable"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("disables the user", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "disable-local-user", "-u", "some-user")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("disabled local user 'some-user'"))
+		})
+	})
+
+	Describe("enable-local-user", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/local_users/static-user/enable"),
+					ghttp.RespondWith(http.StatusConflict, "this user was added with --add-local-user and can only be changed by restarting the web node"),
+				),
+			)
+		})
+
+		It("shows why a static user can't be changed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "enable-local-user", "-u", "static-user")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("can only be changed by restarting the web node"))
+		})
+	})
+})
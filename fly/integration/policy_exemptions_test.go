@@ -0,0 +1,239 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("policy-exemptions", func() {
+		var (
+			flyCmd    *exec.Cmd
+			expiresAt int64
+		)
+
+		BeforeEach(func() {
+			expiresAt = time.Date(2020, 10, 16, 18, 10, 13, 0, time.UTC).Unix()
+			flyCmd = exec.Command(flyPath, "-t", targetName, "policy-exemptions")
+		})
+
+		Context("when exemptions are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/policy/exemptions"),
+						ghttp.RespondWithJSONEncoded(200, []atc.PolicyExemption{
+							{
+								ID:        1,
+								Team:      "some-team",
+								Action:    "RunStep",
+								Reason:    "migrating",
+								CreatedBy: "some-admin",
+								ExpiresAt: expiresAt,
+							},
+							{
+								ID:        2,
+								Team:      "other-team",
+								Action:    "*",
+								Reason:    "incident",
+								ExpiresAt: expiresAt,
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists them in a table", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				expires := time.Unix(expiresAt, 0).Format(time.RFC3339)
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "reason", Color: color.New(color.Bold)},
+						{Contents: "created by", Color: color.New(color.Bold)},
+						{Contents: "expires", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "1"}, {Contents: "some-team"}, {Contents: "RunStep"}, {Contents: "migrating"}, {Contents: "some-admin"}, {Contents: expires}},
+						{{Contents: "2"}, {Contents: "other-team"}, {Contents: "*"}, {Contents: "incident"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: expires}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"id": 1, "team": "some-team", "action": "RunStep", "reason": "migrating", "created_by": "some-admin", "expires_at": 1602871813},
+						{"id": 2, "team": "other-team", "action": "*", "reason": "incident", "expires_at": 1602871813}
+					]`))
+				})
+			})
+		})
+
+		Context("when the API fails", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/policy/exemptions"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+
+	Describe("exempt-policy", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "exempt-policy", "-n", "some-team", "-a", "RunStep", "-r", "migrating", "-d", "24h")
+		})
+
+		Context("when the exemption is created", func() {
+			var received atc.PolicyExemption
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/policy/exemptions"),
+						func(w http.ResponseWriter, r *http.Request) {
+							err := json.NewDecoder(r.Body).Decode(&received)
+							Expect(err).NotTo(HaveOccurred())
+						},
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.PolicyExemption{
+							ID:        42,
+							Team:      "some-team",
+							Action:    "RunStep",
+							Reason:    "migrating",
+							ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
+						}),
+					),
+				)
+			})
+
+			It("creates an exemption expiring after the duration", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("exempted team 'some-team' from policies for 'RunStep' until .* \\(id: 42\\)"))
+
+				Expect(received.Team).To(Equal("some-team"))
+				Expect(received.Action).To(Equal("RunStep"))
+				Expect(received.Reason).To(Equal("migrating"))
+				Expect(received.ExpiresAt).To(BeNumerically("~", time.Now().Add(24*time.Hour).Unix(), 60))
+			})
+		})
+
+		Context("when the exemption is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/policy/exemptions"),
+						ghttp.RespondWith(http.StatusBadRequest, "action must be specified"),
+					),
+				)
+			})
+
+			It("prints the error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("action must be specified"))
+			})
+		})
+
+		Context("when no reason is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "exempt-policy", "-n", "some-team", "-d", "24h")
+			})
+
+			It("fails and says you should provide a reason", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("r", "reason") + "' was not specified"))
+			})
+		})
+	})
+
+	Describe("delete-policy-exemption", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "delete-policy-exemption", "-i", "42")
+		})
+
+		Context("when the exemption exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/policy/exemptions/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes it", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("deleted policy exemption 42"))
+			})
+		})
+
+		Context("when the exemption does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/policy/exemptions/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("policy exemption 42 not found"))
+			})
+		})
+	})
+})
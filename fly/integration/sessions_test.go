@@ -0,0 +1,228 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("sessions", func() {
+		var (
+			flyCmd    *exec.Cmd
+			createdAt int64
+			expiresAt int64
+		)
+
+		BeforeEach(func() {
+			createdAt = time.Date(2020, 10, 24, 18, 10, 13, 0, time.UTC).Unix()
+			expiresAt = time.Date(2020, 10, 25, 18, 10, 13, 0, time.UTC).Unix()
+		})
+
+		Context("when listing your own sessions", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "sessions")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/user/sessions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{
+							{ID: 2, Username: "some-user", Connector: "github", CreatedAt: createdAt, ExpiresAt: expiresAt, Current: true},
+							{ID: 1, Username: "some-user", Connector: "github", CreatedAt: createdAt, ExpiresAt: expiresAt},
+						}),
+					),
+				)
+			})
+
+			It("lists the sessions in a table", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				created := time.Unix(createdAt, 0).Format(time.RFC3339)
+				expires := time.Unix(expiresAt, 0).Format(time.RFC3339)
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "username", Color: color.New(color.Bold)},
+						{Contents: "connector", Color: color.New(color.Bold)},
+						{Contents: "created", Color: color.New(color.Bold)},
+						{Contents: "expires", Color: color.New(color.Bold)},
+						{Contents: "current", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "some-user"}, {Contents: "github"}, {Contents: created}, {Contents: expires}, {Contents: "yes", Color: color.New(color.FgGreen)}},
+						{{Contents: "1"}, {Contents: "some-user"}, {Contents: "github"}, {Contents: created}, {Contents: expires}, {Contents: "no", Color: ui.OffColor}},
+					},
+				}))
+			})
+		})
+
+		Context("when listing another user's sessions", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "sessions", "--user", "other-user", "--connector", "local", "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/users/other-user/sessions", "connector=local"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{
+							{ID: 1, Username: "other-user", Connector: "local", CreatedAt: createdAt, ExpiresAt: expiresAt},
+						}),
+					),
+				)
+			})
+
+			It("prints the sessions as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 1,
+						"username": "other-user",
+						"connector": "local",
+						"created_at": 1603563013,
+						"expires_at": 1603649413
+					}
+				]`))
+			})
+		})
+
+		Context("when --connector is given without --user", func() {
+			It("fails", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "sessions", "--connector", "local")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--connector can only be used with --user"))
+			})
+		})
+	})
+
+	Describe("revoke-sessions", func() {
+		Context("when revoking one of your own sessions", func() {
+			var status int
+
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/sessions/42"),
+						ghttp.RespondWithPtr(&status, nil),
+					),
+				)
+			})
+
+			It("revokes the session", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-sessions", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked session 42"))
+			})
+
+			Context("when the session is not found", func() {
+				BeforeEach(func() {
+					status = http.StatusNotFound
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-sessions", "--id", "42")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("session 42 not found"))
+				})
+			})
+		})
+
+		Context("when revoking another user's sessions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/users/other-user/sessions", "connector=github"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Revoked: 3}),
+					),
+				)
+			})
+
+			It("revokes their sessions", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-sessions", "--user", "other-user", "--connector", "github")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked 3 session\\(s\\) of 'other-user'"))
+			})
+		})
+
+		Context("when neither --id nor --user is given", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-sessions")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("must specify exactly one of --id or --user"))
+			})
+		})
+	})
+
+	Describe("logout --all-sessions", func() {
+		Context("when logging out of a target", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/sessions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Revoked: 2}),
+					),
+				)
+			})
+
+			It("revokes every session and logs out of the target", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "logout", "--all-sessions")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked 2 session\\(s\\)"))
+				Expect(sess.Out).To(gbytes.Say("logged out of target: " + targetName))
+			})
+		})
+
+		Context("when combined with --all", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "logout", "--all", "--all-sessions")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--all-sessions requires --target"))
+			})
+		})
+	})
+})
@@ -122,6 +122,31 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting client certificate auth", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_cert_auth.yml"}
+				})
+
+				It("shows the users and groups configured for client certificates for a given role", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role member:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- cert:spiffe://example.org/ns/ci/sa/deployer"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- cert:platform"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-owner"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting github auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"-c", "fixtures/team_config_with_github_auth.yml"}
@@ -293,6 +318,73 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("pipeline-scoped roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_pipeline_roles.yml"}
+			})
+
+			It("shows the pipelines each role is bound on", func() {
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role member:"))
+				Eventually(sess.Out).Should(gbytes.Say("pipelines:"))
+				Eventually(sess.Out).Should(gbytes.Say("- dev-\\*"))
+				Eventually(sess.Out).Should(gbytes.Say("- local:some-developer"))
+
+				Eventually(sess.Out).Should(gbytes.Say("role member:"))
+				Eventually(sess.Out).Should(gbytes.Say("pipelines:"))
+				Eventually(sess.Out).Should(gbytes.Say("- prod-\\*"))
+				Eventually(sess.Out).Should(gbytes.Say("- release"))
+				Eventually(sess.Out).Should(gbytes.Say("- local:some-deployer"))
+
+				Eventually(sess.Out).Should(gbytes.Say("role viewer:"))
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+
+			It("sends a binding for each role", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"viewer": {
+									"users": ["local:some-developer", "local:some-deployer"],
+									"groups": []
+								},
+								"member": {
+									"users": ["local:some-developer"],
+									"groups": [],
+									"pipelines": ["dev-*"]
+								},
+								"member/1": {
+									"users": ["local:some-deployer"],
+									"groups": [],
+									"pipelines": ["prod-*", "release"]
+								}
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("confirmation", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config.yml"}
@@ -403,6 +495,159 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending a policy profile", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_policy_profile.yml"}
+			})
+
+			expectPolicyProfile := func(profile string) {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								}
+							},
+							"policy_profile": "`+profile+`"
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			}
+
+			Context("when the config file sets it", func() {
+				BeforeEach(func() {
+					expectPolicyProfile("strict")
+				})
+
+				It("shows and sends the policy profile", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("policy profile: strict"))
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when --policy-profile is given", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--policy-profile", "default")
+					expectPolicyProfile("default")
+				})
+
+				It("overrides the config file", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("policy profile: default"))
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Describe("sending quotas", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_quotas.yml"}
+			})
+
+			expectQuotas := func(quotas string) {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								}
+							},
+							"quotas": `+quotas+`
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			}
+
+			Context("when the config file sets them", func() {
+				BeforeEach(func() {
+					expectQuotas(`{"max_pipelines": 10, "max_running_builds": 4}`)
+				})
+
+				It("shows and sends the quotas", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("quotas:"))
+					Eventually(sess.Out).Should(gbytes.Say("max pipelines: 10"))
+					Eventually(sess.Out).Should(gbytes.Say("max running builds: 4"))
+					Eventually(sess.Out).Should(gbytes.Say("max containers: unlimited"))
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when quota flags are given", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--max-running-builds", "2", "--max-volumes", "500")
+					expectQuotas(`{"max_pipelines": 10, "max_running_builds": 2, "max_volumes": 500}`)
+				})
+
+				It("overrides the config file", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("max running builds: 2"))
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when a quota is negative", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--max-containers=-1")
+				})
+
+				It("fails without sending the team", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("team quotas must not be negative"))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
@@ -526,6 +771,26 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting client certificate auth", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"--cert-user", "spiffe://example.org/ns/ci/sa/deployer", "--cert-group", "platform"}
+				})
+
+				It("shows the users and groups configured for client certificates", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- cert:spiffe://example.org/ns/ci/sa/deployer"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- cert:platform"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting cf auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"--cf-org", "myorg-1", "--cf-space", "myorg-2:myspace", "--cf-user", "my-username", "--cf-space-guid", "myspace-guid"}
@@ -0,0 +1,223 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("tokens", func() {
+		var (
+			flyCmd   *exec.Cmd
+			lastUsed int64
+		)
+
+		BeforeEach(func() {
+			lastUsed = time.Date(2020, 10, 16, 18, 10, 13, 0, time.UTC).Unix()
+			flyCmd = exec.Command(flyPath, "-t", targetName, "tokens")
+		})
+
+		Context("when tokens are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWithJSONEncoded(200, []atc.APIToken{
+							{
+								ID:         1,
+								Name:       "deployer",
+								TeamName:   "main",
+								Role:       "member",
+								CreatedBy:  "some-owner",
+								LastUsedAt: lastUsed,
+							},
+							{
+								ID:       2,
+								Name:     "dashboard",
+								TeamName: "main",
+								Role:     "viewer",
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists them in a table", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "role", Color: color.New(color.Bold)},
+						{Contents: "created by", Color: color.New(color.Bold)},
+						{Contents: "expires", Color: color.New(color.Bold)},
+						{Contents: "last used", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "1"}, {Contents: "deployer"}, {Contents: "member"}, {Contents: "some-owner"}, {Contents: "never", Color: color.New(color.Faint)}, {Contents: time.Unix(lastUsed, 0).Format(time.RFC3339)}},
+						{{Contents: "2"}, {Contents: "dashboard"}, {Contents: "viewer"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "never", Color: color.New(color.Faint)}, {Contents: "never", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"id": 1, "name": "deployer", "team_name": "main", "role": "member", "created_by": "some-owner", "created_at": 0, "last_used_at": 1602871813},
+						{"id": 2, "name": "dashboard", "team_name": "main", "role": "viewer", "created_at": 0}
+					]`))
+				})
+			})
+		})
+
+		Context("when the API fails", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+
+	Describe("create-token", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "deployer", "-r", "pipeline-operator", "-e", "720h")
+		})
+
+		Context("when the token is created", func() {
+			var received atc.APIToken
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						func(w http.ResponseWriter, r *http.Request) {
+							err := json.NewDecoder(r.Body).Decode(&received)
+							Expect(err).NotTo(HaveOccurred())
+						},
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:       42,
+							Name:     "deployer",
+							TeamName: "main",
+							Role:     "pipeline-operator",
+							Token:    "concourse_api_abc123",
+						}),
+					),
+				)
+			})
+
+			It("creates the token and prints it", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("created token 'deployer' with role 'pipeline-operator' on team 'main'"))
+				Expect(sess.Out).To(gbytes.Say("concourse_api_abc123"))
+
+				Expect(received.Name).To(Equal("deployer"))
+				Expect(received.Role).To(Equal("pipeline-operator"))
+				Expect(received.ExpiresAt).To(BeNumerically("~", time.Now().Add(720*time.Hour).Unix(), 60))
+			})
+		})
+
+		Context("when the token is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWith(http.StatusConflict, "an api token with this name already exists on the team"),
+					),
+				)
+			})
+
+			It("prints the error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("an api token with this name already exists on the team"))
+			})
+		})
+	})
+
+	Describe("revoke-token", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "revoke-token", "-n", "deployer")
+		})
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/deployer"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked token 'deployer'"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/deployer"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("token 'deployer' not found on team main"))
+			})
+		})
+	})
+})
@@ -87,6 +87,46 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --permissions is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--permissions")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/user", "permissions=true"),
+						ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+							"user_name": "test_user",
+							"teams": map[string][]string{
+								"test_team": {"viewer", "pauser"},
+							},
+							"permissions": map[string][]string{
+								"other_team": {"GetPipeline"},
+								"test_team":  {"GetPipeline", "PausePipeline"},
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows the actions the user may perform on each team", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "other_team"}, {Contents: "GetPipeline"}},
+						{{Contents: "test_team"}, {Contents: "GetPipeline"}},
+						{{Contents: "test_team"}, {Contents: "PausePipeline"}},
+					},
+				}))
+			})
+		})
+
 		Context("and the api returns an internal server error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
@@ -30,6 +30,8 @@ var _ = Describe("Fly CLI", func() {
 			worker5StartTime int64
 			worker6StartTime int64
 			worker7StartTime int64
+
+			worker1DrainDeadline int64
 		)
 
 		BeforeEach(func() {
@@ -94,10 +96,12 @@ var _ = Describe("Fly CLI", func() {
 									{Type: "resource-1", Image: "/images/resource-1"},
 									{Type: "resource-2", Image: "/images/resource-2"},
 								},
-								Team:      "team-1",
-								State:     "landing",
-								Version:   "4.5.6",
-								StartTime: worker1StartTime,
+								Team:           "team-1",
+								State:          "landing",
+								Version:        "4.5.6",
+								StartTime:      worker1StartTime,
+								DrainingBuilds: 3,
+								DrainDeadline:  worker1DrainDeadline,
 							},
 							{
 								Name:             "worker-3",
@@ -132,6 +136,7 @@ var _ = Describe("Fly CLI", func() {
 								State:            "retiring",
 								Version:          "4.5.6",
 								StartTime:        worker5StartTime,
+								DrainingBuilds:   1,
 							},
 						}),
 					),
@@ -146,6 +151,8 @@ var _ = Describe("Fly CLI", func() {
 				worker5StartTime = 0
 				worker6StartTime = 0
 				worker7StartTime = time.Now().Unix() + 700*second
+
+				worker1DrainDeadline = time.Now().Unix() + 90*minute + 20*second
 			})
 
 			It("lists them to the user, ordered by name, with outdated and stalled workers grouped together", func() {
@@ -165,10 +172,10 @@ var _ = Describe("Fly CLI", func() {
 						{Contents: "age", Color: color.New(color.Bold)},
 					},
 					Data: []ui.TableRow{
-						{{Contents: "worker-1"}, {Contents: "1"}, {Contents: "platform1"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "landing"}, {Contents: "4.5.6"}, {Contents: "2d"}},
+						{{Contents: "worker-1"}, {Contents: "1"}, {Contents: "platform1"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "landing (3 builds, lands in 1h30m)"}, {Contents: "4.5.6"}, {Contents: "2d"}},
 						{{Contents: "worker-2"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag2, tag3"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "4.5.6"}, {Contents: "1d"}},
 						{{Contents: "worker-3"}, {Contents: "10"}, {Contents: "platform3"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "landed"}, {Contents: "4.5.6"}, {Contents: "10h3m"}},
-						{{Contents: "worker-5"}, {Contents: "5"}, {Contents: "platform5"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "retiring"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+						{{Contents: "worker-5"}, {Contents: "5"}, {Contents: "platform5"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "retiring (1 build)"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}},
 						{{Contents: "worker-6"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "1.2.3", Color: color.New(color.FgRed)}, {Contents: "n/a", Color: color.New(color.Faint)}},
 						{{Contents: "worker-7"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "none", Color: color.New(color.FgRed)}, {Contents: "n/a", Color: color.New(color.Faint)}},
 						{{Contents: "worker-4"}, {Contents: "7"}, {Contents: "platform4"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "stalled"}, {Contents: "4.5.6"}, {Contents: "8h30m"}},
@@ -186,6 +193,8 @@ var _ = Describe("Fly CLI", func() {
 					worker5StartTime = 0
 					worker6StartTime = 0
 					worker7StartTime = 0
+
+					worker1DrainDeadline = 0
 				})
 
 				It("prints response in json as stdout", func() {
@@ -288,7 +297,8 @@ var _ = Describe("Fly CLI", func() {
                 "version": "4.5.6",
                 "start_time": 0,
                 "state": "landing",
-                "ephemeral": false
+                "ephemeral": false,
+                "draining_builds": 3
               },
               {
                 "addr": "3.2.3.4:7777",
@@ -338,7 +348,8 @@ var _ = Describe("Fly CLI", func() {
                 "version": "4.5.6",
                 "start_time": 0,
                 "state": "retiring",
-                "ephemeral": false
+                "ephemeral": false,
+                "draining_builds": 1
               }
             ]`))
 				})
@@ -377,10 +388,10 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "resource types", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "worker-1"}, {Contents: "1"}, {Contents: "platform1"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "landing"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "2.2.3.4:7777"}, {Contents: "http://2.2.3.4:7788"}, {Contents: "1"}, {Contents: "resource-1, resource-2"}},
+							{{Contents: "worker-1"}, {Contents: "1"}, {Contents: "platform1"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "landing (3 builds, lands in 1h30m)"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "2.2.3.4:7777"}, {Contents: "http://2.2.3.4:7788"}, {Contents: "1"}, {Contents: "resource-1, resource-2"}},
 							{{Contents: "worker-2"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag2, tag3"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "1.2.3.4:7777"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "resource-1"}},
 							{{Contents: "worker-3"}, {Contents: "10"}, {Contents: "platform3"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "landed"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "3.2.3.4:7777"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "none", Color: color.New(color.Faint)}},
-							{{Contents: "worker-5"}, {Contents: "5"}, {Contents: "platform5"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "retiring"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "3.2.3.4:7777"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "none", Color: color.New(color.Faint)}},
+							{{Contents: "worker-5"}, {Contents: "5"}, {Contents: "platform5"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "retiring (1 build)"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "3.2.3.4:7777"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "none", Color: color.New(color.Faint)}},
 							{{Contents: "worker-6"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "1.2.3", Color: color.New(color.FgRed)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "5.5.5.5:7777", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "none", Color: color.New(color.Faint)}},
 							{{Contents: "worker-7"}, {Contents: "0"}, {Contents: "platform2"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "running"}, {Contents: "none", Color: color.New(color.FgRed)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "7.7.7.7:7777", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "0"}, {Contents: "none", Color: color.New(color.Faint)}},
 							{{Contents: "worker-4"}, {Contents: "7"}, {Contents: "platform4"}, {Contents: "tag1"}, {Contents: "team-1"}, {Contents: "stalled"}, {Contents: "4.5.6"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "1"}, {Contents: "none", Color: color.New(color.Faint)}},
@@ -97,6 +97,11 @@ func UpdateTargetProps(targetName TargetName, targetProps TargetProps) error {
 		target.TeamName = targetProps.TeamName
 	}
 
+	if targetProps.ClientCertPath != "" {
+		target.ClientCertPath = targetProps.ClientCertPath
+		target.ClientKeyPath = targetProps.ClientKeyPath
+	}
+
 	flyTargets[targetName] = target
 
 	return writeTargets(flyrcPath(), flyTargets)
@@ -147,6 +147,32 @@ var _ = Describe("Targets", func() {
 			})
 		})
 
+		Context("when a client certificate is provided for update", func() {
+			It("should update the client certificate and key paths", func() {
+				targetProps := rc.TargetProps{
+					ClientCertPath: "/some/client.crt",
+					ClientKeyPath:  "/some/client.key",
+				}
+				err := rc.UpdateTargetProps("some-target", targetProps)
+				Expect(err).ToNot(HaveOccurred())
+
+				targets, err := rc.LoadTargets()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(targets).To(Equal(rc.Targets{
+					"some-target": {
+						API:            "http://concourse.com",
+						TeamName:       "main",
+						ClientCertPath: "/some/client.crt",
+						ClientKeyPath:  "/some/client.key",
+						Token: &rc.TargetToken{
+							Type:  "Bearer",
+							Value: "some-token",
+						},
+					},
+				}))
+			})
+		})
+
 		Context("when target name is provided for update", func() {
 			It("should update target name and keep old prop attributes", func() {
 				err := rc.UpdateTargetName("some-target", "some-other-target")
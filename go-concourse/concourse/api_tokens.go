@@ -0,0 +1,75 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) APITokens() ([]atc.APIToken, error) {
+	var apiTokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &apiTokens,
+	})
+	return apiTokens, err
+}
+
+func (team *team) CreateAPIToken(apiToken atc.APIToken) (atc.APIToken, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(apiToken)
+	if err != nil {
+		return atc.APIToken{}, fmt.Errorf("Unable to marshal api token: %s", err)
+	}
+
+	var created atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params:      rata.Params{"team_name": team.Name()},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return created, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict {
+			return atc.APIToken{}, GenericError{Message: e.Body}
+		}
+
+		return atc.APIToken{}, err
+	default:
+		return atc.APIToken{}, err
+	}
+}
+
+func (team *team) DeleteAPIToken(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteAPIToken,
+		Params: rata.Params{
+			"team_name":  team.Name(),
+			"token_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,146 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler API Tokens", func() {
+	Describe("APITokens", func() {
+		var expectedAPITokens []atc.APIToken
+
+		BeforeEach(func() {
+			expectedAPITokens = []atc.APIToken{
+				{
+					ID:        1,
+					Name:      "deployer",
+					TeamName:  "some-team",
+					Role:      "member",
+					CreatedAt: 100,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedAPITokens),
+				),
+			)
+		})
+
+		It("returns the tokens of the team", func() {
+			apiTokens, err := team.APITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiTokens).To(Equal(expectedAPITokens))
+		})
+	})
+
+	Describe("CreateAPIToken", func() {
+		var apiToken atc.APIToken
+
+		BeforeEach(func() {
+			apiToken = atc.APIToken{
+				Name:      "deployer",
+				Role:      "member",
+				ExpiresAt: 200,
+			}
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				created := apiToken
+				created.ID = 42
+				created.TeamName = "some-team"
+				created.Token = "concourse_api_abc"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+						ghttp.VerifyJSONRepresenting(apiToken),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+					),
+				)
+			})
+
+			It("returns the created token", func() {
+				created, err := team.CreateAPIToken(apiToken)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.ID).To(Equal(42))
+				Expect(created.Token).To(Equal("concourse_api_abc"))
+			})
+		})
+
+		Context("when the token is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+						ghttp.RespondWith(http.StatusBadRequest, "name must be specified"),
+					),
+				)
+			})
+
+			It("returns the validation error", func() {
+				_, err := team.CreateAPIToken(apiToken)
+				Expect(err).To(MatchError("name must be specified"))
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+						ghttp.RespondWith(http.StatusConflict, "an api token with this name already exists on the team"),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := team.CreateAPIToken(apiToken)
+				Expect(err).To(MatchError("an api token with this name already exists on the team"))
+			})
+		})
+	})
+
+	Describe("DeleteAPIToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/deployer"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes it", func() {
+				found, err := team.DeleteAPIToken("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/deployer"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.DeleteAPIToken("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package concourse
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// AuditEventFilter limits the audit events returned. Empty fields match every
+// event.
+type AuditEventFilter struct {
+	Team   string
+	Actor  string
+	Action string
+	Since  time.Time
+	Until  time.Time
+}
+
+func (f AuditEventFilter) addQueryParams(query url.Values) {
+	if f.Team != "" {
+		query.Set(atc.AuditQueryTeam, f.Team)
+	}
+
+	if f.Actor != "" {
+		query.Set(atc.AuditQueryActor, f.Actor)
+	}
+
+	if f.Action != "" {
+		query.Set(atc.AuditQueryAction, f.Action)
+	}
+
+	if !f.Since.IsZero() {
+		query.Set(atc.AuditQuerySince, strconv.FormatInt(f.Since.Unix(), 10))
+	}
+
+	if !f.Until.IsZero() {
+		query.Set(atc.AuditQueryUntil, strconv.FormatInt(f.Until.Unix(), 10))
+	}
+}
+
+// AuditEvents lists the audit events of every team, which only admins can do.
+func (client *client) AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	query := page.QueryParams()
+	filter.addQueryParams(query)
+
+	return listAuditEvents(client.connection, internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       query,
+	})
+}
+
+// AuditEvents lists the audit events of the team. The Team of the filter is
+// ignored.
+func (team *team) AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	filter.Team = ""
+
+	query := page.QueryParams()
+	filter.addQueryParams(query)
+
+	return listAuditEvents(team.connection, internal.Request{
+		RequestName: atc.ListTeamAuditEvents,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+		Query: query,
+	})
+}
+
+func listAuditEvents(connection internal.Connection, request internal.Request) ([]atc.AuditEvent, Pagination, error) {
+	var events []atc.AuditEvent
+
+	headers := http.Header{}
+	err := connection.Send(request, &internal.Response{
+		Result:  &events,
+		Headers: &headers,
+	})
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	pagination, err := paginationFromHeaders(headers)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return events, pagination, nil
+}
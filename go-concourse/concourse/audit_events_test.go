@@ -0,0 +1,79 @@
+package concourse_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit Events", func() {
+	var expectedEvents []atc.AuditEvent
+
+	BeforeEach(func() {
+		expectedEvents = []atc.AuditEvent{
+			{
+				ID:     42,
+				Time:   100,
+				Actor:  "some-user",
+				Action: atc.SaveConfig,
+				Team:   "some-team",
+			},
+		}
+	})
+
+	Describe("AuditEvents", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/audit_events", "action=SaveConfig&actor=some-user&limit=1&since=100&team=some-team&to=50"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents, http.Header{
+						"Link": []string{
+							fmt.Sprintf(`<%s/api/v1/audit_events?to=41&limit=1>; rel="next"`, atcServer.URL()),
+						},
+					}),
+				),
+			)
+		})
+
+		It("returns the filtered events and the pagination", func() {
+			events, pagination, err := client.AuditEvents(concourse.AuditEventFilter{
+				Team:   "some-team",
+				Actor:  "some-user",
+				Action: atc.SaveConfig,
+				Since:  time.Unix(100, 0),
+			}, concourse.Page{To: 50, Limit: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal(expectedEvents))
+			Expect(pagination).To(Equal(concourse.Pagination{
+				Next: &concourse.Page{To: 41, Limit: 1},
+			}))
+		})
+	})
+
+	Describe("Team AuditEvents", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/audit_events", "actor=some-user"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+				),
+			)
+		})
+
+		It("returns the events of the team", func() {
+			events, pagination, err := team.AuditEvents(concourse.AuditEventFilter{
+				Team:  "other-team",
+				Actor: "some-user",
+			}, concourse.Page{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal(expectedEvents))
+			Expect(pagination).To(Equal(concourse.Pagination{}))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListBuildApprovals(buildID string) ([]atc.BuildApproval, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var approvals []atc.BuildApproval
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildApprovals,
+		Params:      params,
+	}, &internal.Response{
+		Result: &approvals,
+	})
+
+	return approvals, err
+}
+
+func (client *client) ApproveBuild(buildID string, approvalID int) error {
+	params := rata.Params{
+		"build_id":    buildID,
+		"approval_id": strconv.Itoa(approvalID),
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ApproveBuild,
+		Params:      params,
+	}, nil)
+}
@@ -0,0 +1,76 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Approvals", func() {
+	Describe("ListBuildApprovals", func() {
+		var expectedApprovals []atc.BuildApproval
+
+		BeforeEach(func() {
+			expectedApprovals = []atc.BuildApproval{
+				{
+					ID:          1,
+					PlanID:      "some-plan-id",
+					Name:        "deploy",
+					Role:        "member",
+					RequestedAt: 100,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/1234/approvals"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedApprovals),
+				),
+			)
+		})
+
+		It("returns the approvals of the build", func() {
+			approvals, err := client.ListBuildApprovals("1234")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approvals).To(Equal(expectedApprovals))
+		})
+	})
+
+	Describe("ApproveBuild", func() {
+		var status int
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/1234/approvals/1"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		It("approves the build", func() {
+			err := client.ApproveBuild("1234", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the user may not approve the build", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns ErrForbidden", func() {
+				err := client.ApproveBuild("1234", 1)
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
@@ -34,6 +34,32 @@ func (team *team) BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string)
 	}
 }
 
+func (team *team) ExplainBuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) (atc.InputsExplanation, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	var explanation atc.InputsExplanation
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ExplainJobInputs,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &explanation,
+	})
+
+	switch err.(type) {
+	case nil:
+		return explanation, true, nil
+	case internal.ResourceNotFoundError:
+		return explanation, false, nil
+	default:
+		return explanation, false, err
+	}
+}
+
 func (team *team) BuildsWithVersionAsInput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error) {
 	params := rata.Params{
 		"pipeline_name":              pipelineRef.Name,
@@ -65,6 +65,59 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 		})
 	})
 
+	Describe("ExplainBuildInputsForJob", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/inputs/explain"
+		queryParams := "instance_vars=%7B%22branch%22%3A%22master%22%7D"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		Context("when pipeline/job exists", func() {
+			var expectedExplanation atc.InputsExplanation
+
+			BeforeEach(func() {
+				expectedExplanation = atc.InputsExplanation{
+					Resolved: true,
+					Inputs: []atc.ExplainedInput{
+						{Name: "myinput", Resource: "myresource", Version: atc.Version{"ref": "v1"}},
+					},
+					Steps: []atc.InputExplainStep{
+						{Event: "vouched", Input: "myinput", Resource: "myresource", JobName: "upstream", BuildID: 1, Version: atc.Version{"ref": "v1"}},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedExplanation),
+					),
+				)
+			})
+
+			It("returns the explanation for the given job", func() {
+				explanation, found, err := team.ExplainBuildInputsForJob(pipelineRef, "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation).To(Equal(expectedExplanation))
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when pipeline/job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.ExplainBuildInputsForJob(pipelineRef, "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("BuildsWithVersionAsInput", func() {
 		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/versions/2/input_to"
 		queryParams := "instance_vars=%7B%22branch%22%3A%22master%22%7D"
@@ -20,11 +20,14 @@ type Client interface {
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	ListBuildApprovals(buildID string) ([]atc.BuildApproval, error)
+	ApproveBuild(buildID string, approvalID int) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	LandWorkerWithDrainTimeout(workerName string, drainTimeout time.Duration) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
@@ -33,7 +36,22 @@ type Client interface {
 	FindTeam(teamName string) (Team, error)
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
+	UserPermissions() (map[string][]string, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ListPolicyExemptions() ([]atc.PolicyExemption, error)
+	CreatePolicyExemption(atc.PolicyExemption) (atc.PolicyExemption, error)
+	DeletePolicyExemption(id int) (bool, error)
+	AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error)
+	ListLocalUsers() ([]atc.LocalUser, error)
+	CreateLocalUser(username string, password string) (atc.LocalUser, error)
+	SetLocalUserPassword(username string, password string) (bool, error)
+	DisableLocalUser(username string) (bool, error)
+	EnableLocalUser(username string) (bool, error)
+	ListSessions() ([]atc.Session, error)
+	RevokeSession(id int) (bool, error)
+	RevokeSessions() (int, error)
+	ListUserSessions(username string, connector string) ([]atc.Session, error)
+	RevokeUserSessions(username string, connector string) (int, error)
 }
 
 type client struct {
@@ -23,6 +23,34 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ApproveBuildStub        func(string, int) error
+	approveBuildMutex       sync.RWMutex
+	approveBuildArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	approveBuildReturns struct {
+		result1 error
+	}
+	approveBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuditEventsStub        func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}
+	auditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
+	auditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -96,6 +124,72 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CreateLocalUserStub        func(string, string) (atc.LocalUser, error)
+	createLocalUserMutex       sync.RWMutex
+	createLocalUserArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createLocalUserReturns struct {
+		result1 atc.LocalUser
+		result2 error
+	}
+	createLocalUserReturnsOnCall map[int]struct {
+		result1 atc.LocalUser
+		result2 error
+	}
+	CreatePolicyExemptionStub        func(atc.PolicyExemption) (atc.PolicyExemption, error)
+	createPolicyExemptionMutex       sync.RWMutex
+	createPolicyExemptionArgsForCall []struct {
+		arg1 atc.PolicyExemption
+	}
+	createPolicyExemptionReturns struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}
+	createPolicyExemptionReturnsOnCall map[int]struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}
+	DeletePolicyExemptionStub        func(int) (bool, error)
+	deletePolicyExemptionMutex       sync.RWMutex
+	deletePolicyExemptionArgsForCall []struct {
+		arg1 int
+	}
+	deletePolicyExemptionReturns struct {
+		result1 bool
+		result2 error
+	}
+	deletePolicyExemptionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DisableLocalUserStub        func(string) (bool, error)
+	disableLocalUserMutex       sync.RWMutex
+	disableLocalUserArgsForCall []struct {
+		arg1 string
+	}
+	disableLocalUserReturns struct {
+		result1 bool
+		result2 error
+	}
+	disableLocalUserReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	EnableLocalUserStub        func(string) (bool, error)
+	enableLocalUserMutex       sync.RWMutex
+	enableLocalUserArgsForCall []struct {
+		arg1 string
+	}
+	enableLocalUserReturns struct {
+		result1 bool
+		result2 error
+	}
+	enableLocalUserReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -158,6 +252,18 @@ type FakeClient struct {
 	landWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LandWorkerWithDrainTimeoutStub        func(string, time.Duration) error
+	landWorkerWithDrainTimeoutMutex       sync.RWMutex
+	landWorkerWithDrainTimeoutArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	landWorkerWithDrainTimeoutReturns struct {
+		result1 error
+	}
+	landWorkerWithDrainTimeoutReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ListActiveUsersSinceStub        func(time.Time) ([]atc.User, error)
 	listActiveUsersSinceMutex       sync.RWMutex
 	listActiveUsersSinceArgsForCall []struct {
@@ -183,6 +289,19 @@ type FakeClient struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListBuildApprovalsStub        func(string) ([]atc.BuildApproval, error)
+	listBuildApprovalsMutex       sync.RWMutex
+	listBuildApprovalsArgsForCall []struct {
+		arg1 string
+	}
+	listBuildApprovalsReturns struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}
+	listBuildApprovalsReturnsOnCall map[int]struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}
 	ListBuildArtifactsStub        func(string) ([]atc.WorkerArtifact, error)
 	listBuildArtifactsMutex       sync.RWMutex
 	listBuildArtifactsArgsForCall []struct {
@@ -196,6 +315,18 @@ type FakeClient struct {
 		result1 []atc.WorkerArtifact
 		result2 error
 	}
+	ListLocalUsersStub        func() ([]atc.LocalUser, error)
+	listLocalUsersMutex       sync.RWMutex
+	listLocalUsersArgsForCall []struct {
+	}
+	listLocalUsersReturns struct {
+		result1 []atc.LocalUser
+		result2 error
+	}
+	listLocalUsersReturnsOnCall map[int]struct {
+		result1 []atc.LocalUser
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -208,6 +339,30 @@ type FakeClient struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
+	ListPolicyExemptionsStub        func() ([]atc.PolicyExemption, error)
+	listPolicyExemptionsMutex       sync.RWMutex
+	listPolicyExemptionsArgsForCall []struct {
+	}
+	listPolicyExemptionsReturns struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}
+	listPolicyExemptionsReturnsOnCall map[int]struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}
+	ListSessionsStub        func() ([]atc.Session, error)
+	listSessionsMutex       sync.RWMutex
+	listSessionsArgsForCall []struct {
+	}
+	listSessionsReturns struct {
+		result1 []atc.Session
+		result2 error
+	}
+	listSessionsReturnsOnCall map[int]struct {
+		result1 []atc.Session
+		result2 error
+	}
 	ListTeamsStub        func() ([]atc.Team, error)
 	listTeamsMutex       sync.RWMutex
 	listTeamsArgsForCall []struct {
@@ -220,6 +375,20 @@ type FakeClient struct {
 		result1 []atc.Team
 		result2 error
 	}
+	ListUserSessionsStub        func(string, string) ([]atc.Session, error)
+	listUserSessionsMutex       sync.RWMutex
+	listUserSessionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listUserSessionsReturns struct {
+		result1 []atc.Session
+		result2 error
+	}
+	listUserSessionsReturnsOnCall map[int]struct {
+		result1 []atc.Session
+		result2 error
+	}
 	ListWorkersStub        func() ([]atc.Worker, error)
 	listWorkersMutex       sync.RWMutex
 	listWorkersArgsForCall []struct {
@@ -243,6 +412,45 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RevokeSessionStub        func(int) (bool, error)
+	revokeSessionMutex       sync.RWMutex
+	revokeSessionArgsForCall []struct {
+		arg1 int
+	}
+	revokeSessionReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeSessionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeSessionsStub        func() (int, error)
+	revokeSessionsMutex       sync.RWMutex
+	revokeSessionsArgsForCall []struct {
+	}
+	revokeSessionsReturns struct {
+		result1 int
+		result2 error
+	}
+	revokeSessionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RevokeUserSessionsStub        func(string, string) (int, error)
+	revokeUserSessionsMutex       sync.RWMutex
+	revokeUserSessionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeUserSessionsReturns struct {
+		result1 int
+		result2 error
+	}
+	revokeUserSessionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -257,6 +465,20 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	SetLocalUserPasswordStub        func(string, string) (bool, error)
+	setLocalUserPasswordMutex       sync.RWMutex
+	setLocalUserPasswordArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setLocalUserPasswordReturns struct {
+		result1 bool
+		result2 error
+	}
+	setLocalUserPasswordReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -290,6 +512,18 @@ type FakeClient struct {
 		result1 atc.UserInfo
 		result2 error
 	}
+	UserPermissionsStub        func() (map[string][]string, error)
+	userPermissionsMutex       sync.RWMutex
+	userPermissionsArgsForCall []struct {
+	}
+	userPermissionsReturns struct {
+		result1 map[string][]string
+		result2 error
+	}
+	userPermissionsReturnsOnCall map[int]struct {
+		result1 map[string][]string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -354,6 +588,134 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ApproveBuild(arg1 string, arg2 int) error {
+	fake.approveBuildMutex.Lock()
+	ret, specificReturn := fake.approveBuildReturnsOnCall[len(fake.approveBuildArgsForCall)]
+	fake.approveBuildArgsForCall = append(fake.approveBuildArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("ApproveBuild", []interface{}{arg1, arg2})
+	fake.approveBuildMutex.Unlock()
+	if fake.ApproveBuildStub != nil {
+		return fake.ApproveBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approveBuildReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ApproveBuildCallCount() int {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	return len(fake.approveBuildArgsForCall)
+}
+
+func (fake *FakeClient) ApproveBuildCalls(stub func(string, int) error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = stub
+}
+
+func (fake *FakeClient) ApproveBuildArgsForCall(i int) (string, int) {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	argsForCall := fake.approveBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ApproveBuildReturns(result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	fake.approveBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ApproveBuildReturnsOnCall(i int, result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	if fake.approveBuildReturnsOnCall == nil {
+		fake.approveBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) AuditEvents(arg1 concourse.AuditEventFilter, arg2 concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error) {
+	fake.auditEventsMutex.Lock()
+	ret, specificReturn := fake.auditEventsReturnsOnCall[len(fake.auditEventsArgsForCall)]
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}{arg1, arg2})
+	fake.recordInvocation("AuditEvents", []interface{}{arg1, arg2})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.auditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeClient) AuditEventsCalls(stub func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = stub
+}
+
+func (fake *FakeClient) AuditEventsArgsForCall(i int) (concourse.AuditEventFilter, concourse.Page) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	argsForCall := fake.auditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) AuditEventsReturns(result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) AuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	if fake.auditEventsReturnsOnCall == nil {
+		fake.auditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 concourse.Pagination
+			result3 error
+		})
+	}
+	fake.auditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -681,127 +1043,443 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
-	fake.findTeamMutex.Lock()
-	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
-	fake.findTeamArgsForCall = append(fake.findTeamArgsForCall, struct {
+func (fake *FakeClient) CreateLocalUser(arg1 string, arg2 string) (atc.LocalUser, error) {
+	fake.createLocalUserMutex.Lock()
+	ret, specificReturn := fake.createLocalUserReturnsOnCall[len(fake.createLocalUserArgsForCall)]
+	fake.createLocalUserArgsForCall = append(fake.createLocalUserArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	fake.recordInvocation("FindTeam", []interface{}{arg1})
-	fake.findTeamMutex.Unlock()
-	if fake.FindTeamStub != nil {
-		return fake.FindTeamStub(arg1)
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateLocalUser", []interface{}{arg1, arg2})
+	fake.createLocalUserMutex.Unlock()
+	if fake.CreateLocalUserStub != nil {
+		return fake.CreateLocalUserStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.findTeamReturns
+	fakeReturns := fake.createLocalUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) FindTeamCallCount() int {
-	fake.findTeamMutex.RLock()
-	defer fake.findTeamMutex.RUnlock()
-	return len(fake.findTeamArgsForCall)
+func (fake *FakeClient) CreateLocalUserCallCount() int {
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	return len(fake.createLocalUserArgsForCall)
 }
 
-func (fake *FakeClient) FindTeamCalls(stub func(string) (concourse.Team, error)) {
-	fake.findTeamMutex.Lock()
-	defer fake.findTeamMutex.Unlock()
-	fake.FindTeamStub = stub
+func (fake *FakeClient) CreateLocalUserCalls(stub func(string, string) (atc.LocalUser, error)) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = stub
 }
 
-func (fake *FakeClient) FindTeamArgsForCall(i int) string {
-	fake.findTeamMutex.RLock()
-	defer fake.findTeamMutex.RUnlock()
-	argsForCall := fake.findTeamArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClient) CreateLocalUserArgsForCall(i int) (string, string) {
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	argsForCall := fake.createLocalUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClient) FindTeamReturns(result1 concourse.Team, result2 error) {
-	fake.findTeamMutex.Lock()
-	defer fake.findTeamMutex.Unlock()
-	fake.FindTeamStub = nil
-	fake.findTeamReturns = struct {
-		result1 concourse.Team
+func (fake *FakeClient) CreateLocalUserReturns(result1 atc.LocalUser, result2 error) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = nil
+	fake.createLocalUserReturns = struct {
+		result1 atc.LocalUser
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) FindTeamReturnsOnCall(i int, result1 concourse.Team, result2 error) {
-	fake.findTeamMutex.Lock()
-	defer fake.findTeamMutex.Unlock()
-	fake.FindTeamStub = nil
-	if fake.findTeamReturnsOnCall == nil {
-		fake.findTeamReturnsOnCall = make(map[int]struct {
-			result1 concourse.Team
+func (fake *FakeClient) CreateLocalUserReturnsOnCall(i int, result1 atc.LocalUser, result2 error) {
+	fake.createLocalUserMutex.Lock()
+	defer fake.createLocalUserMutex.Unlock()
+	fake.CreateLocalUserStub = nil
+	if fake.createLocalUserReturnsOnCall == nil {
+		fake.createLocalUserReturnsOnCall = make(map[int]struct {
+			result1 atc.LocalUser
 			result2 error
 		})
 	}
-	fake.findTeamReturnsOnCall[i] = struct {
-		result1 concourse.Team
+	fake.createLocalUserReturnsOnCall[i] = struct {
+		result1 atc.LocalUser
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
-	fake.getCLIReaderMutex.Lock()
-	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
-	fake.getCLIReaderArgsForCall = append(fake.getCLIReaderArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("GetCLIReader", []interface{}{arg1, arg2})
-	fake.getCLIReaderMutex.Unlock()
-	if fake.GetCLIReaderStub != nil {
-		return fake.GetCLIReaderStub(arg1, arg2)
+func (fake *FakeClient) CreatePolicyExemption(arg1 atc.PolicyExemption) (atc.PolicyExemption, error) {
+	fake.createPolicyExemptionMutex.Lock()
+	ret, specificReturn := fake.createPolicyExemptionReturnsOnCall[len(fake.createPolicyExemptionArgsForCall)]
+	fake.createPolicyExemptionArgsForCall = append(fake.createPolicyExemptionArgsForCall, struct {
+		arg1 atc.PolicyExemption
+	}{arg1})
+	fake.recordInvocation("CreatePolicyExemption", []interface{}{arg1})
+	fake.createPolicyExemptionMutex.Unlock()
+	if fake.CreatePolicyExemptionStub != nil {
+		return fake.CreatePolicyExemptionStub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getCLIReaderReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	fakeReturns := fake.createPolicyExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) GetCLIReaderCallCount() int {
-	fake.getCLIReaderMutex.RLock()
-	defer fake.getCLIReaderMutex.RUnlock()
-	return len(fake.getCLIReaderArgsForCall)
+func (fake *FakeClient) CreatePolicyExemptionCallCount() int {
+	fake.createPolicyExemptionMutex.RLock()
+	defer fake.createPolicyExemptionMutex.RUnlock()
+	return len(fake.createPolicyExemptionArgsForCall)
 }
 
-func (fake *FakeClient) GetCLIReaderCalls(stub func(string, string) (io.ReadCloser, http.Header, error)) {
-	fake.getCLIReaderMutex.Lock()
-	defer fake.getCLIReaderMutex.Unlock()
-	fake.GetCLIReaderStub = stub
+func (fake *FakeClient) CreatePolicyExemptionCalls(stub func(atc.PolicyExemption) (atc.PolicyExemption, error)) {
+	fake.createPolicyExemptionMutex.Lock()
+	defer fake.createPolicyExemptionMutex.Unlock()
+	fake.CreatePolicyExemptionStub = stub
 }
 
-func (fake *FakeClient) GetCLIReaderArgsForCall(i int) (string, string) {
-	fake.getCLIReaderMutex.RLock()
-	defer fake.getCLIReaderMutex.RUnlock()
-	argsForCall := fake.getCLIReaderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeClient) CreatePolicyExemptionArgsForCall(i int) atc.PolicyExemption {
+	fake.createPolicyExemptionMutex.RLock()
+	defer fake.createPolicyExemptionMutex.RUnlock()
+	argsForCall := fake.createPolicyExemptionArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeClient) GetCLIReaderReturns(result1 io.ReadCloser, result2 http.Header, result3 error) {
-	fake.getCLIReaderMutex.Lock()
-	defer fake.getCLIReaderMutex.Unlock()
-	fake.GetCLIReaderStub = nil
-	fake.getCLIReaderReturns = struct {
-		result1 io.ReadCloser
-		result2 http.Header
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeClient) CreatePolicyExemptionReturns(result1 atc.PolicyExemption, result2 error) {
+	fake.createPolicyExemptionMutex.Lock()
+	defer fake.createPolicyExemptionMutex.Unlock()
+	fake.CreatePolicyExemptionStub = nil
+	fake.createPolicyExemptionReturns = struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeClient) GetCLIReaderReturnsOnCall(i int, result1 io.ReadCloser, result2 http.Header, result3 error) {
-	fake.getCLIReaderMutex.Lock()
-	defer fake.getCLIReaderMutex.Unlock()
-	fake.GetCLIReaderStub = nil
-	if fake.getCLIReaderReturnsOnCall == nil {
-		fake.getCLIReaderReturnsOnCall = make(map[int]struct {
-			result1 io.ReadCloser
-			result2 http.Header
-			result3 error
+func (fake *FakeClient) CreatePolicyExemptionReturnsOnCall(i int, result1 atc.PolicyExemption, result2 error) {
+	fake.createPolicyExemptionMutex.Lock()
+	defer fake.createPolicyExemptionMutex.Unlock()
+	fake.CreatePolicyExemptionStub = nil
+	if fake.createPolicyExemptionReturnsOnCall == nil {
+		fake.createPolicyExemptionReturnsOnCall = make(map[int]struct {
+			result1 atc.PolicyExemption
+			result2 error
+		})
+	}
+	fake.createPolicyExemptionReturnsOnCall[i] = struct {
+		result1 atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeletePolicyExemption(arg1 int) (bool, error) {
+	fake.deletePolicyExemptionMutex.Lock()
+	ret, specificReturn := fake.deletePolicyExemptionReturnsOnCall[len(fake.deletePolicyExemptionArgsForCall)]
+	fake.deletePolicyExemptionArgsForCall = append(fake.deletePolicyExemptionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeletePolicyExemption", []interface{}{arg1})
+	fake.deletePolicyExemptionMutex.Unlock()
+	if fake.DeletePolicyExemptionStub != nil {
+		return fake.DeletePolicyExemptionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deletePolicyExemptionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeletePolicyExemptionCallCount() int {
+	fake.deletePolicyExemptionMutex.RLock()
+	defer fake.deletePolicyExemptionMutex.RUnlock()
+	return len(fake.deletePolicyExemptionArgsForCall)
+}
+
+func (fake *FakeClient) DeletePolicyExemptionCalls(stub func(int) (bool, error)) {
+	fake.deletePolicyExemptionMutex.Lock()
+	defer fake.deletePolicyExemptionMutex.Unlock()
+	fake.DeletePolicyExemptionStub = stub
+}
+
+func (fake *FakeClient) DeletePolicyExemptionArgsForCall(i int) int {
+	fake.deletePolicyExemptionMutex.RLock()
+	defer fake.deletePolicyExemptionMutex.RUnlock()
+	argsForCall := fake.deletePolicyExemptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeletePolicyExemptionReturns(result1 bool, result2 error) {
+	fake.deletePolicyExemptionMutex.Lock()
+	defer fake.deletePolicyExemptionMutex.Unlock()
+	fake.DeletePolicyExemptionStub = nil
+	fake.deletePolicyExemptionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeletePolicyExemptionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deletePolicyExemptionMutex.Lock()
+	defer fake.deletePolicyExemptionMutex.Unlock()
+	fake.DeletePolicyExemptionStub = nil
+	if fake.deletePolicyExemptionReturnsOnCall == nil {
+		fake.deletePolicyExemptionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deletePolicyExemptionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DisableLocalUser(arg1 string) (bool, error) {
+	fake.disableLocalUserMutex.Lock()
+	ret, specificReturn := fake.disableLocalUserReturnsOnCall[len(fake.disableLocalUserArgsForCall)]
+	fake.disableLocalUserArgsForCall = append(fake.disableLocalUserArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DisableLocalUser", []interface{}{arg1})
+	fake.disableLocalUserMutex.Unlock()
+	if fake.DisableLocalUserStub != nil {
+		return fake.DisableLocalUserStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.disableLocalUserReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DisableLocalUserCallCount() int {
+	fake.disableLocalUserMutex.RLock()
+	defer fake.disableLocalUserMutex.RUnlock()
+	return len(fake.disableLocalUserArgsForCall)
+}
+
+func (fake *FakeClient) DisableLocalUserCalls(stub func(string) (bool, error)) {
+	fake.disableLocalUserMutex.Lock()
+	defer fake.disableLocalUserMutex.Unlock()
+	fake.DisableLocalUserStub = stub
+}
+
+func (fake *FakeClient) DisableLocalUserArgsForCall(i int) string {
+	fake.disableLocalUserMutex.RLock()
+	defer fake.disableLocalUserMutex.RUnlock()
+	argsForCall := fake.disableLocalUserArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DisableLocalUserReturns(result1 bool, result2 error) {
+	fake.disableLocalUserMutex.Lock()
+	defer fake.disableLocalUserMutex.Unlock()
+	fake.DisableLocalUserStub = nil
+	fake.disableLocalUserReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DisableLocalUserReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.disableLocalUserMutex.Lock()
+	defer fake.disableLocalUserMutex.Unlock()
+	fake.DisableLocalUserStub = nil
+	if fake.disableLocalUserReturnsOnCall == nil {
+		fake.disableLocalUserReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.disableLocalUserReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) EnableLocalUser(arg1 string) (bool, error) {
+	fake.enableLocalUserMutex.Lock()
+	ret, specificReturn := fake.enableLocalUserReturnsOnCall[len(fake.enableLocalUserArgsForCall)]
+	fake.enableLocalUserArgsForCall = append(fake.enableLocalUserArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("EnableLocalUser", []interface{}{arg1})
+	fake.enableLocalUserMutex.Unlock()
+	if fake.EnableLocalUserStub != nil {
+		return fake.EnableLocalUserStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.enableLocalUserReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) EnableLocalUserCallCount() int {
+	fake.enableLocalUserMutex.RLock()
+	defer fake.enableLocalUserMutex.RUnlock()
+	return len(fake.enableLocalUserArgsForCall)
+}
+
+func (fake *FakeClient) EnableLocalUserCalls(stub func(string) (bool, error)) {
+	fake.enableLocalUserMutex.Lock()
+	defer fake.enableLocalUserMutex.Unlock()
+	fake.EnableLocalUserStub = stub
+}
+
+func (fake *FakeClient) EnableLocalUserArgsForCall(i int) string {
+	fake.enableLocalUserMutex.RLock()
+	defer fake.enableLocalUserMutex.RUnlock()
+	argsForCall := fake.enableLocalUserArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) EnableLocalUserReturns(result1 bool, result2 error) {
+	fake.enableLocalUserMutex.Lock()
+	defer fake.enableLocalUserMutex.Unlock()
+	fake.EnableLocalUserStub = nil
+	fake.enableLocalUserReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) EnableLocalUserReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.enableLocalUserMutex.Lock()
+	defer fake.enableLocalUserMutex.Unlock()
+	fake.EnableLocalUserStub = nil
+	if fake.enableLocalUserReturnsOnCall == nil {
+		fake.enableLocalUserReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.enableLocalUserReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
+	fake.findTeamMutex.Lock()
+	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
+	fake.findTeamArgsForCall = append(fake.findTeamArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FindTeam", []interface{}{arg1})
+	fake.findTeamMutex.Unlock()
+	if fake.FindTeamStub != nil {
+		return fake.FindTeamStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findTeamReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) FindTeamCallCount() int {
+	fake.findTeamMutex.RLock()
+	defer fake.findTeamMutex.RUnlock()
+	return len(fake.findTeamArgsForCall)
+}
+
+func (fake *FakeClient) FindTeamCalls(stub func(string) (concourse.Team, error)) {
+	fake.findTeamMutex.Lock()
+	defer fake.findTeamMutex.Unlock()
+	fake.FindTeamStub = stub
+}
+
+func (fake *FakeClient) FindTeamArgsForCall(i int) string {
+	fake.findTeamMutex.RLock()
+	defer fake.findTeamMutex.RUnlock()
+	argsForCall := fake.findTeamArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) FindTeamReturns(result1 concourse.Team, result2 error) {
+	fake.findTeamMutex.Lock()
+	defer fake.findTeamMutex.Unlock()
+	fake.FindTeamStub = nil
+	fake.findTeamReturns = struct {
+		result1 concourse.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FindTeamReturnsOnCall(i int, result1 concourse.Team, result2 error) {
+	fake.findTeamMutex.Lock()
+	defer fake.findTeamMutex.Unlock()
+	fake.FindTeamStub = nil
+	if fake.findTeamReturnsOnCall == nil {
+		fake.findTeamReturnsOnCall = make(map[int]struct {
+			result1 concourse.Team
+			result2 error
+		})
+	}
+	fake.findTeamReturnsOnCall[i] = struct {
+		result1 concourse.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
+	fake.getCLIReaderMutex.Lock()
+	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
+	fake.getCLIReaderArgsForCall = append(fake.getCLIReaderArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetCLIReader", []interface{}{arg1, arg2})
+	fake.getCLIReaderMutex.Unlock()
+	if fake.GetCLIReaderStub != nil {
+		return fake.GetCLIReaderStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getCLIReaderReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) GetCLIReaderCallCount() int {
+	fake.getCLIReaderMutex.RLock()
+	defer fake.getCLIReaderMutex.RUnlock()
+	return len(fake.getCLIReaderArgsForCall)
+}
+
+func (fake *FakeClient) GetCLIReaderCalls(stub func(string, string) (io.ReadCloser, http.Header, error)) {
+	fake.getCLIReaderMutex.Lock()
+	defer fake.getCLIReaderMutex.Unlock()
+	fake.GetCLIReaderStub = stub
+}
+
+func (fake *FakeClient) GetCLIReaderArgsForCall(i int) (string, string) {
+	fake.getCLIReaderMutex.RLock()
+	defer fake.getCLIReaderMutex.RUnlock()
+	argsForCall := fake.getCLIReaderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetCLIReaderReturns(result1 io.ReadCloser, result2 http.Header, result3 error) {
+	fake.getCLIReaderMutex.Lock()
+	defer fake.getCLIReaderMutex.Unlock()
+	fake.GetCLIReaderStub = nil
+	fake.getCLIReaderReturns = struct {
+		result1 io.ReadCloser
+		result2 http.Header
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) GetCLIReaderReturnsOnCall(i int, result1 io.ReadCloser, result2 http.Header, result3 error) {
+	fake.getCLIReaderMutex.Lock()
+	defer fake.getCLIReaderMutex.Unlock()
+	fake.GetCLIReaderStub = nil
+	if fake.getCLIReaderReturnsOnCall == nil {
+		fake.getCLIReaderReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 http.Header
+			result3 error
 		})
 	}
 	fake.getCLIReaderReturnsOnCall[i] = struct {
@@ -978,6 +1656,67 @@ func (fake *FakeClient) LandWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) LandWorkerWithDrainTimeout(arg1 string, arg2 time.Duration) error {
+	fake.landWorkerWithDrainTimeoutMutex.Lock()
+	ret, specificReturn := fake.landWorkerWithDrainTimeoutReturnsOnCall[len(fake.landWorkerWithDrainTimeoutArgsForCall)]
+	fake.landWorkerWithDrainTimeoutArgsForCall = append(fake.landWorkerWithDrainTimeoutArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("LandWorkerWithDrainTimeout", []interface{}{arg1, arg2})
+	fake.landWorkerWithDrainTimeoutMutex.Unlock()
+	if fake.LandWorkerWithDrainTimeoutStub != nil {
+		return fake.LandWorkerWithDrainTimeoutStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.landWorkerWithDrainTimeoutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) LandWorkerWithDrainTimeoutCallCount() int {
+	fake.landWorkerWithDrainTimeoutMutex.RLock()
+	defer fake.landWorkerWithDrainTimeoutMutex.RUnlock()
+	return len(fake.landWorkerWithDrainTimeoutArgsForCall)
+}
+
+func (fake *FakeClient) LandWorkerWithDrainTimeoutCalls(stub func(string, time.Duration) error) {
+	fake.landWorkerWithDrainTimeoutMutex.Lock()
+	defer fake.landWorkerWithDrainTimeoutMutex.Unlock()
+	fake.LandWorkerWithDrainTimeoutStub = stub
+}
+
+func (fake *FakeClient) LandWorkerWithDrainTimeoutArgsForCall(i int) (string, time.Duration) {
+	fake.landWorkerWithDrainTimeoutMutex.RLock()
+	defer fake.landWorkerWithDrainTimeoutMutex.RUnlock()
+	argsForCall := fake.landWorkerWithDrainTimeoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) LandWorkerWithDrainTimeoutReturns(result1 error) {
+	fake.landWorkerWithDrainTimeoutMutex.Lock()
+	defer fake.landWorkerWithDrainTimeoutMutex.Unlock()
+	fake.LandWorkerWithDrainTimeoutStub = nil
+	fake.landWorkerWithDrainTimeoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) LandWorkerWithDrainTimeoutReturnsOnCall(i int, result1 error) {
+	fake.landWorkerWithDrainTimeoutMutex.Lock()
+	defer fake.landWorkerWithDrainTimeoutMutex.Unlock()
+	fake.LandWorkerWithDrainTimeoutStub = nil
+	if fake.landWorkerWithDrainTimeoutReturnsOnCall == nil {
+		fake.landWorkerWithDrainTimeoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.landWorkerWithDrainTimeoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) ListActiveUsersSince(arg1 time.Time) ([]atc.User, error) {
 	fake.listActiveUsersSinceMutex.Lock()
 	ret, specificReturn := fake.listActiveUsersSinceReturnsOnCall[len(fake.listActiveUsersSinceArgsForCall)]
@@ -1096,6 +1835,69 @@ func (fake *FakeClient) ListAllJobsReturnsOnCall(i int, result1 []atc.Job, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListBuildApprovals(arg1 string) ([]atc.BuildApproval, error) {
+	fake.listBuildApprovalsMutex.Lock()
+	ret, specificReturn := fake.listBuildApprovalsReturnsOnCall[len(fake.listBuildApprovalsArgsForCall)]
+	fake.listBuildApprovalsArgsForCall = append(fake.listBuildApprovalsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListBuildApprovals", []interface{}{arg1})
+	fake.listBuildApprovalsMutex.Unlock()
+	if fake.ListBuildApprovalsStub != nil {
+		return fake.ListBuildApprovalsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listBuildApprovalsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListBuildApprovalsCallCount() int {
+	fake.listBuildApprovalsMutex.RLock()
+	defer fake.listBuildApprovalsMutex.RUnlock()
+	return len(fake.listBuildApprovalsArgsForCall)
+}
+
+func (fake *FakeClient) ListBuildApprovalsCalls(stub func(string) ([]atc.BuildApproval, error)) {
+	fake.listBuildApprovalsMutex.Lock()
+	defer fake.listBuildApprovalsMutex.Unlock()
+	fake.ListBuildApprovalsStub = stub
+}
+
+func (fake *FakeClient) ListBuildApprovalsArgsForCall(i int) string {
+	fake.listBuildApprovalsMutex.RLock()
+	defer fake.listBuildApprovalsMutex.RUnlock()
+	argsForCall := fake.listBuildApprovalsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListBuildApprovalsReturns(result1 []atc.BuildApproval, result2 error) {
+	fake.listBuildApprovalsMutex.Lock()
+	defer fake.listBuildApprovalsMutex.Unlock()
+	fake.ListBuildApprovalsStub = nil
+	fake.listBuildApprovalsReturns = struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListBuildApprovalsReturnsOnCall(i int, result1 []atc.BuildApproval, result2 error) {
+	fake.listBuildApprovalsMutex.Lock()
+	defer fake.listBuildApprovalsMutex.Unlock()
+	fake.ListBuildApprovalsStub = nil
+	if fake.listBuildApprovalsReturnsOnCall == nil {
+		fake.listBuildApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildApproval
+			result2 error
+		})
+	}
+	fake.listBuildApprovalsReturnsOnCall[i] = struct {
+		result1 []atc.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListBuildArtifacts(arg1 string) ([]atc.WorkerArtifact, error) {
 	fake.listBuildArtifactsMutex.Lock()
 	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
@@ -1159,6 +1961,61 @@ func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.Wor
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListLocalUsers() ([]atc.LocalUser, error) {
+	fake.listLocalUsersMutex.Lock()
+	ret, specificReturn := fake.listLocalUsersReturnsOnCall[len(fake.listLocalUsersArgsForCall)]
+	fake.listLocalUsersArgsForCall = append(fake.listLocalUsersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListLocalUsers", []interface{}{})
+	fake.listLocalUsersMutex.Unlock()
+	if fake.ListLocalUsersStub != nil {
+		return fake.ListLocalUsersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listLocalUsersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListLocalUsersCallCount() int {
+	fake.listLocalUsersMutex.RLock()
+	defer fake.listLocalUsersMutex.RUnlock()
+	return len(fake.listLocalUsersArgsForCall)
+}
+
+func (fake *FakeClient) ListLocalUsersCalls(stub func() ([]atc.LocalUser, error)) {
+	fake.listLocalUsersMutex.Lock()
+	defer fake.listLocalUsersMutex.Unlock()
+	fake.ListLocalUsersStub = stub
+}
+
+func (fake *FakeClient) ListLocalUsersReturns(result1 []atc.LocalUser, result2 error) {
+	fake.listLocalUsersMutex.Lock()
+	defer fake.listLocalUsersMutex.Unlock()
+	fake.ListLocalUsersStub = nil
+	fake.listLocalUsersReturns = struct {
+		result1 []atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListLocalUsersReturnsOnCall(i int, result1 []atc.LocalUser, result2 error) {
+	fake.listLocalUsersMutex.Lock()
+	defer fake.listLocalUsersMutex.Unlock()
+	fake.ListLocalUsersStub = nil
+	if fake.listLocalUsersReturnsOnCall == nil {
+		fake.listLocalUsersReturnsOnCall = make(map[int]struct {
+			result1 []atc.LocalUser
+			result2 error
+		})
+	}
+	fake.listLocalUsersReturnsOnCall[i] = struct {
+		result1 []atc.LocalUser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -1214,6 +2071,116 @@ func (fake *FakeClient) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListPolicyExemptions() ([]atc.PolicyExemption, error) {
+	fake.listPolicyExemptionsMutex.Lock()
+	ret, specificReturn := fake.listPolicyExemptionsReturnsOnCall[len(fake.listPolicyExemptionsArgsForCall)]
+	fake.listPolicyExemptionsArgsForCall = append(fake.listPolicyExemptionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListPolicyExemptions", []interface{}{})
+	fake.listPolicyExemptionsMutex.Unlock()
+	if fake.ListPolicyExemptionsStub != nil {
+		return fake.ListPolicyExemptionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listPolicyExemptionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListPolicyExemptionsCallCount() int {
+	fake.listPolicyExemptionsMutex.RLock()
+	defer fake.listPolicyExemptionsMutex.RUnlock()
+	return len(fake.listPolicyExemptionsArgsForCall)
+}
+
+func (fake *FakeClient) ListPolicyExemptionsCalls(stub func() ([]atc.PolicyExemption, error)) {
+	fake.listPolicyExemptionsMutex.Lock()
+	defer fake.listPolicyExemptionsMutex.Unlock()
+	fake.ListPolicyExemptionsStub = stub
+}
+
+func (fake *FakeClient) ListPolicyExemptionsReturns(result1 []atc.PolicyExemption, result2 error) {
+	fake.listPolicyExemptionsMutex.Lock()
+	defer fake.listPolicyExemptionsMutex.Unlock()
+	fake.ListPolicyExemptionsStub = nil
+	fake.listPolicyExemptionsReturns = struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListPolicyExemptionsReturnsOnCall(i int, result1 []atc.PolicyExemption, result2 error) {
+	fake.listPolicyExemptionsMutex.Lock()
+	defer fake.listPolicyExemptionsMutex.Unlock()
+	fake.ListPolicyExemptionsStub = nil
+	if fake.listPolicyExemptionsReturnsOnCall == nil {
+		fake.listPolicyExemptionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PolicyExemption
+			result2 error
+		})
+	}
+	fake.listPolicyExemptionsReturnsOnCall[i] = struct {
+		result1 []atc.PolicyExemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListSessions() ([]atc.Session, error) {
+	fake.listSessionsMutex.Lock()
+	ret, specificReturn := fake.listSessionsReturnsOnCall[len(fake.listSessionsArgsForCall)]
+	fake.listSessionsArgsForCall = append(fake.listSessionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListSessions", []interface{}{})
+	fake.listSessionsMutex.Unlock()
+	if fake.ListSessionsStub != nil {
+		return fake.ListSessionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListSessionsCallCount() int {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	return len(fake.listSessionsArgsForCall)
+}
+
+func (fake *FakeClient) ListSessionsCalls(stub func() ([]atc.Session, error)) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = stub
+}
+
+func (fake *FakeClient) ListSessionsReturns(result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	fake.listSessionsReturns = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListSessionsReturnsOnCall(i int, result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	if fake.listSessionsReturnsOnCall == nil {
+		fake.listSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Session
+			result2 error
+		})
+	}
+	fake.listSessionsReturnsOnCall[i] = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListTeams() ([]atc.Team, error) {
 	fake.listTeamsMutex.Lock()
 	ret, specificReturn := fake.listTeamsReturnsOnCall[len(fake.listTeamsArgsForCall)]
@@ -1269,6 +2236,70 @@ func (fake *FakeClient) ListTeamsReturnsOnCall(i int, result1 []atc.Team, result
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListUserSessions(arg1 string, arg2 string) ([]atc.Session, error) {
+	fake.listUserSessionsMutex.Lock()
+	ret, specificReturn := fake.listUserSessionsReturnsOnCall[len(fake.listUserSessionsArgsForCall)]
+	fake.listUserSessionsArgsForCall = append(fake.listUserSessionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListUserSessions", []interface{}{arg1, arg2})
+	fake.listUserSessionsMutex.Unlock()
+	if fake.ListUserSessionsStub != nil {
+		return fake.ListUserSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listUserSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListUserSessionsCallCount() int {
+	fake.listUserSessionsMutex.RLock()
+	defer fake.listUserSessionsMutex.RUnlock()
+	return len(fake.listUserSessionsArgsForCall)
+}
+
+func (fake *FakeClient) ListUserSessionsCalls(stub func(string, string) ([]atc.Session, error)) {
+	fake.listUserSessionsMutex.Lock()
+	defer fake.listUserSessionsMutex.Unlock()
+	fake.ListUserSessionsStub = stub
+}
+
+func (fake *FakeClient) ListUserSessionsArgsForCall(i int) (string, string) {
+	fake.listUserSessionsMutex.RLock()
+	defer fake.listUserSessionsMutex.RUnlock()
+	argsForCall := fake.listUserSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ListUserSessionsReturns(result1 []atc.Session, result2 error) {
+	fake.listUserSessionsMutex.Lock()
+	defer fake.listUserSessionsMutex.Unlock()
+	fake.ListUserSessionsStub = nil
+	fake.listUserSessionsReturns = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListUserSessionsReturnsOnCall(i int, result1 []atc.Session, result2 error) {
+	fake.listUserSessionsMutex.Lock()
+	defer fake.listUserSessionsMutex.Unlock()
+	fake.ListUserSessionsStub = nil
+	if fake.listUserSessionsReturnsOnCall == nil {
+		fake.listUserSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Session
+			result2 error
+		})
+	}
+	fake.listUserSessionsReturnsOnCall[i] = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListWorkers() ([]atc.Worker, error) {
 	fake.listWorkersMutex.Lock()
 	ret, specificReturn := fake.listWorkersReturnsOnCall[len(fake.listWorkersArgsForCall)]
@@ -1384,6 +2415,188 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RevokeSession(arg1 int) (bool, error) {
+	fake.revokeSessionMutex.Lock()
+	ret, specificReturn := fake.revokeSessionReturnsOnCall[len(fake.revokeSessionArgsForCall)]
+	fake.revokeSessionArgsForCall = append(fake.revokeSessionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RevokeSession", []interface{}{arg1})
+	fake.revokeSessionMutex.Unlock()
+	if fake.RevokeSessionStub != nil {
+		return fake.RevokeSessionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeSessionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeSessionCallCount() int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	return len(fake.revokeSessionArgsForCall)
+}
+
+func (fake *FakeClient) RevokeSessionCalls(stub func(int) (bool, error)) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = stub
+}
+
+func (fake *FakeClient) RevokeSessionArgsForCall(i int) int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	argsForCall := fake.revokeSessionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeSessionReturns(result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	fake.revokeSessionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeSessionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	if fake.revokeSessionReturnsOnCall == nil {
+		fake.revokeSessionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeSessionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeSessions() (int, error) {
+	fake.revokeSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeSessionsReturnsOnCall[len(fake.revokeSessionsArgsForCall)]
+	fake.revokeSessionsArgsForCall = append(fake.revokeSessionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RevokeSessions", []interface{}{})
+	fake.revokeSessionsMutex.Unlock()
+	if fake.RevokeSessionsStub != nil {
+		return fake.RevokeSessionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeSessionsCallCount() int {
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	return len(fake.revokeSessionsArgsForCall)
+}
+
+func (fake *FakeClient) RevokeSessionsCalls(stub func() (int, error)) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = stub
+}
+
+func (fake *FakeClient) RevokeSessionsReturns(result1 int, result2 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	fake.revokeSessionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeSessionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	if fake.revokeSessionsReturnsOnCall == nil {
+		fake.revokeSessionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.revokeSessionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessions(arg1 string, arg2 string) (int, error) {
+	fake.revokeUserSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeUserSessionsReturnsOnCall[len(fake.revokeUserSessionsArgsForCall)]
+	fake.revokeUserSessionsArgsForCall = append(fake.revokeUserSessionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeUserSessions", []interface{}{arg1, arg2})
+	fake.revokeUserSessionsMutex.Unlock()
+	if fake.RevokeUserSessionsStub != nil {
+		return fake.RevokeUserSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeUserSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeUserSessionsCallCount() int {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	return len(fake.revokeUserSessionsArgsForCall)
+}
+
+func (fake *FakeClient) RevokeUserSessionsCalls(stub func(string, string) (int, error)) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = stub
+}
+
+func (fake *FakeClient) RevokeUserSessionsArgsForCall(i int) (string, string) {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	argsForCall := fake.revokeUserSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturns(result1 int, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	fake.revokeUserSessionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	if fake.revokeUserSessionsReturnsOnCall == nil {
+		fake.revokeUserSessionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.revokeUserSessionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1448,6 +2661,70 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SetLocalUserPassword(arg1 string, arg2 string) (bool, error) {
+	fake.setLocalUserPasswordMutex.Lock()
+	ret, specificReturn := fake.setLocalUserPasswordReturnsOnCall[len(fake.setLocalUserPasswordArgsForCall)]
+	fake.setLocalUserPasswordArgsForCall = append(fake.setLocalUserPasswordArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetLocalUserPassword", []interface{}{arg1, arg2})
+	fake.setLocalUserPasswordMutex.Unlock()
+	if fake.SetLocalUserPasswordStub != nil {
+		return fake.SetLocalUserPasswordStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setLocalUserPasswordReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SetLocalUserPasswordCallCount() int {
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
+	return len(fake.setLocalUserPasswordArgsForCall)
+}
+
+func (fake *FakeClient) SetLocalUserPasswordCalls(stub func(string, string) (bool, error)) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = stub
+}
+
+func (fake *FakeClient) SetLocalUserPasswordArgsForCall(i int) (string, string) {
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
+	argsForCall := fake.setLocalUserPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SetLocalUserPasswordReturns(result1 bool, result2 error) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = nil
+	fake.setLocalUserPasswordReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetLocalUserPasswordReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setLocalUserPasswordMutex.Lock()
+	defer fake.setLocalUserPasswordMutex.Unlock()
+	fake.SetLocalUserPasswordStub = nil
+	if fake.setLocalUserPasswordReturnsOnCall == nil {
+		fake.setLocalUserPasswordReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setLocalUserPasswordReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1615,11 +2892,70 @@ func (fake *FakeClient) UserInfoReturnsOnCall(i int, result1 atc.UserInfo, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) UserPermissions() (map[string][]string, error) {
+	fake.userPermissionsMutex.Lock()
+	ret, specificReturn := fake.userPermissionsReturnsOnCall[len(fake.userPermissionsArgsForCall)]
+	fake.userPermissionsArgsForCall = append(fake.userPermissionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserPermissions", []interface{}{})
+	fake.userPermissionsMutex.Unlock()
+	if fake.UserPermissionsStub != nil {
+		return fake.UserPermissionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.userPermissionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UserPermissionsCallCount() int {
+	fake.userPermissionsMutex.RLock()
+	defer fake.userPermissionsMutex.RUnlock()
+	return len(fake.userPermissionsArgsForCall)
+}
+
+func (fake *FakeClient) UserPermissionsCalls(stub func() (map[string][]string, error)) {
+	fake.userPermissionsMutex.Lock()
+	defer fake.userPermissionsMutex.Unlock()
+	fake.UserPermissionsStub = stub
+}
+
+func (fake *FakeClient) UserPermissionsReturns(result1 map[string][]string, result2 error) {
+	fake.userPermissionsMutex.Lock()
+	defer fake.userPermissionsMutex.Unlock()
+	fake.UserPermissionsStub = nil
+	fake.userPermissionsReturns = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UserPermissionsReturnsOnCall(i int, result1 map[string][]string, result2 error) {
+	fake.userPermissionsMutex.Lock()
+	defer fake.userPermissionsMutex.Unlock()
+	fake.UserPermissionsStub = nil
+	if fake.userPermissionsReturnsOnCall == nil {
+		fake.userPermissionsReturnsOnCall = make(map[int]struct {
+			result1 map[string][]string
+			result2 error
+		})
+	}
+	fake.userPermissionsReturnsOnCall[i] = struct {
+		result1 map[string][]string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1630,6 +2966,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.createLocalUserMutex.RLock()
+	defer fake.createLocalUserMutex.RUnlock()
+	fake.createPolicyExemptionMutex.RLock()
+	defer fake.createPolicyExemptionMutex.RUnlock()
+	fake.deletePolicyExemptionMutex.RLock()
+	defer fake.deletePolicyExemptionMutex.RUnlock()
+	fake.disableLocalUserMutex.RLock()
+	defer fake.disableLocalUserMutex.RUnlock()
+	fake.enableLocalUserMutex.RLock()
+	defer fake.enableLocalUserMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1640,28 +2986,50 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
+	fake.landWorkerWithDrainTimeoutMutex.RLock()
+	defer fake.landWorkerWithDrainTimeoutMutex.RUnlock()
 	fake.listActiveUsersSinceMutex.RLock()
 	defer fake.listActiveUsersSinceMutex.RUnlock()
 	fake.listAllJobsMutex.RLock()
 	defer fake.listAllJobsMutex.RUnlock()
+	fake.listBuildApprovalsMutex.RLock()
+	defer fake.listBuildApprovalsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
+	fake.listLocalUsersMutex.RLock()
+	defer fake.listLocalUsersMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
+	fake.listPolicyExemptionsMutex.RLock()
+	defer fake.listPolicyExemptionsMutex.RUnlock()
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
+	fake.listUserSessionsMutex.RLock()
+	defer fake.listUserSessionsMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setLocalUserPasswordMutex.RLock()
+	defer fake.setLocalUserPasswordMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
+	fake.userPermissionsMutex.RLock()
+	defer fake.userPermissionsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -4,12 +4,25 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type FakeTeam struct {
+	APITokensStub        func() ([]atc.APIToken, error)
+	aPITokensMutex       sync.RWMutex
+	aPITokensArgsForCall []struct {
+	}
+	aPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	aPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ATCTeamStub        func() atc.Team
 	aTCTeamMutex       sync.RWMutex
 	aTCTeamArgsForCall []struct {
@@ -33,6 +46,22 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	AuditEventsStub        func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}
+	auditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
+	auditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
 	AuthStub        func() atc.TeamAuth
 	authMutex       sync.RWMutex
 	authArgsForCall []struct {
@@ -158,6 +187,19 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	CreateAPITokenStub        func(atc.APIToken) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.APIToken
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -253,6 +295,19 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	DeleteAPITokenStub        func(string) (bool, error)
+	deleteAPITokenMutex       sync.RWMutex
+	deleteAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	deleteAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -307,6 +362,22 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ExplainBuildInputsForJobStub        func(atc.PipelineRef, string) (atc.InputsExplanation, bool, error)
+	explainBuildInputsForJobMutex       sync.RWMutex
+	explainBuildInputsForJobArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}
+	explainBuildInputsForJobReturns struct {
+		result1 atc.InputsExplanation
+		result2 bool
+		result3 error
+	}
+	explainBuildInputsForJobReturnsOnCall map[int]struct {
+		result1 atc.InputsExplanation
+		result2 bool
+		result3 error
+	}
 	ExposePipelineStub        func(atc.PipelineRef) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
@@ -562,6 +633,21 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	PipelineBlockedBuildsStub        func(atc.PipelineRef) ([]atc.Build, bool, error)
+	pipelineBlockedBuildsMutex       sync.RWMutex
+	pipelineBlockedBuildsArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineBlockedBuildsReturns struct {
+		result1 []atc.Build
+		result2 bool
+		result3 error
+	}
+	pipelineBlockedBuildsReturnsOnCall map[int]struct {
+		result1 []atc.Build
+		result2 bool
+		result3 error
+	}
 	PipelineBuildsStub        func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	pipelineBuildsMutex       sync.RWMutex
 	pipelineBuildsArgsForCall []struct {
@@ -597,6 +683,22 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineDeliveryMetricsStub        func(atc.PipelineRef, time.Time) (atc.DeliveryMetrics, bool, error)
+	pipelineDeliveryMetricsMutex       sync.RWMutex
+	pipelineDeliveryMetricsArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 time.Time
+	}
+	pipelineDeliveryMetricsReturns struct {
+		result1 atc.DeliveryMetrics
+		result2 bool
+		result3 error
+	}
+	pipelineDeliveryMetricsReturnsOnCall map[int]struct {
+		result1 atc.DeliveryMetrics
+		result2 bool
+		result3 error
+	}
 	RenamePipelineStub        func(atc.PipelineRef, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -769,6 +871,61 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) APITokens() ([]atc.APIToken, error) {
+	fake.aPITokensMutex.Lock()
+	ret, specificReturn := fake.aPITokensReturnsOnCall[len(fake.aPITokensArgsForCall)]
+	fake.aPITokensArgsForCall = append(fake.aPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APITokens", []interface{}{})
+	fake.aPITokensMutex.Unlock()
+	if fake.APITokensStub != nil {
+		return fake.APITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.aPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) APITokensCallCount() int {
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	return len(fake.aPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) APITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = stub
+}
+
+func (fake *FakeTeam) APITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	fake.aPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) APITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	if fake.aPITokensReturnsOnCall == nil {
+		fake.aPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.aPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ATCTeam() atc.Team {
 	fake.aTCTeamMutex.Lock()
 	ret, specificReturn := fake.aTCTeamReturnsOnCall[len(fake.aTCTeamArgsForCall)]
@@ -884,6 +1041,73 @@ func (fake *FakeTeam) ArchivePipelineReturnsOnCall(i int, result1 bool, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) AuditEvents(arg1 concourse.AuditEventFilter, arg2 concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error) {
+	fake.auditEventsMutex.Lock()
+	ret, specificReturn := fake.auditEventsReturnsOnCall[len(fake.auditEventsArgsForCall)]
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}{arg1, arg2})
+	fake.recordInvocation("AuditEvents", []interface{}{arg1, arg2})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.auditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeTeam) AuditEventsCalls(stub func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = stub
+}
+
+func (fake *FakeTeam) AuditEventsArgsForCall(i int) (concourse.AuditEventFilter, concourse.Page) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	argsForCall := fake.auditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) AuditEventsReturns(result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) AuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	if fake.auditEventsReturnsOnCall == nil {
+		fake.auditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 concourse.Pagination
+			result3 error
+		})
+	}
+	fake.auditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Auth() atc.TeamAuth {
 	fake.authMutex.Lock()
 	ret, specificReturn := fake.authReturnsOnCall[len(fake.authArgsForCall)]
@@ -1407,6 +1631,69 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 atc.APIToken) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.APIToken
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(atc.APIToken) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) atc.APIToken {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string, arg3 []string) (atc.WorkerArtifact, error) {
 	var arg3Copy []string
 	if arg3 != nil {
@@ -1817,6 +2104,69 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeleteAPIToken(arg1 string) (bool, error) {
+	fake.deleteAPITokenMutex.Lock()
+	ret, specificReturn := fake.deleteAPITokenReturnsOnCall[len(fake.deleteAPITokenArgsForCall)]
+	fake.deleteAPITokenArgsForCall = append(fake.deleteAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteAPIToken", []interface{}{arg1})
+	fake.deleteAPITokenMutex.Unlock()
+	if fake.DeleteAPITokenStub != nil {
+		return fake.DeleteAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteAPITokenCallCount() int {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	return len(fake.deleteAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteAPITokenCalls(stub func(string) (bool, error)) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = stub
+}
+
+func (fake *FakeTeam) DeleteAPITokenArgsForCall(i int) string {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	argsForCall := fake.deleteAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteAPITokenReturns(result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	fake.deleteAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	if fake.deleteAPITokenReturnsOnCall == nil {
+		fake.deleteAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -2070,6 +2420,73 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ExplainBuildInputsForJob(arg1 atc.PipelineRef, arg2 string) (atc.InputsExplanation, bool, error) {
+	fake.explainBuildInputsForJobMutex.Lock()
+	ret, specificReturn := fake.explainBuildInputsForJobReturnsOnCall[len(fake.explainBuildInputsForJobArgsForCall)]
+	fake.explainBuildInputsForJobArgsForCall = append(fake.explainBuildInputsForJobArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ExplainBuildInputsForJob", []interface{}{arg1, arg2})
+	fake.explainBuildInputsForJobMutex.Unlock()
+	if fake.ExplainBuildInputsForJobStub != nil {
+		return fake.ExplainBuildInputsForJobStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.explainBuildInputsForJobReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ExplainBuildInputsForJobCallCount() int {
+	fake.explainBuildInputsForJobMutex.RLock()
+	defer fake.explainBuildInputsForJobMutex.RUnlock()
+	return len(fake.explainBuildInputsForJobArgsForCall)
+}
+
+func (fake *FakeTeam) ExplainBuildInputsForJobCalls(stub func(atc.PipelineRef, string) (atc.InputsExplanation, bool, error)) {
+	fake.explainBuildInputsForJobMutex.Lock()
+	defer fake.explainBuildInputsForJobMutex.Unlock()
+	fake.ExplainBuildInputsForJobStub = stub
+}
+
+func (fake *FakeTeam) ExplainBuildInputsForJobArgsForCall(i int) (atc.PipelineRef, string) {
+	fake.explainBuildInputsForJobMutex.RLock()
+	defer fake.explainBuildInputsForJobMutex.RUnlock()
+	argsForCall := fake.explainBuildInputsForJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ExplainBuildInputsForJobReturns(result1 atc.InputsExplanation, result2 bool, result3 error) {
+	fake.explainBuildInputsForJobMutex.Lock()
+	defer fake.explainBuildInputsForJobMutex.Unlock()
+	fake.ExplainBuildInputsForJobStub = nil
+	fake.explainBuildInputsForJobReturns = struct {
+		result1 atc.InputsExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ExplainBuildInputsForJobReturnsOnCall(i int, result1 atc.InputsExplanation, result2 bool, result3 error) {
+	fake.explainBuildInputsForJobMutex.Lock()
+	defer fake.explainBuildInputsForJobMutex.Unlock()
+	fake.ExplainBuildInputsForJobStub = nil
+	if fake.explainBuildInputsForJobReturnsOnCall == nil {
+		fake.explainBuildInputsForJobReturnsOnCall = make(map[int]struct {
+			result1 atc.InputsExplanation
+			result2 bool
+			result3 error
+		})
+	}
+	fake.explainBuildInputsForJobReturnsOnCall[i] = struct {
+		result1 atc.InputsExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ExposePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
@@ -3249,6 +3666,72 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 atc.Pipeline, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) PipelineBlockedBuilds(arg1 atc.PipelineRef) ([]atc.Build, bool, error) {
+	fake.pipelineBlockedBuildsMutex.Lock()
+	ret, specificReturn := fake.pipelineBlockedBuildsReturnsOnCall[len(fake.pipelineBlockedBuildsArgsForCall)]
+	fake.pipelineBlockedBuildsArgsForCall = append(fake.pipelineBlockedBuildsArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("PipelineBlockedBuilds", []interface{}{arg1})
+	fake.pipelineBlockedBuildsMutex.Unlock()
+	if fake.PipelineBlockedBuildsStub != nil {
+		return fake.PipelineBlockedBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineBlockedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineBlockedBuildsCallCount() int {
+	fake.pipelineBlockedBuildsMutex.RLock()
+	defer fake.pipelineBlockedBuildsMutex.RUnlock()
+	return len(fake.pipelineBlockedBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineBlockedBuildsCalls(stub func(atc.PipelineRef) ([]atc.Build, bool, error)) {
+	fake.pipelineBlockedBuildsMutex.Lock()
+	defer fake.pipelineBlockedBuildsMutex.Unlock()
+	fake.PipelineBlockedBuildsStub = stub
+}
+
+func (fake *FakeTeam) PipelineBlockedBuildsArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineBlockedBuildsMutex.RLock()
+	defer fake.pipelineBlockedBuildsMutex.RUnlock()
+	argsForCall := fake.pipelineBlockedBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineBlockedBuildsReturns(result1 []atc.Build, result2 bool, result3 error) {
+	fake.pipelineBlockedBuildsMutex.Lock()
+	defer fake.pipelineBlockedBuildsMutex.Unlock()
+	fake.PipelineBlockedBuildsStub = nil
+	fake.pipelineBlockedBuildsReturns = struct {
+		result1 []atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineBlockedBuildsReturnsOnCall(i int, result1 []atc.Build, result2 bool, result3 error) {
+	fake.pipelineBlockedBuildsMutex.Lock()
+	defer fake.pipelineBlockedBuildsMutex.Unlock()
+	fake.PipelineBlockedBuildsStub = nil
+	if fake.pipelineBlockedBuildsReturnsOnCall == nil {
+		fake.pipelineBlockedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineBlockedBuildsReturnsOnCall[i] = struct {
+		result1 []atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) PipelineBuilds(arg1 atc.PipelineRef, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.pipelineBuildsMutex.Lock()
 	ret, specificReturn := fake.pipelineBuildsReturnsOnCall[len(fake.pipelineBuildsArgsForCall)]
@@ -3388,6 +3871,73 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineDeliveryMetrics(arg1 atc.PipelineRef, arg2 time.Time) (atc.DeliveryMetrics, bool, error) {
+	fake.pipelineDeliveryMetricsMutex.Lock()
+	ret, specificReturn := fake.pipelineDeliveryMetricsReturnsOnCall[len(fake.pipelineDeliveryMetricsArgsForCall)]
+	fake.pipelineDeliveryMetricsArgsForCall = append(fake.pipelineDeliveryMetricsArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineDeliveryMetrics", []interface{}{arg1, arg2})
+	fake.pipelineDeliveryMetricsMutex.Unlock()
+	if fake.PipelineDeliveryMetricsStub != nil {
+		return fake.PipelineDeliveryMetricsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineDeliveryMetricsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineDeliveryMetricsCallCount() int {
+	fake.pipelineDeliveryMetricsMutex.RLock()
+	defer fake.pipelineDeliveryMetricsMutex.RUnlock()
+	return len(fake.pipelineDeliveryMetricsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineDeliveryMetricsCalls(stub func(atc.PipelineRef, time.Time) (atc.DeliveryMetrics, bool, error)) {
+	fake.pipelineDeliveryMetricsMutex.Lock()
+	defer fake.pipelineDeliveryMetricsMutex.Unlock()
+	fake.PipelineDeliveryMetricsStub = stub
+}
+
+func (fake *FakeTeam) PipelineDeliveryMetricsArgsForCall(i int) (atc.PipelineRef, time.Time) {
+	fake.pipelineDeliveryMetricsMutex.RLock()
+	defer fake.pipelineDeliveryMetricsMutex.RUnlock()
+	argsForCall := fake.pipelineDeliveryMetricsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineDeliveryMetricsReturns(result1 atc.DeliveryMetrics, result2 bool, result3 error) {
+	fake.pipelineDeliveryMetricsMutex.Lock()
+	defer fake.pipelineDeliveryMetricsMutex.Unlock()
+	fake.PipelineDeliveryMetricsStub = nil
+	fake.pipelineDeliveryMetricsReturns = struct {
+		result1 atc.DeliveryMetrics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineDeliveryMetricsReturnsOnCall(i int, result1 atc.DeliveryMetrics, result2 bool, result3 error) {
+	fake.pipelineDeliveryMetricsMutex.Lock()
+	defer fake.pipelineDeliveryMetricsMutex.Unlock()
+	fake.PipelineDeliveryMetricsStub = nil
+	if fake.pipelineDeliveryMetricsReturnsOnCall == nil {
+		fake.pipelineDeliveryMetricsReturnsOnCall = make(map[int]struct {
+			result1 atc.DeliveryMetrics
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineDeliveryMetricsReturnsOnCall[i] = struct {
+		result1 atc.DeliveryMetrics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 atc.PipelineRef, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -4115,10 +4665,14 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
 	fake.aTCTeamMutex.RLock()
 	defer fake.aTCTeamMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
 	fake.authMutex.RLock()
 	defer fake.authMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
@@ -4135,6 +4689,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -4147,6 +4703,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
@@ -4155,6 +4713,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
+	fake.explainBuildInputsForJobMutex.RLock()
+	defer fake.explainBuildInputsForJobMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
@@ -4193,10 +4753,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pinResourceVersionMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineBlockedBuildsMutex.RLock()
+	defer fake.pipelineBlockedBuildsMutex.RUnlock()
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineDeliveryMetricsMutex.RLock()
+	defer fake.pipelineDeliveryMetricsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -0,0 +1,109 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListLocalUsers() ([]atc.LocalUser, error) {
+	var users []atc.LocalUser
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListLocalUsers,
+	}, &internal.Response{
+		Result: &users,
+	})
+	return users, err
+}
+
+func (client *client) CreateLocalUser(username string, password string) (atc.LocalUser, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.LocalUser{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return atc.LocalUser{}, fmt.Errorf("Unable to marshal local user: %s", err)
+	}
+
+	var created atc.LocalUser
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreateLocalUser,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return created, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict {
+			return atc.LocalUser{}, GenericError{Message: e.Body}
+		}
+
+		return atc.LocalUser{}, err
+	default:
+		return atc.LocalUser{}, err
+	}
+}
+
+func (client *client) SetLocalUserPassword(username string, password string) (bool, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.LocalUser{
+		Password: password,
+	})
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal local user: %s", err)
+	}
+
+	return client.updateLocalUser(internal.Request{
+		RequestName: atc.SetLocalUserPassword,
+		Params:      rata.Params{"local_user_name": username},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	})
+}
+
+func (client *client) DisableLocalUser(username string) (bool, error) {
+	return client.updateLocalUser(internal.Request{
+		RequestName: atc.DisableLocalUser,
+		Params:      rata.Params{"local_user_name": username},
+	})
+}
+
+func (client *client) EnableLocalUser(username string) (bool, error) {
+	return client.updateLocalUser(internal.Request{
+		RequestName: atc.EnableLocalUser,
+		Params:      rata.Params{"local_user_name": username},
+	})
+}
+
+func (client *client) updateLocalUser(request internal.Request) (bool, error) {
+	err := client.connection.Send(request, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict {
+			return false, GenericError{Message: e.Body}
+		}
+
+		return false, err
+	default:
+		return false, err
+	}
+}
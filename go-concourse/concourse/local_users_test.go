@@ -0,0 +1,148 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Local Users", func() {
+	Describe("ListLocalUsers", func() {
+		var expectedUsers []atc.LocalUser
+
+		BeforeEach(func() {
+			expectedUsers = []atc.LocalUser{
+				{Username: "some-user", CreatedAt: 100},
+				{Username: "static-user", ReadOnly: true},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/local_users"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedUsers),
+				),
+			)
+		})
+
+		It("returns the local users", func() {
+			users, err := client.ListLocalUsers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(Equal(expectedUsers))
+		})
+	})
+
+	Describe("CreateLocalUser", func() {
+		Context("when the user is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/local_users"),
+						ghttp.VerifyJSON(`{"username":"some-user","password":"some-password"}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.LocalUser{
+							Username:  "some-user",
+							CreatedAt: 100,
+						}),
+					),
+				)
+			})
+
+			It("returns the created user", func() {
+				created, err := client.CreateLocalUser("some-user", "some-password")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.Username).To(Equal("some-user"))
+				Expect(created.CreatedAt).To(Equal(int64(100)))
+			})
+		})
+
+		Context("when the user already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/local_users"),
+						ghttp.RespondWith(http.StatusConflict, "a local user with this username already exists"),
+					),
+				)
+			})
+
+			It("returns the error from the server", func() {
+				_, err := client.CreateLocalUser("some-user", "some-password")
+				Expect(err).To(MatchError("a local user with this username already exists"))
+			})
+		})
+	})
+
+	Describe("SetLocalUserPassword", func() {
+		var status int
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/password"),
+					ghttp.VerifyJSON(`{"username":"","password":"new-password"}`),
+					ghttp.RespondWithPtr(&status, nil),
+				),
+			)
+		})
+
+		It("sets the password", func() {
+			found, err := client.SetLocalUserPassword("some-user", "new-password")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := client.SetLocalUserPassword("some-user", "new-password")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DisableLocalUser", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/disable"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("disables the user", func() {
+			found, err := client.DisableLocalUser("some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
+
+	Describe("EnableLocalUser", func() {
+		Context("when the user was added with a flag", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/local_users/some-user/enable"),
+						ghttp.RespondWith(http.StatusConflict, "read-only"),
+					),
+				)
+			})
+
+			It("returns the error from the server", func() {
+				_, err := client.EnableLocalUser("some-user")
+				Expect(err).To(MatchError("read-only"))
+			})
+		})
+	})
+})
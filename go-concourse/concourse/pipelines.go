@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -36,6 +39,60 @@ func (team *team) Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, err
 	}
 }
 
+func (team *team) PipelineDeliveryMetrics(pipelineRef atc.PipelineRef, since time.Time) (atc.DeliveryMetrics, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	query := merge(url.Values{
+		"since": []string{strconv.FormatInt(since.Unix(), 10)},
+	}, pipelineRef.QueryParams())
+
+	var metrics atc.DeliveryMetrics
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineDeliveryMetrics,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &metrics,
+	})
+
+	switch err.(type) {
+	case nil:
+		return metrics, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.DeliveryMetrics{}, false, nil
+	default:
+		return atc.DeliveryMetrics{}, false, err
+	}
+}
+
+func (team *team) PipelineBlockedBuilds(pipelineRef atc.PipelineRef) ([]atc.Build, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	var builds []atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListPipelineBlockedBuilds,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &builds,
+	})
+
+	switch err.(type) {
+	case nil:
+		return builds, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
 func (team *team) OrderingPipelines(pipelineRefs atc.OrderPipelinesRequest) error {
 	params := rata.Params{
 		"team_name": team.Name(),
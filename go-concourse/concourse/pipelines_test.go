@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -331,6 +332,111 @@ var _ = Describe("ATC Handler Pipelines", func() {
 		})
 	})
 
+	Describe("PipelineDeliveryMetrics", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/delivery-metrics"
+		queryParams := "since=1600000000&instance_vars=%7B%22branch%22%3A%22master%22%7D"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		expectedMetrics := atc.DeliveryMetrics{
+			Since: 1600000000,
+			Jobs: []atc.JobDeliveryMetrics{
+				{
+					TeamName:     "some-team",
+					PipelineName: "mypipeline",
+					JobName:      "deploy",
+					Deployments:  4,
+					LeadTime:     3600,
+				},
+			},
+		}
+
+		Context("when the pipeline is found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMetrics),
+					),
+				)
+			})
+
+			It("returns the delivery metrics since the given time", func() {
+				metrics, found, err := team.PipelineDeliveryMetrics(pipelineRef, time.Unix(1600000000, 0))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(metrics).To(Equal(expectedMetrics))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineDeliveryMetrics(pipelineRef, time.Unix(1600000000, 0))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineBlockedBuilds", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/blocked-builds"
+		queryParams := "instance_vars=%7B%22branch%22%3A%22master%22%7D"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		expectedBuilds := []atc.Build{
+			{
+				ID:            1,
+				Name:          "3",
+				Status:        "pending",
+				JobName:       "deploy",
+				PendingReason: "max in flight reached",
+			},
+		}
+
+		Context("when the pipeline is found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds),
+					),
+				)
+			})
+
+			It("returns the blocked builds", func() {
+				builds, found, err := team.PipelineBlockedBuilds(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineBlockedBuilds(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("team.ListPipelines", func() {
 		var expectedPipelines []atc.Pipeline
 
@@ -0,0 +1,73 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListPolicyExemptions() ([]atc.PolicyExemption, error) {
+	var exemptions []atc.PolicyExemption
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListPolicyExemptions,
+	}, &internal.Response{
+		Result: &exemptions,
+	})
+	return exemptions, err
+}
+
+func (client *client) CreatePolicyExemption(exemption atc.PolicyExemption) (atc.PolicyExemption, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(exemption)
+	if err != nil {
+		return atc.PolicyExemption{}, fmt.Errorf("Unable to marshal policy exemption: %s", err)
+	}
+
+	var created atc.PolicyExemption
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreatePolicyExemption,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return created, nil
+	case internal.ResourceNotFoundError:
+		return atc.PolicyExemption{}, fmt.Errorf("team '%s' not found", exemption.Team)
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest {
+			return atc.PolicyExemption{}, GenericError{Message: e.Body}
+		}
+
+		return atc.PolicyExemption{}, err
+	default:
+		return atc.PolicyExemption{}, err
+	}
+}
+
+func (client *client) DeletePolicyExemption(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.DeletePolicyExemption,
+		Params:      rata.Params{"exemption_id": strconv.Itoa(id)},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
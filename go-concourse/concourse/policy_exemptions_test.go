@@ -0,0 +1,146 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Policy Exemptions", func() {
+	Describe("ListPolicyExemptions", func() {
+		var expectedExemptions []atc.PolicyExemption
+
+		BeforeEach(func() {
+			expectedExemptions = []atc.PolicyExemption{
+				{
+					ID:        1,
+					Team:      "some-team",
+					Action:    "RunStep",
+					Reason:    "migrating",
+					ExpiresAt: 200,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/policy/exemptions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedExemptions),
+				),
+			)
+		})
+
+		It("returns the exemptions", func() {
+			exemptions, err := client.ListPolicyExemptions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exemptions).To(Equal(expectedExemptions))
+		})
+	})
+
+	Describe("CreatePolicyExemption", func() {
+		var exemption atc.PolicyExemption
+
+		BeforeEach(func() {
+			exemption = atc.PolicyExemption{
+				Team:      "some-team",
+				Action:    "RunStep",
+				Reason:    "migrating",
+				ExpiresAt: 200,
+			}
+		})
+
+		Context("when the exemption is created", func() {
+			BeforeEach(func() {
+				created := exemption
+				created.ID = 42
+				created.CreatedBy = "some-admin"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/policy/exemptions"),
+						ghttp.VerifyJSONRepresenting(exemption),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+					),
+				)
+			})
+
+			It("returns the created exemption", func() {
+				created, err := client.CreatePolicyExemption(exemption)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.ID).To(Equal(42))
+				Expect(created.CreatedBy).To(Equal("some-admin"))
+			})
+		})
+
+		Context("when the exemption is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/policy/exemptions"),
+						ghttp.RespondWith(http.StatusBadRequest, "reason must be specified"),
+					),
+				)
+			})
+
+			It("returns the validation error", func() {
+				_, err := client.CreatePolicyExemption(exemption)
+				Expect(err).To(MatchError("reason must be specified"))
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/policy/exemptions"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.CreatePolicyExemption(exemption)
+				Expect(err).To(MatchError("team 'some-team' not found"))
+			})
+		})
+	})
+
+	Describe("DeletePolicyExemption", func() {
+		Context("when the exemption exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/policy/exemptions/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes it", func() {
+				found, err := client.DeletePolicyExemption(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the exemption does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/policy/exemptions/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := client.DeletePolicyExemption(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
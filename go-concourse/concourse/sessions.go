@@ -0,0 +1,90 @@
+package concourse
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListSessions() ([]atc.Session, error) {
+	var sessions []atc.Session
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListSessions,
+	}, &internal.Response{
+		Result: &sessions,
+	})
+	return sessions, sessionError(err)
+}
+
+func (client *client) RevokeSession(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeSession,
+		Params:      rata.Params{"session_id": strconv.Itoa(id)},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, sessionError(err)
+	}
+}
+
+func (client *client) RevokeSessions() (int, error) {
+	var revoked atc.RevokedSessions
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeSessions,
+	}, &internal.Response{
+		Result: &revoked,
+	})
+	return revoked.Revoked, sessionError(err)
+}
+
+func (client *client) ListUserSessions(username string, connector string) ([]atc.Session, error) {
+	var sessions []atc.Session
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListUserSessions,
+		Params:      rata.Params{"user_name": username},
+		Query:       connectorQuery(connector),
+	}, &internal.Response{
+		Result: &sessions,
+	})
+	return sessions, sessionError(err)
+}
+
+func (client *client) RevokeUserSessions(username string, connector string) (int, error) {
+	var revoked atc.RevokedSessions
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeUserSessions,
+		Params:      rata.Params{"user_name": username},
+		Query:       connectorQuery(connector),
+	}, &internal.Response{
+		Result: &revoked,
+	})
+	return revoked.Revoked, sessionError(err)
+}
+
+// sessionError explains the 400 returned when the request was not made with a
+// user's token, e.g. with an API token.
+func sessionError(err error) error {
+	if e, ok := err.(internal.UnexpectedResponseError); ok && e.StatusCode == http.StatusBadRequest {
+		return GenericError{Message: "sessions are only available to users who logged in"}
+	}
+
+	return err
+}
+
+func connectorQuery(connector string) url.Values {
+	query := url.Values{}
+	if connector != "" {
+		query.Set("connector", connector)
+	}
+
+	return query
+}
@@ -0,0 +1,148 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Sessions", func() {
+	Describe("ListSessions", func() {
+		Context("when the sessions are listed", func() {
+			var expectedSessions []atc.Session
+
+			BeforeEach(func() {
+				expectedSessions = []atc.Session{
+					{ID: 2, Username: "some-user", Connector: "github", Current: true},
+					{ID: 1, Username: "some-user", Connector: "github"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/user/sessions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSessions),
+					),
+				)
+			})
+
+			It("returns the sessions", func() {
+				sessions, err := client.ListSessions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(Equal(expectedSessions))
+			})
+		})
+
+		Context("when not logged in as a user", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/user/sessions"),
+						ghttp.RespondWith(http.StatusBadRequest, ""),
+					),
+				)
+			})
+
+			It("returns a helpful error", func() {
+				_, err := client.ListSessions()
+				Expect(err).To(BeAssignableToTypeOf(concourse.GenericError{}))
+			})
+		})
+	})
+
+	Describe("RevokeSession", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/user/sessions/42"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the session is revoked", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				revoked, err := client.RevokeSession(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the session is not found", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				revoked, err := client.RevokeSession(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/user/sessions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Revoked: 3}),
+				),
+			)
+		})
+
+		It("returns the number of revoked sessions", func() {
+			revoked, err := client.RevokeSessions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(Equal(3))
+		})
+	})
+
+	Describe("ListUserSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/users/other-user/sessions", "connector=local"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{
+						{ID: 1, Username: "other-user", Connector: "local"},
+					}),
+				),
+			)
+		})
+
+		It("returns the user's sessions", func() {
+			sessions, err := client.ListUserSessions("other-user", "local")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal([]atc.Session{
+				{ID: 1, Username: "other-user", Connector: "local"},
+			}))
+		})
+	})
+
+	Describe("RevokeUserSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/users/other-user/sessions", ""),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Revoked: 2}),
+				),
+			)
+		})
+
+		It("revokes the user's sessions on every connector", func() {
+			revoked, err := client.RevokeUserSessions("other-user", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(Equal(2))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -21,6 +22,8 @@ type Team interface {
 
 	Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error)
+	PipelineDeliveryMetrics(pipelineRef atc.PipelineRef, since time.Time) (atc.DeliveryMetrics, bool, error)
+	PipelineBlockedBuilds(pipelineRef atc.PipelineRef) ([]atc.Build, bool, error)
 	DeletePipeline(pipelineRef atc.PipelineRef) (bool, error)
 	PausePipeline(pipelineRef atc.PipelineRef) (bool, error)
 	ArchivePipeline(pipelineRef atc.PipelineRef) (bool, error)
@@ -35,6 +38,7 @@ type Team interface {
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
 	BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) ([]atc.BuildInput, bool, error)
+	ExplainBuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) (atc.InputsExplanation, bool, error)
 
 	Job(pipelineRef atc.PipelineRef, jobName string) (atc.Job, bool, error)
 	JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error)
@@ -74,6 +78,12 @@ type Team interface {
 
 	CreateArtifact(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
+
+	APITokens() ([]atc.APIToken, error)
+	CreateAPIToken(atc.APIToken) (atc.APIToken, error)
+	DeleteAPIToken(name string) (bool, error)
+
+	AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error)
 }
 
 type team struct {
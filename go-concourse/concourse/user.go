@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -38,3 +39,19 @@ func (client *client) UserInfo() (atc.UserInfo, error) {
 		}
 	}
 }
+
+// UserPermissions returns the actions the user may perform, by team.
+func (client *client) UserPermissions() (map[string][]string, error) {
+	var userInfo atc.UserInfo
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetUser,
+		Query:       url.Values{"permissions": {"true"}},
+	}, &internal.Response{
+		Result: &userInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userInfo.Permissions, nil
+}
@@ -46,4 +46,29 @@ var _ = Describe("Skymarshal Handler User", func() {
 			Expect(result.Teams).To(HaveKeyWithValue("test_team", ContainElement("viewer")))
 		})
 	})
+
+	Describe("UserPermissions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user", "permissions=true"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.UserInfo{
+						UserName: "test_user_name",
+						Teams:    map[string][]string{"test_team": {"viewer", "pauser"}},
+						Permissions: map[string][]string{
+							"test_team": {atc.GetPipeline, atc.PausePipeline},
+						},
+					}),
+				),
+			)
+		})
+
+		It("returns the permissions by team", func() {
+			permissions, err := client.UserPermissions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(permissions).To(Equal(map[string][]string{
+				"test_team": {atc.GetPipeline, atc.PausePipeline},
+			}))
+		})
+	})
 })
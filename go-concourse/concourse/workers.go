@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -81,10 +82,24 @@ func (client *client) PruneWorker(workerName string) error {
 }
 
 func (client *client) LandWorker(workerName string) error {
+	return client.LandWorkerWithDrainTimeout(workerName, 0)
+}
+
+// LandWorkerWithDrainTimeout lands the worker, and lands it anyway once the
+// drain timeout elapses, even if builds are still running on it. A zero
+// timeout waits for the builds.
+func (client *client) LandWorkerWithDrainTimeout(workerName string, drainTimeout time.Duration) error {
 	params := rata.Params{"worker_name": workerName}
+
+	query := url.Values{}
+	if drainTimeout != 0 {
+		query.Set("drain_timeout", drainTimeout.String())
+	}
+
 	err := client.connection.Send(internal.Request{
 		RequestName: atc.LandWorker,
 		Params:      params,
+		Query:       query,
 		Header: http.Header{
 			"Content-Type": {"application/json"},
 		},
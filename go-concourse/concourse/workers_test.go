@@ -2,6 +2,7 @@ package concourse_test
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -144,6 +145,22 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 
+		Context("when a drain timeout is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "drain_timeout=1h0m0s"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("lands the worker with the drain timeout", func() {
+				err := client.LandWorkerWithDrainTimeout("some-worker", time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("failing to land worker", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
@@ -22,3 +22,9 @@ deny["cannot use privileged resource types"] {
   input.action == "SaveConfig"
   input.data.resource_types[_].privileged
 }
+
+deny["cannot run privileged tasks on untagged workers"] {
+  input.action == "RunStep"
+  input.data.privileged
+  count(input.data.worker.tags) == 0
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/skymarshal/logger"
 	"github.com/concourse/concourse/skymarshal/skycmd"
 	s "github.com/concourse/concourse/skymarshal/storage"
@@ -26,6 +27,10 @@ type DexConfig struct {
 	Users       map[string]string
 	RedirectURL string
 	Storage     s.Storage
+
+	// LocalUserFactory provides the local users managed through the API, in
+	// addition to Users.
+	LocalUserFactory db.LocalUserFactory
 }
 
 func NewDexServer(config *DexConfig) (*server.Server, error) {
@@ -54,11 +59,7 @@ func NewDexServerConfig(config *DexConfig) (server.Config, error) {
 	}
 
 	if len(passwords) > 0 {
-		connectors = append(connectors, storage.Connector{
-			ID:   "local",
-			Type: "local",
-			Name: "Username/Password",
-		})
+		connectors = append(connectors, localConnector)
 	}
 
 	redirectURI := strings.TrimRight(config.IssuerURL, "/") + "/callback"
@@ -94,6 +95,11 @@ func NewDexServerConfig(config *DexConfig) (server.Config, error) {
 		return server.Config{}, err
 	}
 
+	store := config.Storage
+	if config.LocalUserFactory != nil {
+		store = newLocalUserStorage(store, config.LocalUserFactory)
+	}
+
 	webConfig := server.WebConfig{
 		LogoURL: strings.TrimRight(config.WebHostURL, "/") + "/themes/concourse/logo.svg",
 		HostURL: config.WebHostURL,
@@ -108,7 +114,7 @@ func NewDexServerConfig(config *DexConfig) (server.Config, error) {
 		SkipApprovalScreen:     true,
 		IDTokensValidFor:       config.Expiration,
 		Issuer:                 config.IssuerURL,
-		Storage:                config.Storage,
+		Storage:                store,
 		Web:                    webConfig,
 		Logger:                 logger.New(config.Logger),
 	}, nil
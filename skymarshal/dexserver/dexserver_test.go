@@ -8,6 +8,8 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/skymarshal/dexserver"
 	store "github.com/concourse/concourse/skymarshal/storage"
 	"github.com/concourse/dex/server"
@@ -181,6 +183,96 @@ var _ = Describe("Dex Server", func() {
 			})
 		})
 
+		Context("when local users are managed through the API", func() {
+			var fakeLocalUserFactory *dbfakes.FakeLocalUserFactory
+
+			BeforeEach(func() {
+				fakeLocalUserFactory = new(dbfakes.FakeLocalUserFactory)
+				config.LocalUserFactory = fakeLocalUserFactory
+			})
+
+			Context("when there are enabled users", func() {
+				BeforeEach(func() {
+					fakeLocalUserFactory.LocalUsersReturns([]atc.LocalUser{{Username: "api-user"}}, nil)
+					fakeLocalUserFactory.FindLocalUserReturns(atc.LocalUser{Username: "api-user"}, true, nil)
+					fakeLocalUserFactory.LocalUserPasswordHashReturns([]byte("some-hash"), true, nil)
+				})
+
+				It("configures the local connector", func() {
+					connectors, err := serverConfig.Storage.ListConnectors()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(connectors[0].ID).To(Equal("local"))
+
+					connector, err := serverConfig.Storage.GetConnector("local")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(connector.Type).To(Equal("local"))
+				})
+
+				It("finds the users' passwords", func() {
+					password, err := serverConfig.Storage.GetPassword("API-User")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(password.UserID).To(Equal("api-user"))
+					Expect(password.Username).To(Equal("api-user"))
+					Expect(password.Email).To(Equal("api-user"))
+					Expect(password.Hash).To(Equal([]byte("some-hash")))
+
+					Expect(fakeLocalUserFactory.FindLocalUserArgsForCall(0)).To(Equal("API-User"))
+				})
+
+				Context("when a user was also added with --add-local-user", func() {
+					BeforeEach(func() {
+						config.Users = map[string]string{
+							"api-user": "some-password",
+						}
+					})
+
+					It("prefers the user added with the flag", func() {
+						password, err := serverConfig.Storage.GetPassword("api-user")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(bcrypt.CompareHashAndPassword(password.Hash, []byte("some-password"))).NotTo(HaveOccurred())
+						Expect(fakeLocalUserFactory.FindLocalUserCallCount()).To(BeZero())
+					})
+
+					It("does not configure the local connector twice", func() {
+						connectors, err := serverConfig.Storage.ListConnectors()
+						Expect(err).NotTo(HaveOccurred())
+
+						var local int
+						for _, connector := range connectors {
+							if connector.ID == "local" {
+								local++
+							}
+						}
+
+						Expect(local).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when a user is disabled", func() {
+				BeforeEach(func() {
+					fakeLocalUserFactory.LocalUsersReturns([]atc.LocalUser{{Username: "api-user", Disabled: true}}, nil)
+					fakeLocalUserFactory.FindLocalUserReturns(atc.LocalUser{Username: "api-user", Disabled: true}, true, nil)
+				})
+
+				It("does not find the user's password", func() {
+					_, err := serverConfig.Storage.GetPassword("api-user")
+					Expect(err).To(MatchError("not found"))
+				})
+
+				It("does not configure the local connector", func() {
+					connectors, err := serverConfig.Storage.ListConnectors()
+					Expect(err).NotTo(HaveOccurred())
+					for _, connector := range connectors {
+						Expect(connector.ID).ToNot(Equal("local"))
+					}
+
+					_, err = serverConfig.Storage.GetConnector("local")
+					Expect(err).To(MatchError("not found"))
+				})
+			})
+		})
+
 		Context("when clients are configured in plain text", func() {
 			BeforeEach(func() {
 				config.Clients = map[string]string{
@@ -0,0 +1,124 @@
+package dexserver
+
+import (
+	"github.com/concourse/concourse/atc/db"
+	s "github.com/concourse/concourse/skymarshal/storage"
+	"github.com/concourse/dex/storage"
+)
+
+var localConnector = storage.Connector{
+	ID:   "local",
+	Type: "local",
+	Name: "Username/Password",
+}
+
+// localUserStorage adds the local users managed through the API to the users
+// added with the --add-local-user flag. Dex looks up every login in its
+// storage, so users created, disabled or given a new password through the API
+// take effect without restarting the web node.
+type localUserStorage struct {
+	s.Storage
+
+	localUserFactory db.LocalUserFactory
+}
+
+func newLocalUserStorage(store s.Storage, localUserFactory db.LocalUserFactory) s.Storage {
+	return &localUserStorage{
+		Storage:          store,
+		localUserFactory: localUserFactory,
+	}
+}
+
+// GetPassword prefers the users added with the --add-local-user flag, and
+// ignores disabled users.
+func (store *localUserStorage) GetPassword(email string) (storage.Password, error) {
+	password, err := store.Storage.GetPassword(email)
+	if err != storage.ErrNotFound {
+		return password, err
+	}
+
+	user, found, err := store.localUserFactory.FindLocalUser(email)
+	if err != nil {
+		return storage.Password{}, err
+	}
+
+	if !found || user.Disabled {
+		return storage.Password{}, storage.ErrNotFound
+	}
+
+	hash, found, err := store.localUserFactory.LocalUserPasswordHash(user.Username)
+	if err != nil {
+		return storage.Password{}, err
+	}
+
+	if !found {
+		return storage.Password{}, storage.ErrNotFound
+	}
+
+	return storage.Password{
+		UserID:   user.Username,
+		Username: user.Username,
+		Email:    user.Username,
+		Hash:     hash,
+	}, nil
+}
+
+// ListConnectors includes the local connector as soon as a user has been
+// created through the API, even if no users were added with the
+// --add-local-user flag.
+func (store *localUserStorage) ListConnectors() ([]storage.Connector, error) {
+	connectors, err := store.Storage.ListConnectors()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connector := range connectors {
+		if connector.ID == localConnector.ID {
+			return connectors, nil
+		}
+	}
+
+	hasUsers, err := store.hasLocalUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	if hasUsers {
+		connectors = append([]storage.Connector{localConnector}, connectors...)
+	}
+
+	return connectors, nil
+}
+
+func (store *localUserStorage) GetConnector(id string) (storage.Connector, error) {
+	connector, err := store.Storage.GetConnector(id)
+	if err != storage.ErrNotFound || id != localConnector.ID {
+		return connector, err
+	}
+
+	hasUsers, err := store.hasLocalUsers()
+	if err != nil {
+		return storage.Connector{}, err
+	}
+
+	if !hasUsers {
+		return storage.Connector{}, storage.ErrNotFound
+	}
+
+	return localConnector, nil
+}
+
+func (store *localUserStorage) hasLocalUsers() (bool, error) {
+	users, err := store.localUserFactory.LocalUsers()
+	if err != nil {
+		return false, err
+	}
+
+	for _, user := range users {
+		if !user.Disabled {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
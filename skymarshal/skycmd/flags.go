@@ -51,7 +51,9 @@ type AuthFlags struct {
 }
 
 type AuthTeamFlags struct {
-	LocalUsers []string  `long:"local-user" description:"A whitelisted local concourse user. These are the users you've added at web startup with the --add-local-user flag." value-name:"USERNAME"`
+	LocalUsers []string  `long:"local-user" description:"A whitelisted local concourse user. These are the users you've added at web startup with the --add-local-user flag or with fly create-local-user." value-name:"USERNAME"`
+	CertUsers  []string  `long:"cert-user" description:"A whitelisted client certificate, identified by its URI SAN (e.g. a SPIFFE ID) or its common name. Requires --tls-client-ca on the web node." value-name:"URI_OR_COMMON_NAME"`
+	CertGroups []string  `long:"cert-group" description:"A whitelisted organizational unit of client certificates. Requires --tls-client-ca on the web node." value-name:"ORGANIZATIONAL_UNIT"`
 	Config     flag.File `short:"c" long:"config" description:"Configuration file for specifying team params"`
 }
 
@@ -133,14 +135,47 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 			}
 		}
 
+		if conf, ok := role["cert"].(map[string]interface{}); ok {
+			certUsers, err := formatNames(conf["users"])
+			if err != nil {
+				return nil, fmt.Errorf("role %s: cert users: %w", roleName, err)
+			}
+
+			certGroups, err := formatNames(conf["groups"])
+			if err != nil {
+				return nil, fmt.Errorf("role %s: cert groups: %w", roleName, err)
+			}
+
+			users = append(users, prefixed("cert", certUsers)...)
+			groups = append(groups, prefixed("cert", certGroups)...)
+		}
+
 		if len(users) == 0 && len(groups) == 0 {
 			continue
 		}
 
-		auth[roleName] = map[string][]string{
+		config := map[string][]string{
 			"users":  users,
 			"groups": groups,
 		}
+
+		if rawPipelines, ok := role[atc.TeamAuthPipelines]; ok {
+			pipelines, err := formatPipelines(rawPipelines)
+			if err != nil {
+				return nil, fmt.Errorf("role %s: %w", roleName, err)
+			}
+
+			config[atc.TeamAuthPipelines] = pipelines
+		}
+
+		// a role may be bound more than once, e.g. to the whole team and to
+		// only some of its pipelines
+		key := roleName
+		for n := 1; auth[key] != nil; n++ {
+			key = fmt.Sprintf("%s/%d", roleName, n)
+		}
+
+		auth[key] = config
 	}
 
 	if err := auth.Validate(); err != nil {
@@ -150,6 +185,66 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 	return auth, nil
 }
 
+func formatPipelines(rawPipelines interface{}) ([]string, error) {
+	list, ok := rawPipelines.([]interface{})
+	if !ok {
+		return nil, errors.New("pipelines must be a list of pipeline names or globs")
+	}
+
+	pipelines := []string{}
+	for _, rawPipeline := range list {
+		pipeline, ok := rawPipeline.(string)
+		if !ok || pipeline == "" {
+			return nil, errors.New("pipelines must be a list of pipeline names or globs")
+		}
+
+		pipelines = append(pipelines, pipeline)
+	}
+
+	if len(pipelines) == 0 {
+		return nil, errors.New("pipelines must not be empty")
+	}
+
+	return pipelines, nil
+}
+
+// formatNames reads an optional list of names from a configuration file.
+func formatNames(rawNames interface{}) ([]string, error) {
+	if rawNames == nil {
+		return nil, nil
+	}
+
+	list, ok := rawNames.([]interface{})
+	if !ok {
+		return nil, errors.New("must be a list")
+	}
+
+	names := []string{}
+	for _, rawName := range list {
+		name, ok := rawName.(string)
+		if !ok {
+			return nil, errors.New("must be a list of strings")
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// prefixed qualifies the names with the connector they belong to, skipping
+// empty names.
+func prefixed(connectorID string, names []string) []string {
+	qualified := []string{}
+	for _, name := range names {
+		if name != "" {
+			qualified = append(qualified, connectorID+":"+strings.ToLower(name))
+		}
+	}
+
+	return qualified
+}
+
 // When formatting team config from the command line flags, the connector's
 // TeamConfig has already been populated by the flags library. All we need to
 // do is grab the teamConfig object and extract the users and groups.
@@ -182,6 +277,9 @@ func (flag *AuthTeamFlags) formatFromFlags() (atc.TeamAuth, error) {
 		}
 	}
 
+	users = append(users, prefixed("cert", flag.CertUsers)...)
+	groups = append(groups, prefixed("cert", flag.CertGroups)...)
+
 	if len(users) == 0 && len(groups) == 0 {
 		return nil, atc.ErrAuthConfigInvalid
 	}
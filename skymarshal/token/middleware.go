@@ -2,7 +2,10 @@ package token
 
 import (
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . Middleware
@@ -124,3 +127,40 @@ func (m *middleware) GetStateToken(r *http.Request) string {
 	}
 	return cookie.Value
 }
+
+//go:generate counterfeiter . AccessTokenFetcher
+
+type AccessTokenFetcher interface {
+	GetAccessToken(rawToken string) (db.AccessToken, bool, error)
+}
+
+type revocationMiddleware struct {
+	Middleware
+
+	accessTokenFetcher AccessTokenFetcher
+}
+
+// NewRevocationMiddleware ignores the auth token cookie once its token has
+// been revoked, so that a revoked token isn't handed out again on login.
+func NewRevocationMiddleware(middleware Middleware, accessTokenFetcher AccessTokenFetcher) Middleware {
+	return &revocationMiddleware{
+		Middleware:         middleware,
+		accessTokenFetcher: accessTokenFetcher,
+	}
+}
+
+func (m *revocationMiddleware) GetAuthToken(r *http.Request) string {
+	tokenString := m.Middleware.GetAuthToken(r)
+
+	parts := strings.Split(tokenString, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return tokenString
+	}
+
+	_, found, err := m.accessTokenFetcher.GetAccessToken(parts[1])
+	if err != nil || !found {
+		return ""
+	}
+
+	return tokenString
+}
@@ -1,12 +1,15 @@
 package token_test
 
 import (
+	"errors"
 	"time"
 
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/skymarshal/token"
+	"github.com/concourse/concourse/skymarshal/token/tokenfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -169,4 +172,53 @@ var _ = Describe("Token Middleware", func() {
 			})
 		})
 	})
+
+	Describe("Revocation", func() {
+		var (
+			fakeAccessTokenFetcher *tokenfakes.FakeAccessTokenFetcher
+			result                 string
+		)
+
+		BeforeEach(func() {
+			fakeAccessTokenFetcher = new(tokenfakes.FakeAccessTokenFetcher)
+			middleware = token.NewRevocationMiddleware(middleware, fakeAccessTokenFetcher)
+
+			r.AddCookie(&http.Cookie{Name: "skymarshal_auth", Value: "Bearer some-token"})
+		})
+
+		JustBeforeEach(func() {
+			result = middleware.GetAuthToken(r)
+		})
+
+		Context("when the token has not been revoked", func() {
+			BeforeEach(func() {
+				fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{Token: "some-token"}, true, nil)
+			})
+
+			It("gets the token from the request", func() {
+				Expect(result).To(Equal("Bearer some-token"))
+				Expect(fakeAccessTokenFetcher.GetAccessTokenArgsForCall(0)).To(Equal("some-token"))
+			})
+		})
+
+		Context("when the token has been revoked", func() {
+			BeforeEach(func() {
+				fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, false, nil)
+			})
+
+			It("ignores the token", func() {
+				Expect(result).To(BeEmpty())
+			})
+		})
+
+		Context("when looking up the token fails", func() {
+			BeforeEach(func() {
+				fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, false, errors.New("nope"))
+			})
+
+			It("ignores the token", func() {
+				Expect(result).To(BeEmpty())
+			})
+		})
+	})
 })
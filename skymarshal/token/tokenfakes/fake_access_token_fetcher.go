@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package tokenfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+type FakeAccessTokenFetcher struct {
+	GetAccessTokenStub        func(string) (db.AccessToken, bool, error)
+	getAccessTokenMutex       sync.RWMutex
+	getAccessTokenArgsForCall []struct {
+		arg1 string
+	}
+	getAccessTokenReturns struct {
+		result1 db.AccessToken
+		result2 bool
+		result3 error
+	}
+	getAccessTokenReturnsOnCall map[int]struct {
+		result1 db.AccessToken
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessToken(arg1 string) (db.AccessToken, bool, error) {
+	fake.getAccessTokenMutex.Lock()
+	ret, specificReturn := fake.getAccessTokenReturnsOnCall[len(fake.getAccessTokenArgsForCall)]
+	fake.getAccessTokenArgsForCall = append(fake.getAccessTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAccessToken", []interface{}{arg1})
+	fake.getAccessTokenMutex.Unlock()
+	if fake.GetAccessTokenStub != nil {
+		return fake.GetAccessTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAccessTokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessTokenCallCount() int {
+	fake.getAccessTokenMutex.RLock()
+	defer fake.getAccessTokenMutex.RUnlock()
+	return len(fake.getAccessTokenArgsForCall)
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessTokenCalls(stub func(string) (db.AccessToken, bool, error)) {
+	fake.getAccessTokenMutex.Lock()
+	defer fake.getAccessTokenMutex.Unlock()
+	fake.GetAccessTokenStub = stub
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessTokenArgsForCall(i int) string {
+	fake.getAccessTokenMutex.RLock()
+	defer fake.getAccessTokenMutex.RUnlock()
+	argsForCall := fake.getAccessTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessTokenReturns(result1 db.AccessToken, result2 bool, result3 error) {
+	fake.getAccessTokenMutex.Lock()
+	defer fake.getAccessTokenMutex.Unlock()
+	fake.GetAccessTokenStub = nil
+	fake.getAccessTokenReturns = struct {
+		result1 db.AccessToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccessTokenFetcher) GetAccessTokenReturnsOnCall(i int, result1 db.AccessToken, result2 bool, result3 error) {
+	fake.getAccessTokenMutex.Lock()
+	defer fake.getAccessTokenMutex.Unlock()
+	fake.GetAccessTokenStub = nil
+	if fake.getAccessTokenReturnsOnCall == nil {
+		fake.getAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 db.AccessToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getAccessTokenReturnsOnCall[i] = struct {
+		result1 db.AccessToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAccessTokenFetcher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getAccessTokenMutex.RLock()
+	defer fake.getAccessTokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAccessTokenFetcher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ token.AccessTokenFetcher = new(FakeAccessTokenFetcher)
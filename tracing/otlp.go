@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+)
+
+// OTLP service to export traces to, using the OpenTelemetry protocol
+type OTLP struct {
+	Address  string            `long:"otlp-address"  description:"otlp collector address: host:port for grpc, or a URL for http"`
+	Protocol string            `long:"otlp-protocol" description:"protocol to send spans to the otlp collector with" choice:"grpc" choice:"http" default:"grpc"`
+	Headers  map[string]string `long:"otlp-header"   description:"headers to attach to each tracing message"`
+	UseTLS   bool              `long:"otlp-use-tls"  description:"whether to use tls to connect to a grpc otlp collector. http collectors use the scheme of the address"`
+}
+
+// IsConfigured identifies if an address has been set
+func (o OTLP) IsConfigured() bool {
+	return o.Address != ""
+}
+
+// Exporter returns a SpanExporter to send batches of spans to an OTLP
+// collector
+func (o OTLP) Exporter() (export.SpanBatcher, error) {
+	switch o.Protocol {
+	case OTLPProtocolHTTP:
+		return newOTLPHTTPExporter(o.Address, o.Headers)
+
+	case "", OTLPProtocolGRPC:
+		options := []otlp.ExporterOption{
+			otlp.WithAddress(o.Address),
+			otlp.WithHeaders(o.Headers),
+		}
+
+		if o.UseTLS {
+			options = append(options, otlp.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+		} else {
+			options = append(options, otlp.WithInsecure())
+		}
+
+		exporter, err := otlp.NewExporter(options...)
+		if err != nil {
+			err = fmt.Errorf("failed to create otlp exporter: %w", err)
+			return nil, err
+		}
+
+		return exporter, nil
+
+	default:
+		return nil, fmt.Errorf("unknown otlp protocol: %s", o.Protocol)
+	}
+}
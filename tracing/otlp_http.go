@@ -0,0 +1,303 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"google.golang.org/grpc/codes"
+)
+
+const otlpHTTPTracesPath = "/v1/traces"
+
+// otlpHTTPExporter sends spans to an OTLP collector over HTTP, using the JSON
+// encoding of the protocol.
+type otlpHTTPExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newOTLPHTTPExporter(address string, headers map[string]string) (*otlpHTTPExporter, error) {
+	endpoint, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: invalid address: %w", err)
+	}
+
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("failed to create otlp exporter: address must be an http or https URL: %s", address)
+	}
+
+	if endpoint.Path == "" || endpoint.Path == "/" {
+		endpoint.Path = otlpHTTPTracesPath
+	}
+
+	return &otlpHTTPExporter{
+		url:     endpoint.String(),
+		headers: headers,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (e *otlpHTTPExporter) ExportSpans(ctx context.Context, spans []*export.SpanData) {
+	err := e.export(ctx, spans)
+	if err != nil {
+		global.Handle(fmt.Errorf("otlp: failed to export spans: %w", err))
+	}
+}
+
+func (e *otlpHTTPExporter) export(ctx context.Context, spans []*export.SpanData) error {
+	payload, err := json.Marshal(otlpTracesRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range e.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("collector responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpLink struct {
+	TraceID    string         `json:"traceId"`
+	SpanID     string         `json:"spanId"`
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Links             []otlpLink     `json:"links,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+// otlpInstrumentationScope is what older versions of the protocol called the
+// instrumentation library. Collectors drop spans sent under the old field
+// names without an error.
+type otlpInstrumentationScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpInstrumentationScope `json:"scope"`
+	Spans []otlpSpan               `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpExportTraceServiceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// otlpTracesRequest groups the spans by the resource and the instrumentation
+// scope they come from, in the order they were ended.
+func otlpTracesRequest(spans []*export.SpanData) otlpExportTraceServiceRequest {
+	type source struct {
+		resource label.Distinct
+		library  instrumentation.Library
+	}
+
+	request := otlpExportTraceServiceRequest{ResourceSpans: []otlpResourceSpans{}}
+
+	resourceIndex := map[label.Distinct]int{}
+	libraryIndex := map[source]int{}
+	for _, span := range spans {
+		var resource label.Distinct
+		if span.Resource != nil {
+			resource = span.Resource.Equivalent()
+		}
+
+		r, found := resourceIndex[resource]
+		if !found {
+			var converted otlpResource
+			if span.Resource != nil {
+				converted.Attributes = otlpAttributes(span.Resource.Attributes())
+			}
+
+			r = len(request.ResourceSpans)
+			resourceIndex[resource] = r
+			request.ResourceSpans = append(request.ResourceSpans, otlpResourceSpans{
+				Resource: converted,
+			})
+		}
+
+		resourceSpans := &request.ResourceSpans[r]
+
+		key := source{resource, span.InstrumentationLibrary}
+		l, found := libraryIndex[key]
+		if !found {
+			l = len(resourceSpans.ScopeSpans)
+			libraryIndex[key] = l
+			resourceSpans.ScopeSpans = append(resourceSpans.ScopeSpans, otlpScopeSpans{
+				Scope: otlpInstrumentationScope{
+					Name:    span.InstrumentationLibrary.Name,
+					Version: span.InstrumentationLibrary.Version,
+				},
+			})
+		}
+
+		scopeSpans := &resourceSpans.ScopeSpans[l]
+		scopeSpans.Spans = append(scopeSpans.Spans, otlpSpanFrom(span))
+	}
+
+	return request
+}
+
+func otlpSpanFrom(span *export.SpanData) otlpSpan {
+	converted := otlpSpan{
+		TraceID:           hex.EncodeToString(span.SpanContext.TraceID[:]),
+		SpanID:            hex.EncodeToString(span.SpanContext.SpanID[:]),
+		Name:              span.Name,
+		Kind:              int(span.SpanKind),
+		StartTimeUnixNano: otlpTime(span.StartTime),
+		EndTimeUnixNano:   otlpTime(span.EndTime),
+		Attributes:        otlpAttributes(span.Attributes),
+		Status: otlpStatus{
+			Code:    otlpStatusCode(span.StatusCode),
+			Message: span.StatusMessage,
+		},
+	}
+
+	if span.ParentSpanID.IsValid() {
+		converted.ParentSpanID = hex.EncodeToString(span.ParentSpanID[:])
+	}
+
+	for _, event := range span.MessageEvents {
+		converted.Events = append(converted.Events, otlpEvent{
+			TimeUnixNano: otlpTime(event.Time),
+			Name:         event.Name,
+			Attributes:   otlpAttributes(event.Attributes),
+		})
+	}
+
+	for _, link := range span.Links {
+		converted.Links = append(converted.Links, otlpLink{
+			TraceID:    hex.EncodeToString(link.TraceID[:]),
+			SpanID:     hex.EncodeToString(link.SpanID[:]),
+			Attributes: otlpAttributes(link.Attributes),
+		})
+	}
+
+	return converted
+}
+
+// otlpStatusCode maps the gRPC style codes of the SDK onto the protocol's
+// status codes, which only tell errors apart from spans with no status set.
+func otlpStatusCode(code codes.Code) int {
+	const (
+		otlpStatusCodeUnset = 0
+		otlpStatusCodeError = 2
+	)
+
+	if code == codes.OK {
+		return otlpStatusCodeUnset
+	}
+
+	return otlpStatusCodeError
+}
+
+// otlpTime encodes a timestamp the way the JSON mapping of protobuf encodes
+// 64 bit integers: as a string.
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpAttributes(kvs []label.KeyValue) []otlpKeyValue {
+	var attrs []otlpKeyValue
+	for _, kv := range kvs {
+		attrs = append(attrs, otlpKeyValue{
+			Key:   string(kv.Key),
+			Value: otlpValue(kv.Value),
+		})
+	}
+
+	return attrs
+}
+
+func otlpValue(v label.Value) otlpAnyValue {
+	switch v.Type() {
+	case label.BOOL:
+		b := v.AsBool()
+		return otlpAnyValue{BoolValue: &b}
+	case label.INT32, label.INT64, label.UINT32, label.UINT64:
+		i := v.Emit()
+		return otlpAnyValue{IntValue: &i}
+	case label.FLOAT32, label.FLOAT64:
+		f, err := strconv.ParseFloat(v.Emit(), 64)
+		if err == nil {
+			return otlpAnyValue{DoubleValue: &f}
+		}
+	}
+
+	s := v.Emit()
+	return otlpAnyValue{StringValue: &s}
+}
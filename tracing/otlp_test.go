@@ -0,0 +1,281 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/tracing/tracingtest"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLP", func() {
+	var (
+		config   tracing.OTLP
+		exporter export.SpanBatcher
+		err      error
+	)
+
+	traceProvider := func() trace.Provider {
+		return tracing.BatchedTraceProvider(exporter, sdktrace.WithBatchTimeout(10*time.Millisecond))
+	}
+
+	JustBeforeEach(func() {
+		exporter, err = config.Exporter()
+	})
+
+	Describe("IsConfigured", func() {
+		It("is configured once an address is set", func() {
+			Expect(tracing.OTLP{}.IsConfigured()).To(BeFalse())
+			Expect(tracing.OTLP{Address: "collector:55680"}.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Context("over http", func() {
+		var (
+			collector *httptest.Server
+
+			lock     sync.Mutex
+			requests []*http.Request
+			payloads []map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			payloads = nil
+
+			collector = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]interface{}
+				err := json.NewDecoder(r.Body).Decode(&payload)
+				Expect(err).ToNot(HaveOccurred())
+
+				lock.Lock()
+				requests = append(requests, r)
+				payloads = append(payloads, payload)
+				lock.Unlock()
+			}))
+
+			config = tracing.OTLP{
+				Address:  collector.URL,
+				Protocol: tracing.OTLPProtocolHTTP,
+				Headers:  map[string]string{"X-Some-Header": "some-value"},
+			}
+		})
+
+		AfterEach(func() {
+			collector.Close()
+		})
+
+		requestCount := func() int {
+			lock.Lock()
+			defer lock.Unlock()
+
+			return len(requests)
+		}
+
+		exportedSpans := func() []interface{} {
+			lock.Lock()
+			defer lock.Unlock()
+
+			var spans []interface{}
+			for _, payload := range payloads {
+				for _, resourceSpans := range payload["resourceSpans"].([]interface{}) {
+					for _, scopeSpans := range resourceSpans.(map[string]interface{})["scopeSpans"].([]interface{}) {
+						spans = append(spans, scopeSpans.(map[string]interface{})["spans"].([]interface{})...)
+					}
+				}
+			}
+
+			return spans
+		}
+
+		It("sends ended spans to the collector as json", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			_, span := traceProvider().Tracer("concourse").Start(context.Background(), "some-span")
+			span.SetAttributes(label.String("some-attr", "some-value"), label.Int64("some-int", 42))
+			span.End()
+
+			Eventually(requestCount).Should(Equal(1))
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			Expect(requests[0].URL.Path).To(Equal("/v1/traces"))
+			Expect(requests[0].Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(requests[0].Header.Get("X-Some-Header")).To(Equal("some-value"))
+
+			resourceSpans := payloads[0]["resourceSpans"].([]interface{})
+			Expect(resourceSpans).To(HaveLen(1))
+
+			scopeSpans := resourceSpans[0].(map[string]interface{})["scopeSpans"].([]interface{})
+			Expect(scopeSpans).To(HaveLen(1))
+			Expect(scopeSpans[0]).To(HaveKeyWithValue("scope", HaveKeyWithValue("name", "concourse")))
+
+			spans := scopeSpans[0].(map[string]interface{})["spans"].([]interface{})
+			Expect(spans).To(HaveLen(1))
+
+			traceID := span.SpanContext().TraceID
+			spanID := span.SpanContext().SpanID
+
+			exported := spans[0].(map[string]interface{})
+			Expect(exported).To(HaveKeyWithValue("name", "some-span"))
+			Expect(exported).To(HaveKeyWithValue("traceId", hex.EncodeToString(traceID[:])))
+			Expect(exported).To(HaveKeyWithValue("spanId", hex.EncodeToString(spanID[:])))
+			Expect(exported).ToNot(HaveKey("parentSpanId"))
+			Expect(exported["attributes"]).To(ConsistOf(
+				map[string]interface{}{"key": "some-attr", "value": map[string]interface{}{"stringValue": "some-value"}},
+				map[string]interface{}{"key": "some-int", "value": map[string]interface{}{"intValue": "42"}},
+			))
+		})
+
+		It("marks failed spans as errors", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			tracer := traceProvider().Tracer("concourse")
+			_, ok := tracer.Start(context.Background(), "ok-span")
+			ok.End()
+			_, failed := tracer.Start(context.Background(), "failed-span")
+			failed.SetStatus(codes.Internal, "something went wrong")
+			failed.End()
+
+			Eventually(exportedSpans).Should(HaveLen(2))
+
+			Expect(exportedSpans()[0]).To(HaveKeyWithValue("status", map[string]interface{}{"code": float64(0)}))
+			Expect(exportedSpans()[1]).To(HaveKeyWithValue("status", map[string]interface{}{
+				"code":    float64(2),
+				"message": "something went wrong",
+			}))
+		})
+
+		It("links child spans to their parent", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			tracer := traceProvider().Tracer("concourse")
+			ctx, parent := tracer.Start(context.Background(), "parent")
+			_, child := tracer.Start(ctx, "child")
+			child.End()
+			parent.End()
+
+			Eventually(exportedSpans).Should(HaveLen(2))
+
+			parentID := parent.SpanContext().SpanID
+			exported := exportedSpans()[0]
+			Expect(exported).To(HaveKeyWithValue("name", "child"))
+			Expect(exported).To(HaveKeyWithValue("parentSpanId", hex.EncodeToString(parentID[:])))
+		})
+
+		It("sends a batch of spans in one request", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			exporter.ExportSpans(context.Background(), []*export.SpanData{
+				{Name: "some-span", InstrumentationLibrary: instrumentation.Library{Name: "concourse"}},
+				{Name: "other-span", InstrumentationLibrary: instrumentation.Library{Name: "concourse"}},
+				{Name: "another-span", InstrumentationLibrary: instrumentation.Library{Name: "other-library"}},
+			})
+
+			Expect(requestCount()).To(Equal(1))
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			resourceSpans := payloads[0]["resourceSpans"].([]interface{})
+			Expect(resourceSpans).To(HaveLen(1))
+
+			scopeSpans := resourceSpans[0].(map[string]interface{})["scopeSpans"].([]interface{})
+			Expect(scopeSpans).To(HaveLen(2))
+			Expect(scopeSpans[0]).To(HaveKeyWithValue("scope", HaveKeyWithValue("name", "concourse")))
+			Expect(scopeSpans[0]).To(HaveKeyWithValue("spans", HaveLen(2)))
+			Expect(scopeSpans[1]).To(HaveKeyWithValue("scope", HaveKeyWithValue("name", "other-library")))
+			Expect(scopeSpans[1]).To(HaveKeyWithValue("spans", HaveLen(1)))
+		})
+
+		Context("when the address has a path", func() {
+			BeforeEach(func() {
+				config.Address = collector.URL + "/custom/traces"
+			})
+
+			It("sends spans to that path", func() {
+				_, span := traceProvider().Tracer("concourse").Start(context.Background(), "some-span")
+				span.End()
+
+				Eventually(requestCount).Should(Equal(1))
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				Expect(requests[0].URL.Path).To(Equal("/custom/traces"))
+			})
+		})
+
+		Context("when the address is not a URL", func() {
+			BeforeEach(func() {
+				config.Address = "collector:55681"
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("address must be an http or https URL")))
+			})
+		})
+	})
+
+	Context("over grpc", func() {
+		var collector *tracingtest.GRPCCollector
+
+		BeforeEach(func() {
+			collector, err = tracingtest.NewGRPCCollector()
+			Expect(err).ToNot(HaveOccurred())
+
+			config = tracing.OTLP{
+				Address: collector.Address(),
+				Headers: map[string]string{"x-some-header": "some-value"},
+			}
+		})
+
+		AfterEach(func() {
+			collector.Stop()
+		})
+
+		It("exports spans to the collector's trace service", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			tracer := traceProvider().Tracer("concourse")
+
+			// spans ended before the exporter is connected are dropped
+			Eventually(func() []string {
+				_, span := tracer.Start(context.Background(), "some-span")
+				span.End()
+
+				return collector.Methods()
+			}).Should(ContainElement("/opentelemetry.proto.collector.trace.v1.TraceService/Export"))
+
+			Expect(collector.Calls()[0].Metadata.Get("x-some-header")).To(ConsistOf("some-value"))
+		})
+	})
+
+	Context("with an unknown protocol", func() {
+		BeforeEach(func() {
+			config = tracing.OTLP{
+				Address:  "collector:55680",
+				Protocol: "carrier-pigeon",
+			}
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("unknown otlp protocol: carrier-pigeon"))
+		})
+	})
+})
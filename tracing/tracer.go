@@ -39,16 +39,20 @@ var Configured bool
 type Config struct {
 	Jaeger      Jaeger
 	Stackdriver Stackdriver
+	OTLP        OTLP
 }
 
 func (c Config) Prepare() error {
 	var exp export.SpanSyncer
+	var batcher export.SpanBatcher
 	var err error
 	switch {
 	case c.Jaeger.IsConfigured():
 		exp, err = c.Jaeger.Exporter()
 	case c.Stackdriver.IsConfigured():
 		exp, err = c.Stackdriver.Exporter()
+	case c.OTLP.IsConfigured():
+		batcher, err = c.OTLP.Exporter()
 	}
 	if err != nil {
 		return err
@@ -56,6 +60,9 @@ func (c Config) Prepare() error {
 	if exp != nil {
 		ConfigureTraceProvider(TraceProvider(exp))
 	}
+	if batcher != nil {
+		ConfigureTraceProvider(BatchedTraceProvider(batcher))
+	}
 	return nil
 }
 
@@ -204,3 +211,17 @@ func TraceProvider(exporter export.SpanSyncer) trace.Provider {
 	)
 	return provider
 }
+
+// BatchedTraceProvider is a TraceProvider which exports spans in batches in
+// the background, so that ending a span doesn't wait for a round trip to the
+// collector.
+func BatchedTraceProvider(exporter export.SpanBatcher, options ...sdktrace.BatchSpanProcessorOption) trace.Provider {
+	// as with TraceProvider, NewProvider can only error on a nil exporter.
+	provider, _ := sdktrace.NewProvider(sdktrace.WithConfig(
+		sdktrace.Config{
+			DefaultSampler: sdktrace.AlwaysSample(),
+		}),
+		sdktrace.WithBatcher(exporter, options...),
+	)
+	return provider
+}
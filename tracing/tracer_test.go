@@ -90,6 +90,17 @@ var _ = Describe("Tracer", func() {
 			Expect(tracing.Configured).To(BeTrue())
 		})
 
+		It("configures tracing if otlp flags are provided", func() {
+			c := tracing.Config{
+				OTLP: tracing.OTLP{
+					Address:  "http://collector:55681",
+					Protocol: tracing.OTLPProtocolHTTP,
+				},
+			}
+			c.Prepare()
+			Expect(tracing.Configured).To(BeTrue())
+		})
+
 		It("does not configure tracing if no flags are provided", func() {
 			c := tracing.Config{}
 			c.Prepare()
@@ -0,0 +1,96 @@
+// Package tracingtest provides a fake OTLP/gRPC collector for the tests of
+// the exporters that send spans and metrics to one.
+package tracingtest
+
+import (
+	"net"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Call is a request the collector received.
+type Call struct {
+	Method   string
+	Metadata metadata.MD
+}
+
+// GRPCCollector records every call made to it. The collector's services
+// aren't registered, so that any call can be recorded without depending on
+// their generated code.
+type GRPCCollector struct {
+	server   *grpc.Server
+	listener net.Listener
+
+	lock  sync.Mutex
+	calls []Call
+}
+
+// NewGRPCCollector starts a collector listening on a local port.
+func NewGRPCCollector() (*GRPCCollector, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &GRPCCollector{
+		listener: listener,
+	}
+
+	collector.server = grpc.NewServer(grpc.UnknownServiceHandler(collector.record))
+
+	go collector.server.Serve(listener)
+
+	return collector, nil
+}
+
+// Address is the host:port the collector listens on.
+func (c *GRPCCollector) Address() string {
+	return c.listener.Addr().String()
+}
+
+// Calls returns the calls received so far.
+func (c *GRPCCollector) Calls() []Call {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return append([]Call{}, c.calls...)
+}
+
+// Methods returns the methods of the calls received so far.
+func (c *GRPCCollector) Methods() []string {
+	var methods []string
+	for _, call := range c.Calls() {
+		methods = append(methods, call.Method)
+	}
+
+	return methods
+}
+
+func (c *GRPCCollector) Stop() {
+	c.server.Stop()
+}
+
+func (c *GRPCCollector) record(srv interface{}, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	md, _ := metadata.FromIncomingContext(stream.Context())
+
+	// every field of the request is unknown to an empty message, so any
+	// request can be received into one
+	var request empty.Empty
+	err := stream.RecvMsg(&request)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	c.calls = append(c.calls, Call{
+		Method:   method,
+		Metadata: md,
+	})
+	c.lock.Unlock()
+
+	return stream.SendMsg(&empty.Empty{})
+}
@@ -180,7 +180,10 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 // process for the worker. The worker will transition to 'landing' and finally
 // to 'landed' when it is fully drained, causing any existing registrations to
 // exit.
-func (client *Client) Land(ctx context.Context) error {
+//
+// If drainTimeout is non-zero, the worker will be landed once it elapses even
+// if builds are still running on it.
+func (client *Client) Land(ctx context.Context, drainTimeout time.Duration) error {
 	logger := lagerctx.FromContext(ctx)
 
 	sshClient, _, err := client.dial(ctx, 0)
@@ -191,7 +194,12 @@ func (client *Client) Land(ctx context.Context) error {
 
 	defer sshClient.Close()
 
-	return client.run(ctx, sshClient, "land-worker", os.Stdout)
+	command := LandWorker
+	if drainTimeout != 0 {
+		command += " --drain-timeout=" + drainTimeout.String()
+	}
+
+	return client.run(ctx, sshClient, command, os.Stdout)
 }
 
 // Retire invokes the 'retire-worker' command, which will initiate the retiring
@@ -3,6 +3,8 @@ package tsa
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"time"
 
 	"net/http/httputil"
 
@@ -17,6 +19,10 @@ import (
 type Lander struct {
 	ATCEndpoint *rata.RequestGenerator
 	HTTPClient  *http.Client
+
+	// DrainTimeout is how long the ATC waits for builds to finish before
+	// landing the worker anyway. Zero means waiting indefinitely.
+	DrainTimeout time.Duration
 }
 
 func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
@@ -33,6 +39,12 @@ func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
 		return err
 	}
 
+	if l.DrainTimeout != 0 {
+		request.URL.RawQuery = url.Values{
+			"drain_timeout": {l.DrainTimeout.String()},
+		}.Encode()
+	}
+
 	response, err := l.HTTPClient.Do(request)
 	if err != nil {
 		logger.Error("failed-to-land", err)
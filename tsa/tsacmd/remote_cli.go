@@ -137,6 +137,8 @@ func (r forwardWorkerRequest) expectedForwards() int {
 
 type landWorkerRequest struct {
 	server *server
+
+	drainTimeout time.Duration
 }
 
 func checkTeam(state ConnState, worker atc.Worker) error {
@@ -168,8 +170,9 @@ func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channe
 	}
 
 	return (&tsa.Lander{
-		ATCEndpoint: req.server.atcEndpointPicker.Pick(),
-		HTTPClient:  req.server.httpClient,
+		ATCEndpoint:  req.server.atcEndpointPicker.Pick(),
+		HTTPClient:   req.server.httpClient,
+		DrainTimeout: req.drainTimeout,
 	}).Land(ctx, worker)
 }
 
@@ -508,8 +508,19 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			baggageclaimAddr: *baggageclaim,
 		}
 	case tsa.LandWorker:
+		var fs = flag.NewFlagSet(command, flag.ContinueOnError)
+
+		var drainTimeout = fs.Duration("drain-timeout", 0, "duration after which the worker lands regardless of running builds")
+
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, "", err
+		}
+
 		req = landWorkerRequest{
 			server: server,
+
+			drainTimeout: *drainTimeout,
 		}
 	case tsa.RetireWorker:
 		req = retireWorkerRequest{
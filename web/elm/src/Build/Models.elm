@@ -73,4 +73,5 @@ type StepHeaderType
     | StepHeaderTask
     | StepHeaderSetPipeline Bool
     | StepHeaderLoadVar
+    | StepHeaderManualApproval Bool
     | StepHeaderAcross
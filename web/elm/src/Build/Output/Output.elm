@@ -150,6 +150,11 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        ContainerUsage origin summary time ->
+            ( updateStep origin.id (appendStepLog ("\u{001B}[1mresource usage: \u{001B}[0m" ++ summary ++ "\n") time) model
+            , effects
+            )
+
         Error origin message time ->
             ( updateStep origin.id (setStepError message time) model
             , effects
@@ -48,6 +48,7 @@ type StepTree
     = Task Step
     | SetPipeline Step
     | LoadVar Step
+    | ManualApproval Step
     | ArtifactInput Step
     | Check Step
     | Get Step
@@ -202,6 +203,9 @@ fold acc start stepTree =
         LoadVar step ->
             acc step start
 
+        ManualApproval step ->
+            acc step start
+
         ArtifactInput step ->
             acc step start
 
@@ -277,6 +281,7 @@ type BuildEvent
     | SetPipelineChanged Origin Bool
     | Log Origin String (Maybe Time.Posix)
     | SelectedWorker Origin String (Maybe Time.Posix)
+    | ContainerUsage Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
     | End
     | Opened
@@ -351,6 +356,9 @@ map f tree =
         LoadVar step ->
             LoadVar (f step)
 
+        ManualApproval step ->
+            ManualApproval (f step)
+
         Across vars vals expanded step substeps ->
             Across vars vals expanded (f step) substeps
 
@@ -539,6 +547,9 @@ finishTree root =
         LoadVar step ->
             LoadVar (finishStep step)
 
+        ManualApproval step ->
+            ManualApproval (finishStep step)
+
         Aggregate trees ->
             Aggregate (Array.map finishTree trees)
 
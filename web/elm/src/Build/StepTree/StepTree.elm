@@ -100,6 +100,9 @@ init hl resources buildPlan =
         Concourse.BuildStepLoadVar name ->
             initBottom hl LoadVar buildPlan name
 
+        Concourse.BuildStepManualApproval name ->
+            initBottom hl ManualApproval buildPlan name
+
         Concourse.BuildStepAggregate plans ->
             initMultiStep hl resources buildPlan.id Aggregate plans
 
@@ -451,6 +454,9 @@ viewTree session model tree depth =
         LoadVar step ->
             viewStep model session depth step StepHeaderLoadVar
 
+        ManualApproval step ->
+            viewStep model session depth step (StepHeaderManualApproval (step.state == StepStateRunning))
+
         Try step ->
             viewTree session model step depth
 
@@ -1091,6 +1097,11 @@ viewStepHeaderLabel headerType stepID =
                     , onMouseEnter <| Hover <| Just <| ChangedStepLabel stepID "pipeline config changed"
                     ]
 
+                StepHeaderManualApproval True ->
+                    [ onMouseLeave <| Hover Nothing
+                    , onMouseEnter <| Hover <| Just <| ChangedStepLabel stepID "awaiting approval"
+                    ]
+
                 _ ->
                     []
     in
@@ -1119,6 +1130,9 @@ viewStepHeaderLabel headerType stepID =
                 StepHeaderLoadVar ->
                     "load_var:"
 
+                StepHeaderManualApproval _ ->
+                    "manual_approval:"
+
                 StepHeaderAcross ->
                     "across:"
         ]
@@ -219,6 +219,9 @@ stepHeaderLabel headerType =
             StepHeaderSetPipeline True ->
                 Colors.started
 
+            StepHeaderManualApproval True ->
+                Colors.started
+
             _ ->
                 Colors.pending
     , style "line-height" "28px"
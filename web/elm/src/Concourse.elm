@@ -353,6 +353,9 @@ mapBuildPlan fn plan =
                 BuildStepLoadVar _ ->
                     []
 
+                BuildStepManualApproval _ ->
+                    []
+
                 BuildStepArtifactInput _ ->
                     []
 
@@ -415,6 +418,7 @@ type BuildStep
     = BuildStepTask StepName
     | BuildStepSetPipeline StepName
     | BuildStepLoadVar StepName
+    | BuildStepManualApproval StepName
     | BuildStepArtifactInput StepName
     | BuildStepCheck StepName
     | BuildStepGet StepName (Maybe Version)
@@ -525,6 +529,8 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildSetPipeline)
                 , Json.Decode.field "load_var" <|
                     lazy (\_ -> decodeBuildStepLoadVar)
+                , Json.Decode.field "manual_approval" <|
+                    lazy (\_ -> decodeBuildStepManualApproval)
                 , Json.Decode.field "across" <|
                     lazy (\_ -> decodeBuildStepAcross)
                 ]
@@ -661,6 +667,12 @@ decodeBuildStepLoadVar =
         |> andMap (Json.Decode.field "name" Json.Decode.string)
 
 
+decodeBuildStepManualApproval : Json.Decode.Decoder BuildStep
+decodeBuildStepManualApproval =
+    Json.Decode.succeed BuildStepManualApproval
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
 decodeBuildStepAcross : Json.Decode.Decoder BuildStep
 decodeBuildStepAcross =
     Json.Decode.map BuildStepAcross
@@ -95,6 +95,15 @@ decodeBuildEvent =
                                 (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
                             )
 
+                    "container-usage" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 ContainerUsage
+                                (Json.Decode.field "origin" <| Json.Decode.lazy (\_ -> decodeOrigin))
+                                decodeContainerUsageSummary
+                                (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     "error" ->
                         Json.Decode.field "data" decodeErrorEvent
 
@@ -199,6 +208,64 @@ decodeBuildEvent =
             )
 
 
+decodeContainerUsageSummary : Json.Decode.Decoder String
+decodeContainerUsageSummary =
+    Json.Decode.map7
+        (\cpu memoryPeak memoryAverage networkRx networkTx blockIORead blockIOWrite ->
+            "cpu "
+                ++ String.fromFloat (toFloat (round (cpu * 100)) / 100)
+                ++ " cores avg, memory "
+                ++ formatBytes memoryPeak
+                ++ " peak ("
+                ++ formatBytes memoryAverage
+                ++ " avg), network "
+                ++ formatBytes networkRx
+                ++ " in / "
+                ++ formatBytes networkTx
+                ++ " out, disk "
+                ++ formatBytes blockIORead
+                ++ " read / "
+                ++ formatBytes blockIOWrite
+                ++ " written"
+        )
+        (Json.Decode.field "cpu_average" Json.Decode.float)
+        (Json.Decode.field "memory_peak" Json.Decode.float)
+        (Json.Decode.field "memory_average" Json.Decode.float)
+        (Json.Decode.field "network_rx" Json.Decode.float)
+        (Json.Decode.field "network_tx" Json.Decode.float)
+        (optionalBytes "block_io_read")
+        (optionalBytes "block_io_write")
+
+
+{-| Usage events from before version 1.1 don't report the disk IO.
+-}
+optionalBytes : String -> Json.Decode.Decoder Float
+optionalBytes field =
+    Json.Decode.oneOf
+        [ Json.Decode.field field Json.Decode.float
+        , Json.Decode.succeed 0
+        ]
+
+
+formatBytes : Float -> String
+formatBytes bytes =
+    formatBytesIn [ "B", "KiB", "MiB", "GiB", "TiB" ] bytes
+
+
+formatBytesIn : List String -> Float -> String
+formatBytesIn units bytes =
+    case units of
+        unit :: rest ->
+            if bytes < 1024 || List.isEmpty rest then
+                String.fromFloat (toFloat (round (bytes * 10)) / 10) ++ unit
+
+            else
+                formatBytesIn rest (bytes / 1024)
+
+        [] ->
+            String.fromFloat bytes
+
+
 dateFromSeconds : Int -> Time.Posix
 dateFromSeconds =
     Time.millisToPosix << (*) 1000
@@ -2816,6 +2816,23 @@ all =
                                 )
                             >> Tuple.first
 
+                    fetchPlanWithManualApprovalStep : () -> Application.Model
+                    fetchPlanWithManualApprovalStep =
+                        givenBuildStarted
+                            >> Tuple.first
+                            >> Application.handleCallback
+                                (Callback.PlanAndResourcesFetched 307 <|
+                                    Ok <|
+                                        ( { id = "plan"
+                                          , step =
+                                                Concourse.BuildStepManualApproval
+                                                    "step"
+                                          }
+                                        , { inputs = [], outputs = [] }
+                                        )
+                                )
+                            >> Tuple.first
+
                     fetchPlanWithLoadVarStep : () -> Application.Model
                     fetchPlanWithLoadVarStep =
                         givenBuildStarted
@@ -3025,6 +3042,29 @@ all =
                     fetchPlanWithLoadVarStep
                         >> Common.queryView
                         >> Query.has loadVarStepLabel
+                , test "manual_approval step shows manual_approval label" <|
+                    fetchPlanWithManualApprovalStep
+                        >> Common.queryView
+                        >> Query.has manualApprovalStepLabel
+                , test "manual_approval step awaiting approval shows yellow label" <|
+                    fetchPlanWithManualApprovalStep
+                        >> Application.handleDelivery
+                            (EventsReceived <|
+                                Ok <|
+                                    [ { url =
+                                            eventsUrl
+                                      , data =
+                                            STModels.Start
+                                                { source = ""
+                                                , id = "plan"
+                                                }
+                                                (Time.millisToPosix 0)
+                                      }
+                                    ]
+                            )
+                        >> Tuple.first
+                        >> Common.queryView
+                        >> Query.has awaitingApprovalStepLabel
                 , test "artifact output step shows put label" <|
                     fetchPlanWithEnsureArtifactOutputStep
                         >> Common.queryView
@@ -3993,6 +4033,22 @@ loadVarStepLabel =
     ]
 
 
+manualApprovalStepLabel =
+    [ style "color" Colors.pending
+    , style "line-height" "28px"
+    , style "padding-left" "6px"
+    , containing [ text "manual_approval:" ]
+    ]
+
+
+awaitingApprovalStepLabel =
+    [ style "color" Colors.started
+    , style "line-height" "28px"
+    , style "padding-left" "6px"
+    , containing [ text "manual_approval:" ]
+    ]
+
+
 firstOccurrenceLabelID =
     Message.Message.ChangedStepLabel
         "foo"
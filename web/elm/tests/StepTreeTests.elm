@@ -31,6 +31,7 @@ all =
         [ initTask
         , initSetPipeline
         , initLoadVar
+        , initManualApproval
         , initCheck
         , initGet
         , initPut
@@ -160,6 +161,32 @@ initLoadVar =
         ]
 
 
+initManualApproval : Test
+initManualApproval =
+    let
+        { tree, foci } =
+            StepTree.init Routes.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepManualApproval "some-name"
+                }
+    in
+    describe "init with ManualApproval"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal
+                    (Models.ManualApproval (someStep "some-id" "some-name" Models.StepStatePending))
+                    tree
+        , test "using the focus" <|
+            \_ ->
+                assertFocus "some-id"
+                    foci
+                    tree
+                    (\s -> { s | state = Models.StepStateSucceeded })
+                    (Models.ManualApproval (someStep "some-id" "some-name" Models.StepStateSucceeded))
+        ]
+
+
 initCheck : Test
 initCheck =
     let
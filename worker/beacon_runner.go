@@ -17,6 +17,7 @@ func NewBeaconRunner(
 	tsaClient *tsa.Client,
 	rebalanceInterval time.Duration,
 	connectionDrainTimeout time.Duration,
+	landDrainTimeout time.Duration,
 	gardenAddr string,
 	baggageclaimAddr string,
 ) ifrit.Runner {
@@ -30,6 +31,7 @@ func NewBeaconRunner(
 
 		RebalanceInterval:      rebalanceInterval,
 		ConnectionDrainTimeout: connectionDrainTimeout,
+		LandDrainTimeout:       landDrainTimeout,
 
 		DrainSignals: signals,
 
@@ -103,6 +103,18 @@ var _ = Describe("Beacon", func() {
 				Consistently(process.Wait()).ShouldNot(Receive())
 			})
 
+			Context("when a land drain timeout is configured", func() {
+				BeforeEach(func() {
+					beacon.LandDrainTimeout = time.Hour
+				})
+
+				It("lands the worker with the drain timeout", func() {
+					Eventually(fakeClient.LandCallCount).Should(Equal(1))
+					_, drainTimeout := fakeClient.LandArgsForCall(0)
+					Expect(drainTimeout).To(Equal(time.Hour))
+				})
+			})
+
 			Describe("Drained", func() {
 				It("returns true", func() {
 					Eventually(beacon.Drained).Should(BeTrue())
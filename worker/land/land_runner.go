@@ -3,6 +3,7 @@ package land
 import (
 	"context"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -14,6 +15,8 @@ type LandWorkerCommand struct {
 	TSA worker.TSAConfig `group:"TSA Configuration" namespace:"tsa" required:"true"`
 
 	WorkerName string `long:"name" required:"true" description:"The name of the worker you wish to land."`
+
+	DrainTimeout time.Duration `long:"drain-timeout" description:"Duration after which the worker is landed even if builds are still running on it."`
 }
 
 func (cmd *LandWorkerCommand) Execute(args []string) error {
@@ -24,5 +27,5 @@ func (cmd *LandWorkerCommand) Execute(args []string) error {
 		Name: cmd.WorkerName,
 	})
 
-	return client.Land(lagerctx.NewContext(context.Background(), logger))
+	return client.Land(lagerctx.NewContext(context.Background(), logger), cmd.DrainTimeout)
 }
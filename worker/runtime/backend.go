@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd"
 	bespec "github.com/concourse/concourse/worker/runtime/spec"
 	"github.com/containerd/containerd"
@@ -27,6 +28,7 @@ type GardenBackend struct {
 	killer        Killer
 	network       Network
 	rootfsManager RootfsManager
+	oomDetector   OOMDetector
 	userNamespace UserNamespace
 	initBinPath   string
 
@@ -60,6 +62,15 @@ func WithRootfsManager(r RootfsManager) GardenBackendOpt {
 	}
 }
 
+// WithOOMDetector configures the detector used to tell whether processes of
+// containers were killed for running out of memory.
+//
+func WithOOMDetector(d OOMDetector) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.oomDetector = d
+	}
+}
+
 // WithKiller configures the killer used to terminate tasks.
 //
 func WithKiller(k Killer) GardenBackendOpt {
@@ -137,6 +148,10 @@ func NewGardenBackend(client libcontainerd.Client, opts ...GardenBackendOpt) (b
 		b.rootfsManager = NewRootfsManager()
 	}
 
+	if b.oomDetector == nil {
+		b.oomDetector = NewOOMDetector()
+	}
+
 	if b.userNamespace == nil {
 		b.userNamespace = NewUserNamespace()
 	}
@@ -194,7 +209,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		return nil, fmt.Errorf("new container: %w", err)
 	}
 
-	err = b.startTask(ctx, cont)
+	err = b.startTask(ctx, cont, gdnSpec)
 	if err != nil {
 		return nil, fmt.Errorf("starting task: %w", err)
 	}
@@ -203,6 +218,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b.oomDetector,
 	), nil
 }
 
@@ -236,18 +252,49 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 
 	oci.Mounts = append(oci.Mounts, netMounts...)
 
+	networkOf := gdnSpec.Properties[atc.ContainerPropertyNetworkOf]
+	if networkOf != "" {
+		netns, err := b.networkNamespaceOf(ctx, networkOf)
+		if err != nil {
+			return nil, fmt.Errorf("network namespace of %s: %w", networkOf, err)
+		}
+
+		oci.Linux.Namespaces = bespec.OciNamespacesWithNetwork(oci.Linux.Namespaces, netns)
+	}
+
 	return b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci)
 }
 
-func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container) error {
+// networkNamespaceOf retrieves the path to the network namespace of the
+// container with the given handle.
+//
+func (b *GardenBackend) networkNamespaceOf(ctx context.Context, handle string) (string, error) {
+	container, err := b.client.GetContainer(ctx, handle)
+	if err != nil {
+		return "", fmt.Errorf("get container: %w", err)
+	}
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("task lookup: %w", err)
+	}
+
+	return netNsPath(task), nil
+}
+
+func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, gdnSpec garden.ContainerSpec) error {
 	task, err := cont.NewTask(ctx, cio.NullIO, containerd.WithNoNewKeyring)
 	if err != nil {
 		return fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
-	if err != nil {
-		return fmt.Errorf("network add: %w", err)
+	// containers joining the network of another one are already wired up
+	//
+	if gdnSpec.Properties[atc.ContainerPropertyNetworkOf] == "" {
+		err = b.network.Add(ctx, task, gdnSpec.NetOut)
+		if err != nil {
+			return fmt.Errorf("network add: %w", err)
+		}
 	}
 
 	return task.Start(ctx)
@@ -286,9 +333,19 @@ func (b *GardenBackend) Destroy(handle string) error {
 		return fmt.Errorf("gracefully killing task: %w", err)
 	}
 
-	err = b.network.Remove(ctx, task)
+	labels, err := container.Labels(ctx)
 	if err != nil {
-		return fmt.Errorf("network remove: %w", err)
+		return fmt.Errorf("labels retrieval: %w", err)
+	}
+
+	// the network of containers that joined another one's is torn down
+	// along with the latter
+	//
+	if labels[atc.ContainerPropertyNetworkOf] == "" {
+		err = b.network.Remove(ctx, task)
+		if err != nil {
+			return fmt.Errorf("network remove: %w", err)
+		}
 	}
 
 	_, err = task.Delete(ctx, containerd.WithProcessKill)
@@ -325,6 +382,7 @@ func (b *GardenBackend) Containers(properties garden.Properties) (containers []g
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b.oomDetector,
 		)
 	}
 
@@ -347,6 +405,7 @@ func (b *GardenBackend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b.oomDetector,
 	), nil
 }
 
@@ -380,10 +439,27 @@ func (b *GardenBackend) BulkInfo(handles []string) (info map[string]garden.Conta
 	return
 }
 
-// BulkMetrics - Not Implemented
+// BulkMetrics retrieves the metrics of several containers at once, reporting
+// failures to retrieve those of a given container in its own entry.
 //
 func (b *GardenBackend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
-	err = ErrNotImplemented
+	metrics = make(map[string]garden.ContainerMetricsEntry, len(handles))
+
+	for _, handle := range handles {
+		entry := garden.ContainerMetricsEntry{}
+
+		container, err := b.Lookup(handle)
+		if err == nil {
+			entry.Metrics, err = container.Metrics()
+		}
+
+		if err != nil {
+			entry.Err = garden.NewError(err.Error())
+		}
+
+		metrics[handle] = entry
+	}
+
 	return
 }
 
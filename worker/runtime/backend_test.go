@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
@@ -146,6 +147,70 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 	s.Equal("handle", cont.Handle())
 }
 
+func (s *BackendSuite) TestCreateContainerAddsTaskToNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	egress := []garden.NetOutRule{{Protocol: garden.ProtocolTCP}}
+
+	spec := minimumValidGdnSpec
+	spec.NetOut = egress
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.AddCallCount())
+	_, task, actualEgress := s.network.AddArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal(egress, actualEgress)
+}
+
+func (s *BackendSuite) TestCreateContainerJoiningNetworkOfAnother() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	otherTask := new(libcontainerdfakes.FakeTask)
+	otherTask.PidReturns(123)
+	otherContainer := new(libcontainerdfakes.FakeContainer)
+	otherContainer.TaskReturns(otherTask, nil)
+	s.client.GetContainerReturns(otherContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{atc.ContainerPropertyNetworkOf: "other-handle"}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	_, handle := s.client.GetContainerArgsForCall(0)
+	s.Equal("other-handle", handle)
+
+	_, _, _, oci := s.client.NewContainerArgsForCall(0)
+	s.Contains(oci.Linux.Namespaces, specs.LinuxNamespace{
+		Type: specs.NetworkNamespace,
+		Path: "/proc/123/ns/net",
+	})
+
+	s.Equal(0, s.network.AddCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerJoiningNetworkOfMissingContainer() {
+	s.client.GetContainerReturns(nil, errors.New("not-found"))
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{atc.ContainerPropertyNetworkOf: "other-handle"}
+
+	_, err := s.backend.Create(spec)
+	s.Error(err)
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
 func (s *BackendSuite) TestCreateMaxContainersReached() {
 	backend, err := runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
@@ -314,6 +379,29 @@ func (s *BackendSuite) TestContainersConversion() {
 	s.Len(containers, 2)
 }
 
+func (s *BackendSuite) TestBulkMetrics() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeTask.MetricsReturns(nil, errors.New("metrics-err"))
+
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.TaskReturns(fakeTask, nil)
+
+	s.client.GetContainerStub = func(_ context.Context, handle string) (containerd.Container, error) {
+		if handle == "missing" {
+			return nil, errors.New("not found")
+		}
+
+		return fakeContainer, nil
+	}
+
+	metrics, err := s.backend.BulkMetrics([]string{"handle", "missing"})
+	s.NoError(err)
+	s.Len(metrics, 2)
+
+	s.Equal("task metrics: metrics-err", metrics["handle"].Err.Error())
+	s.Equal("get container: not found", metrics["missing"].Err.Error())
+}
+
 func (s *BackendSuite) TestLookupEmptyHandleError() {
 	_, err := s.backend.Lookup("")
 	s.Equal("empty handle", err.Error())
@@ -484,6 +572,20 @@ func (s *BackendSuite) TestDestroySucceeds() {
 	s.NoError(err)
 }
 
+func (s *BackendSuite) TestDestroyContainerThatJoinedNetworkOfAnother() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{atc.ContainerPropertyNetworkOf: "other-handle"}, nil)
+
+	err := s.backend.Destroy("some handle")
+	s.NoError(err)
+
+	s.Equal(0, s.network.RemoveCallCount())
+	s.Equal(1, fakeContainer.DeleteCallCount())
+}
+
 func (s *BackendSuite) TestStartInitsClientAndSetsUpRestrictedNetworks() {
 	err := s.backend.Start()
 	s.NoError(err)
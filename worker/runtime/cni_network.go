@@ -2,10 +2,12 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha1"
 	"fmt"
 	"path/filepath"
 	"strings"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime/iptables"
 	"github.com/containerd/containerd"
 	"github.com/containerd/go-cni"
@@ -45,6 +47,16 @@ const (
 	binariesDir = "/usr/local/concourse/bin"
 
 	ipTablesAdminChainName = "CONCOURSE-OPERATOR"
+
+	// egressChainPrefix is the prefix of the per-container chains holding
+	// the egress allowlist of a container.
+	//
+	egressChainPrefix = "CONCOURSE-EGRESS-"
+
+	// containerInterface is the name of the interface that CNI sets up
+	// inside the container's network namespace.
+	//
+	containerInterface = "eth0"
 )
 
 var (
@@ -258,7 +270,16 @@ func (n cniNetwork) SetupMounts(handle string) ([]specs.Mount, error) {
 
 func (n cniNetwork) SetupRestrictedNetworks() error {
 	const tableName = "filter"
-	err := n.ipt.CreateChainOrFlushIfExists(tableName, ipTablesAdminChainName)
+
+	// containers keep running across restarts of the worker, so the jump
+	// rules to their egress chains are put back after flushing the chain
+	//
+	jumpRules, err := n.egressJumpRules()
+	if err != nil {
+		return err
+	}
+
+	err = n.ipt.CreateChainOrFlushIfExists(tableName, ipTablesAdminChainName)
 	if err != nil {
 		return fmt.Errorf("create chain or flush if exists failed: %w", err)
 	}
@@ -276,9 +297,48 @@ func (n cniNetwork) SetupRestrictedNetworks() error {
 			return fmt.Errorf("appending reject rule for restricted network %s failed: %w", restrictedNetwork, err)
 		}
 	}
+
+	for _, rulespec := range jumpRules {
+		err = n.ipt.AppendRule(tableName, ipTablesAdminChainName, rulespec...)
+		if err != nil {
+			return fmt.Errorf("restoring jump rule %v failed: %w", rulespec, err)
+		}
+	}
+
 	return nil
 }
 
+// egressJumpRules returns the rulespecs of the rules in the admin chain that
+// jump to the egress chain of a container, if the admin chain exists.
+//
+func (n cniNetwork) egressJumpRules() ([][]string, error) {
+	const tableName = "filter"
+
+	exists, err := n.ipt.ChainExists(tableName, ipTablesAdminChainName)
+	if err != nil {
+		return nil, fmt.Errorf("checking if %s exists failed: %w", ipTablesAdminChainName, err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	rules, err := n.ipt.ListRules(tableName, ipTablesAdminChainName)
+	if err != nil {
+		return nil, fmt.Errorf("listing rules in %s failed: %w", ipTablesAdminChainName, err)
+	}
+
+	var jumpRules [][]string
+	for _, rule := range rules {
+		rulespec, target, ok := parseAppendRule(rule)
+		if ok && strings.HasPrefix(target, egressChainPrefix) {
+			jumpRules = append(jumpRules, rulespec)
+		}
+	}
+
+	return jumpRules, nil
+}
+
 func (n cniNetwork) generateResolvConfContents() ([]byte, error) {
 	contents := ""
 	resolvConfEntries := n.nameServers
@@ -293,18 +353,32 @@ func (n cniNetwork) generateResolvConfContents() ([]byte, error) {
 	return []byte(contents), err
 }
 
-func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
+func (n cniNetwork) Add(ctx context.Context, task containerd.Task, egress []garden.NetOutRule) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
 	}
 
 	id, netns := netId(task), netNsPath(task)
 
-	_, err := n.client.Setup(ctx, id, netns)
+	result, err := n.client.Setup(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net setup: %w", err)
 	}
 
+	if len(egress) == 0 {
+		return nil
+	}
+
+	ip, err := containerIP(result)
+	if err != nil {
+		return fmt.Errorf("container ip: %w", err)
+	}
+
+	err = n.setupEgressRules(id, ip, egress)
+	if err != nil {
+		return fmt.Errorf("setup egress rules: %w", err)
+	}
+
 	return nil
 }
 
@@ -315,7 +389,12 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	err := n.client.Remove(ctx, id, netns)
+	err := n.removeEgressRules(id)
+	if err != nil {
+		return fmt.Errorf("remove egress rules: %w", err)
+	}
+
+	err = n.client.Remove(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net teardown: %w", err)
 	}
@@ -323,6 +402,191 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+// setupEgressRules restricts the connections that the container with the
+// given address can open to the destinations allowed by `egress`.
+//
+// The rules live in a chain dedicated to the container, which the admin chain
+// jumps to for any traffic originating from the container. Anything that is
+// not explicitly allowed gets logged (with a prefix identifying the
+// container) and rejected.
+//
+func (n cniNetwork) setupEgressRules(id, ip string, egress []garden.NetOutRule) error {
+	const tableName = "filter"
+	chain := egressChainName(id)
+
+	err := n.ipt.CreateChainOrFlushIfExists(tableName, chain)
+	if err != nil {
+		return fmt.Errorf("create chain or flush if exists failed: %w", err)
+	}
+
+	for _, rule := range egress {
+		for _, rulespec := range netOutRuleSpecs(rule) {
+			err = n.ipt.AppendRule(tableName, chain, append(rulespec, "-j", "ACCEPT")...)
+			if err != nil {
+				return fmt.Errorf("appending accept rule failed: %w", err)
+			}
+		}
+	}
+
+	err = n.ipt.AppendRule(tableName, chain,
+		"-m", "limit", "--limit", "10/min",
+		// iptables limits the prefix to 29 characters, which leaves room for
+		// the start of the handle only
+		//
+		"-j", "LOG", "--log-prefix", fmt.Sprintf("egress denied %.8s: ", id),
+	)
+	if err != nil {
+		return fmt.Errorf("appending log rule failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(tableName, chain, "-j", "REJECT")
+	if err != nil {
+		return fmt.Errorf("appending reject rule failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(tableName, ipTablesAdminChainName, "-s", ip+"/32", "-j", chain)
+	if err != nil {
+		return fmt.Errorf("appending jump rule to %s failed: %w", chain, err)
+	}
+
+	return nil
+}
+
+// removeEgressRules removes the chain holding the egress allowlist of a
+// container, if any, along with the rules in the admin chain jumping to it.
+//
+func (n cniNetwork) removeEgressRules(id string) error {
+	const tableName = "filter"
+	chain := egressChainName(id)
+
+	rules, err := n.ipt.ListRules(tableName, ipTablesAdminChainName)
+	if err != nil {
+		return fmt.Errorf("listing rules in %s failed: %w", ipTablesAdminChainName, err)
+	}
+
+	found := false
+	for _, rule := range rules {
+		rulespec, target, ok := parseAppendRule(rule)
+		if !ok || target != chain {
+			continue
+		}
+
+		err = n.ipt.DeleteRule(tableName, ipTablesAdminChainName, rulespec...)
+		if err != nil {
+			return fmt.Errorf("deleting jump rule to %s failed: %w", chain, err)
+		}
+
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+
+	err = n.ipt.DeleteChain(tableName, chain)
+	if err != nil {
+		return fmt.Errorf("deleting chain %s failed: %w", chain, err)
+	}
+
+	return nil
+}
+
+// parseAppendRule parses a rule listed in the format used by `iptables -S`,
+// e.g.
+//
+//	-A CONCOURSE-OPERATOR -s 10.80.0.2/32 -j CONCOURSE-EGRESS-0a1b2c3d
+//
+// returning its rulespec (without the chain) and its target.
+//
+func parseAppendRule(rule string) (rulespec []string, target string, ok bool) {
+	fields := strings.Fields(rule)
+	if len(fields) < 4 || fields[0] != "-A" {
+		return nil, "", false
+	}
+
+	return fields[2:], fields[len(fields)-1], true
+}
+
+// netOutRuleSpecs converts a garden.NetOutRule to the iptables rulespecs
+// (without a target) matching the traffic it allows.
+//
+func netOutRuleSpecs(rule garden.NetOutRule) [][]string {
+	var protocol []string
+	switch rule.Protocol {
+	case garden.ProtocolTCP:
+		protocol = []string{"-p", "tcp"}
+	case garden.ProtocolUDP:
+		protocol = []string{"-p", "udp"}
+	case garden.ProtocolICMP:
+		protocol = []string{"-p", "icmp"}
+	}
+
+	networks := [][]string{nil}
+	if len(rule.Networks) > 0 {
+		networks = nil
+		for _, network := range rule.Networks {
+			if network.End == nil || network.Start.Equal(network.End) {
+				networks = append(networks, []string{"-d", network.Start.String()})
+				continue
+			}
+
+			networks = append(networks, []string{
+				"-m", "iprange", "--dst-range", network.Start.String() + "-" + network.End.String(),
+			})
+		}
+	}
+
+	// ports can only be matched on for protocols that have them
+	//
+	ports := [][]string{nil}
+	if len(rule.Ports) > 0 && (rule.Protocol == garden.ProtocolTCP || rule.Protocol == garden.ProtocolUDP) {
+		ports = nil
+		for _, port := range rule.Ports {
+			ports = append(ports, []string{"--dport", fmt.Sprintf("%d:%d", port.Start, port.End)})
+		}
+	}
+
+	var rulespecs [][]string
+	for _, network := range networks {
+		for _, port := range ports {
+			var rulespec []string
+			rulespec = append(rulespec, protocol...)
+			rulespec = append(rulespec, network...)
+			rulespec = append(rulespec, port...)
+
+			rulespecs = append(rulespecs, rulespec)
+		}
+	}
+
+	return rulespecs
+}
+
+// containerIP retrieves the IPv4 address assigned to the container's
+// interface from the result of setting up its network.
+//
+func containerIP(result *cni.CNIResult) (string, error) {
+	if result == nil {
+		return "", fmt.Errorf("empty cni result")
+	}
+
+	config, found := result.Interfaces[containerInterface]
+	if !found {
+		return "", fmt.Errorf("interface %s not found", containerInterface)
+	}
+
+	for _, ipConfig := range config.IPConfigs {
+		if ipConfig.IP.To4() != nil {
+			return ipConfig.IP.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no ipv4 address assigned to %s", containerInterface)
+}
+
+func egressChainName(id string) string {
+	return fmt.Sprintf("%s%x", egressChainPrefix, sha1.Sum([]byte(id)))[:len(egressChainPrefix)+8]
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
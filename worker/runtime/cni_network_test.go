@@ -3,12 +3,15 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"net"
 	"strings"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/iptables/iptablesfakes"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -21,6 +24,7 @@ type CNINetworkSuite struct {
 	network runtime.Network
 	cni     *runtimefakes.FakeCNI
 	store   *runtimefakes.FakeFileStore
+	ipt     *iptablesfakes.FakeIptables
 }
 
 func (s *CNINetworkSuite) SetupTest() {
@@ -28,11 +32,12 @@ func (s *CNINetworkSuite) SetupTest() {
 
 	s.store = new(runtimefakes.FakeFileStore)
 	s.cni = new(runtimefakes.FakeCNI)
+	s.ipt = new(iptablesfakes.FakeIptables)
 
 	s.network, err = runtime.NewCNINetwork(
 		runtime.WithCNIFileStore(s.store),
 		runtime.WithCNIClient(s.cni),
-		runtime.WithIptables(new(iptablesfakes.FakeIptables)),
+		runtime.WithIptables(s.ipt),
 	)
 	s.NoError(err)
 }
@@ -164,8 +169,56 @@ func (s *CNINetworkSuite) TestSetupRestrictedNetworksCreatesEmptyAdminChain() {
 	s.Equal(rulespec, []string{"-d", "8.8.8.8", "-j", "REJECT"})
 }
 
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksRestoresEgressJumpRules() {
+	fakeIpt := new(iptablesfakes.FakeIptables)
+	fakeIpt.ChainExistsReturns(true, nil)
+	fakeIpt.ListRulesReturns([]string{
+		"-N CONCOURSE-OPERATOR",
+		"-A CONCOURSE-OPERATOR -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT",
+		"-A CONCOURSE-OPERATOR -d 1.1.1.1/32 -j REJECT",
+		"-A CONCOURSE-OPERATOR -s 10.80.0.2/32 -j CONCOURSE-EGRESS-87ea5dfc",
+	}, nil)
+
+	network, err := runtime.NewCNINetwork(
+		runtime.WithRestrictedNetworks([]string{"1.1.1.1"}),
+		runtime.WithIptables(fakeIpt),
+	)
+	s.NoError(err)
+
+	err = network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	tablename, chainName := fakeIpt.ChainExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+
+	s.Equal(1, fakeIpt.CreateChainOrFlushIfExistsCallCount())
+
+	s.Equal(3, fakeIpt.AppendRuleCallCount())
+	tablename, chainName, rulespec := fakeIpt.AppendRuleArgsForCall(2)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-s", "10.80.0.2/32", "-j", "CONCOURSE-EGRESS-87ea5dfc"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksWithoutAdminChainDoesntListRules() {
+	fakeIpt := new(iptablesfakes.FakeIptables)
+	fakeIpt.ChainExistsReturns(false, nil)
+
+	network, err := runtime.NewCNINetwork(
+		runtime.WithIptables(fakeIpt),
+	)
+	s.NoError(err)
+
+	err = network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	s.Equal(0, fakeIpt.ListRulesCallCount())
+	s.Equal(1, fakeIpt.CreateChainOrFlushIfExistsCallCount())
+}
+
 func (s *CNINetworkSuite) TestAddNilTask() {
-	err := s.network.Add(context.Background(), nil)
+	err := s.network.Add(context.Background(), nil, nil)
 	s.EqualError(err, "nil task")
 }
 
@@ -173,7 +226,7 @@ func (s *CNINetworkSuite) TestAddSetupErrors() {
 	s.cni.SetupReturns(nil, errors.New("setup-err"))
 	task := new(libcontainerdfakes.FakeTask)
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, nil)
 	s.EqualError(errors.Unwrap(err), "setup-err")
 }
 
@@ -182,13 +235,99 @@ func (s *CNINetworkSuite) TestAdd() {
 	task.PidReturns(123)
 	task.IDReturns("id")
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, nil)
 	s.NoError(err)
 
 	s.Equal(1, s.cni.SetupCallCount())
 	_, id, netns, _ := s.cni.SetupArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(0, s.ipt.AppendRuleCallCount())
+}
+
+func (s *CNINetworkSuite) TestAddWithEgressWithoutContainerIP() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{"eth0": {}},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Add(context.Background(), task, []garden.NetOutRule{{}})
+	s.EqualError(errors.Unwrap(err), "no ipv4 address assigned to eth0")
+}
+
+func (s *CNINetworkSuite) TestAddWithEgress() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.2")}},
+			},
+		},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	_, cidr, err := net.ParseCIDR("10.0.0.0/8")
+	s.NoError(err)
+
+	err = s.network.Add(context.Background(), task, []garden.NetOutRule{
+		{
+			Protocol: garden.ProtocolTCP,
+			Networks: []garden.IPRange{garden.IPRangeFromIPNet(cidr)},
+			Ports: []garden.PortRange{
+				garden.PortRangeFromPort(443),
+				{Start: 8000, End: 8080},
+			},
+		},
+		{
+			Networks: []garden.IPRange{garden.IPRangeFromIP(net.ParseIP("1.2.3.4"))},
+		},
+	})
+	s.NoError(err)
+
+	tablename, chainName := s.ipt.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-EGRESS-87ea5dfc", chainName)
+
+	s.Equal(6, s.ipt.AppendRuleCallCount())
+
+	for i, expected := range [][]string{
+		{"-p", "tcp", "-m", "iprange", "--dst-range", "10.0.0.0-10.255.255.255", "--dport", "443:443", "-j", "ACCEPT"},
+		{"-p", "tcp", "-m", "iprange", "--dst-range", "10.0.0.0-10.255.255.255", "--dport", "8000:8080", "-j", "ACCEPT"},
+		{"-d", "1.2.3.4", "-j", "ACCEPT"},
+		{"-m", "limit", "--limit", "10/min", "-j", "LOG", "--log-prefix", "egress denied id: "},
+		{"-j", "REJECT"},
+	} {
+		tablename, chainName, rulespec := s.ipt.AppendRuleArgsForCall(i)
+		s.Equal("filter", tablename)
+		s.Equal("CONCOURSE-EGRESS-87ea5dfc", chainName)
+		s.Equal(expected, rulespec)
+	}
+
+	tablename, chainName, rulespec := s.ipt.AppendRuleArgsForCall(5)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-s", "10.80.0.2/32", "-j", "CONCOURSE-EGRESS-87ea5dfc"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestAddWithEgressLogsTheStartOfTheHandle() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.2")}},
+			},
+		},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("3f5c9ad2-2b4e-4c1a-6d7e-0a1b2c3d4e5f")
+
+	err := s.network.Add(context.Background(), task, []garden.NetOutRule{{}})
+	s.NoError(err)
+
+	_, _, rulespec := s.ipt.AppendRuleArgsForCall(1)
+	prefix := rulespec[len(rulespec)-1]
+	s.Equal("egress denied 3f5c9ad2: ", prefix)
+	s.LessOrEqual(len(prefix), 29)
 }
 
 func (s *CNINetworkSuite) TestRemoveNilTask() {
@@ -216,4 +355,34 @@ func (s *CNINetworkSuite) TestRemove() {
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(0, s.ipt.DeleteChainCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveWithEgress() {
+	s.ipt.ListRulesReturns([]string{
+		"-N CONCOURSE-OPERATOR",
+		"-A CONCOURSE-OPERATOR -d 1.1.1.1/32 -j REJECT",
+		"-A CONCOURSE-OPERATOR -s 10.80.0.2/32 -j CONCOURSE-EGRESS-87ea5dfc",
+		"-A CONCOURSE-OPERATOR -s 10.80.0.3/32 -j CONCOURSE-EGRESS-aaaaaaaa",
+	}, nil)
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(1, s.ipt.DeleteRuleCallCount())
+	tablename, chainName, rulespec := s.ipt.DeleteRuleArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-s", "10.80.0.2/32", "-j", "CONCOURSE-EGRESS-87ea5dfc"}, rulespec)
+
+	s.Equal(1, s.ipt.DeleteChainCallCount())
+	tablename, chainName = s.ipt.DeleteChainArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-EGRESS-87ea5dfc", chainName)
+
+	s.Equal(1, s.cni.RemoveCallCount())
 }
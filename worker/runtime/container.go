@@ -1,14 +1,21 @@
 package runtime
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/typeurl"
 	uuid "github.com/nu7hatch/gouuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -27,17 +34,20 @@ type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	oomDetector   OOMDetector
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	oomDetector OOMDetector,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		oomDetector:   oomDetector,
 	}
 }
 
@@ -181,9 +191,37 @@ func (c *Container) Properties() (garden.Properties, error) {
 	return labels, nil
 }
 
-// Property returns the value of the property with the specified name.
+// oomKills counts the processes of the container that were killed for running
+// out of memory so far.
+//
+func (c *Container) oomKills() (string, error) {
+	task, err := c.container.Task(context.Background(), nil)
+	if err != nil {
+		return "", fmt.Errorf("task: %w", err)
+	}
+
+	kills, err := c.oomDetector.OOMKills(task)
+	if err != nil {
+		return "", fmt.Errorf("oom detection: %w", err)
+	}
+
+	return strconv.FormatUint(kills, 10), nil
+}
+
+// Property returns the value of the property with the specified name. The
+// usage and OOM kill properties are computed from the cgroup of the task
+// instead.
 //
 func (c *Container) Property(name string) (string, error) {
+	switch name {
+	case atc.ContainerPropertyMemoryPeak,
+		atc.ContainerPropertyBlockIORead,
+		atc.ContainerPropertyBlockIOWrite:
+		return c.usageProperty(name)
+	case atc.ContainerPropertyOOMKills:
+		return c.oomKills()
+	}
+
 	properties, err := c.Properties()
 	if err != nil {
 		return "", err
@@ -224,9 +262,211 @@ func (c *Container) Info() (info garden.ContainerInfo, err error) {
 	return
 }
 
-// Metrics - Not Implemented
+// Metrics retrieves the resources consumed by the container, as accounted by
+// the cgroup of its task.
+//
 func (c *Container) Metrics() (metrics garden.Metrics, err error) {
-	err = ErrNotImplemented
+	ctx := context.Background()
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("task lookup: %w", err)
+		return
+	}
+
+	stats, err := taskStats(ctx, task)
+	if err != nil {
+		return
+	}
+
+	metrics = cgroupMetrics(stats)
+
+	// the traffic of the network namespace isn't accounted by cgroups; not
+	// being able to read it shouldn't prevent reporting the rest.
+	//
+	netStat, netErr := networkStat(task.Pid())
+	if netErr == nil {
+		metrics.NetworkStat = netStat
+	}
+
+	return
+}
+
+func taskStats(ctx context.Context, task containerd.Task) (*v1.Metrics, error) {
+	metric, err := task.Metrics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("task metrics: %w", err)
+	}
+
+	data, err := typeurl.UnmarshalAny(metric.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal metrics: %w", err)
+	}
+
+	stats, ok := data.(*v1.Metrics)
+	if !ok {
+		return nil, fmt.Errorf("unexpected metrics type %T", data)
+	}
+
+	return stats, nil
+}
+
+// usageProperty computes one of the properties that report the usage which
+// the cgroup of the task accounted over its whole life.
+//
+func (c *Container) usageProperty(name string) (string, error) {
+	ctx := context.Background()
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("task lookup: %w", err)
+	}
+
+	stats, err := taskStats(ctx, task)
+	if err != nil {
+		return "", err
+	}
+
+	var value uint64
+	switch name {
+	case atc.ContainerPropertyMemoryPeak:
+		if stats.Memory != nil && stats.Memory.Usage != nil {
+			value = stats.Memory.Usage.Max
+		}
+	case atc.ContainerPropertyBlockIORead:
+		value = blockIOBytes(stats, "read")
+	case atc.ContainerPropertyBlockIOWrite:
+		value = blockIOBytes(stats, "write")
+	}
+
+	return strconv.FormatUint(value, 10), nil
+}
+
+// blockIOBytes sums the bytes transferred by the operation across devices.
+//
+func blockIOBytes(stats *v1.Metrics, op string) uint64 {
+	if stats.Blkio == nil {
+		return 0
+	}
+
+	var total uint64
+	for _, entry := range stats.Blkio.IoServiceBytesRecursive {
+		if strings.EqualFold(entry.Op, op) {
+			total += entry.Value
+		}
+	}
+
+	return total
+}
+
+func cgroupMetrics(stats *v1.Metrics) garden.Metrics {
+	var metrics garden.Metrics
+
+	if stats.CPU != nil && stats.CPU.Usage != nil {
+		metrics.CPUStat = garden.ContainerCPUStat{
+			Usage:  stats.CPU.Usage.Total,
+			User:   stats.CPU.Usage.User,
+			System: stats.CPU.Usage.Kernel,
+		}
+	}
+
+	if stats.Memory != nil {
+		memory := stats.Memory
+
+		var usage uint64
+		if memory.Usage != nil {
+			usage = memory.Usage.Usage
+		}
+
+		// mirrors the way the kernel accounts memory towards the limit when
+		// deciding to reclaim or OOM kill: inactive file pages are the first
+		// to be evicted.
+		//
+		usageTowardLimit := usage
+		if memory.TotalInactiveFile < usageTowardLimit {
+			usageTowardLimit -= memory.TotalInactiveFile
+		}
+
+		metrics.MemoryStat = garden.ContainerMemoryStat{
+			ActiveAnon:              memory.ActiveAnon,
+			ActiveFile:              memory.ActiveFile,
+			Cache:                   memory.Cache,
+			HierarchicalMemoryLimit: memory.HierarchicalMemoryLimit,
+			InactiveAnon:            memory.InactiveAnon,
+			InactiveFile:            memory.InactiveFile,
+			MappedFile:              memory.MappedFile,
+			Rss:                     memory.RSS,
+			TotalCache:              memory.TotalCache,
+			TotalInactiveFile:       memory.TotalInactiveFile,
+			TotalRss:                memory.TotalRSS,
+			TotalUsageTowardLimit:   usageTowardLimit,
+		}
+	}
+
+	if stats.Pids != nil {
+		metrics.PidStat = garden.ContainerPidStat{
+			Current: stats.Pids.Current,
+			Max:     stats.Pids.Limit,
+		}
+	}
+
+	return metrics
+}
+
+// networkStat reads the traffic that went through the network interface of
+// the network namespace a process belongs to.
+//
+func networkStat(pid uint32) (stat garden.ContainerNetworkStat, err error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	return parseNetDev(f, containerInterface)
+}
+
+// parseNetDev parses the statistics of the interface `iface` out of the
+// contents of `/proc/<pid>/net/dev`.
+//
+func parseNetDev(r io.Reader, iface string) (stat garden.ContainerNetworkStat, err error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) != iface {
+			continue
+		}
+
+		// receive and transmit columns are 8 fields each, starting with the
+		// amount of bytes.
+		//
+		fields := strings.Fields(parts[1])
+		if len(fields) < 9 {
+			err = fmt.Errorf("malformed statistics for %s", iface)
+			return
+		}
+
+		stat.RxBytes, err = strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			err = fmt.Errorf("parse received bytes: %w", err)
+			return
+		}
+
+		stat.TxBytes, err = strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			err = fmt.Errorf("parse transmitted bytes: %w", err)
+			return
+		}
+
+		return
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return
+	}
+
+	err = fmt.Errorf("interface %s not found", iface)
 	return
 }
 
@@ -4,10 +4,14 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/typeurl"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +27,7 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *runtimefakes.FakeRootfsManager
 	killer              *runtimefakes.FakeKiller
+	oomDetector         *runtimefakes.FakeOOMDetector
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -31,11 +36,13 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(runtimefakes.FakeRootfsManager)
 	s.killer = new(runtimefakes.FakeKiller)
+	s.oomDetector = new(runtimefakes.FakeOOMDetector)
 
 	s.container = runtime.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.oomDetector,
 	)
 }
 
@@ -294,6 +301,45 @@ func (s *ContainerSuite) TestPropertyReturnsValue() {
 	s.Equal("some-value", result)
 }
 
+func (s *ContainerSuite) TestPropertyCountsOOMKills() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.oomDetector.OOMKillsReturns(2, nil)
+
+	kills, err := s.container.Property(atc.ContainerPropertyOOMKills)
+	s.NoError(err)
+	s.Equal("2", kills)
+
+	s.Equal(s.containerdTask, s.oomDetector.OOMKillsArgsForCall(0))
+	s.Equal(0, s.containerdContainer.LabelsCallCount())
+}
+
+func (s *ContainerSuite) TestPropertyWithoutOOMKills() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.oomDetector.OOMKillsReturns(0, nil)
+
+	kills, err := s.container.Property(atc.ContainerPropertyOOMKills)
+	s.NoError(err)
+	s.Equal("0", kills)
+}
+
+func (s *ContainerSuite) TestPropertyOOMDetectionFails() {
+	expectedErr := errors.New("no cgroup")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.oomDetector.OOMKillsReturns(0, expectedErr)
+
+	_, err := s.container.Property(atc.ContainerPropertyOOMKills)
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestPropertiesDoesntDetectOOMKills() {
+	s.containerdContainer.LabelsReturns(garden.Properties{"any": "some-value"}, nil)
+
+	properties, err := s.container.Properties()
+	s.NoError(err)
+	s.Equal(garden.Properties{"any": "some-value"}, properties)
+	s.Equal(0, s.oomDetector.OOMKillsCallCount())
+}
+
 func (s *ContainerSuite) TestCurrentCPULimitsGetInfoFails() {
 	expectedErr := errors.New("get-spec-error")
 	s.containerdContainer.SpecReturns(nil, expectedErr)
@@ -365,3 +411,102 @@ func (s *ContainerSuite) TestCurrentMemoryLimitsReturnsLimit() {
 	s.NoError(err)
 	s.Equal(garden.MemoryLimits{LimitInBytes: uint64(limitBytes)}, limits)
 }
+
+func (s *ContainerSuite) TestMetricsTaskLookupError() {
+	s.containerdContainer.TaskReturns(nil, errors.New("task-err"))
+
+	_, err := s.container.Metrics()
+	s.Error(err)
+	s.EqualError(errors.Unwrap(err), "task-err")
+}
+
+func (s *ContainerSuite) TestMetricsTaskMetricsError() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(nil, errors.New("metrics-err"))
+
+	_, err := s.container.Metrics()
+	s.Error(err)
+	s.EqualError(errors.Unwrap(err), "metrics-err")
+}
+
+func (s *ContainerSuite) TestMetricsFromCgroup() {
+	data, err := typeurl.MarshalAny(&v1.Metrics{
+		CPU: &v1.CPUStat{
+			Usage: &v1.CPUUsage{Total: 3000, User: 2000, Kernel: 1000},
+		},
+		Memory: &v1.MemoryStat{
+			Cache:                   100,
+			RSS:                     200,
+			TotalInactiveFile:       50,
+			HierarchicalMemoryLimit: 1024,
+			Usage:                   &v1.MemoryEntry{Usage: 300},
+		},
+		Pids: &v1.PidsStat{Current: 3, Limit: 10},
+	})
+	s.NoError(err)
+
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(&types.Metric{Data: data}, nil)
+
+	metrics, err := s.container.Metrics()
+	s.NoError(err)
+
+	s.Equal(garden.ContainerCPUStat{Usage: 3000, User: 2000, System: 1000}, metrics.CPUStat)
+	s.Equal(garden.ContainerPidStat{Current: 3, Max: 10}, metrics.PidStat)
+	s.Equal(uint64(250), metrics.MemoryStat.TotalUsageTowardLimit)
+	s.Equal(uint64(200), metrics.MemoryStat.Rss)
+	s.Equal(uint64(100), metrics.MemoryStat.Cache)
+	s.Equal(uint64(1024), metrics.MemoryStat.HierarchicalMemoryLimit)
+}
+
+func (s *ContainerSuite) TestMetricsUnexpectedType() {
+	data, err := typeurl.MarshalAny(&v1.PidsStat{Current: 3})
+	s.NoError(err)
+
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(&types.Metric{Data: data}, nil)
+
+	_, err = s.container.Metrics()
+	s.EqualError(err, "unexpected metrics type *v1.PidsStat")
+}
+
+func (s *ContainerSuite) TestPropertyReportsLifetimeUsageFromCgroup() {
+	data, err := typeurl.MarshalAny(&v1.Metrics{
+		Memory: &v1.MemoryStat{
+			Usage: &v1.MemoryEntry{Usage: 300, Max: 4096},
+		},
+		Blkio: &v1.BlkIOStat{
+			IoServiceBytesRecursive: []*v1.BlkIOEntry{
+				{Major: 8, Minor: 0, Op: "Read", Value: 100},
+				{Major: 8, Minor: 16, Op: "Read", Value: 20},
+				{Major: 8, Minor: 0, Op: "Write", Value: 50},
+				{Major: 8, Minor: 0, Op: "Total", Value: 150},
+			},
+		},
+	})
+	s.NoError(err)
+
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(&types.Metric{Data: data}, nil)
+
+	peak, err := s.container.Property(atc.ContainerPropertyMemoryPeak)
+	s.NoError(err)
+	s.Equal("4096", peak)
+
+	read, err := s.container.Property(atc.ContainerPropertyBlockIORead)
+	s.NoError(err)
+	s.Equal("120", read)
+
+	written, err := s.container.Property(atc.ContainerPropertyBlockIOWrite)
+	s.NoError(err)
+	s.Equal("50", written)
+
+	s.Equal(0, s.containerdContainer.LabelsCallCount())
+}
+
+func (s *ContainerSuite) TestPropertyUsageTaskLookupError() {
+	s.containerdContainer.TaskReturns(nil, errors.New("task-err"))
+
+	_, err := s.container.Property(atc.ContainerPropertyMemoryPeak)
+	s.EqualError(errors.Unwrap(err), "task-err")
+}
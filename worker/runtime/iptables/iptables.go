@@ -9,6 +9,10 @@ import (
 type Iptables interface {
 	CreateChainOrFlushIfExists(table string, chain string) error
 	AppendRule(table string, chain string, rulespec ...string) error
+	DeleteRule(table string, chain string, rulespec ...string) error
+	ListRules(table string, chain string) ([]string, error)
+	ChainExists(table string, chain string) (bool, error)
+	DeleteChain(table string, chain string) error
 }
 
 type iptables struct {
@@ -38,4 +42,40 @@ func (ipt *iptables) CreateChainOrFlushIfExists(table string, chain string) erro
 func (ipt *iptables) AppendRule(table string, chain string, rulespec ...string) error {
 	err := ipt.goipt.Append(table, chain, rulespec...)
 	return err
-}
\ No newline at end of file
+}
+
+func (ipt *iptables) DeleteRule(table string, chain string, rulespec ...string) error {
+	err := ipt.goipt.Delete(table, chain, rulespec...)
+	return err
+}
+
+func (ipt *iptables) ListRules(table string, chain string) ([]string, error) {
+	return ipt.goipt.List(table, chain)
+}
+
+func (ipt *iptables) ChainExists(table string, chain string) (bool, error) {
+	chains, err := ipt.goipt.ListChains(table)
+	if err != nil {
+		return false, err
+	}
+
+	for _, existing := range chains {
+		if existing == chain {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// DeleteChain flushes all the rules in a chain and removes it. The chain must
+// not be referenced by any rule in other chains.
+func (ipt *iptables) DeleteChain(table string, chain string) error {
+	err := ipt.goipt.ClearChain(table, chain)
+	if err != nil {
+		return err
+	}
+
+	err = ipt.goipt.DeleteChain(table, chain)
+	return err
+}
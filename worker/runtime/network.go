@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -20,9 +21,10 @@ type Network interface {
 	//
 	SetupRestrictedNetworks() (err error)
 
-	// Add adds a task to the network.
+	// Add adds a task to the network, restricting the connections it can
+	// open to the destinations allowed by `egress` (if any).
 	//
-	Add(ctx context.Context, task containerd.Task) (err error)
+	Add(ctx context.Context, task containerd.Task, egress []garden.NetOutRule) (err error)
 
 	// Removes a task from the network.
 	//
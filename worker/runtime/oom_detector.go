@@ -0,0 +1,165 @@
+package runtime
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . OOMDetector
+
+// OOMDetector counts the processes of a task that were killed for running out
+// of memory.
+//
+type OOMDetector interface {
+	// OOMKills counts how many times the kernel killed processes in the
+	// memory cgroup of the task for exceeding its limit. The count is a total
+	// over the life of the cgroup, so it never goes down.
+	//
+	OOMKills(task containerd.Task) (uint64, error)
+}
+
+// oomDetector detects OOM kills through the counters that the kernel
+// maintains for each memory cgroup.
+//
+type oomDetector struct {
+	procRoot   string
+	cgroupRoot string
+}
+
+// OOMDetectorOpt is a functional option that modifies the behavior of an
+// oomDetector.
+//
+type OOMDetectorOpt func(d *oomDetector)
+
+// WithProcRoot configures where the proc filesystem is mounted.
+//
+func WithProcRoot(path string) OOMDetectorOpt {
+	return func(d *oomDetector) {
+		d.procRoot = path
+	}
+}
+
+// WithCgroupRoot configures where the cgroup filesystem is mounted.
+//
+func WithCgroupRoot(path string) OOMDetectorOpt {
+	return func(d *oomDetector) {
+		d.cgroupRoot = path
+	}
+}
+
+func NewOOMDetector(opts ...OOMDetectorOpt) *oomDetector {
+	d := &oomDetector{
+		procRoot:   "/proc",
+		cgroupRoot: "/sys/fs/cgroup",
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// OOMKills looks up the memory cgroup of the init process of the task and
+// reads how many times the OOM killer acted in it.
+//
+// With cgroups v1, the counter is exposed in `memory.oom_control`, while with
+// the unified hierarchy, it's exposed in `memory.events`.
+//
+func (d oomDetector) OOMKills(task containerd.Task) (uint64, error) {
+	f, err := os.Open(filepath.Join(d.procRoot, strconv.Itoa(int(task.Pid())), "cgroup"))
+	if err != nil {
+		return 0, fmt.Errorf("open cgroup: %w", err)
+	}
+	defer f.Close()
+
+	cgroup, unified, err := MemoryCgroup(f)
+	if err != nil {
+		return 0, fmt.Errorf("memory cgroup: %w", err)
+	}
+
+	counters := filepath.Join(d.cgroupRoot, "memory", cgroup, "memory.oom_control")
+	if unified {
+		counters = filepath.Join(d.cgroupRoot, cgroup, "memory.events")
+	}
+
+	f, err = os.Open(counters)
+	if err != nil {
+		return 0, fmt.Errorf("open oom counters: %w", err)
+	}
+	defer f.Close()
+
+	kills, err := FlatKeyedValue(f, "oom_kill")
+	if err != nil {
+		return 0, fmt.Errorf("oom kill counter: %w", err)
+	}
+
+	return kills, nil
+}
+
+// MemoryCgroup parses the contents of `/proc/<pid>/cgroup`, returning the path
+// of the cgroup that accounts for the memory of the process, and whether it is
+// part of the unified (cgroups v2) hierarchy.
+//
+func MemoryCgroup(r io.Reader) (path string, unified bool, err error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		//
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		if parts[0] == "0" && parts[1] == "" {
+			path, unified = parts[2], true
+			continue
+		}
+
+		for _, controller := range strings.Split(parts[1], ",") {
+			if controller == "memory" {
+				return parts[2], false, nil
+			}
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return
+	}
+
+	if !unified {
+		err = fmt.Errorf("no memory controller found")
+	}
+
+	return
+}
+
+// FlatKeyedValue parses the value of `key` out of a cgroup file in the flat
+// keyed format, i.e., made of lines of space separated key-value pairs.
+//
+func FlatKeyedValue(r io.Reader, key string) (uint64, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != key {
+			continue
+		}
+
+		return strconv.ParseUint(fields[1], 10, 64)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("key %s not found", key)
+}
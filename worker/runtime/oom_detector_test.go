@@ -0,0 +1,144 @@
+package runtime_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type OOMDetectorSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	root string
+	task *libcontainerdfakes.FakeTask
+}
+
+func (s *OOMDetectorSuite) SetupTest() {
+	var err error
+
+	s.root, err = ioutil.TempDir("", "oom-detector")
+	s.NoError(err)
+
+	s.task = new(libcontainerdfakes.FakeTask)
+	s.task.PidReturns(123)
+}
+
+func (s *OOMDetectorSuite) TearDownTest() {
+	os.RemoveAll(s.root)
+}
+
+func (s *OOMDetectorSuite) writeFile(path, contents string) {
+	path = filepath.Join(s.root, path)
+
+	s.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.NoError(ioutil.WriteFile(path, []byte(contents), 0644))
+}
+
+func (s *OOMDetectorSuite) detector() runtime.OOMDetector {
+	return runtime.NewOOMDetector(
+		runtime.WithProcRoot(filepath.Join(s.root, "proc")),
+		runtime.WithCgroupRoot(filepath.Join(s.root, "cgroup")),
+	)
+}
+
+func (s *OOMDetectorSuite) TestOOMKilledCgroupsV1() {
+	s.writeFile("proc/123/cgroup", "5:pids:/garden/handle\n4:memory:/garden/handle\n0::/\n")
+	s.writeFile("cgroup/memory/garden/handle/memory.oom_control", "oom_kill_disable 0\nunder_oom 0\noom_kill 1\n")
+
+	kills, err := s.detector().OOMKills(s.task)
+	s.NoError(err)
+	s.Equal(uint64(1), kills)
+}
+
+func (s *OOMDetectorSuite) TestNotOOMKilledCgroupsV1() {
+	s.writeFile("proc/123/cgroup", "4:memory:/garden/handle\n")
+	s.writeFile("cgroup/memory/garden/handle/memory.oom_control", "oom_kill_disable 0\nunder_oom 0\noom_kill 0\n")
+
+	kills, err := s.detector().OOMKills(s.task)
+	s.NoError(err)
+	s.Zero(kills)
+}
+
+func (s *OOMDetectorSuite) TestOOMKilledCgroupsV2() {
+	s.writeFile("proc/123/cgroup", "0::/garden/handle\n")
+	s.writeFile("cgroup/garden/handle/memory.events", "low 0\nhigh 0\nmax 4\noom 2\noom_kill 2\n")
+
+	kills, err := s.detector().OOMKills(s.task)
+	s.NoError(err)
+	s.Equal(uint64(2), kills)
+}
+
+func (s *OOMDetectorSuite) TestOOMKilledWithoutCgroup() {
+	_, err := s.detector().OOMKills(s.task)
+	s.Error(err)
+}
+
+func (s *OOMDetectorSuite) TestMemoryCgroup() {
+	for _, tc := range []struct {
+		desc      string
+		input     string
+		shouldErr bool
+		path      string
+		unified   bool
+	}{
+		{
+			desc:      "empty input",
+			shouldErr: true,
+		},
+		{
+			desc:      "no memory controller",
+			input:     "5:pids:/garden/handle\n",
+			shouldErr: true,
+		},
+		{
+			desc:  "memory controller",
+			input: "5:pids:/garden/handle\n4:memory:/garden/handle\n",
+			path:  "/garden/handle",
+		},
+		{
+			desc:  "co-mounted controllers",
+			input: "4:cpu,memory:/garden/handle\n",
+			path:  "/garden/handle",
+		},
+		{
+			desc:    "unified hierarchy",
+			input:   "0::/garden/handle\n",
+			path:    "/garden/handle",
+			unified: true,
+		},
+		{
+			desc:  "hybrid hierarchy",
+			input: "0::/\n4:memory:/garden/handle\n",
+			path:  "/garden/handle",
+		},
+	} {
+		s.T().Run(tc.desc, func(t *testing.T) {
+			path, unified, err := runtime.MemoryCgroup(bytes.NewBufferString(tc.input))
+			if tc.shouldErr {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(tc.path, path)
+			s.Equal(tc.unified, unified)
+		})
+	}
+}
+
+func (s *OOMDetectorSuite) TestFlatKeyedValue() {
+	value, err := runtime.FlatKeyedValue(bytes.NewBufferString("oom 3\noom_kill 2\n"), "oom_kill")
+	s.NoError(err)
+	s.Equal(uint64(2), value)
+
+	_, err = runtime.FlatKeyedValue(bytes.NewBufferString("oom 3\n"), "oom_kill")
+	s.Error(err)
+}
@@ -5,17 +5,19 @@ import (
 	"context"
 	"sync"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/containerd/containerd"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 type FakeNetwork struct {
-	AddStub        func(context.Context, containerd.Task) error
+	AddStub        func(context.Context, containerd.Task, []garden.NetOutRule) error
 	addMutex       sync.RWMutex
 	addArgsForCall []struct {
 		arg1 context.Context
 		arg2 containerd.Task
+		arg3 []garden.NetOutRule
 	}
 	addReturns struct {
 		result1 error
@@ -62,17 +64,23 @@ type FakeNetwork struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeNetwork) Add(arg1 context.Context, arg2 containerd.Task) error {
+func (fake *FakeNetwork) Add(arg1 context.Context, arg2 containerd.Task, arg3 []garden.NetOutRule) error {
+	var arg3Copy []garden.NetOutRule
+	if arg3 != nil {
+		arg3Copy = make([]garden.NetOutRule, len(arg3))
+		copy(arg3Copy, arg3)
+	}
 	fake.addMutex.Lock()
 	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
 	fake.addArgsForCall = append(fake.addArgsForCall, struct {
 		arg1 context.Context
 		arg2 containerd.Task
-	}{arg1, arg2})
-	fake.recordInvocation("Add", []interface{}{arg1, arg2})
+		arg3 []garden.NetOutRule
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("Add", []interface{}{arg1, arg2, arg3Copy})
 	fake.addMutex.Unlock()
 	if fake.AddStub != nil {
-		return fake.AddStub(arg1, arg2)
+		return fake.AddStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -87,17 +95,17 @@ func (fake *FakeNetwork) AddCallCount() int {
 	return len(fake.addArgsForCall)
 }
 
-func (fake *FakeNetwork) AddCalls(stub func(context.Context, containerd.Task) error) {
+func (fake *FakeNetwork) AddCalls(stub func(context.Context, containerd.Task, []garden.NetOutRule) error) {
 	fake.addMutex.Lock()
 	defer fake.addMutex.Unlock()
 	fake.AddStub = stub
 }
 
-func (fake *FakeNetwork) AddArgsForCall(i int) (context.Context, containerd.Task) {
+func (fake *FakeNetwork) AddArgsForCall(i int) (context.Context, containerd.Task, []garden.NetOutRule) {
 	fake.addMutex.RLock()
 	defer fake.addMutex.RUnlock()
 	argsForCall := fake.addArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeNetwork) AddReturns(result1 error) {
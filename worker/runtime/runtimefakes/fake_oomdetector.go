@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package runtimefakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/containerd/containerd"
+)
+
+type FakeOOMDetector struct {
+	OOMKillsStub        func(containerd.Task) (uint64, error)
+	oOMKillsMutex       sync.RWMutex
+	oOMKillsArgsForCall []struct {
+		arg1 containerd.Task
+	}
+	oOMKillsReturns struct {
+		result1 uint64
+		result2 error
+	}
+	oOMKillsReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOOMDetector) OOMKills(arg1 containerd.Task) (uint64, error) {
+	fake.oOMKillsMutex.Lock()
+	ret, specificReturn := fake.oOMKillsReturnsOnCall[len(fake.oOMKillsArgsForCall)]
+	fake.oOMKillsArgsForCall = append(fake.oOMKillsArgsForCall, struct {
+		arg1 containerd.Task
+	}{arg1})
+	fake.recordInvocation("OOMKills", []interface{}{arg1})
+	fake.oOMKillsMutex.Unlock()
+	if fake.OOMKillsStub != nil {
+		return fake.OOMKillsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.oOMKillsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOOMDetector) OOMKillsCallCount() int {
+	fake.oOMKillsMutex.RLock()
+	defer fake.oOMKillsMutex.RUnlock()
+	return len(fake.oOMKillsArgsForCall)
+}
+
+func (fake *FakeOOMDetector) OOMKillsCalls(stub func(containerd.Task) (uint64, error)) {
+	fake.oOMKillsMutex.Lock()
+	defer fake.oOMKillsMutex.Unlock()
+	fake.OOMKillsStub = stub
+}
+
+func (fake *FakeOOMDetector) OOMKillsArgsForCall(i int) containerd.Task {
+	fake.oOMKillsMutex.RLock()
+	defer fake.oOMKillsMutex.RUnlock()
+	argsForCall := fake.oOMKillsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOOMDetector) OOMKillsReturns(result1 uint64, result2 error) {
+	fake.oOMKillsMutex.Lock()
+	defer fake.oOMKillsMutex.Unlock()
+	fake.OOMKillsStub = nil
+	fake.oOMKillsReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOOMDetector) OOMKillsReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.oOMKillsMutex.Lock()
+	defer fake.oOMKillsMutex.Unlock()
+	fake.OOMKillsStub = nil
+	if fake.oOMKillsReturnsOnCall == nil {
+		fake.oOMKillsReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.oOMKillsReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOOMDetector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.oOMKillsMutex.RLock()
+	defer fake.oOMKillsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOOMDetector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ runtime.OOMDetector = new(FakeOOMDetector)
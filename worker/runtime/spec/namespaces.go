@@ -23,3 +23,20 @@ func OciNamespaces(privileged bool) []specs.LinuxNamespace {
 
 	return PrivilegedContainerNamespaces
 }
+
+// OciNamespacesWithNetwork returns a copy of `namespaces` where the network
+// namespace is the existing one at `path` rather than a new one.
+//
+func OciNamespacesWithNetwork(namespaces []specs.LinuxNamespace, path string) []specs.LinuxNamespace {
+	result := make([]specs.LinuxNamespace, len(namespaces))
+
+	for i, namespace := range namespaces {
+		if namespace.Type == specs.NetworkNamespace {
+			namespace.Path = path
+		}
+
+		result[i] = namespace
+	}
+
+	return result
+}
@@ -207,6 +207,17 @@ func (s *SpecSuite) TestOciNamespaces() {
 	}
 }
 
+func (s *SpecSuite) TestOciNamespacesWithNetwork() {
+	namespaces := spec.OciNamespacesWithNetwork(spec.UnprivilegedContainerNamespaces, "/proc/123/ns/net")
+
+	s.Len(namespaces, len(spec.UnprivilegedContainerNamespaces))
+	s.Contains(namespaces, specs.LinuxNamespace{Type: specs.NetworkNamespace, Path: "/proc/123/ns/net"})
+	s.Contains(namespaces, specs.LinuxNamespace{Type: specs.UserNamespace})
+
+	// the defaults must be left untouched
+	s.Contains(spec.UnprivilegedContainerNamespaces, specs.LinuxNamespace{Type: specs.NetworkNamespace})
+}
+
 func (s *SpecSuite) TestOciCapabilities() {
 	for _, tc := range []struct {
 		desc       string
@@ -48,6 +48,8 @@ type WorkerCommand struct {
 
 	ConnectionDrainTimeout time.Duration `long:"connection-drain-timeout" default:"1h" description:"Duration after which a worker should give up draining forwarded connections on shutdown."`
 
+	LandDrainTimeout time.Duration `long:"land-drain-timeout" description:"Duration after which a landing worker is landed even if builds are still running on it. By default, it waits for them indefinitely."`
+
 	RuntimeConfiguration `group:"Runtime Configuration"`
 
 	// This refers to flags relevant to the operation of the Guardian runtime.
@@ -107,6 +109,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		tsaClient,
 		cmd.RebalanceInterval,
 		cmd.ConnectionDrainTimeout,
+		cmd.LandDrainTimeout,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
 	)
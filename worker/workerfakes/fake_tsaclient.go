@@ -4,6 +4,7 @@ package workerfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
@@ -34,10 +35,11 @@ type FakeTSAClient struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	LandStub        func(context.Context) error
+	LandStub        func(context.Context, time.Duration) error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
 		arg1 context.Context
+		arg2 time.Duration
 	}
 	landReturns struct {
 		result1 error
@@ -232,16 +234,17 @@ func (fake *FakeTSAClient) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTSAClient) Land(arg1 context.Context) error {
+func (fake *FakeTSAClient) Land(arg1 context.Context, arg2 time.Duration) error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
 	fake.landArgsForCall = append(fake.landArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	fake.recordInvocation("Land", []interface{}{arg1})
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("Land", []interface{}{arg1, arg2})
 	fake.landMutex.Unlock()
 	if fake.LandStub != nil {
-		return fake.LandStub(arg1)
+		return fake.LandStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -256,17 +259,17 @@ func (fake *FakeTSAClient) LandCallCount() int {
 	return len(fake.landArgsForCall)
 }
 
-func (fake *FakeTSAClient) LandCalls(stub func(context.Context) error) {
+func (fake *FakeTSAClient) LandCalls(stub func(context.Context, time.Duration) error) {
 	fake.landMutex.Lock()
 	defer fake.landMutex.Unlock()
 	fake.LandStub = stub
 }
 
-func (fake *FakeTSAClient) LandArgsForCall(i int) context.Context {
+func (fake *FakeTSAClient) LandArgsForCall(i int) (context.Context, time.Duration) {
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
 	argsForCall := fake.landArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTSAClient) LandReturns(result1 error) {